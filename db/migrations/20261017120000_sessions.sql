@@ -0,0 +1,19 @@
+-- migrate:up
+CREATE TABLE session (
+    id TEXT PRIMARY KEY NOT NULL,
+    refresh_hash BLOB NOT NULL,
+    previous_refresh_hash BLOB,
+    created_at DATETIME NOT NULL,
+    last_seen DATETIME NOT NULL,
+    rotated_at DATETIME NOT NULL,
+    expires_at DATETIME NOT NULL,
+    revoked_at DATETIME,
+    user_agent TEXT NOT NULL,
+    ip TEXT NOT NULL,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (user_id) REFERENCES user (id)
+);
+
+-- migrate:down
+DROP TABLE session;
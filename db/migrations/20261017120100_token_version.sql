@@ -0,0 +1,5 @@
+-- migrate:up
+ALTER TABLE user ADD token_version INTEGER NOT NULL DEFAULT 0;
+
+-- migrate:down
+ALTER TABLE user DROP COLUMN token_version;
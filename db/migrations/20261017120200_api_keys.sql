@@ -0,0 +1,17 @@
+-- migrate:up
+CREATE TABLE api_key (
+    id INTEGER PRIMARY KEY NOT NULL,
+    name TEXT NOT NULL,
+    prefix TEXT NOT NULL UNIQUE,
+    hash BLOB NOT NULL,
+    scopes TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    expires_at DATETIME,
+    last_used DATETIME,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (user_id) REFERENCES user (id)
+);
+
+-- migrate:down
+DROP TABLE api_key;
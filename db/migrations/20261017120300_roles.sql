@@ -0,0 +1,10 @@
+-- migrate:up
+ALTER TABLE user ADD role TEXT NOT NULL DEFAULT 'user';
+ALTER TABLE user ADD disabled_at DATETIME;
+ALTER TABLE user ADD password_reset_required BOOLEAN NOT NULL DEFAULT FALSE;
+UPDATE user SET role = 'admin' WHERE id = (SELECT MIN(id) FROM user);
+
+-- migrate:down
+ALTER TABLE user DROP COLUMN role;
+ALTER TABLE user DROP COLUMN disabled_at;
+ALTER TABLE user DROP COLUMN password_reset_required;
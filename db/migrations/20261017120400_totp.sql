@@ -0,0 +1,28 @@
+-- migrate:up
+ALTER TABLE user ADD totp_secret TEXT;
+ALTER TABLE user ADD totp_enabled BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE user ADD totp_last_step INTEGER NOT NULL DEFAULT 0;
+CREATE TABLE recovery_code (
+    id INTEGER PRIMARY KEY NOT NULL,
+    hash BLOB NOT NULL,
+    used_at DATETIME,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (user_id) REFERENCES user (id)
+);
+CREATE TABLE login_challenge (
+    id TEXT PRIMARY KEY NOT NULL,
+    failures INTEGER NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL,
+    expires_at DATETIME NOT NULL,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
+);
+
+-- migrate:down
+ALTER TABLE user DROP COLUMN totp_secret;
+ALTER TABLE user DROP COLUMN totp_enabled;
+ALTER TABLE user DROP COLUMN totp_last_step;
+DROP TABLE recovery_code;
+DROP TABLE login_challenge;
@@ -0,0 +1,5 @@
+-- migrate:up
+ALTER TABLE credential ADD name TEXT NOT NULL DEFAULT '';
+
+-- migrate:down
+ALTER TABLE credential DROP COLUMN name;
@@ -0,0 +1,9 @@
+-- migrate:up
+CREATE TABLE ceremony (
+    id TEXT PRIMARY KEY NOT NULL,
+    data BLOB NOT NULL,
+    expires_at DATETIME NOT NULL
+);
+
+-- migrate:down
+DROP TABLE ceremony;
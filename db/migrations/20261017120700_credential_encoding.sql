@@ -0,0 +1,79 @@
+-- migrate:up
+ALTER TABLE credential ADD clone_warning BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Store transports as a JSON array instead of a comma separated list
+UPDATE credential
+SET transports = CASE
+    WHEN trim(transports, ', ') = '' THEN '[]'
+    ELSE '["' || replace(trim(transports, ', '), ', ', '","') || '"]'
+END
+WHERE transports IS NOT NULL;
+
+-- Store credential IDs base64url encoded instead of as raw bytes, 3 bytes at a time
+WITH RECURSIVE
+    chunk (cred_id, data, pos) AS (
+        SELECT cred_id, hex(cred_id), 1 FROM credential
+        UNION ALL
+        SELECT cred_id, data, pos + 6 FROM chunk WHERE pos + 6 <= length(data)
+    ),
+    digits (cred_id, pos, bytes, data) AS (
+        SELECT cred_id, pos, length(substr(data, pos, 6)) / 2, substr(substr(data, pos, 6) || '0000', 1, 6)
+        FROM chunk
+    ),
+    num (cred_id, pos, bytes, n) AS (
+        SELECT cred_id, pos, bytes,
+            ((instr('0123456789ABCDEF', substr(data, 1, 1)) - 1) << 20) |
+            ((instr('0123456789ABCDEF', substr(data, 2, 1)) - 1) << 16) |
+            ((instr('0123456789ABCDEF', substr(data, 3, 1)) - 1) << 12) |
+            ((instr('0123456789ABCDEF', substr(data, 4, 1)) - 1) << 8) |
+            ((instr('0123456789ABCDEF', substr(data, 5, 1)) - 1) << 4) |
+            (instr('0123456789ABCDEF', substr(data, 6, 1)) - 1)
+        FROM digits
+    ),
+    encoded (cred_id, value) AS (
+        SELECT cred_id, group_concat(
+            substr(
+                substr(a, ((n >> 18) & 63) + 1, 1) ||
+                substr(a, ((n >> 12) & 63) + 1, 1) ||
+                substr(a, ((n >> 6) & 63) + 1, 1) ||
+                substr(a, (n & 63) + 1, 1),
+                1, bytes + 1
+            ), '' ORDER BY pos
+        )
+        FROM num, (SELECT 'ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_' AS a)
+        GROUP BY cred_id
+    )
+UPDATE credential
+SET cred_id = (SELECT value FROM encoded WHERE encoded.cred_id = credential.cred_id);
+
+-- migrate:down
+ALTER TABLE credential DROP COLUMN clone_warning;
+
+UPDATE credential
+SET transports = CASE
+    WHEN transports = '[]' THEN ''
+    ELSE replace(replace(replace(transports, '["', ''), '"]', ''), '","', ', ') || ', '
+END
+WHERE transports IS NOT NULL;
+
+WITH RECURSIVE
+    chunk (cred_id, pos) AS (
+        SELECT cred_id, 1 FROM credential
+        UNION ALL
+        SELECT cred_id, pos + 4 FROM chunk WHERE pos + 4 <= length(cred_id)
+    ),
+    num (cred_id, pos, bytes, n) AS (
+        SELECT cred_id, pos, length(substr(cred_id, pos, 4)) * 3 / 4,
+            ((instr(a, substr(cred_id, pos, 1)) - 1) << 18) |
+            ((max(instr(a, substr(cred_id, pos + 1, 1)), 1) - 1) << 12) |
+            ((max(instr(a, substr(cred_id, pos + 2, 1)), 1) - 1) << 6) |
+            (max(instr(a, substr(cred_id, pos + 3, 1)), 1) - 1)
+        FROM chunk, (SELECT 'ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_' AS a)
+    ),
+    decoded (cred_id, value) AS (
+        SELECT cred_id, CAST(unhex(group_concat(substr(printf('%06X', n), 1, bytes * 2), '' ORDER BY pos)) AS TEXT)
+        FROM num
+        GROUP BY cred_id
+    )
+UPDATE credential
+SET cred_id = (SELECT value FROM decoded WHERE decoded.cred_id = credential.cred_id);
@@ -0,0 +1,22 @@
+-- migrate:up
+ALTER TABLE user ADD email TEXT;
+ALTER TABLE user ADD email_verified_at DATETIME;
+CREATE UNIQUE INDEX user_verified_email ON user (email) WHERE email_verified_at IS NOT NULL;
+CREATE TABLE email_token (
+    id INTEGER PRIMARY KEY NOT NULL,
+    hash BLOB NOT NULL UNIQUE,
+    purpose TEXT NOT NULL,
+    email TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    expires_at DATETIME NOT NULL,
+    used_at DATETIME,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (user_id) REFERENCES user (id)
+);
+
+-- migrate:down
+DROP INDEX user_verified_email;
+ALTER TABLE user DROP COLUMN email;
+ALTER TABLE user DROP COLUMN email_verified_at;
+DROP TABLE email_token;
@@ -0,0 +1,16 @@
+-- migrate:up
+CREATE TABLE identity (
+    id INTEGER PRIMARY KEY NOT NULL,
+    provider TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    email TEXT,
+    created_at DATETIME NOT NULL,
+    last_used DATETIME NOT NULL,
+    user_id INTEGER NOT NULL,
+
+    UNIQUE (provider, subject),
+    FOREIGN KEY (user_id) REFERENCES user (id)
+);
+
+-- migrate:down
+DROP TABLE identity;
@@ -0,0 +1,48 @@
+-- migrate:up
+CREATE TABLE signing_key (
+    id TEXT PRIMARY KEY NOT NULL,
+    algorithm TEXT NOT NULL,
+    private_key BLOB NOT NULL,
+    created_at DATETIME NOT NULL
+);
+CREATE TABLE oauth_client (
+    id TEXT PRIMARY KEY NOT NULL,
+    name TEXT NOT NULL,
+    secret_hash BLOB,
+    redirect_uris TEXT NOT NULL,
+    scopes TEXT NOT NULL,
+    created_at DATETIME NOT NULL
+);
+CREATE TABLE oauth_code (
+    hash BLOB PRIMARY KEY NOT NULL,
+    redirect_uri TEXT NOT NULL,
+    scopes TEXT NOT NULL,
+    nonce TEXT NOT NULL,
+    code_challenge TEXT NOT NULL,
+    expires_at DATETIME NOT NULL,
+    client_id TEXT NOT NULL,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (client_id) REFERENCES oauth_client (id),
+    FOREIGN KEY (user_id) REFERENCES user (id)
+);
+CREATE TABLE oauth_consent (
+    scopes TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    client_id TEXT NOT NULL,
+    user_id INTEGER NOT NULL,
+
+    PRIMARY KEY (client_id, user_id),
+    FOREIGN KEY (client_id) REFERENCES oauth_client (id),
+    FOREIGN KEY (user_id) REFERENCES user (id)
+);
+ALTER TABLE session ADD client_id TEXT REFERENCES oauth_client (id);
+ALTER TABLE session ADD scopes TEXT;
+
+-- migrate:down
+ALTER TABLE session DROP COLUMN client_id;
+ALTER TABLE session DROP COLUMN scopes;
+DROP TABLE oauth_consent;
+DROP TABLE oauth_code;
+DROP TABLE oauth_client;
+DROP TABLE signing_key;
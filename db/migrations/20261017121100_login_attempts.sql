@@ -0,0 +1,15 @@
+-- migrate:up
+CREATE TABLE login_attempt (
+    id INTEGER PRIMARY KEY NOT NULL,
+    username TEXT NOT NULL,
+    ip TEXT NOT NULL,
+    user_agent TEXT NOT NULL,
+    factor TEXT NOT NULL,
+    success BOOLEAN NOT NULL,
+    created_at DATETIME NOT NULL
+);
+CREATE INDEX login_attempt_username ON login_attempt (username, factor, created_at);
+CREATE INDEX login_attempt_ip ON login_attempt (ip, created_at);
+
+-- migrate:down
+DROP TABLE login_attempt;
@@ -0,0 +1,10 @@
+-- migrate:up
+CREATE TABLE rate_limit (
+    key TEXT PRIMARY KEY NOT NULL,
+    tokens REAL NOT NULL,
+    updated_at DATETIME NOT NULL
+);
+CREATE INDEX rate_limit_updated_at ON rate_limit (updated_at);
+
+-- migrate:down
+DROP TABLE rate_limit;
@@ -0,0 +1,29 @@
+-- migrate:up
+CREATE TABLE audit_event (
+    id INTEGER PRIMARY KEY NOT NULL,
+    actor_id INTEGER,
+    user_id INTEGER,
+    action TEXT NOT NULL,
+    target TEXT NOT NULL,
+    ip TEXT NOT NULL,
+    user_agent TEXT NOT NULL,
+    outcome TEXT NOT NULL,
+    metadata TEXT NOT NULL,
+    created_at DATETIME NOT NULL
+);
+CREATE INDEX audit_event_user_id ON audit_event (user_id, created_at);
+CREATE INDEX audit_event_actor_id ON audit_event (actor_id, created_at);
+CREATE INDEX audit_event_action ON audit_event (action, created_at);
+CREATE TRIGGER audit_event_no_update BEFORE UPDATE ON audit_event
+BEGIN
+    SELECT RAISE(ABORT, 'audit events are append-only');
+END;
+CREATE TRIGGER audit_event_no_delete BEFORE DELETE ON audit_event
+BEGIN
+    SELECT RAISE(ABORT, 'audit events are append-only');
+END;
+
+-- migrate:down
+DROP TRIGGER audit_event_no_delete;
+DROP TRIGGER audit_event_no_update;
+DROP TABLE audit_event;
@@ -0,0 +1,358 @@
+-- migrate:up
+-- Usernames must be unique, so of the users sharing a username only the first keeps it,
+-- the others get their id appended, like alice-12. If that name is taken too, creating
+-- the index fails, and that user has to be renamed by hand before upgrading.
+UPDATE user SET username = username || '-' || id
+WHERE id NOT IN (SELECT MIN(id) FROM user GROUP BY username);
+CREATE UNIQUE INDEX user_username ON user (username);
+
+CREATE TABLE file_new (
+    id INTEGER PRIMARY KEY NOT NULL,
+    name TEXT NOT NULL,
+    data BLOB NOT NULL,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
+);
+INSERT INTO file_new (id, name, data, user_id)
+SELECT id, name, data, user_id FROM file;
+DROP TABLE file;
+ALTER TABLE file_new RENAME TO file;
+
+CREATE TABLE item_new (
+    id INTEGER PRIMARY KEY NOT NULL,
+    name TEXT NOT NULL,
+    added DATETIME NOT NULL,
+    description TEXT NOT NULL,
+    price REAL NOT NULL,
+    quantity INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
+);
+INSERT INTO item_new (id, name, added, description, price, quantity, user_id)
+SELECT id, name, added, description, price, quantity, user_id FROM item;
+DROP TABLE item;
+ALTER TABLE item_new RENAME TO item;
+
+CREATE TABLE credential_new (
+    cred_id TEXT PRIMARY KEY NOT NULL,
+    cred_public_key BLOB NOT NULL,
+    sign_count INTEGER NOT NULL,
+    transports TEXT,
+    user_verified BOOLEAN,
+    backup_eligible BOOLEAN,
+    backup_state BOOLEAN,
+    attestation_object BLOB,
+    attestation_client_data BLOB,
+    created_at DATETIME NOT NULL,
+    last_used DATETIME NOT NULL,
+    user_id INTEGER NOT NULL,
+    name TEXT NOT NULL DEFAULT '',
+    clone_warning BOOLEAN NOT NULL DEFAULT FALSE,
+
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
+);
+INSERT INTO credential_new (
+    cred_id, cred_public_key, sign_count, transports, user_verified, backup_eligible, backup_state,
+    attestation_object, attestation_client_data, created_at, last_used, user_id, name, clone_warning
+)
+SELECT
+    cred_id, cred_public_key, sign_count, transports, user_verified, backup_eligible, backup_state,
+    attestation_object, attestation_client_data, created_at, last_used, user_id, name, clone_warning
+FROM credential;
+DROP TABLE credential;
+ALTER TABLE credential_new RENAME TO credential;
+
+CREATE TABLE session_new (
+    id TEXT PRIMARY KEY NOT NULL,
+    refresh_hash BLOB NOT NULL,
+    previous_refresh_hash BLOB,
+    created_at DATETIME NOT NULL,
+    last_seen DATETIME NOT NULL,
+    rotated_at DATETIME NOT NULL,
+    expires_at DATETIME NOT NULL,
+    revoked_at DATETIME,
+    user_agent TEXT NOT NULL,
+    ip TEXT NOT NULL,
+    user_id INTEGER NOT NULL,
+    client_id TEXT,
+    scopes TEXT,
+
+    FOREIGN KEY (client_id) REFERENCES oauth_client (id),
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
+);
+INSERT INTO session_new (
+    id, refresh_hash, previous_refresh_hash, created_at, last_seen, rotated_at, expires_at, revoked_at,
+    user_agent, ip, user_id, client_id, scopes
+)
+SELECT
+    id, refresh_hash, previous_refresh_hash, created_at, last_seen, rotated_at, expires_at, revoked_at,
+    user_agent, ip, user_id, client_id, scopes
+FROM session;
+DROP TABLE session;
+ALTER TABLE session_new RENAME TO session;
+
+CREATE TABLE api_key_new (
+    id INTEGER PRIMARY KEY NOT NULL,
+    name TEXT NOT NULL,
+    prefix TEXT NOT NULL UNIQUE,
+    hash BLOB NOT NULL,
+    scopes TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    expires_at DATETIME,
+    last_used DATETIME,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
+);
+INSERT INTO api_key_new (id, name, prefix, hash, scopes, created_at, expires_at, last_used, user_id)
+SELECT id, name, prefix, hash, scopes, created_at, expires_at, last_used, user_id FROM api_key;
+DROP TABLE api_key;
+ALTER TABLE api_key_new RENAME TO api_key;
+
+CREATE TABLE recovery_code_new (
+    id INTEGER PRIMARY KEY NOT NULL,
+    hash BLOB NOT NULL,
+    used_at DATETIME,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
+);
+INSERT INTO recovery_code_new (id, hash, used_at, user_id)
+SELECT id, hash, used_at, user_id FROM recovery_code;
+DROP TABLE recovery_code;
+ALTER TABLE recovery_code_new RENAME TO recovery_code;
+
+CREATE TABLE email_token_new (
+    id INTEGER PRIMARY KEY NOT NULL,
+    hash BLOB NOT NULL UNIQUE,
+    purpose TEXT NOT NULL,
+    email TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    expires_at DATETIME NOT NULL,
+    used_at DATETIME,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
+);
+INSERT INTO email_token_new (id, hash, purpose, email, created_at, expires_at, used_at, user_id)
+SELECT id, hash, purpose, email, created_at, expires_at, used_at, user_id FROM email_token;
+DROP TABLE email_token;
+ALTER TABLE email_token_new RENAME TO email_token;
+
+CREATE TABLE identity_new (
+    id INTEGER PRIMARY KEY NOT NULL,
+    provider TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    email TEXT,
+    created_at DATETIME NOT NULL,
+    last_used DATETIME NOT NULL,
+    user_id INTEGER NOT NULL,
+
+    UNIQUE (provider, subject),
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
+);
+INSERT INTO identity_new (id, provider, subject, email, created_at, last_used, user_id)
+SELECT id, provider, subject, email, created_at, last_used, user_id FROM identity;
+DROP TABLE identity;
+ALTER TABLE identity_new RENAME TO identity;
+
+CREATE TABLE oauth_code_new (
+    hash BLOB PRIMARY KEY NOT NULL,
+    redirect_uri TEXT NOT NULL,
+    scopes TEXT NOT NULL,
+    nonce TEXT NOT NULL,
+    code_challenge TEXT NOT NULL,
+    expires_at DATETIME NOT NULL,
+    client_id TEXT NOT NULL,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (client_id) REFERENCES oauth_client (id),
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
+);
+INSERT INTO oauth_code_new (hash, redirect_uri, scopes, nonce, code_challenge, expires_at, client_id, user_id)
+SELECT hash, redirect_uri, scopes, nonce, code_challenge, expires_at, client_id, user_id FROM oauth_code;
+DROP TABLE oauth_code;
+ALTER TABLE oauth_code_new RENAME TO oauth_code;
+
+CREATE TABLE oauth_consent_new (
+    scopes TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    client_id TEXT NOT NULL,
+    user_id INTEGER NOT NULL,
+
+    PRIMARY KEY (client_id, user_id),
+    FOREIGN KEY (client_id) REFERENCES oauth_client (id),
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
+);
+INSERT INTO oauth_consent_new (scopes, created_at, client_id, user_id)
+SELECT scopes, created_at, client_id, user_id FROM oauth_consent;
+DROP TABLE oauth_consent;
+ALTER TABLE oauth_consent_new RENAME TO oauth_consent;
+
+-- migrate:down
+CREATE TABLE oauth_consent_old (
+    scopes TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    client_id TEXT NOT NULL,
+    user_id INTEGER NOT NULL,
+
+    PRIMARY KEY (client_id, user_id),
+    FOREIGN KEY (client_id) REFERENCES oauth_client (id),
+    FOREIGN KEY (user_id) REFERENCES user (id)
+);
+INSERT INTO oauth_consent_old SELECT * FROM oauth_consent;
+DROP TABLE oauth_consent;
+ALTER TABLE oauth_consent_old RENAME TO oauth_consent;
+
+CREATE TABLE oauth_code_old (
+    hash BLOB PRIMARY KEY NOT NULL,
+    redirect_uri TEXT NOT NULL,
+    scopes TEXT NOT NULL,
+    nonce TEXT NOT NULL,
+    code_challenge TEXT NOT NULL,
+    expires_at DATETIME NOT NULL,
+    client_id TEXT NOT NULL,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (client_id) REFERENCES oauth_client (id),
+    FOREIGN KEY (user_id) REFERENCES user (id)
+);
+INSERT INTO oauth_code_old SELECT * FROM oauth_code;
+DROP TABLE oauth_code;
+ALTER TABLE oauth_code_old RENAME TO oauth_code;
+
+CREATE TABLE identity_old (
+    id INTEGER PRIMARY KEY NOT NULL,
+    provider TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    email TEXT,
+    created_at DATETIME NOT NULL,
+    last_used DATETIME NOT NULL,
+    user_id INTEGER NOT NULL,
+
+    UNIQUE (provider, subject),
+    FOREIGN KEY (user_id) REFERENCES user (id)
+);
+INSERT INTO identity_old SELECT * FROM identity;
+DROP TABLE identity;
+ALTER TABLE identity_old RENAME TO identity;
+
+CREATE TABLE email_token_old (
+    id INTEGER PRIMARY KEY NOT NULL,
+    hash BLOB NOT NULL UNIQUE,
+    purpose TEXT NOT NULL,
+    email TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    expires_at DATETIME NOT NULL,
+    used_at DATETIME,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (user_id) REFERENCES user (id)
+);
+INSERT INTO email_token_old SELECT * FROM email_token;
+DROP TABLE email_token;
+ALTER TABLE email_token_old RENAME TO email_token;
+
+CREATE TABLE recovery_code_old (
+    id INTEGER PRIMARY KEY NOT NULL,
+    hash BLOB NOT NULL,
+    used_at DATETIME,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (user_id) REFERENCES user (id)
+);
+INSERT INTO recovery_code_old SELECT * FROM recovery_code;
+DROP TABLE recovery_code;
+ALTER TABLE recovery_code_old RENAME TO recovery_code;
+
+CREATE TABLE api_key_old (
+    id INTEGER PRIMARY KEY NOT NULL,
+    name TEXT NOT NULL,
+    prefix TEXT NOT NULL UNIQUE,
+    hash BLOB NOT NULL,
+    scopes TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    expires_at DATETIME,
+    last_used DATETIME,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (user_id) REFERENCES user (id)
+);
+INSERT INTO api_key_old SELECT * FROM api_key;
+DROP TABLE api_key;
+ALTER TABLE api_key_old RENAME TO api_key;
+
+CREATE TABLE session_old (
+    id TEXT PRIMARY KEY NOT NULL,
+    refresh_hash BLOB NOT NULL,
+    previous_refresh_hash BLOB,
+    created_at DATETIME NOT NULL,
+    last_seen DATETIME NOT NULL,
+    rotated_at DATETIME NOT NULL,
+    expires_at DATETIME NOT NULL,
+    revoked_at DATETIME,
+    user_agent TEXT NOT NULL,
+    ip TEXT NOT NULL,
+    user_id INTEGER NOT NULL,
+    client_id TEXT,
+    scopes TEXT,
+
+    FOREIGN KEY (client_id) REFERENCES oauth_client (id),
+    FOREIGN KEY (user_id) REFERENCES user (id)
+);
+INSERT INTO session_old SELECT * FROM session;
+DROP TABLE session;
+ALTER TABLE session_old RENAME TO session;
+
+CREATE TABLE credential_old (
+    cred_id TEXT PRIMARY KEY NOT NULL,
+    cred_public_key BLOB NOT NULL,
+    sign_count INTEGER NOT NULL,
+    transports TEXT,
+    user_verified BOOLEAN,
+    backup_eligible BOOLEAN,
+    backup_state BOOLEAN,
+    attestation_object BLOB,
+    attestation_client_data BLOB,
+    created_at DATETIME NOT NULL,
+    last_used DATETIME NOT NULL,
+    user_id INTEGER NOT NULL,
+    name TEXT NOT NULL DEFAULT '',
+    clone_warning BOOLEAN NOT NULL DEFAULT FALSE,
+
+    FOREIGN KEY (user_id) REFERENCES user (id)
+);
+INSERT INTO credential_old SELECT * FROM credential;
+DROP TABLE credential;
+ALTER TABLE credential_old RENAME TO credential;
+
+CREATE TABLE item_old (
+    id INTEGER PRIMARY KEY NOT NULL,
+    name TEXT NOT NULL,
+    added DATETIME NOT NULL,
+    description TEXT NOT NULL,
+    price REAL NOT NULL,
+    quantity INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (user_id) REFERENCES user (id)
+);
+INSERT INTO item_old SELECT * FROM item;
+DROP TABLE item;
+ALTER TABLE item_old RENAME TO item;
+
+CREATE TABLE file_old (
+    id INTEGER PRIMARY KEY NOT NULL,
+    name TEXT NOT NULL,
+    data BLOB NOT NULL,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (user_id) REFERENCES user (id)
+);
+INSERT INTO file_old SELECT * FROM file;
+DROP TABLE file;
+ALTER TABLE file_old RENAME TO file;
+
+DROP INDEX user_username;
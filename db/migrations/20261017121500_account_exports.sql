@@ -0,0 +1,28 @@
+-- migrate:up
+CREATE TABLE account_export (
+    id TEXT PRIMARY KEY NOT NULL,
+    status TEXT NOT NULL,
+    size INTEGER NOT NULL DEFAULT 0,
+    error TEXT,
+    created_at DATETIME NOT NULL,
+    finished_at DATETIME,
+    heartbeat_at DATETIME NOT NULL,
+    expires_at DATETIME NOT NULL,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
+);
+CREATE INDEX account_export_user_id ON account_export (user_id, created_at);
+
+CREATE TABLE account_export_page (
+    export_id TEXT NOT NULL,
+    page INTEGER NOT NULL,
+    data BLOB NOT NULL,
+
+    PRIMARY KEY (export_id, page),
+    FOREIGN KEY (export_id) REFERENCES account_export (id) ON DELETE CASCADE
+);
+
+-- migrate:down
+DROP TABLE account_export_page;
+DROP TABLE account_export;
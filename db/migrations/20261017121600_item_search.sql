@@ -0,0 +1,37 @@
+-- migrate:up
+CREATE VIRTUAL TABLE item_search USING fts5 (
+    name,
+    description,
+    content = 'item',
+    content_rowid = 'id',
+    tokenize = 'unicode61 remove_diacritics 2',
+    prefix = '2 3'
+);
+
+CREATE TRIGGER item_search_insert AFTER INSERT ON item
+BEGIN
+    INSERT INTO item_search (rowid, name, description) VALUES (NEW.id, NEW.name, NEW.description);
+END;
+
+CREATE TRIGGER item_search_delete AFTER DELETE ON item
+BEGIN
+    INSERT INTO item_search (item_search, rowid, name, description) VALUES ('delete', OLD.id, OLD.name, OLD.description);
+END;
+
+CREATE TRIGGER item_search_update AFTER UPDATE OF name, description ON item
+BEGIN
+    INSERT INTO item_search (item_search, rowid, name, description) VALUES ('delete', OLD.id, OLD.name, OLD.description);
+    INSERT INTO item_search (rowid, name, description) VALUES (NEW.id, NEW.name, NEW.description);
+END;
+
+-- Rank matches in names above matches in descriptions
+INSERT INTO item_search (item_search, rank) VALUES ('rank', 'bm25(10.0, 1.0)');
+
+-- Index existing items
+INSERT INTO item_search (item_search) VALUES ('rebuild');
+
+-- migrate:down
+DROP TRIGGER item_search_update;
+DROP TRIGGER item_search_delete;
+DROP TRIGGER item_search_insert;
+DROP TABLE item_search;
@@ -3,19 +3,89 @@ CREATE TABLE user (
     id INTEGER PRIMARY KEY NOT NULL,
     username TEXT NOT NULL,
     password TEXT NOT NULL,
-    profile_picture_id INTEGER, webauthn_id TEXT NOT NULL,
+    profile_picture_id INTEGER, webauthn_id TEXT NOT NULL, token_version INTEGER NOT NULL DEFAULT 0, role TEXT NOT NULL DEFAULT 'user', disabled_at DATETIME, password_reset_required BOOLEAN NOT NULL DEFAULT FALSE, totp_secret TEXT, totp_enabled BOOLEAN NOT NULL DEFAULT FALSE, totp_last_step INTEGER NOT NULL DEFAULT 0, email TEXT, email_verified_at DATETIME,
 
     FOREIGN KEY (profile_picture_id) REFERENCES file (id)
 );
-CREATE TABLE file (
+CREATE TABLE login_challenge (
+    id TEXT PRIMARY KEY NOT NULL,
+    failures INTEGER NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL,
+    expires_at DATETIME NOT NULL,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
+);
+CREATE TABLE ceremony (
+    id TEXT PRIMARY KEY NOT NULL,
+    data BLOB NOT NULL,
+    expires_at DATETIME NOT NULL
+);
+CREATE UNIQUE INDEX user_verified_email ON user (email) WHERE email_verified_at IS NOT NULL;
+CREATE TABLE signing_key (
+    id TEXT PRIMARY KEY NOT NULL,
+    algorithm TEXT NOT NULL,
+    private_key BLOB NOT NULL,
+    created_at DATETIME NOT NULL
+);
+CREATE TABLE oauth_client (
+    id TEXT PRIMARY KEY NOT NULL,
+    name TEXT NOT NULL,
+    secret_hash BLOB,
+    redirect_uris TEXT NOT NULL,
+    scopes TEXT NOT NULL,
+    created_at DATETIME NOT NULL
+);
+CREATE TABLE login_attempt (
+    id INTEGER PRIMARY KEY NOT NULL,
+    username TEXT NOT NULL,
+    ip TEXT NOT NULL,
+    user_agent TEXT NOT NULL,
+    factor TEXT NOT NULL,
+    success BOOLEAN NOT NULL,
+    created_at DATETIME NOT NULL
+);
+CREATE INDEX login_attempt_username ON login_attempt (username, factor, created_at);
+CREATE INDEX login_attempt_ip ON login_attempt (ip, created_at);
+CREATE TABLE rate_limit (
+    key TEXT PRIMARY KEY NOT NULL,
+    tokens REAL NOT NULL,
+    updated_at DATETIME NOT NULL
+);
+CREATE INDEX rate_limit_updated_at ON rate_limit (updated_at);
+CREATE TABLE audit_event (
+    id INTEGER PRIMARY KEY NOT NULL,
+    actor_id INTEGER,
+    user_id INTEGER,
+    action TEXT NOT NULL,
+    target TEXT NOT NULL,
+    ip TEXT NOT NULL,
+    user_agent TEXT NOT NULL,
+    outcome TEXT NOT NULL,
+    metadata TEXT NOT NULL,
+    created_at DATETIME NOT NULL
+);
+CREATE INDEX audit_event_user_id ON audit_event (user_id, created_at);
+CREATE INDEX audit_event_actor_id ON audit_event (actor_id, created_at);
+CREATE INDEX audit_event_action ON audit_event (action, created_at);
+CREATE TRIGGER audit_event_no_update BEFORE UPDATE ON audit_event
+BEGIN
+    SELECT RAISE(ABORT, 'audit events are append-only');
+END;
+CREATE TRIGGER audit_event_no_delete BEFORE DELETE ON audit_event
+BEGIN
+    SELECT RAISE(ABORT, 'audit events are append-only');
+END;
+CREATE UNIQUE INDEX user_username ON user (username);
+CREATE TABLE IF NOT EXISTS "file" (
     id INTEGER PRIMARY KEY NOT NULL,
     name TEXT NOT NULL,
     data BLOB NOT NULL,
     user_id INTEGER NOT NULL,
 
-    FOREIGN KEY (user_id) REFERENCES user (id)
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
 );
-CREATE TABLE item (
+CREATE TABLE IF NOT EXISTS "item" (
     id INTEGER PRIMARY KEY NOT NULL,
     name TEXT NOT NULL,
     added DATETIME NOT NULL,
@@ -24,9 +94,9 @@ CREATE TABLE item (
     quantity INTEGER NOT NULL,
     user_id INTEGER NOT NULL,
 
-    FOREIGN KEY (user_id) REFERENCES user (id)
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
 );
-CREATE TABLE credential (
+CREATE TABLE IF NOT EXISTS "credential" (
     cred_id TEXT PRIMARY KEY NOT NULL,
     cred_public_key BLOB NOT NULL,
     sign_count INTEGER NOT NULL,
@@ -39,10 +109,163 @@ CREATE TABLE credential (
     created_at DATETIME NOT NULL,
     last_used DATETIME NOT NULL,
     user_id INTEGER NOT NULL,
+    name TEXT NOT NULL DEFAULT '',
+    clone_warning BOOLEAN NOT NULL DEFAULT FALSE,
+
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
+);
+CREATE TABLE IF NOT EXISTS "session" (
+    id TEXT PRIMARY KEY NOT NULL,
+    refresh_hash BLOB NOT NULL,
+    previous_refresh_hash BLOB,
+    created_at DATETIME NOT NULL,
+    last_seen DATETIME NOT NULL,
+    rotated_at DATETIME NOT NULL,
+    expires_at DATETIME NOT NULL,
+    revoked_at DATETIME,
+    user_agent TEXT NOT NULL,
+    ip TEXT NOT NULL,
+    user_id INTEGER NOT NULL,
+    client_id TEXT,
+    scopes TEXT,
+
+    FOREIGN KEY (client_id) REFERENCES oauth_client (id),
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
+);
+CREATE TABLE IF NOT EXISTS "api_key" (
+    id INTEGER PRIMARY KEY NOT NULL,
+    name TEXT NOT NULL,
+    prefix TEXT NOT NULL UNIQUE,
+    hash BLOB NOT NULL,
+    scopes TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    expires_at DATETIME,
+    last_used DATETIME,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
+);
+CREATE TABLE IF NOT EXISTS "recovery_code" (
+    id INTEGER PRIMARY KEY NOT NULL,
+    hash BLOB NOT NULL,
+    used_at DATETIME,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
+);
+CREATE TABLE IF NOT EXISTS "email_token" (
+    id INTEGER PRIMARY KEY NOT NULL,
+    hash BLOB NOT NULL UNIQUE,
+    purpose TEXT NOT NULL,
+    email TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    expires_at DATETIME NOT NULL,
+    used_at DATETIME,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
+);
+CREATE TABLE IF NOT EXISTS "identity" (
+    id INTEGER PRIMARY KEY NOT NULL,
+    provider TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    email TEXT,
+    created_at DATETIME NOT NULL,
+    last_used DATETIME NOT NULL,
+    user_id INTEGER NOT NULL,
+
+    UNIQUE (provider, subject),
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
+);
+CREATE TABLE IF NOT EXISTS "oauth_code" (
+    hash BLOB PRIMARY KEY NOT NULL,
+    redirect_uri TEXT NOT NULL,
+    scopes TEXT NOT NULL,
+    nonce TEXT NOT NULL,
+    code_challenge TEXT NOT NULL,
+    expires_at DATETIME NOT NULL,
+    client_id TEXT NOT NULL,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (client_id) REFERENCES oauth_client (id),
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
+);
+CREATE TABLE IF NOT EXISTS "oauth_consent" (
+    scopes TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    client_id TEXT NOT NULL,
+    user_id INTEGER NOT NULL,
+
+    PRIMARY KEY (client_id, user_id),
+    FOREIGN KEY (client_id) REFERENCES oauth_client (id),
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
+);
+CREATE TABLE account_export (
+    id TEXT PRIMARY KEY NOT NULL,
+    status TEXT NOT NULL,
+    size INTEGER NOT NULL DEFAULT 0,
+    error TEXT,
+    created_at DATETIME NOT NULL,
+    finished_at DATETIME,
+    heartbeat_at DATETIME NOT NULL,
+    expires_at DATETIME NOT NULL,
+    user_id INTEGER NOT NULL,
+
+    FOREIGN KEY (user_id) REFERENCES user (id) ON DELETE CASCADE
+);
+CREATE INDEX account_export_user_id ON account_export (user_id, created_at);
+CREATE TABLE account_export_page (
+    export_id TEXT NOT NULL,
+    page INTEGER NOT NULL,
+    data BLOB NOT NULL,
 
-    FOREIGN KEY (user_id) REFERENCES user (id)
+    PRIMARY KEY (export_id, page),
+    FOREIGN KEY (export_id) REFERENCES account_export (id) ON DELETE CASCADE
 );
+CREATE VIRTUAL TABLE item_search USING fts5 (
+    name,
+    description,
+    content = 'item',
+    content_rowid = 'id',
+    tokenize = 'unicode61 remove_diacritics 2',
+    prefix = '2 3'
+)
+/* item_search(name,description) */;
+CREATE TABLE IF NOT EXISTS 'item_search_data'(id INTEGER PRIMARY KEY, block BLOB);
+CREATE TABLE IF NOT EXISTS 'item_search_idx'(segid, term, pgno, PRIMARY KEY(segid, term)) WITHOUT ROWID;
+CREATE TABLE IF NOT EXISTS 'item_search_docsize'(id INTEGER PRIMARY KEY, sz BLOB);
+CREATE TABLE IF NOT EXISTS 'item_search_config'(k PRIMARY KEY, v) WITHOUT ROWID;
+CREATE TRIGGER item_search_insert AFTER INSERT ON item
+BEGIN
+    INSERT INTO item_search (rowid, name, description) VALUES (NEW.id, NEW.name, NEW.description);
+END;
+CREATE TRIGGER item_search_delete AFTER DELETE ON item
+BEGIN
+    INSERT INTO item_search (item_search, rowid, name, description) VALUES ('delete', OLD.id, OLD.name, OLD.description);
+END;
+CREATE TRIGGER item_search_update AFTER UPDATE OF name, description ON item
+BEGIN
+    INSERT INTO item_search (item_search, rowid, name, description) VALUES ('delete', OLD.id, OLD.name, OLD.description);
+    INSERT INTO item_search (rowid, name, description) VALUES (NEW.id, NEW.name, NEW.description);
+END;
 -- Dbmate schema migrations
 INSERT INTO "schema_migrations" (version) VALUES
   ('20250410195416'),
-  ('20250418055807');
+  ('20250418055807'),
+  ('20261017120000'),
+  ('20261017120100'),
+  ('20261017120200'),
+  ('20261017120300'),
+  ('20261017120400'),
+  ('20261017120500'),
+  ('20261017120600'),
+  ('20261017120700'),
+  ('20261017120800'),
+  ('20261017120900'),
+  ('20261017121000'),
+  ('20261017121100'),
+  ('20261017121200'),
+  ('20261017121300'),
+  ('20261017121400'),
+  ('20261017121500'),
+  ('20261017121600');
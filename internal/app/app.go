@@ -1,23 +1,42 @@
 package app
 
 import (
+	"context"
 	"embed"
 	"log/slog"
+	"os"
+	"strings"
 
 	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/stephenafamo/bob"
 
+	"github.com/spotdemo4/ts-server/internal/audit"
 	"github.com/spotdemo4/ts-server/internal/auth"
+	"github.com/spotdemo4/ts-server/internal/auth/oidc"
 	"github.com/spotdemo4/ts-server/internal/database"
+	"github.com/spotdemo4/ts-server/internal/export"
+	"github.com/spotdemo4/ts-server/internal/mail"
+	"github.com/spotdemo4/ts-server/internal/ratelimit"
 )
 
 type App struct {
-	Log  *slog.Logger
-	Env  *Env
-	DB   *bob.DB
-	Auth *auth.Auth
+	Log    *slog.Logger
+	Env    *Env
+	DB     *bob.DB
+	Auth   *auth.Auth
+	Audit  *audit.Log
+	Export *export.Exporter
+	Mail   mail.Mailer
+	OIDC   map[string]*oidc.Provider
+
+	RateLimits ratelimit.Store
 }
 
+const (
+	outboxDirMode = 0o700 // Emails contain secret links
+	keyDirMode    = 0o700 // Private keys
+)
+
 func New(name string, dbFS embed.FS) (*App, error) {
 	// Create logger
 	logger := slog.Default()
@@ -50,13 +69,114 @@ func New(name string, dbFS embed.FS) (*App, error) {
 		return nil, err
 	}
 
+	// Create webauthn ceremony store
+	var ceremonies auth.CeremonyStore = auth.NewSQLiteCeremonyStore(db)
+	if env.CeremonyStore == "memory" {
+		ceremonies = auth.NewMemoryCeremonyStore()
+	}
+
+	// Create rate limit store
+	var limits ratelimit.Store = ratelimit.NewMemoryStore()
+	if env.RateLimitStore == "sqlite" {
+		limits = ratelimit.NewSQLiteStore(db)
+	}
+
+	// Create token signing key store
+	var keyStore auth.KeyStore = auth.NewSQLiteKeyStore(db)
+	if env.SigningKeyStore == "file" {
+		err = os.MkdirAll(env.SigningKeyDir, keyDirMode)
+		if err != nil {
+			return nil, err
+		}
+		keyStore = auth.NewFileKeyStore(env.SigningKeyDir)
+	}
+
+	// Create breached password list
+	var breached auth.BreachedPasswords
+	if env.PasswordBreachedFile != "" {
+		breached, err = auth.NewFileBreachedPasswords(env.PasswordBreachedFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Create audit log
+	events := audit.New(db, logger)
+
 	// Create auth service
-	auth := auth.New(db, name, env.Key, web)
+	auth, err := auth.New(
+		db,
+		name,
+		strings.TrimSuffix(env.URL.String(), "/"),
+		env.AdminUsername,
+		env.Key,
+		web,
+		ceremonies,
+		auth.KeyConfig{
+			Store:     keyStore,
+			Algorithm: env.SigningKeyAlgorithm,
+			Rotation:  env.SigningKeyRotation,
+		},
+		auth.PasswordPolicy{
+			MinLength:     env.PasswordMinLength,
+			MinScore:      env.PasswordMinScore,
+			AllowUsername: env.PasswordAllowUsername,
+			Breached:      breached,
+		},
+		auth.NewArgon2idHasher(env.PasswordHash),
+		events,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load token signing keys, and keep rotating them
+	err = auth.LoadKeys(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	go auth.RotateKeys(context.Background(), logger)
+
+	// Make sure the bootstrap admin is an admin
+	err = auth.Bootstrap(context.Background(), env.AdminUsername)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create mailer
+	var mailer mail.Mailer
+	switch env.MailTransport {
+	case "smtp":
+		mailer = mail.NewSMTPMailer(env.SMTPHost, env.SMTPPort, env.SMTPUsername, env.SMTPPassword, env.MailFrom)
+	default:
+		if env.MailOutbox != "" {
+			err = os.MkdirAll(env.MailOutbox, outboxDirMode)
+			if err != nil {
+				return nil, err
+			}
+		}
+		mailer = mail.NewOutbox(env.MailOutbox, env.MailFrom, logger)
+	}
+
+	// Create OIDC providers
+	providers := make(map[string]*oidc.Provider)
+	for _, config := range env.OIDCProviders {
+		providers[config.Name] = oidc.NewProvider(config)
+	}
+
+	// Create account exporter
+	exporter := export.New(db, logger)
 
 	return &App{
-		Log:  logger,
-		Env:  env,
-		DB:   db,
-		Auth: auth,
+		Log:    logger,
+		Env:    env,
+		DB:     db,
+		Auth:   auth,
+		Audit:  events,
+		Export: exporter,
+		Mail:   mailer,
+		OIDC:   providers,
+
+		RateLimits: limits,
 	}, nil
 }
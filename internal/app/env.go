@@ -2,18 +2,58 @@ package app
 
 import (
 	"errors"
+	"fmt"
 	"log/slog"
+	"net/netip"
 	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
+
+	"github.com/spotdemo4/ts-server/internal/auth"
+	"github.com/spotdemo4/ts-server/internal/auth/oidc"
+	"github.com/spotdemo4/ts-server/internal/ratelimit"
 )
 
+const DefaultItemBatchMaxSize = 500 // Most entries in one batch item request
+
 type Env struct {
-	Port        string
-	Key         string
-	URL         *url.URL
-	DatabaseURL string
+	Port          string
+	Key           string
+	URL           *url.URL
+	DatabaseURL   string
+	AdminUsername string
+	CeremonyStore string
+
+	RateLimitStore string
+	RateLimits     []ratelimit.Policy
+	TrustedProxies []netip.Prefix
+
+	PasswordMinLength     int
+	PasswordMinScore      int
+	PasswordAllowUsername bool
+	PasswordBreachedFile  string
+	PasswordHash          auth.Argon2Params
+
+	ItemBatchMaxSize int
+
+	SigningKeyStore     string
+	SigningKeyDir       string
+	SigningKeyAlgorithm string
+	SigningKeyRotation  time.Duration
+
+	MailTransport string
+	MailFrom      string
+	MailOutbox    string
+	SMTPHost      string
+	SMTPPort      string
+	SMTPUsername  string
+	SMTPPassword  string
+
+	OIDCProviders []oidc.Config
 }
 
 func getEnv(log *slog.Logger) (*Env, error) {
@@ -24,9 +64,27 @@ func getEnv(log *slog.Logger) (*Env, error) {
 
 	// Create
 	env := Env{
-		Port:        os.Getenv("PORT"),
-		Key:         os.Getenv("KEY"),
-		DatabaseURL: os.Getenv("DATABASE_URL"),
+		Port:          os.Getenv("PORT"),
+		Key:           os.Getenv("KEY"),
+		DatabaseURL:   os.Getenv("DATABASE_URL"),
+		AdminUsername: os.Getenv("ADMIN_USERNAME"),
+		CeremonyStore: os.Getenv("CEREMONY_STORE"),
+
+		RateLimitStore: os.Getenv("RATE_LIMIT_STORE"),
+
+		PasswordBreachedFile: os.Getenv("PASSWORD_BREACHED_FILE"),
+
+		SigningKeyStore:     os.Getenv("SIGNING_KEY_STORE"),
+		SigningKeyDir:       os.Getenv("SIGNING_KEY_DIR"),
+		SigningKeyAlgorithm: os.Getenv("SIGNING_KEY_ALGORITHM"),
+
+		MailTransport: os.Getenv("MAIL_TRANSPORT"),
+		MailFrom:      os.Getenv("MAIL_FROM"),
+		MailOutbox:    os.Getenv("MAIL_OUTBOX"),
+		SMTPHost:      os.Getenv("SMTP_HOST"),
+		SMTPPort:      os.Getenv("SMTP_PORT"),
+		SMTPUsername:  os.Getenv("SMTP_USERNAME"),
+		SMTPPassword:  os.Getenv("SMTP_PASSWORD"),
 	}
 
 	// Validate
@@ -34,12 +92,197 @@ func getEnv(log *slog.Logger) (*Env, error) {
 		env.Port = "8080"
 		log.Info("env 'PORT' not found, setting default", "port", env.Port)
 	}
+	// Tokens and browser state are signed with the rotating signing keys. The key only encrypts secrets
+	// stored in the database, like TOTP secrets, which needs a symmetric key that does not change.
 	if env.Key == "" {
 		return nil, errors.New("env 'KEY' not found")
 	}
 	if env.DatabaseURL == "" {
 		return nil, errors.New("env 'DATABASE_URL' not found")
 	}
+	switch env.CeremonyStore {
+	case "":
+		env.CeremonyStore = "sqlite"
+		log.Info("env 'CEREMONY_STORE' not found, setting default", "store", env.CeremonyStore)
+	case "sqlite", "memory":
+	default:
+		return nil, errors.New("env 'CEREMONY_STORE' must be 'sqlite' or 'memory'")
+	}
+
+	switch env.RateLimitStore {
+	case "":
+		env.RateLimitStore = "memory"
+		log.Info("env 'RATE_LIMIT_STORE' not found, setting default", "store", env.RateLimitStore)
+	case "sqlite", "memory":
+	default:
+		return nil, errors.New("env 'RATE_LIMIT_STORE' must be 'sqlite' or 'memory'")
+	}
+	if os.Getenv("RATE_LIMITS") == "" {
+		env.RateLimits = ratelimit.DefaultPolicies
+		log.Info("env 'RATE_LIMITS' not found, setting default", "policies", len(env.RateLimits))
+	} else {
+		env.RateLimits, err = ratelimit.ParsePolicies(os.Getenv("RATE_LIMITS"))
+		if err != nil {
+			return nil, fmt.Errorf("env 'RATE_LIMITS': %w", err)
+		}
+	}
+
+	// Parse trusted proxies
+	for proxy := range strings.SplitSeq(os.Getenv("TRUSTED_PROXIES"), ",") {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+
+		var prefix netip.Prefix
+		if strings.Contains(proxy, "/") {
+			prefix, err = netip.ParsePrefix(proxy)
+		} else {
+			var addr netip.Addr
+			addr, err = netip.ParseAddr(proxy)
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		if err != nil {
+			return nil, errors.New("env 'TRUSTED_PROXIES' must be a list of IP addresses or CIDR ranges")
+		}
+		env.TrustedProxies = append(env.TrustedProxies, prefix.Masked())
+	}
+
+	if os.Getenv("PASSWORD_MIN_LENGTH") == "" {
+		env.PasswordMinLength = auth.PasswordMinLength
+		log.Info("env 'PASSWORD_MIN_LENGTH' not found, setting default", "length", env.PasswordMinLength)
+	} else {
+		env.PasswordMinLength, err = strconv.Atoi(os.Getenv("PASSWORD_MIN_LENGTH"))
+		if err != nil || env.PasswordMinLength < 1 {
+			return nil, errors.New("env 'PASSWORD_MIN_LENGTH' must be a positive number")
+		}
+	}
+	if os.Getenv("PASSWORD_MIN_SCORE") == "" {
+		env.PasswordMinScore = auth.PasswordMinScore
+		log.Info("env 'PASSWORD_MIN_SCORE' not found, setting default", "score", env.PasswordMinScore)
+	} else {
+		env.PasswordMinScore, err = strconv.Atoi(os.Getenv("PASSWORD_MIN_SCORE"))
+		if err != nil || env.PasswordMinScore < 0 || env.PasswordMinScore > auth.PasswordScoreMax {
+			return nil, errors.New("env 'PASSWORD_MIN_SCORE' must be a number from 0 to 4")
+		}
+	}
+	if os.Getenv("PASSWORD_ALLOW_USERNAME") != "" {
+		env.PasswordAllowUsername, err = strconv.ParseBool(os.Getenv("PASSWORD_ALLOW_USERNAME"))
+		if err != nil {
+			return nil, errors.New("env 'PASSWORD_ALLOW_USERNAME' must be 'true' or 'false'")
+		}
+	}
+
+	env.PasswordHash = auth.DefaultArgon2Params
+	for _, param := range []struct {
+		name  string
+		value *uint32
+	}{
+		{"PASSWORD_HASH_MEMORY", &env.PasswordHash.Memory},
+		{"PASSWORD_HASH_ITERATIONS", &env.PasswordHash.Iterations},
+	} {
+		if os.Getenv(param.name) == "" {
+			log.Info("env '"+param.name+"' not found, setting default", "value", *param.value)
+			continue
+		}
+		var value uint64
+		value, err = strconv.ParseUint(os.Getenv(param.name), 10, 32)
+		if err != nil || value == 0 {
+			return nil, errors.New("env '" + param.name + "' must be a positive number")
+		}
+		*param.value = uint32(value)
+	}
+	if os.Getenv("PASSWORD_HASH_THREADS") == "" {
+		log.Info("env 'PASSWORD_HASH_THREADS' not found, setting default", "value", env.PasswordHash.Threads)
+	} else {
+		var threads uint64
+		threads, err = strconv.ParseUint(os.Getenv("PASSWORD_HASH_THREADS"), 10, 8)
+		if err != nil || threads == 0 {
+			return nil, errors.New("env 'PASSWORD_HASH_THREADS' must be a number from 1 to 255")
+		}
+		env.PasswordHash.Threads = uint8(threads)
+	}
+
+	if os.Getenv("ITEM_BATCH_MAX_SIZE") == "" {
+		env.ItemBatchMaxSize = DefaultItemBatchMaxSize
+		log.Info("env 'ITEM_BATCH_MAX_SIZE' not found, setting default", "size", env.ItemBatchMaxSize)
+	} else {
+		env.ItemBatchMaxSize, err = strconv.Atoi(os.Getenv("ITEM_BATCH_MAX_SIZE"))
+		if err != nil || env.ItemBatchMaxSize < 1 {
+			return nil, errors.New("env 'ITEM_BATCH_MAX_SIZE' must be a positive number")
+		}
+	}
+
+	switch env.SigningKeyStore {
+	case "":
+		env.SigningKeyStore = "sqlite"
+		log.Info("env 'SIGNING_KEY_STORE' not found, setting default", "store", env.SigningKeyStore)
+	case "sqlite":
+	case "file":
+		if env.SigningKeyDir == "" {
+			return nil, errors.New("env 'SIGNING_KEY_DIR' not found")
+		}
+	default:
+		return nil, errors.New("env 'SIGNING_KEY_STORE' must be 'sqlite' or 'file'")
+	}
+	switch env.SigningKeyAlgorithm {
+	case "":
+		env.SigningKeyAlgorithm = auth.AlgorithmES256
+		log.Info("env 'SIGNING_KEY_ALGORITHM' not found, setting default", "algorithm", env.SigningKeyAlgorithm)
+	case auth.AlgorithmES256, auth.AlgorithmEdDSA:
+	default:
+		return nil, errors.New("env 'SIGNING_KEY_ALGORITHM' must be 'ES256' or 'EdDSA'")
+	}
+	if os.Getenv("SIGNING_KEY_ROTATION") == "" {
+		env.SigningKeyRotation = auth.KeyRotationInterval
+		log.Info("env 'SIGNING_KEY_ROTATION' not found, setting default", "rotation", env.SigningKeyRotation)
+	} else {
+		env.SigningKeyRotation, err = time.ParseDuration(os.Getenv("SIGNING_KEY_ROTATION"))
+		if err != nil || env.SigningKeyRotation < 0 {
+			return nil, errors.New("env 'SIGNING_KEY_ROTATION' must be a duration like '720h', or '0' to disable rotation")
+		}
+	}
+
+	switch env.MailTransport {
+	case "":
+		env.MailTransport = "outbox"
+		log.Info("env 'MAIL_TRANSPORT' not found, setting default", "transport", env.MailTransport)
+	case "outbox":
+	case "smtp":
+		if env.SMTPHost == "" {
+			return nil, errors.New("env 'SMTP_HOST' not found")
+		}
+		if env.SMTPPort == "" {
+			env.SMTPPort = "587"
+			log.Info("env 'SMTP_PORT' not found, setting default", "port", env.SMTPPort)
+		}
+	default:
+		return nil, errors.New("env 'MAIL_TRANSPORT' must be 'outbox' or 'smtp'")
+	}
+
+	// Parse OIDC providers
+	for name := range strings.SplitSeq(os.Getenv("OIDC_PROVIDERS"), ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		prefix := "OIDC_" + strings.ToUpper(name) + "_"
+		config := oidc.Config{
+			Name:         strings.ToLower(name),
+			Issuer:       os.Getenv(prefix + "ISSUER"),
+			ClientID:     os.Getenv(prefix + "CLIENT_ID"),
+			ClientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+			Scopes:       strings.Fields(os.Getenv(prefix + "SCOPES")),
+		}
+		if config.Issuer == "" {
+			return nil, errors.New("env '" + prefix + "ISSUER' not found")
+		}
+		if config.ClientID == "" {
+			return nil, errors.New("env '" + prefix + "CLIENT_ID' not found")
+		}
+		env.OIDCProviders = append(env.OIDCProviders, config)
+	}
 
 	// Parse URL
 	if os.Getenv("URL") == "" {
@@ -52,5 +295,10 @@ func getEnv(log *slog.Logger) (*Env, error) {
 		}
 	}
 
+	if env.MailFrom == "" {
+		env.MailFrom = "noreply@" + env.URL.Hostname()
+		log.Info("env 'MAIL_FROM' not found, setting default", "from", env.MailFrom)
+	}
+
 	return &env, nil
 }
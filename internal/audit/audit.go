@@ -0,0 +1,209 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+)
+
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+const (
+	ActionLogin                = "login"
+	ActionLoginPasskey         = "login.passkey"
+	ActionLoginOIDC            = "login.oidc"
+	ActionLoginSecondFactor    = "login.second_factor"
+	ActionLogout               = "logout"
+	ActionSignUp               = "signup"
+	ActionPasswordChange       = "password.change"
+	ActionPasswordResetRequest = "password.reset_request"
+	ActionPasswordReset        = "password.reset"
+	ActionUsernameChange       = "username.change"
+	ActionAccountDelete        = "account.delete"
+	ActionAccountExport        = "account.export"
+	ActionEmailChange          = "email.change"
+	ActionEmailVerify          = "email.verify"
+	ActionPasskeyRegister      = "passkey.register"
+	ActionPasskeyRename        = "passkey.rename"
+	ActionPasskeyDelete        = "passkey.delete"
+	ActionTOTPEnable           = "totp.enable"
+	ActionTOTPDisable          = "totp.disable"
+	ActionRecoveryCodesReset   = "totp.recovery_codes"
+	ActionSessionRevoke        = "session.revoke"
+	ActionSessionRevokeOthers  = "session.revoke_others"
+	ActionAPIKeyCreate         = "apikey.create"
+	ActionAPIKeyRevoke         = "apikey.revoke"
+	ActionIdentityLink         = "identity.link"
+	ActionIdentityUnlink       = "identity.unlink"
+	ActionOAuthConsent         = "oauth.consent"
+	ActionItemCreate           = "item.create"
+	ActionItemUpdate           = "item.update"
+	ActionItemDelete           = "item.delete"
+	ActionItemImport           = "item.import"
+	ActionUserRole             = "user.role"
+	ActionUserDisable          = "user.disable"
+	ActionUserEnable           = "user.enable"
+	ActionUserForceReset       = "user.force_password_reset"
+	ActionUserDelete           = "user.delete"
+	ActionOAuthClientCreate    = "oauth_client.create"
+	ActionOAuthClientDelete    = "oauth_client.delete"
+)
+
+// Event is something that happened to an account.
+type Event struct {
+	// ActorID is the user that did it, zero if they are not known, like with failed sign ins.
+	ActorID int32
+
+	// UserID is the user it happened to, zero if there is none.
+	UserID int32
+
+	Action string
+
+	// Target is what it happened to, like item:5 or session:<id>.
+	Target string
+
+	// Outcome defaults to OutcomeSuccess.
+	Outcome string
+
+	Metadata map[string]any
+}
+
+// Request is the client an event came from.
+type Request struct {
+	IP        string
+	UserAgent string
+}
+
+type requestKey struct{}
+
+// NewContext adds the client of a request to the context, so events recorded with it include the client.
+func NewContext(ctx context.Context, req Request) context.Context {
+	return context.WithValue(ctx, requestKey{}, req)
+}
+
+// FromContext returns the client of the request.
+func FromContext(ctx context.Context) (Request, bool) {
+	req, ok := ctx.Value(requestKey{}).(Request)
+	return req, ok
+}
+
+// Log records events in the database, events can never be changed or removed.
+type Log struct {
+	db  *bob.DB
+	log *slog.Logger
+}
+
+func New(db *bob.DB, log *slog.Logger) *Log {
+	return &Log{
+		db:  db,
+		log: log,
+	}
+}
+
+// Record records an event. Failures are logged instead of returned, so they never fail the action itself.
+func (l *Log) Record(ctx context.Context, event Event) {
+	req, _ := FromContext(ctx)
+	if event.Outcome == "" {
+		event.Outcome = OutcomeSuccess
+	}
+	if event.Metadata == nil {
+		event.Metadata = map[string]any{}
+	}
+	metadata, err := json.Marshal(event.Metadata)
+	if err != nil {
+		metadata = []byte("{}")
+	}
+
+	setter := &models.AuditEventSetter{
+		Action:    omit.From(event.Action),
+		Target:    omit.From(event.Target),
+		IP:        omit.From(req.IP),
+		UserAgent: omit.From(req.UserAgent),
+		Outcome:   omit.From(event.Outcome),
+		Metadata:  omit.From(string(metadata)),
+		CreatedAt: omit.From(time.Now()),
+	}
+	if event.ActorID != 0 {
+		setter.ActorID = omitnull.From(event.ActorID)
+	}
+	if event.UserID != 0 {
+		setter.UserID = omitnull.From(event.UserID)
+	}
+
+	_, err = models.AuditEvents.Insert(setter).Exec(context.WithoutCancel(ctx), l.db)
+	if err != nil {
+		l.log.Error("Failed to record audit event", "action", event.Action, "error", err)
+	}
+}
+
+// Filter narrows down listed events, zero fields match every event.
+type Filter struct {
+	ActorID int32
+	UserID  int32
+	Action  string
+	Target  string
+	Outcome string
+	Start   *time.Time
+	End     *time.Time
+
+	Limit  int
+	Offset int
+}
+
+// List returns the events matching the filter newest first, and how many match in total.
+func (l *Log) List(ctx context.Context, filter Filter) ([]*models.AuditEvent, int64, error) {
+	where := []bob.Mod[*dialect.SelectQuery]{}
+	if filter.ActorID != 0 {
+		where = append(where, models.SelectWhere.AuditEvents.ActorID.EQ(filter.ActorID))
+	}
+	if filter.UserID != 0 {
+		where = append(where, models.SelectWhere.AuditEvents.UserID.EQ(filter.UserID))
+	}
+	if filter.Action != "" {
+		where = append(where, models.SelectWhere.AuditEvents.Action.EQ(filter.Action))
+	}
+	if filter.Target != "" {
+		where = append(where, models.SelectWhere.AuditEvents.Target.EQ(filter.Target))
+	}
+	if filter.Outcome != "" {
+		where = append(where, models.SelectWhere.AuditEvents.Outcome.EQ(filter.Outcome))
+	}
+	if filter.Start != nil {
+		where = append(where, models.SelectWhere.AuditEvents.CreatedAt.GTE(*filter.Start))
+	}
+	if filter.End != nil {
+		where = append(where, models.SelectWhere.AuditEvents.CreatedAt.LTE(*filter.End))
+	}
+
+	count, err := models.AuditEvents.Query(where...).Count(ctx, l.db)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	events, err := models.AuditEvents.Query(
+		append(
+			where,
+			sm.OrderBy(models.AuditEvents.Columns.CreatedAt).Desc(),
+			sm.OrderBy(models.AuditEvents.Columns.ID).Desc(),
+			sm.Limit(filter.Limit),
+			sm.Offset(filter.Offset),
+		)...,
+	).All(ctx, l.db)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return events, count, nil
+}
@@ -0,0 +1,186 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+)
+
+type Scope string
+
+const (
+	ScopeItemRead  Scope = "item:read"
+	ScopeItemWrite Scope = "item:write"
+	ScopeUserRead  Scope = "user:read"
+)
+
+// DefaultScopes are given to keys created without choosing scopes.
+//
+//nolint:gochecknoglobals // Constant
+var DefaultScopes = []Scope{ScopeItemRead, ScopeItemWrite, ScopeUserRead}
+
+const (
+	APIKeyPrefix          = "tsk_"          // All API keys start with this
+	apiKeyIDLength        = 6               // 48 bits
+	apiKeySecretLength    = 32              // 256 bits
+	APIKeyLastUsedRefresh = time.Minute * 1 // How often last used is written
+)
+
+var ErrInvalidAPIKey = errors.New("invalid api key")
+
+// IsAPIKey checks if a bearer token is formatted as an API key.
+func IsAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyPrefix)
+}
+
+// ParseScopes parses a space separated list of scopes.
+func ParseScopes(s string) []Scope {
+	scopes := []Scope{}
+	for scope := range strings.FieldsSeq(s) {
+		scopes = append(scopes, Scope(scope))
+	}
+
+	return scopes
+}
+
+// FormatScopes formats scopes as a space separated list.
+func FormatScopes(scopes []Scope) string {
+	s := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		s = append(s, string(scope))
+	}
+
+	return strings.Join(s, " ")
+}
+
+type NewAPIKeyParams struct {
+	Name      string
+	Scopes    []Scope
+	ExpiresAt *time.Time
+}
+
+// NewAPIKey creates a new API key for the user, returning the key, which is only available now.
+func (u User) NewAPIKey(ctx context.Context, params NewAPIKeyParams) (*models.APIKey, string, error) {
+	id := make([]byte, apiKeyIDLength)
+	if _, err := rand.Read(id); err != nil {
+		return nil, "", err
+	}
+	secret := make([]byte, apiKeySecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", err
+	}
+
+	// The prefix identifies the key, the secret is only stored hashed
+	prefix := APIKeyPrefix + hex.EncodeToString(id)
+	secretString := hex.EncodeToString(secret)
+
+	key, err := models.APIKeys.Insert(
+		&models.APIKeySetter{
+			Name:      omit.From(params.Name),
+			Prefix:    omit.From(prefix),
+			Hash:      omit.From(hashSecret(secretString)),
+			Scopes:    omit.From(FormatScopes(params.Scopes)),
+			CreatedAt: omit.From(time.Now()),
+			ExpiresAt: omitnull.FromPtr(params.ExpiresAt),
+			UserID:    omit.From(u.ID),
+		},
+	).One(ctx, u.db)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return key, prefix + "_" + secretString, nil
+}
+
+// APIKeys retrieves the user's API keys.
+func (u User) APIKeys(ctx context.Context) (models.APIKeySlice, error) {
+	return models.APIKeys.Query(
+		models.SelectWhere.APIKeys.UserID.EQ(u.ID),
+		sm.OrderBy(models.APIKeys.Columns.CreatedAt).Desc(),
+	).All(ctx, u.db)
+}
+
+// RevokeAPIKey deletes one of the user's API keys.
+func (u User) RevokeAPIKey(ctx context.Context, id int32) error {
+	key, err := models.APIKeys.Query(
+		models.SelectWhere.APIKeys.ID.EQ(id),
+		models.SelectWhere.APIKeys.UserID.EQ(u.ID),
+	).One(ctx, u.db)
+	if err != nil {
+		return err
+	}
+
+	return key.Delete(ctx, u.db)
+}
+
+// HasScope checks if the user is allowed to act within a scope.
+// Users that did not authenticate with an API key have every scope.
+func (u User) HasScope(scope Scope) bool {
+	if u.APIKey == nil {
+		return true
+	}
+
+	return slices.Contains(ParseScopes(u.APIKey.Scopes), scope)
+}
+
+// GetUserFromAPIKey retrieves a user from an API key.
+func (a *Auth) GetUserFromAPIKey(ctx context.Context, apiKey string) (User, error) {
+	id, secret, ok := strings.Cut(strings.TrimPrefix(apiKey, APIKeyPrefix), "_")
+	if !IsAPIKey(apiKey) || !ok {
+		return User{}, ErrInvalidAPIKey
+	}
+	prefix := APIKeyPrefix + id
+
+	// Get key
+	key, err := models.APIKeys.Query(
+		models.SelectWhere.APIKeys.Prefix.EQ(prefix),
+	).One(ctx, a.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return User{}, ErrInvalidAPIKey
+		}
+		return User{}, err
+	}
+
+	// Validate key
+	if subtle.ConstantTimeCompare(hashSecret(secret), key.Hash) != 1 {
+		return User{}, ErrInvalidAPIKey
+	}
+	if key.ExpiresAt.IsValue() && time.Now().After(key.ExpiresAt.MustGet()) {
+		return User{}, ErrInvalidAPIKey
+	}
+
+	// Update last used, but not on every request
+	if !key.LastUsed.IsValue() || time.Since(key.LastUsed.MustGet()) > APIKeyLastUsedRefresh {
+		err = key.Update(ctx, a.db, &models.APIKeySetter{
+			LastUsed: omitnull.From(time.Now()),
+		})
+		if err != nil {
+			return User{}, err
+		}
+	}
+
+	// Get user
+	user, err := a.getCachedUser(ctx, key.UserID)
+	if err != nil {
+		return User{}, err
+	}
+	if user.Disabled() {
+		return User{}, ErrUserDisabled
+	}
+	user.APIKey = key
+
+	return user, nil
+}
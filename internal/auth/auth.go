@@ -3,37 +3,80 @@ package auth
 import (
 	"context"
 	"errors"
-	"fmt"
 	"strconv"
+	"sync"
 
-	"github.com/aarondl/opt/null"
 	"github.com/aarondl/opt/omit"
 	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/stephenafamo/bob"
-	"golang.org/x/crypto/bcrypt"
 
+	"github.com/spotdemo4/ts-server/internal/audit"
 	"github.com/spotdemo4/ts-server/internal/bob/models"
 )
 
 type Auth struct {
-	Web    *webauthn.WebAuthn
-	issuer string
-	key    string
+	Web        *webauthn.WebAuthn
+	Ceremonies CeremonyStore
+	issuer     string
+	url        string
+	admin      string
+	passwords  PasswordPolicy
+	hasher     PasswordHasher
+	events     *audit.Log
 
-	db *bob.DB
+	db       *bob.DB
+	cache    *userCache
+	keys     *keyRing
+	box      *secretBox
+	attempts *loginSweeper
+
+	// dummyHash is checked when a user has no password, so unknown usernames take as long as known ones
+	dummyHash func() string
 }
 
-// New creates a new Auth instance.
-func New(db *bob.DB, issuer string, key string, web *webauthn.WebAuthn) *Auth {
+// New creates a new Auth instance, the signing keys must be loaded with LoadKeys before tokens are issued.
+// The user with the admin username always becomes an admin when they sign up,
+// and the secret key encrypts secrets stored in the database.
+func New(
+	db *bob.DB,
+	issuer string,
+	url string,
+	admin string,
+	secret string,
+	web *webauthn.WebAuthn,
+	ceremonies CeremonyStore,
+	keys KeyConfig,
+	passwords PasswordPolicy,
+	hasher PasswordHasher,
+	events *audit.Log,
+) (*Auth, error) {
+	box, err := newSecretBox(secret)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Auth{
-		Web:    web,
-		issuer: issuer,
-		key:    key,
+		Web:        web,
+		Ceremonies: ceremonies,
+		issuer:     issuer,
+		url:        url,
+		admin:      admin,
+		passwords:  passwords,
+		hasher:     hasher,
+		events:     events,
 
-		db: db,
-	}
+		db:       db,
+		cache:    newUserCache(),
+		keys:     newKeyRing(keys),
+		box:      box,
+		attempts: &loginSweeper{},
+		dummyHash: sync.OnceValue(func() string {
+			hash, _ := hasher.Hash("not a real password")
+			return hash
+		}),
+	}, nil
 }
 
 type NewUserParams struct {
@@ -41,25 +84,50 @@ type NewUserParams struct {
 	Password string
 }
 
-// NewUser creates a new user in the database.
+// NewUser creates a new user in the database, if the password meets the password policy.
+// The first user created becomes an admin.
 func (a *Auth) NewUser(ctx context.Context, params NewUserParams) error {
-	hash, err := bcrypt.GenerateFromPassword([]byte(params.Password), bcrypt.DefaultCost)
+	err := a.CheckPassword(ctx, params.Password, params.Username)
 	if err != nil {
 		return err
 	}
 
-	_, err = models.Users.Insert(
-		&models.UserSetter{
-			Username:   omit.From(params.Username),
-			Password:   omit.From(string(hash)),
-			WebauthnID: omit.From(uuid.New().String()),
-		},
-	).Exec(ctx, a.db)
+	hash, err := a.hasher.Hash(params.Password)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return a.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		// Get role
+		role, err := a.newUserRole(ctx, exec, params.Username)
+		if err != nil {
+			return err
+		}
+
+		_, err = models.Users.Insert(
+			&models.UserSetter{
+				Username:   omit.From(params.Username),
+				Password:   omit.From(hash),
+				WebauthnID: omit.From(uuid.New().String()),
+				Role:       omit.From(string(role)),
+			},
+		).Exec(ctx, exec)
+
+		return err
+	})
+}
+
+// newUserRole returns the role of a user that is being created.
+func (a *Auth) newUserRole(ctx context.Context, exec bob.Executor, username string) (Role, error) {
+	count, err := models.Users.Query().Count(ctx, exec)
+	if err != nil {
+		return "", err
+	}
+	if count == 0 || (a.admin != "" && username == a.admin) {
+		return RoleAdmin, nil
+	}
+
+	return RoleUser, nil
 }
 
 // GetUser retrieves a user by their ID.
@@ -94,24 +162,32 @@ func (a *Auth) GetUserByName(ctx context.Context, username string) (User, error)
 	}, nil
 }
 
+// GetUserByWebAuthnID retrieves a user by their WebAuthn user handle.
+func (a *Auth) GetUserByWebAuthnID(ctx context.Context, webauthnID []byte) (User, error) {
+	user, err := models.Users.Query(
+		models.SelectWhere.Users.WebauthnID.EQ(string(webauthnID)),
+	).One(ctx, a.db)
+	if err != nil {
+		return User{}, err
+	}
+
+	return User{
+		User: *user,
+		db:   a.db,
+		auth: a,
+	}, nil
+}
+
 type Claims struct {
 	jwt.RegisteredClaims
 
-	Password         string `json:"password"`
-	ProfilePictureID *int32 `json:"profilePictureID"`
-	WebauthnID       string `json:"webauthnID"`
+	Session string `json:"sid"`
+	Version int32  `json:"ver"`
 }
 
-// GetUserFromToken retrieves a user from a JWT token.
-func (a *Auth) GetUserFromToken(tokenString string) (User, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (any, error) {
-		// Don't forget to validate the alg is what you expect:
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-
-		return []byte(a.key), nil
-	})
+// GetUserFromToken retrieves a user from a JWT token, making sure its session has not been revoked.
+func (a *Auth) GetUserFromToken(ctx context.Context, tokenString string) (User, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, a.keyFunc)
 	if err != nil {
 		return User{}, err
 	}
@@ -124,23 +200,57 @@ func (a *Auth) GetUserFromToken(tokenString string) (User, error) {
 		return User{}, errors.New("could not parse claims")
 	}
 
-	userid, err := strconv.ParseInt(claims.ID, 10, 32)
+	// Challenge tokens are not access tokens
+	if len(claims.Audience) != 0 {
+		return User{}, errors.New("not an access token")
+	}
+
+	userid, err := strconv.ParseInt(claims.Subject, 10, 32)
 	if err != nil {
-		return User{}, errors.New("invalid id")
+		return User{}, errors.New("invalid subject")
 	}
 
-	return User{
-		User: models.User{
-			ID:               int32(userid),
-			Username:         claims.Subject,
-			Password:         claims.Password,
-			ProfilePictureID: null.FromPtr(claims.ProfilePictureID),
-			WebauthnID:       claims.WebauthnID,
-		},
+	// Get user
+	user, err := a.getCachedUser(ctx, int32(userid))
+	if err != nil {
+		return User{}, err
+	}
 
-		db:   a.db,
-		auth: a,
-	}, nil
+	// Tokens issued before the user's security stamp changed are no longer valid
+	if claims.Version != user.TokenVersion {
+		return User{}, errors.New("token version outdated")
+	}
+	if user.Disabled() {
+		return User{}, ErrUserDisabled
+	}
+
+	// Check session
+	err = a.checkSession(ctx, user.ID, claims.Session)
+	if err != nil {
+		return User{}, err
+	}
+	user.SessionID = claims.Session
+
+	return user, nil
+}
+
+// getCachedUser retrieves a user by their ID, using the cache if possible.
+func (a *Auth) getCachedUser(ctx context.Context, userid int32) (User, error) {
+	if user, ok := a.cache.get(userid); ok {
+		return User{
+			User: user,
+			db:   a.db,
+			auth: a,
+		}, nil
+	}
+
+	user, err := a.GetUser(ctx, userid)
+	if err != nil {
+		return User{}, err
+	}
+	a.cache.set(user.User)
+
+	return user, nil
 }
 
 // key is an unexported type for keys defined in this package.
@@ -0,0 +1,168 @@
+package auth
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha1" //nolint:gosec // Breached passwords are indexed by SHA-1
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	hibpPrefixLength = 5   // Hash prefix ranges are looked up by, as in the Pwned Passwords API
+	hibpLineLength   = 128 // Longer than any line of a range file
+)
+
+// BreachedPasswords checks passwords against passwords exposed in data breaches.
+type BreachedPasswords interface {
+	// Count returns how many times the password appeared in breaches, zero if it never did.
+	Count(ctx context.Context, password string) (int, error)
+}
+
+// FileBreachedPasswords checks passwords against a local copy of the Pwned Passwords list in the HIBP format.
+// The path is either a directory of range files named by hash prefix, like 21BD1.txt, each with SUFFIX:COUNT lines,
+// or a single file of HASH:COUNT lines sorted by hash. Passwords are looked up by hash prefix like with the
+// Pwned Passwords API, but they never leave the server.
+type FileBreachedPasswords struct {
+	path string
+}
+
+func NewFileBreachedPasswords(path string) (*FileBreachedPasswords, error) {
+	_, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileBreachedPasswords{
+		path: path,
+	}, nil
+}
+
+func (b *FileBreachedPasswords) Count(_ context.Context, password string) (int, error) {
+	sum := sha1.Sum([]byte(password)) //nolint:gosec // Not used for security, only for lookup
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:hibpPrefixLength], hash[hibpPrefixLength:]
+
+	info, err := os.Stat(b.path)
+	if err != nil {
+		return 0, err
+	}
+	if info.IsDir() {
+		return b.countRange(prefix, suffix)
+	}
+
+	return b.countSorted(hash)
+}
+
+// countRange looks the suffix up in the range file of the prefix.
+func (b *FileBreachedPasswords) countRange(prefix string, suffix string) (int, error) {
+	f, err := os.Open(filepath.Join(b.path, prefix+".txt"))
+	if errors.Is(err, os.ErrNotExist) {
+		f, err = os.Open(filepath.Join(b.path, prefix))
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		count, ok := hibpCount(scanner.Text(), suffix)
+		if ok {
+			return count, nil
+		}
+	}
+
+	return 0, scanner.Err()
+}
+
+// countSorted binary searches the sorted file for the hash, seeking to the start of lines.
+func (b *FileBreachedPasswords) countSorted(hash string) (int, error) {
+	f, err := os.Open(b.path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	// Find the first line at or after the hash
+	low, high := int64(0), info.Size()
+	for low < high {
+		mid := low + (high-low)/2 //nolint:mnd // Halve
+
+		var line string
+		line, err = readLineAfter(f, mid)
+		if err != nil {
+			return 0, err
+		}
+		if line != "" && strings.ToUpper(line[:min(len(line), len(hash))]) < hash {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+
+	// The first line at or after the hash starts at the final offset
+	line, err := readLineAfter(f, low)
+	if err != nil {
+		return 0, err
+	}
+	count, _ := hibpCount(line, hash)
+
+	return count, nil
+}
+
+// readLineAfter reads the first full line starting after an offset, or at the offset if it is zero.
+func readLineAfter(r io.ReaderAt, offset int64) (string, error) {
+	start := offset
+	if offset > 0 {
+		start = offset - 1
+	}
+
+	buf := make([]byte, hibpLineLength*2) //nolint:mnd // The rest of a line and the next line
+	n, err := r.ReadAt(buf, start)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+	buf = buf[:n]
+
+	// Skip the partial line
+	if offset > 0 {
+		i := bytes.IndexByte(buf, '\n')
+		if i < 0 {
+			return "", nil
+		}
+		buf = buf[i+1:]
+	}
+
+	line, _, _ := bytes.Cut(buf, []byte("\n"))
+	return strings.TrimSpace(string(line)), nil
+}
+
+// hibpCount parses a HASH:COUNT line, if it is for the hash.
+func hibpCount(line string, hash string) (int, bool) {
+	lineHash, count, ok := strings.Cut(strings.TrimSpace(line), ":")
+	if !ok || !strings.EqualFold(lineHash, hash) {
+		return 0, false
+	}
+
+	n, err := strconv.Atoi(count)
+	if err != nil {
+		return 0, false
+	}
+
+	return n, true
+}
@@ -0,0 +1,157 @@
+package auth_test
+
+import (
+	"crypto/sha1" //nolint:gosec // Breached passwords are indexed by SHA-1
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/spotdemo4/ts-server/internal/auth"
+)
+
+func sha1Hex(password string) string {
+	sum := sha1.Sum([]byte(password)) //nolint:gosec // Breached passwords are indexed by SHA-1
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+// TestCountSorted looks up every password of sorted files, and passwords between and around them.
+func TestCountSorted(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		passwords int
+		line      func(hash string, count int) string
+	}{
+		{
+			name:      "empty",
+			passwords: 0,
+			line:      func(hash string, count int) string { return fmt.Sprintf("%s:%d\n", hash, count) },
+		},
+		{
+			name:      "one line",
+			passwords: 1,
+			line:      func(hash string, count int) string { return fmt.Sprintf("%s:%d\n", hash, count) },
+		},
+		{
+			name:      "many lines",
+			passwords: 1000,
+			line:      func(hash string, count int) string { return fmt.Sprintf("%s:%d\n", hash, count) },
+		},
+		{
+			name:      "crlf",
+			passwords: 1000,
+			line:      func(hash string, count int) string { return fmt.Sprintf("%s:%d\r\n", hash, count) },
+		},
+		{
+			name:      "lowercase",
+			passwords: 1000,
+			line: func(hash string, count int) string {
+				return fmt.Sprintf("%s:%d\n", strings.ToLower(hash), count)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Counts are the index of the password plus one
+			counts := map[string]int{}
+			byHash := map[string]int{}
+			for i := range tt.passwords {
+				password := fmt.Sprintf("password%d", i)
+				counts[password] = i + 1
+				byHash[sha1Hex(password)] = i + 1
+			}
+
+			var file strings.Builder
+			for _, hash := range slices.Sorted(maps.Keys(byHash)) {
+				file.WriteString(tt.line(hash, byHash[hash]))
+			}
+
+			path := filepath.Join(t.TempDir(), "pwned-passwords.txt")
+			err := os.WriteFile(path, []byte(file.String()), 0o600)
+			if err != nil {
+				t.Fatalf("Error writing file: %v", err)
+			}
+			breached, err := auth.NewFileBreachedPasswords(path)
+			if err != nil {
+				t.Fatalf("Error opening file: %v", err)
+			}
+
+			for password, want := range counts {
+				got, err := breached.Count(t.Context(), password)
+				if err != nil {
+					t.Fatalf("Count(%q) returned error: %v", password, err)
+				}
+				if got != want {
+					t.Errorf("Count(%q) = %d, want %d", password, got, want)
+				}
+			}
+			for i := range 100 {
+				password := fmt.Sprintf("not breached%d", i)
+				got, err := breached.Count(t.Context(), password)
+				if err != nil {
+					t.Fatalf("Count(%q) returned error: %v", password, err)
+				}
+				if got != 0 {
+					t.Errorf("Count(%q) = %d, want 0", password, got)
+				}
+			}
+		})
+	}
+}
+
+func TestCountRange(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	hash := sha1Hex("password")
+	err := os.WriteFile(
+		filepath.Join(dir, hash[:5]+".txt"),
+		[]byte("0018A45C4D1DEF81644B54AB7F969B88D65:1\r\n"+hash[5:]+":42\r\n"),
+		0o600,
+	)
+	if err != nil {
+		t.Fatalf("Error writing file: %v", err)
+	}
+	breached, err := auth.NewFileBreachedPasswords(dir)
+	if err != nil {
+		t.Fatalf("Error opening directory: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		want     int
+	}{
+		{
+			name:     "breached",
+			password: "password",
+			want:     42,
+		},
+		{
+			name:     "no range file",
+			password: "glimmer-otter-57-harbor",
+			want:     0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := breached.Count(t.Context(), tt.password)
+			if err != nil {
+				t.Fatalf("Count(%q) returned error: %v", tt.password, err)
+			}
+			if got != tt.want {
+				t.Errorf("Count(%q) = %d, want %d", tt.password, got, tt.want)
+			}
+		})
+	}
+}
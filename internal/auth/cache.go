@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"sync"
+	"time"
+
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+)
+
+const (
+	UserCacheTTL  = time.Second * 30 // 30 seconds
+	UserCacheSize = 1024
+)
+
+type cachedUser struct {
+	user    models.User
+	expires time.Time
+}
+
+// userCache is a small in-memory cache of users to avoid a database lookup on every request.
+type userCache struct {
+	users map[int32]cachedUser
+	mu    sync.Mutex
+}
+
+func newUserCache() *userCache {
+	return &userCache{
+		users: make(map[int32]cachedUser),
+		mu:    sync.Mutex{},
+	}
+}
+
+// get retrieves a user from the cache, if it exists and has not expired.
+func (c *userCache) get(userid int32) (models.User, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, ok := c.users[userid]
+	if !ok {
+		return models.User{}, false
+	}
+	if time.Now().After(cached.expires) {
+		delete(c.users, userid)
+		return models.User{}, false
+	}
+
+	return cached.user, true
+}
+
+// set adds a user to the cache, evicting expired users if the cache is full.
+func (c *userCache) set(user models.User) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.users) >= UserCacheSize {
+		now := time.Now()
+		for id, cached := range c.users {
+			if now.After(cached.expires) {
+				delete(c.users, id)
+			}
+		}
+	}
+	if len(c.users) >= UserCacheSize {
+		return
+	}
+
+	c.users[user.ID] = cachedUser{
+		user:    user,
+		expires: time.Now().Add(UserCacheTTL),
+	}
+}
+
+// forget removes a user from the cache.
+func (c *userCache) forget(userid int32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.users, userid)
+}
@@ -0,0 +1,155 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+)
+
+const (
+	CeremonyTTL           = time.Minute * 5 // 5 minutes
+	CeremonySweepInterval = time.Minute * 1 // How often expired ceremonies are removed
+)
+
+var ErrCeremonyNotFound = errors.New("ceremony does not exist or has expired")
+
+// CeremonyStore holds the session data of WebAuthn ceremonies between their begin and finish steps.
+type CeremonyStore interface {
+	// Put stores the session data, returning the random ID of the ceremony.
+	Put(ctx context.Context, data *webauthn.SessionData) (string, error)
+
+	// Take retrieves the session data of a ceremony and removes it, so it can only be used once.
+	Take(ctx context.Context, id string) (*webauthn.SessionData, error)
+}
+
+type memoryCeremony struct {
+	data    *webauthn.SessionData
+	expires time.Time
+}
+
+// MemoryCeremonyStore keeps ceremonies in memory, they are lost on restart.
+type MemoryCeremonyStore struct {
+	ceremonies map[string]memoryCeremony
+	swept      time.Time
+	mu         sync.Mutex
+}
+
+func NewMemoryCeremonyStore() *MemoryCeremonyStore {
+	return &MemoryCeremonyStore{
+		ceremonies: make(map[string]memoryCeremony),
+		swept:      time.Now(),
+		mu:         sync.Mutex{},
+	}
+}
+
+// Put stores the session data, removing expired ceremonies every sweep interval.
+func (s *MemoryCeremonyStore) Put(_ context.Context, data *webauthn.SessionData) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.swept) > CeremonySweepInterval {
+		for id, ceremony := range s.ceremonies {
+			if now.After(ceremony.expires) {
+				delete(s.ceremonies, id)
+			}
+		}
+		s.swept = now
+	}
+
+	id := uuid.New().String()
+	s.ceremonies[id] = memoryCeremony{
+		data:    data,
+		expires: now.Add(CeremonyTTL),
+	}
+
+	return id, nil
+}
+
+func (s *MemoryCeremonyStore) Take(_ context.Context, id string) (*webauthn.SessionData, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ceremony, ok := s.ceremonies[id]
+	if !ok {
+		return nil, ErrCeremonyNotFound
+	}
+	delete(s.ceremonies, id)
+
+	if time.Now().After(ceremony.expires) {
+		return nil, ErrCeremonyNotFound
+	}
+
+	return ceremony.data, nil
+}
+
+// SQLiteCeremonyStore keeps ceremonies in the database, so they survive restarts and are shared between replicas.
+type SQLiteCeremonyStore struct {
+	db *bob.DB
+}
+
+func NewSQLiteCeremonyStore(db *bob.DB) *SQLiteCeremonyStore {
+	return &SQLiteCeremonyStore{
+		db: db,
+	}
+}
+
+// Put stores the session data, removing expired ceremonies.
+func (s *SQLiteCeremonyStore) Put(ctx context.Context, data *webauthn.SessionData) (string, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = models.Ceremonies.Delete(
+		models.DeleteWhere.Ceremonies.ExpiresAt.LT(time.Now()),
+	).Exec(ctx, s.db)
+	if err != nil {
+		return "", err
+	}
+
+	ceremony, err := models.Ceremonies.Insert(
+		&models.CeremonySetter{
+			ID:        omit.From(uuid.New().String()),
+			Data:      omit.From(b),
+			ExpiresAt: omit.From(time.Now().Add(CeremonyTTL)),
+		},
+	).One(ctx, s.db)
+	if err != nil {
+		return "", err
+	}
+
+	return ceremony.ID, nil
+}
+
+func (s *SQLiteCeremonyStore) Take(ctx context.Context, id string) (*webauthn.SessionData, error) {
+	// Deleting and returning the row makes sure only one request can use it
+	ceremony, err := models.Ceremonies.Delete(
+		models.DeleteWhere.Ceremonies.ID.EQ(id),
+		models.DeleteWhere.Ceremonies.ExpiresAt.GT(time.Now()),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrCeremonyNotFound
+		}
+		return nil, err
+	}
+
+	data := &webauthn.SessionData{}
+	err = json.Unmarshal(ceremony.Data, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -1,7 +1,8 @@
 package auth
 
 import (
-	"strings"
+	"encoding/base64"
+	"encoding/json"
 
 	"github.com/go-webauthn/webauthn/protocol"
 	"github.com/go-webauthn/webauthn/webauthn"
@@ -13,11 +14,14 @@ func NewCreds(creds models.CredentialSlice) []webauthn.Credential {
 	webauthnCreds := []webauthn.Credential{}
 
 	for _, c := range creds {
+		id, err := DecodeCredentialID(c.CredID)
+		if err != nil {
+			continue
+		}
+
 		transports := []protocol.AuthenticatorTransport{}
 		if c.Transports.IsValue() {
-			for t := range strings.SplitSeq(c.Transports.GetOrZero(), " ") {
-				transports = append(transports, protocol.AuthenticatorTransport(t))
-			}
+			transports = ParseTransports(c.Transports.GetOrZero())
 		}
 
 		flags := webauthn.CredentialFlags{}
@@ -40,10 +44,11 @@ func NewCreds(creds models.CredentialSlice) []webauthn.Credential {
 		}
 
 		webauthnCreds = append(webauthnCreds, webauthn.Credential{
-			ID:        []byte(c.CredID),
+			ID:        id,
 			PublicKey: c.CredPublicKey,
 			Authenticator: webauthn.Authenticator{
-				SignCount: uint32(c.SignCount),
+				SignCount:    uint32(c.SignCount),
+				CloneWarning: c.CloneWarning,
 			},
 			Transport:   transports,
 			Flags:       flags,
@@ -53,3 +58,31 @@ func NewCreds(creds models.CredentialSlice) []webauthn.Credential {
 
 	return webauthnCreds
 }
+
+// EncodeCredentialID encodes a raw credential ID as it is stored, base64url without padding.
+func EncodeCredentialID(id []byte) string {
+	return base64.RawURLEncoding.EncodeToString(id)
+}
+
+// DecodeCredentialID decodes a stored credential ID.
+func DecodeCredentialID(id string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(id)
+}
+
+// EncodeTransports encodes transports as they are stored, a JSON array.
+func EncodeTransports(transports []protocol.AuthenticatorTransport) string {
+	if transports == nil {
+		transports = []protocol.AuthenticatorTransport{}
+	}
+
+	b, _ := json.Marshal(transports)
+	return string(b)
+}
+
+// ParseTransports parses stored transports, ignoring invalid values.
+func ParseTransports(s string) []protocol.AuthenticatorTransport {
+	transports := []protocol.AuthenticatorTransport{}
+	_ = json.Unmarshal([]byte(s), &transports)
+
+	return transports
+}
@@ -0,0 +1,292 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+)
+
+type EmailPurpose string
+
+const (
+	EmailPurposeVerify EmailPurpose = "verify"
+	EmailPurposeReset  EmailPurpose = "reset"
+)
+
+const (
+	emailTokenLength           = 32             // 256 bits
+	EmailVerifyTokenDuration   = time.Hour * 24 // 1 day
+	PasswordResetTokenDuration = time.Hour * 1  // 1 hour
+)
+
+var (
+	ErrInvalidEmailToken    = errors.New("invalid or expired token")
+	ErrEmailAlreadyVerified = errors.New("email already verified")
+	ErrEmailInUse           = errors.New("email already in use")
+)
+
+// NormalizeEmail formats an email address the way it is stored.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// EmailVerified checks if the user has an email address they proved they own.
+func (u User) EmailVerified() bool {
+	return u.Email.IsValue() && u.EmailVerifiedAt.IsValue()
+}
+
+// SetEmail sets the user's email address, returning a token that verifies it.
+// The address cannot be used for account recovery until it is verified.
+func (u User) SetEmail(ctx context.Context, email string) (string, error) {
+	email = NormalizeEmail(email)
+	if u.EmailVerified() && u.Email.MustGet() == email {
+		return "", ErrEmailAlreadyVerified
+	}
+
+	var token string
+	err := u.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		err := u.Update(ctx, exec, &models.UserSetter{
+			Email:           omitnull.From(email),
+			EmailVerifiedAt: omitnull.FromPtr[time.Time](nil),
+		})
+		if err != nil {
+			return err
+		}
+
+		// Tokens sent to the previous address are no longer valid
+		_, err = models.EmailTokens.Delete(
+			models.DeleteWhere.EmailTokens.UserID.EQ(u.ID),
+		).Exec(ctx, exec)
+		if err != nil {
+			return err
+		}
+
+		token, err = newEmailToken(ctx, exec, u.ID, email, EmailPurposeVerify, EmailVerifyTokenDuration)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	u.auth.cache.forget(u.ID)
+
+	return token, nil
+}
+
+// RemoveEmail removes the user's email address.
+func (u User) RemoveEmail(ctx context.Context) error {
+	err := u.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		err := u.Update(ctx, exec, &models.UserSetter{
+			Email:           omitnull.FromPtr[string](nil),
+			EmailVerifiedAt: omitnull.FromPtr[time.Time](nil),
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = models.EmailTokens.Delete(
+			models.DeleteWhere.EmailTokens.UserID.EQ(u.ID),
+		).Exec(ctx, exec)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	u.auth.cache.forget(u.ID)
+
+	return nil
+}
+
+// VerifyEmail marks the email address a verification token was sent to as verified.
+func (a *Auth) VerifyEmail(ctx context.Context, token string) (User, error) {
+	var user User
+	err := a.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		emailToken, err := useEmailToken(ctx, exec, token, EmailPurposeVerify)
+		if err != nil {
+			return err
+		}
+
+		// The user may have changed their address since the token was sent
+		u, err := models.FindUser(ctx, exec, emailToken.UserID)
+		if err != nil {
+			return err
+		}
+		if u.Email.GetOrZero() != emailToken.Email {
+			return ErrInvalidEmailToken
+		}
+
+		// Only one account can recover with an address
+		exists, err := models.Users.Query(
+			models.SelectWhere.Users.Email.EQ(emailToken.Email),
+			models.SelectWhere.Users.EmailVerifiedAt.IsNotNull(),
+		).Exists(ctx, exec)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return ErrEmailInUse
+		}
+
+		err = u.Update(ctx, exec, &models.UserSetter{
+			EmailVerifiedAt: omitnull.From(time.Now()),
+		})
+		if err != nil {
+			return err
+		}
+
+		user = User{
+			User: *u,
+			db:   a.db,
+			auth: a,
+		}
+		return nil
+	})
+	if err != nil {
+		return User{}, err
+	}
+	a.cache.forget(user.ID)
+
+	return user, nil
+}
+
+// NewPasswordReset creates a password reset token for the user with the verified email address,
+// returning the user and the token. Previous reset tokens are no longer valid.
+func (a *Auth) NewPasswordReset(ctx context.Context, email string) (User, string, error) {
+	email = NormalizeEmail(email)
+
+	u, err := models.Users.Query(
+		models.SelectWhere.Users.Email.EQ(email),
+		models.SelectWhere.Users.EmailVerifiedAt.IsNotNull(),
+	).One(ctx, a.db)
+	if err != nil {
+		return User{}, "", err
+	}
+	user := User{
+		User: *u,
+		db:   a.db,
+		auth: a,
+	}
+	if user.Disabled() {
+		return User{}, "", ErrUserDisabled
+	}
+
+	var token string
+	err = a.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		_, err := models.EmailTokens.Delete(
+			models.DeleteWhere.EmailTokens.UserID.EQ(user.ID),
+			models.DeleteWhere.EmailTokens.Purpose.EQ(string(EmailPurposeReset)),
+		).Exec(ctx, exec)
+		if err != nil {
+			return err
+		}
+
+		token, err = newEmailToken(ctx, exec, user.ID, email, EmailPurposeReset, PasswordResetTokenDuration)
+		return err
+	})
+	if err != nil {
+		return User{}, "", err
+	}
+
+	return user, token, nil
+}
+
+// ResetPassword sets the password of the user a password reset token was sent to.
+// Every session of the user is revoked.
+func (a *Auth) ResetPassword(ctx context.Context, token string, password string) (User, error) {
+	// Check the password before the token is used up, so it can be tried again with a better password
+	var user User
+	err := a.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		emailToken, err := useEmailToken(ctx, exec, token, EmailPurposeReset)
+		if err != nil {
+			return err
+		}
+
+		// The token is only valid while the address it was sent to is verified
+		row, err := models.FindUser(ctx, exec, emailToken.UserID)
+		if err != nil {
+			return err
+		}
+		user = User{
+			User: *row,
+			db:   a.db,
+			auth: a,
+		}
+		if !user.EmailVerified() || user.Email.MustGet() != emailToken.Email {
+			return ErrInvalidEmailToken
+		}
+
+		return a.CheckPassword(ctx, password, user.Username, emailToken.Email)
+	})
+	if err != nil {
+		return User{}, err
+	}
+
+	err = user.SetPassword(ctx, password)
+	if err != nil {
+		return User{}, err
+	}
+
+	return user, nil
+}
+
+// newEmailToken creates a token that is sent to an email address, returning it.
+func newEmailToken(
+	ctx context.Context,
+	exec bob.Executor,
+	userid int32,
+	email string,
+	purpose EmailPurpose,
+	duration time.Duration,
+) (string, error) {
+	b := make([]byte, emailTokenLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	_, err := models.EmailTokens.Insert(
+		&models.EmailTokenSetter{
+			Hash:      omit.From(hashSecret(token)),
+			Purpose:   omit.From(string(purpose)),
+			Email:     omit.From(email),
+			CreatedAt: omit.From(time.Now()),
+			ExpiresAt: omit.From(time.Now().Add(duration)),
+			UserID:    omit.From(userid),
+		},
+	).Exec(ctx, exec)
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// useEmailToken marks a token as used, returning it.
+func useEmailToken(ctx context.Context, exec bob.Executor, token string, purpose EmailPurpose) (*models.EmailToken, error) {
+	// Only accept each token once, even with concurrent requests
+	used, err := models.EmailTokens.Update(
+		(&models.EmailTokenSetter{
+			UsedAt: omitnull.From(time.Now()),
+		}).UpdateMod(),
+		models.UpdateWhere.EmailTokens.Hash.EQ(hashSecret(token)),
+		models.UpdateWhere.EmailTokens.Purpose.EQ(string(purpose)),
+		models.UpdateWhere.EmailTokens.UsedAt.IsNull(),
+		models.UpdateWhere.EmailTokens.ExpiresAt.GT(time.Now()),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	if len(used) != 1 {
+		return nil, ErrInvalidEmailToken
+	}
+
+	return used[0], nil
+}
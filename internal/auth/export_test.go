@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stephenafamo/bob"
+
+	"github.com/spotdemo4/ts-server/internal/audit"
+	"github.com/spotdemo4/ts-server/internal/database"
+)
+
+// Exported for tests
+//
+//nolint:gochecknoglobals // Constant
+var (
+	ValidateTOTP  = validateTOTP
+	ParseArgon2id = parseArgon2id
+)
+
+// TOTPCode returns the code of a base32 secret for a time step.
+func TOTPCode(secret string, step int64) string {
+	key, err := totpEncoding.DecodeString(secret)
+	if err != nil {
+		panic(err)
+	}
+
+	return totpCode(key, step)
+}
+
+// NewTestAuth returns an Auth using a new database with the current schema.
+func NewTestAuth(t *testing.T) (*Auth, *bob.DB) {
+	t.Helper()
+
+	db, err := database.New("sqlite:" + filepath.Join(t.TempDir(), "auth.db"))
+	if err != nil {
+		t.Fatalf("Error opening database: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	schema, err := os.ReadFile("../../db/schema.sql")
+	if err != nil {
+		t.Fatalf("Error reading schema: %v", err)
+	}
+	_, err = db.ExecContext(t.Context(), string(schema))
+	if err != nil {
+		t.Fatalf("Error creating schema: %v", err)
+	}
+
+	a, err := New(
+		db,
+		"test",
+		"http://localhost",
+		"",
+		"test secret",
+		nil,
+		nil,
+		KeyConfig{},
+		PasswordPolicy{},
+		NewArgon2idHasher(DefaultArgon2Params),
+		audit.New(db, slog.New(slog.NewTextHandler(io.Discard, nil))),
+	)
+	if err != nil {
+		t.Fatalf("Error creating auth: %v", err)
+	}
+
+	return a, db
+}
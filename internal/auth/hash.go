@@ -0,0 +1,173 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	HashArgon2id = "argon2id" // Current password hash algorithm
+	HashBcrypt   = "bcrypt"   // Legacy password hash algorithm, truncates passwords to 72 bytes
+)
+
+var ErrUnknownHash = errors.New("unknown password hash format")
+
+// PasswordHasher hashes passwords into PHC strings, like $argon2id$v=19$m=19456,t=2,p=1$<salt>$<hash>.
+type PasswordHasher interface {
+	// Hash hashes a password with the current algorithm and parameters.
+	Hash(password string) (string, error)
+
+	// Verify checks a password against a hash of any supported algorithm.
+	Verify(hash string, password string) (bool, error)
+
+	// NeedsRehash checks if a hash was made with an outdated algorithm or parameters.
+	NeedsRehash(hash string) bool
+}
+
+// Argon2Params are the tunable parameters of Argon2id.
+type Argon2Params struct {
+	Memory     uint32 // KiB
+	Iterations uint32
+	Threads    uint8
+	SaltLength uint32
+	KeyLength  uint32
+}
+
+// DefaultArgon2Params are the minimum parameters recommended by OWASP.
+//
+//nolint:gochecknoglobals,mnd // Constant
+var DefaultArgon2Params = Argon2Params{
+	Memory:     19 * 1024,
+	Iterations: 2,
+	Threads:    1,
+	SaltLength: 16,
+	KeyLength:  32,
+}
+
+// Argon2idHasher hashes passwords with Argon2id, and verifies legacy bcrypt hashes so they can be upgraded.
+type Argon2idHasher struct {
+	params Argon2Params
+}
+
+func NewArgon2idHasher(params Argon2Params) *Argon2idHasher {
+	return &Argon2idHasher{
+		params: params,
+	}
+}
+
+func (h *Argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.params.SaltLength)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, h.params.Iterations, h.params.Memory, h.params.Threads, h.params.KeyLength)
+
+	return fmt.Sprintf(
+		"$%s$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		HashArgon2id,
+		argon2.Version,
+		h.params.Memory,
+		h.params.Iterations,
+		h.params.Threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (*Argon2idHasher) Verify(hash string, password string) (bool, error) {
+	switch hashAlgorithm(hash) {
+	case HashArgon2id:
+		params, salt, key, err := parseArgon2id(hash)
+		if err != nil {
+			return false, err
+		}
+
+		//nolint:gosec // Key length comes from our own hash
+		other := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Threads, uint32(len(key)))
+
+		return subtle.ConstantTimeCompare(key, other) == 1, nil
+
+	case HashBcrypt:
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+
+		return err == nil, err
+
+	default:
+		return false, ErrUnknownHash
+	}
+}
+
+func (h *Argon2idHasher) NeedsRehash(hash string) bool {
+	if hashAlgorithm(hash) != HashArgon2id {
+		return true
+	}
+
+	params, salt, key, err := parseArgon2id(hash)
+	if err != nil {
+		return true
+	}
+
+	return params.Memory != h.params.Memory ||
+		params.Iterations != h.params.Iterations ||
+		params.Threads != h.params.Threads ||
+		len(salt) != int(h.params.SaltLength) ||
+		len(key) != int(h.params.KeyLength)
+}
+
+// hashAlgorithm returns the algorithm of a PHC string, or bcrypt's modular crypt format.
+func hashAlgorithm(hash string) string {
+	switch {
+	case strings.HasPrefix(hash, "$"+HashArgon2id+"$"):
+		return HashArgon2id
+	case strings.HasPrefix(hash, "$2a$"), strings.HasPrefix(hash, "$2b$"), strings.HasPrefix(hash, "$2y$"):
+		return HashBcrypt
+	default:
+		return ""
+	}
+}
+
+// parseArgon2id parses an Argon2id PHC string into its parameters, salt and key.
+func parseArgon2id(hash string) (Argon2Params, []byte, []byte, error) {
+	// "", "argon2id", "v=19", "m=...,t=...,p=...", salt, key
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 { //nolint:mnd // Fields of the format
+		return Argon2Params{}, nil, nil, ErrUnknownHash
+	}
+
+	var version int
+	_, err := fmt.Sscanf(parts[2], "v=%d", &version)
+	if err != nil || version != argon2.Version {
+		return Argon2Params{}, nil, nil, ErrUnknownHash
+	}
+
+	var params Argon2Params
+	_, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Threads)
+	if err != nil {
+		return Argon2Params{}, nil, nil, ErrUnknownHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return Argon2Params{}, nil, nil, ErrUnknownHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return Argon2Params{}, nil, nil, ErrUnknownHash
+	}
+	params.SaltLength = uint32(len(salt)) //nolint:gosec // Short
+	params.KeyLength = uint32(len(key))   //nolint:gosec // Short
+
+	return params, salt, key, nil
+}
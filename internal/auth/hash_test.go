@@ -0,0 +1,260 @@
+package auth_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/spotdemo4/ts-server/internal/auth"
+)
+
+// Cheap parameters so tests run quickly.
+//
+//nolint:gochecknoglobals // Constant
+var testArgon2Params = auth.Argon2Params{
+	Memory:     64,
+	Iterations: 1,
+	Threads:    1,
+	SaltLength: 8,
+	KeyLength:  16,
+}
+
+func TestParseArgon2id(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		hash    string
+		params  auth.Argon2Params
+		salt    []byte
+		key     []byte
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			hash: "$argon2id$v=19$m=19456,t=2,p=1$c2FsdHNhbHQ$a2V5a2V5a2V5",
+			params: auth.Argon2Params{
+				Memory:     19456,
+				Iterations: 2,
+				Threads:    1,
+				SaltLength: 8,
+				KeyLength:  9,
+			},
+			salt: []byte("saltsalt"),
+			key:  []byte("keykeykey"),
+		},
+		{
+			name:    "other version",
+			hash:    "$argon2id$v=16$m=19456,t=2,p=1$c2FsdHNhbHQ$a2V5a2V5a2V5",
+			wantErr: true,
+		},
+		{
+			name:    "missing field",
+			hash:    "$argon2id$v=19$m=19456,t=2,p=1$a2V5a2V5a2V5",
+			wantErr: true,
+		},
+		{
+			name:    "extra field",
+			hash:    "$argon2id$v=19$m=19456,t=2,p=1$c2FsdHNhbHQ$a2V5a2V5a2V5$",
+			wantErr: true,
+		},
+		{
+			name:    "missing parameter",
+			hash:    "$argon2id$v=19$m=19456,t=2$c2FsdHNhbHQ$a2V5a2V5a2V5",
+			wantErr: true,
+		},
+		{
+			name:    "parameter out of range",
+			hash:    "$argon2id$v=19$m=19456,t=2,p=256$c2FsdHNhbHQ$a2V5a2V5a2V5",
+			wantErr: true,
+		},
+		{
+			name:    "padded salt",
+			hash:    "$argon2id$v=19$m=19456,t=2,p=1$c2FsdA==$a2V5a2V5a2V5",
+			wantErr: true,
+		},
+		{
+			name:    "invalid key",
+			hash:    "$argon2id$v=19$m=19456,t=2,p=1$c2FsdHNhbHQ$not base64!",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			params, salt, key, err := auth.ParseArgon2id(tt.hash)
+			if tt.wantErr {
+				if !errors.Is(err, auth.ErrUnknownHash) {
+					t.Errorf("parseArgon2id returned %v, want %v", err, auth.ErrUnknownHash)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseArgon2id returned error: %v", err)
+			}
+			if params != tt.params || !bytes.Equal(salt, tt.salt) || !bytes.Equal(key, tt.key) {
+				t.Errorf("parseArgon2id = %+v, %q, %q, want %+v, %q, %q", params, salt, key, tt.params, tt.salt, tt.key)
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	hasher := auth.NewArgon2idHasher(testArgon2Params)
+	argon, err := hasher.Hash("correct horse")
+	if err != nil {
+		t.Fatalf("Error hashing password: %v", err)
+	}
+	legacy, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("Error hashing password: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		hash     string
+		password string
+		want     bool
+		wantErr  error
+	}{
+		{
+			name:     "argon2id",
+			hash:     argon,
+			password: "correct horse",
+			want:     true,
+		},
+		{
+			name:     "argon2id wrong password",
+			hash:     argon,
+			password: "correct horse ",
+		},
+		{
+			name:     "bcrypt",
+			hash:     string(legacy),
+			password: "correct horse",
+			want:     true,
+		},
+		{
+			name:     "bcrypt wrong password",
+			hash:     string(legacy),
+			password: "Correct horse",
+		},
+		{
+			name:     "malformed argon2id",
+			hash:     "$argon2id$v=19$m=64,t=1,p=1$c2FsdHNhbHQ",
+			password: "correct horse",
+			wantErr:  auth.ErrUnknownHash,
+		},
+		{
+			name:     "unknown algorithm",
+			hash:     "$scrypt$ln=15,r=8,p=1$c2FsdHNhbHQ$a2V5a2V5a2V5",
+			password: "correct horse",
+			wantErr:  auth.ErrUnknownHash,
+		},
+		{
+			name:     "plain text",
+			hash:     "correct horse",
+			password: "correct horse",
+			wantErr:  auth.ErrUnknownHash,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ok, err := hasher.Verify(tt.hash, tt.password)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify returned error %v, want %v", err, tt.wantErr)
+			}
+			if ok != tt.want {
+				t.Errorf("Verify = %v, want %v", ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	t.Parallel()
+
+	hash := func(params auth.Argon2Params) string {
+		h, err := auth.NewArgon2idHasher(params).Hash("correct horse")
+		if err != nil {
+			t.Fatalf("Error hashing password: %v", err)
+		}
+
+		return h
+	}
+	with := func(change func(*auth.Argon2Params)) auth.Argon2Params {
+		params := testArgon2Params
+		change(&params)
+
+		return params
+	}
+
+	legacy, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("Error hashing password: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		hash string
+		want bool
+	}{
+		{
+			name: "current",
+			hash: hash(testArgon2Params),
+			want: false,
+		},
+		{
+			name: "other memory",
+			hash: hash(with(func(p *auth.Argon2Params) { p.Memory = 32 })),
+			want: true,
+		},
+		{
+			name: "other iterations",
+			hash: hash(with(func(p *auth.Argon2Params) { p.Iterations = 2 })),
+			want: true,
+		},
+		{
+			name: "other threads",
+			hash: hash(with(func(p *auth.Argon2Params) { p.Threads = 2 })),
+			want: true,
+		},
+		{
+			name: "other salt length",
+			hash: hash(with(func(p *auth.Argon2Params) { p.SaltLength = 16 })),
+			want: true,
+		},
+		{
+			name: "other key length",
+			hash: hash(with(func(p *auth.Argon2Params) { p.KeyLength = 32 })),
+			want: true,
+		},
+		{
+			name: "bcrypt",
+			hash: string(legacy),
+			want: true,
+		},
+		{
+			name: "malformed",
+			hash: "$argon2id$v=19$m=64,t=1,p=1",
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := auth.NewArgon2idHasher(testArgon2Params).NeedsRehash(tt.hash)
+			if got != tt.want {
+				t.Errorf("NeedsRehash(%q) = %v, want %v", tt.hash, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,256 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+)
+
+const (
+	minUsernameLength = 3      // Same as sign up
+	maxUsernameLength = 32     // Same as username changes
+	usernameAttempts  = 100    // Numbered usernames tried before falling back to a random one
+	defaultUsername   = "user" // Used when the provider has nothing better
+	randomUsernameLen = 8      // Length of the random suffix of the fallback username
+	usernameChars     = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789._-"
+)
+
+var ErrIdentityInUse = errors.New("identity already linked to another account")
+
+// IdentityParams describes an account at an external identity provider.
+type IdentityParams struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+
+	// Username is suggested by the provider, it is changed if it is taken.
+	Username string
+}
+
+// GetUserByIdentity retrieves the user an external identity is linked to, recording its use.
+func (a *Auth) GetUserByIdentity(ctx context.Context, provider string, subject string) (User, error) {
+	identity, err := models.Identities.Query(
+		models.SelectWhere.Identities.Provider.EQ(provider),
+		models.SelectWhere.Identities.Subject.EQ(subject),
+	).One(ctx, a.db)
+	if err != nil {
+		return User{}, err
+	}
+
+	err = identity.Update(ctx, a.db, &models.IdentitySetter{
+		LastUsed: omit.From(time.Now()),
+	})
+	if err != nil {
+		return User{}, err
+	}
+
+	return a.GetUser(ctx, identity.UserID)
+}
+
+// NewUserFromIdentity creates a new user without a password that signs in with an external identity.
+// A verified email address is used for account recovery when no other account uses it.
+func (a *Auth) NewUserFromIdentity(ctx context.Context, params IdentityParams) (User, error) {
+	var user User
+	err := a.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		username, err := availableUsername(ctx, exec, params.Username)
+		if err != nil {
+			return err
+		}
+
+		role, err := a.newUserRole(ctx, exec, username)
+		if err != nil {
+			return err
+		}
+
+		setter := &models.UserSetter{
+			Username:   omit.From(username),
+			Password:   omit.From(""),
+			WebauthnID: omit.From(uuid.New().String()),
+			Role:       omit.From(string(role)),
+		}
+
+		// Trust addresses the provider verified
+		email := NormalizeEmail(params.Email)
+		if params.EmailVerified && email != "" {
+			var exists bool
+			exists, err = models.Users.Query(
+				models.SelectWhere.Users.Email.EQ(email),
+				models.SelectWhere.Users.EmailVerifiedAt.IsNotNull(),
+			).Exists(ctx, exec)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				setter.Email = omitnull.From(email)
+				setter.EmailVerifiedAt = omitnull.From(time.Now())
+			}
+		}
+
+		u, err := models.Users.Insert(setter).One(ctx, exec)
+		if err != nil {
+			return err
+		}
+
+		err = insertIdentity(ctx, exec, u.ID, params)
+		if err != nil {
+			return err
+		}
+
+		user = User{
+			User: *u,
+			db:   a.db,
+			auth: a,
+		}
+		return nil
+	})
+
+	return user, err
+}
+
+// LinkIdentity lets the user sign in with an external identity.
+func (u User) LinkIdentity(ctx context.Context, params IdentityParams) error {
+	return u.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		identity, err := models.Identities.Query(
+			models.SelectWhere.Identities.Provider.EQ(params.Provider),
+			models.SelectWhere.Identities.Subject.EQ(params.Subject),
+		).One(ctx, exec)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		// Linking again only records the use
+		if identity != nil {
+			if identity.UserID != u.ID {
+				return ErrIdentityInUse
+			}
+
+			return identity.Update(ctx, exec, &models.IdentitySetter{
+				LastUsed: omit.From(time.Now()),
+			})
+		}
+
+		return insertIdentity(ctx, exec, u.ID, params)
+	})
+}
+
+// Identities retrieves the user's linked external identities, newest first.
+func (u User) Identities(ctx context.Context) (models.IdentitySlice, error) {
+	return models.Identities.Query(
+		models.SelectWhere.Identities.UserID.EQ(u.ID),
+		sm.OrderBy(models.Identities.Columns.CreatedAt).Desc(),
+	).All(ctx, u.db)
+}
+
+// UnlinkIdentity removes one of the user's external identities.
+// Users cannot remove their last sign-in method.
+func (u User) UnlinkIdentity(ctx context.Context, id int32) error {
+	return u.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		identity, err := models.Identities.Query(
+			models.SelectWhere.Identities.ID.EQ(id),
+			models.SelectWhere.Identities.UserID.EQ(u.ID),
+		).One(ctx, exec)
+		if err != nil {
+			return err
+		}
+
+		// Make sure the user can still sign in
+		count, err := u.signInMethods(ctx, exec)
+		if err != nil {
+			return err
+		}
+		if count <= 1 {
+			return ErrLastSignInMethod
+		}
+
+		return identity.Delete(ctx, exec)
+	})
+}
+
+// signInMethods counts the ways the user can sign in.
+func (u User) signInMethods(ctx context.Context, exec bob.Executor) (int64, error) {
+	passkeys, err := models.Credentials.Query(
+		models.SelectWhere.Credentials.UserID.EQ(u.ID),
+	).Count(ctx, exec)
+	if err != nil {
+		return 0, err
+	}
+
+	identities, err := models.Identities.Query(
+		models.SelectWhere.Identities.UserID.EQ(u.ID),
+	).Count(ctx, exec)
+	if err != nil {
+		return 0, err
+	}
+
+	count := passkeys + identities
+	if u.HasPassword() {
+		count++
+	}
+
+	return count, nil
+}
+
+// insertIdentity links an external identity to a user.
+func insertIdentity(ctx context.Context, exec bob.Executor, userid int32, params IdentityParams) error {
+	now := time.Now()
+
+	setter := &models.IdentitySetter{
+		Provider:  omit.From(params.Provider),
+		Subject:   omit.From(params.Subject),
+		CreatedAt: omit.From(now),
+		LastUsed:  omit.From(now),
+		UserID:    omit.From(userid),
+	}
+	if params.Email != "" {
+		setter.Email = omitnull.From(NormalizeEmail(params.Email))
+	}
+
+	_, err := models.Identities.Insert(setter).Exec(ctx, exec)
+	return err
+}
+
+// availableUsername turns a suggested username into one that is valid and not taken.
+func availableUsername(ctx context.Context, exec bob.Executor, suggested string) (string, error) {
+	// Only keep characters that are safe everywhere usernames are shown
+	base := strings.Map(func(r rune) rune {
+		if strings.ContainsRune(usernameChars, r) {
+			return r
+		}
+		return -1
+	}, suggested)
+	if len(base) < minUsernameLength {
+		base = defaultUsername
+	}
+	base = base[:min(len(base), maxUsernameLength-1-randomUsernameLen)] // Leave room for the longest suffix
+
+	for i := 1; i <= usernameAttempts; i++ {
+		username := base
+		if i > 1 {
+			username = base + strconv.Itoa(i)
+		}
+
+		exists, err := models.Users.Query(
+			models.SelectWhere.Users.Username.EQ(username),
+		).Exists(ctx, exec)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return username, nil
+		}
+	}
+
+	return base + "-" + strings.ReplaceAll(uuid.New().String(), "-", "")[:randomUsernameLen], nil
+}
@@ -0,0 +1,361 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const (
+	AlgorithmES256 = "ES256" // ECDSA with P-256, supported by most clients
+	AlgorithmEdDSA = "EdDSA" // Ed25519, smaller and faster
+
+	KeyRotationInterval = time.Hour * 24 * 30 // Default time a key signs tokens before it is replaced
+	KeyRetirePeriod     = time.Hour * 24      // How long a replaced key can still verify tokens
+	KeyCheckInterval    = time.Minute * 5     // How often keys are reloaded and rotated
+	keyReloadInterval   = time.Minute * 1     // How often an unknown key ID may trigger a reload
+)
+
+var ErrNoSigningKey = errors.New("no signing key loaded")
+
+// KeyConfig configures the keys tokens are signed with.
+type KeyConfig struct {
+	Store     KeyStore
+	Algorithm string
+
+	// Rotation is how long a key signs tokens before it is replaced, zero disables rotation.
+	Rotation time.Duration
+}
+
+// signingKey is a private key tokens are signed with.
+type signingKey struct {
+	id        string
+	method    jwt.SigningMethod
+	key       crypto.Signer
+	createdAt time.Time
+}
+
+// keyRing holds the keys tokens are signed and verified with.
+type keyRing struct {
+	config   KeyConfig
+	current  *signingKey
+	keys     map[string]*signingKey
+	reloaded time.Time
+	mu       sync.RWMutex
+}
+
+func newKeyRing(config KeyConfig) *keyRing {
+	if config.Algorithm == "" {
+		config.Algorithm = AlgorithmES256
+	}
+
+	return &keyRing{
+		config: config,
+		keys:   make(map[string]*signingKey),
+	}
+}
+
+// JWK is a public key as described in RFC 7517.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JWKS is a set of public keys.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// LoadKeys loads the signing keys, generating a new one if there are none or the newest is due for rotation.
+// Keys that were replaced longer than the retire period ago are deleted.
+func (a *Auth) LoadKeys(ctx context.Context) error {
+	store := a.keys.config.Store
+
+	stored, err := store.List(ctx)
+	if err != nil {
+		return err
+	}
+	slices.SortFunc(stored, func(a, b StoredKey) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+
+	// Rotate the newest key
+	now := time.Now()
+	rotation := a.keys.config.Rotation
+	if len(stored) == 0 ||
+		stored[0].Algorithm != a.keys.config.Algorithm ||
+		(rotation > 0 && now.Sub(stored[0].CreatedAt) >= rotation) {
+		var key StoredKey
+		key, err = newStoredKey(a.keys.config.Algorithm)
+		if err != nil {
+			return err
+		}
+		err = store.Add(ctx, key)
+		if err != nil {
+			return err
+		}
+		stored = slices.Insert(stored, 0, key)
+	}
+
+	keys := make(map[string]*signingKey)
+	for i, s := range stored {
+		// A key is retired once the next key replaced it
+		if i > 0 && now.Sub(stored[i-1].CreatedAt) > KeyRetirePeriod {
+			err = store.Delete(ctx, s.ID)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		var key *signingKey
+		key, err = parseSigningKey(s)
+		if err != nil {
+			return err
+		}
+		keys[key.id] = key
+	}
+
+	a.keys.mu.Lock()
+	defer a.keys.mu.Unlock()
+	a.keys.keys = keys
+	a.keys.current = keys[stored[0].ID]
+	a.keys.reloaded = now
+
+	return nil
+}
+
+// RotateKeys reloads the signing keys every check interval until the context is done,
+// picking up keys rotated by other replicas and rotating keys that are due.
+func (a *Auth) RotateKeys(ctx context.Context, log *slog.Logger) {
+	ticker := time.NewTicker(KeyCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := a.LoadKeys(ctx)
+			if err != nil {
+				log.Error("Failed to rotate signing keys", "error", err)
+			}
+		}
+	}
+}
+
+// JWKS returns the public keys tokens can be verified with, newest first.
+func (a *Auth) JWKS() JWKS {
+	a.keys.mu.RLock()
+	defer a.keys.mu.RUnlock()
+
+	set := JWKS{
+		Keys: []JWK{},
+	}
+	for _, key := range a.keys.keys {
+		set.Keys = append(set.Keys, key.jwk())
+	}
+	slices.SortFunc(set.Keys, func(x, y JWK) int {
+		return a.keys.keys[y.Kid].createdAt.Compare(a.keys.keys[x.Kid].createdAt)
+	})
+
+	return set
+}
+
+// SigningAlgorithms returns the algorithms tokens are signed with.
+func (a *Auth) SigningAlgorithms() []string {
+	a.keys.mu.RLock()
+	defer a.keys.mu.RUnlock()
+
+	algorithms := []string{}
+	for _, key := range a.keys.keys {
+		if !slices.Contains(algorithms, key.method.Alg()) {
+			algorithms = append(algorithms, key.method.Alg())
+		}
+	}
+	slices.Sort(algorithms)
+
+	return algorithms
+}
+
+// sign signs claims with the current key.
+func (a *Auth) sign(claims jwt.Claims) (string, error) {
+	return a.signWithType(claims, "JWT")
+}
+
+// signWithType signs claims with the current key, setting the typ header.
+func (a *Auth) signWithType(claims jwt.Claims, typ string) (string, error) {
+	a.keys.mu.RLock()
+	key := a.keys.current
+	a.keys.mu.RUnlock()
+	if key == nil {
+		return "", ErrNoSigningKey
+	}
+
+	token := jwt.NewWithClaims(key.method, claims)
+	token.Header["kid"] = key.id
+	token.Header["typ"] = typ
+
+	return token.SignedString(key.key)
+}
+
+// SignState signs state that is kept by the browser, like consent requests and sign in flows,
+// so it cannot be changed on the way back. The claims must have an audience and an expiry.
+func (a *Auth) SignState(claims jwt.Claims) (string, error) {
+	return a.sign(claims)
+}
+
+// ParseState parses state signed by SignState into claims, checking its audience and expiry.
+func (a *Auth) ParseState(tokenString string, claims jwt.Claims, audience string) error {
+	token, err := jwt.ParseWithClaims(
+		tokenString,
+		claims,
+		a.keyFunc,
+		jwt.WithAudience(audience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return err
+	}
+	if !token.Valid {
+		return errors.New("token not valid")
+	}
+
+	return nil
+}
+
+// keyFunc returns the key used to verify a token.
+// Unknown key IDs reload the keys, as another replica may have rotated them.
+func (a *Auth) keyFunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	a.keys.mu.RLock()
+	key, ok := a.keys.keys[kid]
+	reloaded := a.keys.reloaded
+	a.keys.mu.RUnlock()
+	if !ok && kid != "" && time.Since(reloaded) > keyReloadInterval {
+		err := a.LoadKeys(context.Background())
+		if err != nil {
+			return nil, err
+		}
+
+		a.keys.mu.RLock()
+		key, ok = a.keys.keys[kid]
+		a.keys.mu.RUnlock()
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown key: %s", kid)
+	}
+
+	// Don't forget to validate the alg is what you expect:
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+
+	return key.key.Public(), nil
+}
+
+// jwk returns the public key as a JWK.
+func (k *signingKey) jwk() JWK {
+	switch public := k.key.Public().(type) {
+	case ed25519.PublicKey:
+		return JWK{
+			Kty: "OKP",
+			Kid: k.id,
+			Use: "sig",
+			Alg: k.method.Alg(),
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(public),
+		}
+
+	case *ecdsa.PublicKey:
+		size := (public.Curve.Params().BitSize + 7) / 8 //nolint:mnd // Bits to bytes
+		return JWK{
+			Kty: "EC",
+			Kid: k.id,
+			Use: "sig",
+			Alg: k.method.Alg(),
+			Crv: public.Curve.Params().Name,
+			X:   base64.RawURLEncoding.EncodeToString(public.X.FillBytes(make([]byte, size))),
+			Y:   base64.RawURLEncoding.EncodeToString(public.Y.FillBytes(make([]byte, size))),
+		}
+
+	default:
+		return JWK{}
+	}
+}
+
+// newStoredKey generates a signing key for an algorithm.
+func newStoredKey(algorithm string) (StoredKey, error) {
+	var key crypto.Signer
+	var err error
+	switch algorithm {
+	case AlgorithmES256:
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case AlgorithmEdDSA:
+		_, key, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return StoredKey{}, fmt.Errorf("unsupported signing key algorithm: %s", algorithm)
+	}
+	if err != nil {
+		return StoredKey{}, err
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return StoredKey{}, err
+	}
+
+	return StoredKey{
+		ID:         uuid.New().String(),
+		Algorithm:  algorithm,
+		CreatedAt:  time.Now(),
+		PrivateKey: der,
+	}, nil
+}
+
+// parseSigningKey parses a stored signing key, making sure it matches its algorithm.
+func parseSigningKey(stored StoredKey) (*signingKey, error) {
+	algorithm, err := keyAlgorithm(stored.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	if algorithm != stored.Algorithm {
+		return nil, errors.New("signing key does not match its algorithm")
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(stored.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("signing key cannot sign")
+	}
+
+	return &signingKey{
+		id:        stored.ID,
+		method:    jwt.GetSigningMethod(algorithm),
+		key:       signer,
+		createdAt: stored.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,212 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+)
+
+const (
+	keyFileMode   = 0o600  // Only the server may read private keys
+	keyFileSuffix = ".pem" // Keys are stored as PKCS #8 PEM files
+	keyPEMType    = "PRIVATE KEY"
+)
+
+// StoredKey is a private key tokens are signed with, as kept by a KeyStore.
+type StoredKey struct {
+	ID        string
+	Algorithm string
+	CreatedAt time.Time
+
+	// PrivateKey is the PKCS #8 DER encoded key.
+	PrivateKey []byte
+}
+
+// KeyStore keeps the private keys tokens are signed with.
+type KeyStore interface {
+	// List returns all stored keys.
+	List(ctx context.Context) ([]StoredKey, error)
+
+	// Add stores a new key.
+	Add(ctx context.Context, key StoredKey) error
+
+	// Delete removes a key, tokens signed with it can no longer be verified.
+	Delete(ctx context.Context, id string) error
+}
+
+// SQLiteKeyStore keeps keys in the database, so they are shared between replicas.
+type SQLiteKeyStore struct {
+	db *bob.DB
+}
+
+func NewSQLiteKeyStore(db *bob.DB) *SQLiteKeyStore {
+	return &SQLiteKeyStore{
+		db: db,
+	}
+}
+
+func (s *SQLiteKeyStore) List(ctx context.Context) ([]StoredKey, error) {
+	rows, err := models.SigningKeys.Query().All(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]StoredKey, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, StoredKey{
+			ID:         row.ID,
+			Algorithm:  row.Algorithm,
+			CreatedAt:  row.CreatedAt,
+			PrivateKey: row.PrivateKey,
+		})
+	}
+
+	return keys, nil
+}
+
+func (s *SQLiteKeyStore) Add(ctx context.Context, key StoredKey) error {
+	_, err := models.SigningKeys.Insert(
+		&models.SigningKeySetter{
+			ID:         omit.From(key.ID),
+			Algorithm:  omit.From(key.Algorithm),
+			PrivateKey: omit.From(key.PrivateKey),
+			CreatedAt:  omit.From(key.CreatedAt),
+		},
+	).Exec(ctx, s.db)
+
+	return err
+}
+
+func (s *SQLiteKeyStore) Delete(ctx context.Context, id string) error {
+	_, err := models.SigningKeys.Delete(
+		models.DeleteWhere.SigningKeys.ID.EQ(id),
+	).Exec(ctx, s.db)
+
+	return err
+}
+
+// FileKeyStore keeps keys as PEM files in a directory, named <created unix time>_<id>.pem.
+// Keys can be provisioned by placing files in the directory, for example from a secret manager.
+type FileKeyStore struct {
+	dir string
+}
+
+func NewFileKeyStore(dir string) *FileKeyStore {
+	return &FileKeyStore{
+		dir: dir,
+	}
+}
+
+func (s *FileKeyStore) List(_ context.Context) ([]StoredKey, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []StoredKey{}
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), keyFileSuffix)
+		if entry.IsDir() || !ok {
+			continue
+		}
+
+		created, id, ok := strings.Cut(name, "_")
+		if !ok {
+			return nil, fmt.Errorf("invalid signing key file name: %s", entry.Name())
+		}
+		unix, err := strconv.ParseInt(created, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signing key file name: %s", entry.Name())
+		}
+
+		b, err := os.ReadFile(filepath.Join(s.dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		block, _ := pem.Decode(b)
+		if block == nil || block.Type != keyPEMType {
+			return nil, fmt.Errorf("invalid signing key file: %s", entry.Name())
+		}
+
+		// The algorithm follows from the type of key
+		algorithm, err := keyAlgorithm(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signing key file %s: %w", entry.Name(), err)
+		}
+
+		keys = append(keys, StoredKey{
+			ID:         id,
+			Algorithm:  algorithm,
+			CreatedAt:  time.Unix(unix, 0),
+			PrivateKey: block.Bytes,
+		})
+	}
+
+	return keys, nil
+}
+
+// Add writes the key to a temporary file first, so other replicas never read a partial key.
+func (s *FileKeyStore) Add(_ context.Context, key StoredKey) error {
+	name := strconv.FormatInt(key.CreatedAt.Unix(), 10) + "_" + key.ID + keyFileSuffix
+	b := pem.EncodeToMemory(&pem.Block{
+		Type:  keyPEMType,
+		Bytes: key.PrivateKey,
+	})
+
+	tmp := filepath.Join(s.dir, "."+name)
+	err := os.WriteFile(tmp, b, keyFileMode)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, filepath.Join(s.dir, name))
+}
+
+func (s *FileKeyStore) Delete(_ context.Context, id string) error {
+	matches, err := filepath.Glob(filepath.Join(s.dir, "*_"+id+keyFileSuffix))
+	if err != nil {
+		return err
+	}
+
+	for _, match := range matches {
+		err = os.Remove(match)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// keyAlgorithm returns the algorithm a PKCS #8 DER encoded key signs with.
+func keyAlgorithm(der []byte) (string, error) {
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return "", err
+	}
+
+	switch key := key.(type) {
+	case ed25519.PrivateKey:
+		return AlgorithmEdDSA, nil
+	case *ecdsa.PrivateKey:
+		if key.Curve.Params().Name == "P-256" {
+			return AlgorithmES256, nil
+		}
+	}
+
+	return "", errors.New("unsupported key type, must be Ed25519 or P-256")
+}
@@ -0,0 +1,247 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/spotdemo4/ts-server/internal/audit"
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+)
+
+const (
+	LoginAttemptWindow    = time.Hour * 1       // Failed attempts older than this are forgiven
+	LoginAttemptRetention = time.Hour * 24 * 30 // How long attempts are kept for auditing
+	LoginAttemptSweep     = time.Minute * 10    // How often attempts past retention are removed
+	UsernameFreeAttempts  = 5                   // Failed attempts per username before backing off
+	IPFreeAttempts        = 20                  // Failed attempts per IP before backing off
+	LockoutBaseDelay      = time.Second * 30    // Lockout after the free attempts, doubled with every failure
+	LockoutMaxDuration    = time.Minute * 15    // Longest lockout
+	lockoutMaxDoublings   = 16                  // Keeps the backoff from overflowing
+)
+
+// Factor is what a sign in attempt checked, each is throttled separately for a username.
+type Factor string
+
+const (
+	FactorPassword     Factor = "password"
+	FactorSecondFactor Factor = "second_factor"
+)
+
+var ErrInvalidCredentials = errors.New("invalid username or password")
+
+// LockoutError is returned while too many attempts failed recently.
+type LockoutError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LockoutError) Error() string {
+	return fmt.Sprintf("too many failed attempts, try again in %s", e.RetryAfter.Round(time.Second))
+}
+
+// loginSweeper removes attempts past retention, at most every sweep interval.
+type loginSweeper struct {
+	swept time.Time
+	mu    sync.Mutex
+}
+
+type LoginParams struct {
+	Username  string
+	Password  string
+	IP        string
+	UserAgent string
+}
+
+// Login checks a username and password, backing off exponentially after too many failures
+// for the username or the IP. Unknown usernames fail the same way, and take as long, as wrong passwords.
+func (a *Auth) Login(ctx context.Context, params LoginParams) (User, error) {
+	err := a.CheckLockout(ctx, FactorPassword, params.Username, params.IP)
+	var lockout *LockoutError
+	if errors.As(err, &lockout) {
+		a.events.Record(ctx, audit.Event{
+			Action:   audit.ActionLogin,
+			Target:   "username:" + params.Username,
+			Outcome:  audit.OutcomeFailure,
+			Metadata: map[string]any{"reason": "locked_out", "retry_after": lockout.RetryAfter.Seconds()},
+		})
+	}
+	if err != nil {
+		return User{}, err
+	}
+
+	// Get user
+	user, err := a.GetUserByName(ctx, params.Username)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return User{}, err
+	}
+	found := err == nil
+
+	// Check password
+	valid := false
+	if found && user.HasPassword() {
+		valid = user.Validate(params.Password)
+	} else {
+		_, _ = a.hasher.Verify(a.dummyHash(), params.Password)
+	}
+
+	err = a.RecordLoginAttempt(ctx, FactorPassword, params, valid)
+	if err != nil {
+		return User{}, err
+	}
+	if !valid {
+		event := audit.Event{
+			Action:   audit.ActionLogin,
+			Target:   "username:" + params.Username,
+			Outcome:  audit.OutcomeFailure,
+			Metadata: map[string]any{"reason": "invalid_credentials"},
+		}
+		if found {
+			event.UserID = user.ID
+		}
+		a.events.Record(ctx, event)
+
+		return User{}, ErrInvalidCredentials
+	}
+
+	// Upgrade hashes made with an outdated algorithm or parameters, now that the password is known
+	if a.hasher.NeedsRehash(user.Password) {
+		err = user.rehashPassword(ctx, params.Password)
+		if err != nil {
+			return User{}, err
+		}
+	}
+
+	a.events.Record(ctx, audit.Event{
+		ActorID: user.ID,
+		UserID:  user.ID,
+		Action:  audit.ActionLogin,
+		Target:  "username:" + params.Username,
+	})
+
+	return user, nil
+}
+
+// CheckLockout returns a LockoutError if too many attempts of a factor failed recently for the username,
+// or too many attempts of any factor failed recently for the IP.
+func (a *Auth) CheckLockout(ctx context.Context, factor Factor, username string, ip string) error {
+	windowStart := time.Now().Add(-LoginAttemptWindow)
+
+	// Successful attempts forgive the username's failures of the same factor,
+	// so a correct password does not forgive guessed second factors
+	usernameSince := windowStart
+	success, err := models.LoginAttempts.Query(
+		models.SelectWhere.LoginAttempts.Username.EQ(username),
+		models.SelectWhere.LoginAttempts.Factor.EQ(string(factor)),
+		models.SelectWhere.LoginAttempts.Success.EQ(true),
+		models.SelectWhere.LoginAttempts.CreatedAt.GT(windowStart),
+		sm.OrderBy(models.LoginAttempts.Columns.CreatedAt).Desc(),
+	).One(ctx, a.db)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	if success != nil {
+		usernameSince = success.CreatedAt
+	}
+
+	usernameWait, err := a.lockoutWait(
+		ctx,
+		UsernameFreeAttempts,
+		models.SelectWhere.LoginAttempts.Username.EQ(username),
+		models.SelectWhere.LoginAttempts.Factor.EQ(string(factor)),
+		models.SelectWhere.LoginAttempts.CreatedAt.GT(usernameSince),
+	)
+	if err != nil {
+		return err
+	}
+
+	ipWait, err := a.lockoutWait(
+		ctx,
+		IPFreeAttempts,
+		models.SelectWhere.LoginAttempts.IP.EQ(ip),
+		models.SelectWhere.LoginAttempts.CreatedAt.GT(windowStart),
+	)
+	if err != nil {
+		return err
+	}
+
+	wait := max(usernameWait, ipWait)
+	if wait > 0 {
+		return &LockoutError{
+			RetryAfter: wait,
+		}
+	}
+
+	return nil
+}
+
+// RecordLoginAttempt records the outcome of a sign in attempt with a factor, for lockouts and auditing.
+func (a *Auth) RecordLoginAttempt(ctx context.Context, factor Factor, params LoginParams, success bool) error {
+	now := time.Now()
+
+	// Remove attempts past retention
+	a.attempts.mu.Lock()
+	sweep := now.Sub(a.attempts.swept) > LoginAttemptSweep
+	if sweep {
+		a.attempts.swept = now
+	}
+	a.attempts.mu.Unlock()
+	if sweep {
+		_, err := models.LoginAttempts.Delete(
+			models.DeleteWhere.LoginAttempts.CreatedAt.LT(now.Add(-LoginAttemptRetention)),
+		).Exec(ctx, a.db)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := models.LoginAttempts.Insert(
+		&models.LoginAttemptSetter{
+			Username:  omit.From(params.Username),
+			IP:        omit.From(params.IP),
+			UserAgent: omit.From(params.UserAgent),
+			Factor:    omit.From(string(factor)),
+			Success:   omit.From(success),
+			CreatedAt: omit.From(now),
+		},
+	).Exec(ctx, a.db)
+
+	return err
+}
+
+// lockoutWait returns how long to wait before the next attempt, given the attempts that count.
+func (a *Auth) lockoutWait(
+	ctx context.Context,
+	free int64,
+	where ...bob.Mod[*dialect.SelectQuery],
+) (time.Duration, error) {
+	failed := append(where, models.SelectWhere.LoginAttempts.Success.EQ(false))
+
+	count, err := models.LoginAttempts.Query(failed...).Count(ctx, a.db)
+	if err != nil {
+		return 0, err
+	}
+	if count < free {
+		return 0, nil
+	}
+
+	last, err := models.LoginAttempts.Query(
+		append(failed, sm.OrderBy(models.LoginAttempts.Columns.CreatedAt).Desc())...,
+	).One(ctx, a.db)
+	if err != nil {
+		return 0, err
+	}
+
+	// Double the lockout with every failure past the free attempts
+	delay := LockoutBaseDelay << min(count-free, lockoutMaxDoublings)
+	delay = min(delay, LockoutMaxDuration)
+
+	return max(time.Until(last.CreatedAt.Add(delay)), 0), nil
+}
@@ -0,0 +1,208 @@
+package auth_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+
+	"github.com/spotdemo4/ts-server/internal/auth"
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+)
+
+type attempt struct {
+	factor   auth.Factor
+	username string
+	ip       string
+	success  bool
+}
+
+func failures(n int, factor auth.Factor, username string, ip string) []attempt {
+	attempts := []attempt{}
+	for range n {
+		attempts = append(attempts, attempt{factor: factor, username: username, ip: ip})
+	}
+
+	return attempts
+}
+
+func TestCheckLockout(t *testing.T) {
+	t.Parallel()
+
+	password := auth.FactorPassword
+	second := auth.FactorSecondFactor
+
+	// Failed second factors, each after a correct password
+	interleaved := []attempt{}
+	for range auth.UsernameFreeAttempts {
+		interleaved = append(interleaved,
+			attempt{factor: password, username: "alice", ip: "1", success: true},
+			attempt{factor: second, username: "alice", ip: "1"},
+		)
+	}
+
+	// Failures of many usernames from one IP
+	spread := []attempt{}
+	for i := range auth.IPFreeAttempts {
+		spread = append(spread, attempt{factor: password, username: fmt.Sprintf("user%d", i), ip: "1"})
+	}
+
+	tests := []struct {
+		name     string
+		old      []attempt // Recorded before the window
+		attempts []attempt
+		factor   auth.Factor
+		username string
+		ip       string
+		want     time.Duration // Zero if not locked out
+	}{
+		{
+			name:     "free attempts",
+			attempts: failures(auth.UsernameFreeAttempts-1, password, "alice", "1"),
+			factor:   password,
+			username: "alice",
+			ip:       "1",
+			want:     0,
+		},
+		{
+			name:     "locked after the free attempts",
+			attempts: failures(auth.UsernameFreeAttempts, password, "alice", "1"),
+			factor:   password,
+			username: "alice",
+			ip:       "1",
+			want:     auth.LockoutBaseDelay,
+		},
+		{
+			name:     "doubled with every failure",
+			attempts: failures(auth.UsernameFreeAttempts+2, password, "alice", "1"),
+			factor:   password,
+			username: "alice",
+			ip:       "1",
+			want:     auth.LockoutBaseDelay * 4,
+		},
+		{
+			name:     "capped",
+			attempts: failures(100, password, "alice", "1"),
+			factor:   password,
+			username: "alice",
+			ip:       "1",
+			want:     auth.LockoutMaxDuration,
+		},
+		{
+			name:     "from another ip",
+			attempts: failures(auth.UsernameFreeAttempts, password, "alice", "1"),
+			factor:   password,
+			username: "alice",
+			ip:       "2",
+			want:     auth.LockoutBaseDelay,
+		},
+		{
+			name:     "other username",
+			attempts: failures(auth.UsernameFreeAttempts, password, "alice", "1"),
+			factor:   password,
+			username: "bob",
+			ip:       "2",
+			want:     0,
+		},
+		{
+			name: "success forgives failures",
+			attempts: append(
+				failures(auth.UsernameFreeAttempts, password, "alice", "1"),
+				attempt{factor: password, username: "alice", ip: "1", success: true},
+			),
+			factor:   password,
+			username: "alice",
+			ip:       "1",
+			want:     0,
+		},
+		{
+			name:     "correct passwords do not forgive second factors",
+			attempts: interleaved,
+			factor:   second,
+			username: "alice",
+			ip:       "2",
+			want:     auth.LockoutBaseDelay,
+		},
+		{
+			name:     "failed second factors do not lock passwords",
+			attempts: interleaved,
+			factor:   password,
+			username: "alice",
+			ip:       "2",
+			want:     0,
+		},
+		{
+			name:     "ip locked across usernames",
+			attempts: spread,
+			factor:   password,
+			username: "bob",
+			ip:       "1",
+			want:     auth.LockoutBaseDelay,
+		},
+		{
+			name:     "ip locked across factors",
+			attempts: append(spread[1:], attempt{factor: second, username: "bob", ip: "1"}),
+			factor:   password,
+			username: "carol",
+			ip:       "1",
+			want:     auth.LockoutBaseDelay,
+		},
+		{
+			name:     "failures before the window",
+			old:      failures(100, password, "alice", "1"),
+			attempts: failures(auth.UsernameFreeAttempts-1, password, "alice", "1"),
+			factor:   password,
+			username: "alice",
+			ip:       "1",
+			want:     0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			a, db := auth.NewTestAuth(t)
+
+			for _, old := range tt.old {
+				_, err := models.LoginAttempts.Insert(&models.LoginAttemptSetter{
+					Username:  omit.From(old.username),
+					IP:        omit.From(old.ip),
+					UserAgent: omit.From(""),
+					Factor:    omit.From(string(old.factor)),
+					Success:   omit.From(old.success),
+					CreatedAt: omit.From(time.Now().Add(-auth.LoginAttemptWindow - time.Minute)),
+				}).Exec(t.Context(), db)
+				if err != nil {
+					t.Fatalf("Error inserting attempt: %v", err)
+				}
+			}
+			for _, at := range tt.attempts {
+				err := a.RecordLoginAttempt(t.Context(), at.factor, auth.LoginParams{
+					Username: at.username,
+					IP:       at.ip,
+				}, at.success)
+				if err != nil {
+					t.Fatalf("Error recording attempt: %v", err)
+				}
+			}
+
+			err := a.CheckLockout(t.Context(), tt.factor, tt.username, tt.ip)
+			if tt.want == 0 {
+				if err != nil {
+					t.Errorf("CheckLockout returned %v, want no lockout", err)
+				}
+				return
+			}
+
+			var lockout *auth.LockoutError
+			if !errors.As(err, &lockout) {
+				t.Fatalf("CheckLockout returned %v, want a lockout of %s", err, tt.want)
+			}
+			if lockout.RetryAfter > tt.want || lockout.RetryAfter < tt.want-5*time.Second {
+				t.Errorf("CheckLockout returned a lockout of %s, want %s", lockout.RetryAfter, tt.want)
+			}
+		})
+	}
+}
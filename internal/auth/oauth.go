@@ -0,0 +1,536 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+)
+
+const (
+	OAuthScopeOpenID  = "openid"         // Issue an ID token
+	OAuthScopeProfile = "profile"        // Username
+	OAuthScopeEmail   = "email"          // Email address
+	OAuthScopeOffline = "offline_access" // Issue a refresh token
+
+	AuthorizationCodeDuration = time.Minute * 1 // How long the client has to exchange a code
+	AccessTokenType           = "at+jwt"        // typ header of access tokens issued to clients, RFC 9068
+	oauthSecretLength         = 32              // 256 bits
+)
+
+//nolint:gochecknoglobals // Scopes clients can request
+var OAuthScopes = []string{OAuthScopeOpenID, OAuthScopeProfile, OAuthScopeEmail, OAuthScopeOffline}
+
+var (
+	ErrInvalidClient      = errors.New("invalid client")
+	ErrInvalidGrant       = errors.New("invalid or expired authorization grant")
+	ErrInvalidRedirectURI = errors.New("redirect uri not registered for client")
+	ErrInvalidAccessToken = errors.New("invalid access token")
+)
+
+// OAuthClient is an application that signs users in with this server.
+type OAuthClient struct {
+	models.OauthClient
+}
+
+type NewOAuthClientParams struct {
+	Name         string
+	RedirectURIs []string
+	Scopes       []string
+
+	// Public clients, like single page and mobile apps, cannot keep a secret and must use PKCE.
+	Public bool
+}
+
+// OAuthTokens are the tokens issued to a client.
+type OAuthTokens struct {
+	Access    string
+	ExpiresIn time.Duration
+	Scopes    []string
+
+	// Refresh is only issued with the offline_access scope, and is empty when it was not rotated.
+	Refresh string
+
+	// IDToken is only issued with the openid scope.
+	IDToken string
+}
+
+// ClientClaims are the claims of an access token issued to a client.
+type ClientClaims struct {
+	jwt.RegisteredClaims
+
+	Session  string `json:"sid"`
+	Version  int32  `json:"ver"`
+	ClientID string `json:"client_id"`
+	Scope    string `json:"scope"`
+}
+
+// IssuerURL identifies this server in the tokens it issues to clients.
+func (a *Auth) IssuerURL() string {
+	return a.url
+}
+
+// NewOAuthClient registers a client, returning its secret, which is only available now.
+// Public clients have no secret.
+func (a *Auth) NewOAuthClient(ctx context.Context, params NewOAuthClientParams) (OAuthClient, string, error) {
+	redirectURIs, err := json.Marshal(params.RedirectURIs)
+	if err != nil {
+		return OAuthClient{}, "", err
+	}
+
+	setter := &models.OauthClientSetter{
+		ID:           omit.From(uuid.New().String()),
+		Name:         omit.From(params.Name),
+		RedirectUris: omit.From(string(redirectURIs)),
+		Scopes:       omit.From(strings.Join(params.Scopes, " ")),
+		CreatedAt:    omit.From(time.Now()),
+	}
+
+	var secret string
+	if !params.Public {
+		secret, err = newOAuthSecret()
+		if err != nil {
+			return OAuthClient{}, "", err
+		}
+		setter.SecretHash = omitnull.From(hashSecret(secret))
+	}
+
+	client, err := models.OauthClients.Insert(setter).One(ctx, a.db)
+	if err != nil {
+		return OAuthClient{}, "", err
+	}
+
+	return OAuthClient{*client}, secret, nil
+}
+
+// OAuthClients retrieves all registered clients, newest first.
+func (a *Auth) OAuthClients(ctx context.Context) ([]OAuthClient, error) {
+	rows, err := models.OauthClients.Query(
+		sm.OrderBy(models.OauthClients.Columns.CreatedAt).Desc(),
+	).All(ctx, a.db)
+	if err != nil {
+		return nil, err
+	}
+
+	clients := make([]OAuthClient, 0, len(rows))
+	for _, row := range rows {
+		clients = append(clients, OAuthClient{*row})
+	}
+
+	return clients, nil
+}
+
+// GetOAuthClient retrieves a client by its ID.
+func (a *Auth) GetOAuthClient(ctx context.Context, id string) (OAuthClient, error) {
+	client, err := models.FindOauthClient(ctx, a.db, id)
+	if err != nil {
+		return OAuthClient{}, err
+	}
+
+	return OAuthClient{*client}, nil
+}
+
+// AuthenticateOAuthClient retrieves a client, checking its secret if it has one.
+func (a *Auth) AuthenticateOAuthClient(ctx context.Context, id string, secret string) (OAuthClient, error) {
+	client, err := a.GetOAuthClient(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return OAuthClient{}, ErrInvalidClient
+		}
+		return OAuthClient{}, err
+	}
+
+	if client.Public() {
+		return client, nil
+	}
+	if subtle.ConstantTimeCompare(hashSecret(secret), client.SecretHash.MustGet()) != 1 {
+		return OAuthClient{}, ErrInvalidClient
+	}
+
+	return client, nil
+}
+
+// DeleteOAuthClient deletes a client, signing users out of it.
+func (a *Auth) DeleteOAuthClient(ctx context.Context, id string) error {
+	return a.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		client, err := models.FindOauthClient(ctx, exec, id)
+		if err != nil {
+			return err
+		}
+
+		// Delete owned rows
+		_, err = models.OauthCodes.Delete(models.DeleteWhere.OauthCodes.ClientID.EQ(id)).Exec(ctx, exec)
+		if err != nil {
+			return err
+		}
+		_, err = models.OauthConsents.Delete(models.DeleteWhere.OauthConsents.ClientID.EQ(id)).Exec(ctx, exec)
+		if err != nil {
+			return err
+		}
+		_, err = models.Sessions.Delete(models.DeleteWhere.Sessions.ClientID.EQ(id)).Exec(ctx, exec)
+		if err != nil {
+			return err
+		}
+
+		return client.Delete(ctx, exec)
+	})
+}
+
+// Public checks if the client has no secret.
+func (c OAuthClient) Public() bool {
+	return c.SecretHash.IsNull()
+}
+
+// RedirectURIs returns the URIs the client may receive codes at.
+func (c OAuthClient) RedirectURIs() []string {
+	uris := []string{}
+	_ = json.Unmarshal([]byte(c.RedirectUris), &uris)
+
+	return uris
+}
+
+// AllowsRedirectURI checks if a redirect URI is registered, it must match exactly.
+func (c OAuthClient) AllowsRedirectURI(uri string) bool {
+	return slices.Contains(c.RedirectURIs(), uri)
+}
+
+// AllowedScopes returns the scopes the client may request.
+func (c OAuthClient) AllowedScopes() []string {
+	return strings.Fields(c.Scopes)
+}
+
+// FilterScopes removes the scopes the client may not request, and duplicates.
+func (c OAuthClient) FilterScopes(requested []string) []string {
+	allowed := c.AllowedScopes()
+
+	scopes := []string{}
+	for _, scope := range requested {
+		if slices.Contains(allowed, scope) && !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	return scopes
+}
+
+// HasConsent checks if the user already allowed the client to use all of the scopes.
+func (u User) HasConsent(ctx context.Context, clientID string, scopes []string) (bool, error) {
+	consent, err := models.FindOauthConsent(ctx, u.db, clientID, u.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	granted := strings.Fields(consent.Scopes)
+	for _, scope := range scopes {
+		if !slices.Contains(granted, scope) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// GrantConsent allows the client to use the scopes, in addition to the ones allowed before.
+func (u User) GrantConsent(ctx context.Context, clientID string, scopes []string) error {
+	return u.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		consent, err := models.FindOauthConsent(ctx, exec, clientID, u.ID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		if consent == nil {
+			_, err = models.OauthConsents.Insert(
+				&models.OauthConsentSetter{
+					Scopes:    omit.From(strings.Join(scopes, " ")),
+					CreatedAt: omit.From(time.Now()),
+					ClientID:  omit.From(clientID),
+					UserID:    omit.From(u.ID),
+				},
+			).Exec(ctx, exec)
+			return err
+		}
+
+		granted := strings.Fields(consent.Scopes)
+		for _, scope := range scopes {
+			if !slices.Contains(granted, scope) {
+				granted = append(granted, scope)
+			}
+		}
+
+		return consent.Update(ctx, exec, &models.OauthConsentSetter{
+			Scopes: omit.From(strings.Join(granted, " ")),
+		})
+	})
+}
+
+type AuthorizationParams struct {
+	ClientID    string
+	RedirectURI string
+	Scopes      []string
+	Nonce       string
+
+	// CodeChallenge is the PKCE S256 challenge, empty if the client did not send one.
+	CodeChallenge string
+}
+
+// NewAuthorizationCode issues a short lived, single use code the client exchanges for tokens.
+func (u User) NewAuthorizationCode(ctx context.Context, params AuthorizationParams) (string, error) {
+	if u.Disabled() {
+		return "", ErrUserDisabled
+	}
+
+	code, err := newOAuthSecret()
+	if err != nil {
+		return "", err
+	}
+
+	_, err = models.OauthCodes.Insert(
+		&models.OauthCodeSetter{
+			Hash:          omit.From(hashSecret(code)),
+			RedirectURI:   omit.From(params.RedirectURI),
+			Scopes:        omit.From(strings.Join(params.Scopes, " ")),
+			Nonce:         omit.From(params.Nonce),
+			CodeChallenge: omit.From(params.CodeChallenge),
+			ExpiresAt:     omit.From(time.Now().Add(AuthorizationCodeDuration)),
+			ClientID:      omit.From(params.ClientID),
+			UserID:        omit.From(u.ID),
+		},
+	).Exec(ctx, u.db)
+	if err != nil {
+		return "", err
+	}
+
+	return code, nil
+}
+
+// ExchangeAuthorizationCode exchanges a code for tokens, creating a session for the client.
+// The redirect URI and PKCE verifier must match the ones the code was issued with.
+func (a *Auth) ExchangeAuthorizationCode(
+	ctx context.Context,
+	client OAuthClient,
+	code string,
+	redirectURI string,
+	verifier string,
+	params SessionParams,
+) (OAuthTokens, error) {
+	// Codes can only be used once
+	row, err := models.OauthCodes.Delete(
+		models.DeleteWhere.OauthCodes.Hash.EQ(hashSecret(code)),
+	).One(ctx, a.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return OAuthTokens{}, ErrInvalidGrant
+		}
+		return OAuthTokens{}, err
+	}
+
+	if time.Now().After(row.ExpiresAt) || row.ClientID != client.ID || row.RedirectURI != redirectURI {
+		return OAuthTokens{}, ErrInvalidGrant
+	}
+
+	// Public clients must prove they started the flow
+	if row.CodeChallenge == "" && client.Public() {
+		return OAuthTokens{}, ErrInvalidGrant
+	}
+	if row.CodeChallenge != "" {
+		sum := sha256.Sum256([]byte(verifier))
+		challenge := base64.RawURLEncoding.EncodeToString(sum[:])
+		if subtle.ConstantTimeCompare([]byte(challenge), []byte(row.CodeChallenge)) != 1 {
+			return OAuthTokens{}, ErrInvalidGrant
+		}
+	}
+
+	// Get user
+	user, err := a.GetUser(ctx, row.UserID)
+	if err != nil {
+		return OAuthTokens{}, err
+	}
+
+	// Create session, it only outlives the access token if the client may refresh it
+	scopes := strings.Fields(row.Scopes)
+	params.ClientID = client.ID
+	params.Scopes = scopes
+	params.Expiration = AccessTokenDuration
+	if slices.Contains(scopes, OAuthScopeOffline) {
+		params.Expiration = RefreshTokenDuration
+	}
+	tokens, err := user.NewSession(ctx, params)
+	if err != nil {
+		return OAuthTokens{}, err
+	}
+
+	oauthTokens, err := a.clientTokens(user, client, tokens.Session.ID, scopes, row.Nonce)
+	if err != nil {
+		return OAuthTokens{}, err
+	}
+	if slices.Contains(scopes, OAuthScopeOffline) {
+		oauthTokens.Refresh = tokens.Refresh
+	}
+
+	return oauthTokens, nil
+}
+
+// RefreshOAuth exchanges a refresh token issued to a client for new tokens, rotating the refresh token.
+func (a *Auth) RefreshOAuth(ctx context.Context, client OAuthClient, refreshToken string) (OAuthTokens, error) {
+	user, session, refresh, err := a.refreshSession(ctx, refreshToken, client.ID)
+	if err != nil {
+		if errors.Is(err, ErrInvalidRefreshToken) {
+			return OAuthTokens{}, ErrInvalidGrant
+		}
+		return OAuthTokens{}, err
+	}
+
+	oauthTokens, err := a.clientTokens(user, client, session.ID, strings.Fields(session.Scopes.GetOrZero()), "")
+	if err != nil {
+		return OAuthTokens{}, err
+	}
+	oauthTokens.Refresh = refresh
+
+	return oauthTokens, nil
+}
+
+// GetUserFromClientToken retrieves a user from an access token issued to a client, with the granted scopes.
+func (a *Auth) GetUserFromClientToken(ctx context.Context, tokenString string) (User, []string, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &ClientClaims{}, a.keyFunc,
+		jwt.WithIssuer(a.url),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil || !token.Valid || token.Header["typ"] != AccessTokenType {
+		return User{}, nil, ErrInvalidAccessToken
+	}
+
+	claims, ok := token.Claims.(*ClientClaims)
+	if !ok || claims.ClientID == "" {
+		return User{}, nil, ErrInvalidAccessToken
+	}
+
+	userid, err := strconv.ParseInt(claims.Subject, 10, 32)
+	if err != nil {
+		return User{}, nil, ErrInvalidAccessToken
+	}
+
+	// Get user
+	user, err := a.getCachedUser(ctx, int32(userid))
+	if err != nil {
+		return User{}, nil, err
+	}
+	if claims.Version != user.TokenVersion || user.Disabled() {
+		return User{}, nil, ErrInvalidAccessToken
+	}
+
+	// Check session
+	err = a.checkSession(ctx, user.ID, claims.Session)
+	if err != nil {
+		return User{}, nil, ErrInvalidAccessToken
+	}
+	user.SessionID = claims.Session
+
+	return user, strings.Fields(claims.Scope), nil
+}
+
+// UserInfo returns the claims about the user the scopes allow the client to see.
+func (u User) UserInfo(scopes []string) map[string]any {
+	info := map[string]any{
+		"sub": strconv.Itoa(int(u.ID)),
+	}
+
+	if slices.Contains(scopes, OAuthScopeProfile) {
+		info["preferred_username"] = u.Username
+		info["name"] = u.Username
+	}
+
+	if slices.Contains(scopes, OAuthScopeEmail) && u.Email.IsValue() {
+		info["email"] = u.Email.MustGet()
+		info["email_verified"] = u.EmailVerified()
+	}
+
+	return info
+}
+
+// clientTokens signs the access token, and the ID token if the openid scope was granted.
+func (a *Auth) clientTokens(
+	user User,
+	client OAuthClient,
+	session string,
+	scopes []string,
+	nonce string,
+) (OAuthTokens, error) {
+	now := time.Now()
+
+	access, err := a.signWithType(ClientClaims{
+		Session:  session,
+		Version:  user.TokenVersion,
+		ClientID: client.ID,
+		Scope:    strings.Join(scopes, " "),
+
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:   a.url,
+			ID:       uuid.New().String(),
+			Subject:  strconv.Itoa(int(user.ID)),
+			Audience: jwt.ClaimStrings{client.ID},
+			IssuedAt: &jwt.NumericDate{
+				Time: now,
+			},
+			ExpiresAt: &jwt.NumericDate{
+				Time: now.Add(AccessTokenDuration),
+			},
+		},
+	}, AccessTokenType)
+	if err != nil {
+		return OAuthTokens{}, err
+	}
+	tokens := OAuthTokens{
+		ExpiresIn: AccessTokenDuration,
+		Scopes:    scopes,
+		Access:    access,
+	}
+
+	if slices.Contains(scopes, OAuthScopeOpenID) {
+		claims := jwt.MapClaims(user.UserInfo(scopes))
+		claims["iss"] = a.url
+		claims["aud"] = client.ID
+		claims["azp"] = client.ID
+		claims["sid"] = session
+		claims["iat"] = now.Unix()
+		claims["exp"] = now.Add(AccessTokenDuration).Unix()
+		if nonce != "" {
+			claims["nonce"] = nonce
+		}
+		tokens.IDToken, err = a.sign(claims)
+		if err != nil {
+			return OAuthTokens{}, err
+		}
+	}
+
+	return tokens, nil
+}
+
+// newOAuthSecret generates a random client secret or authorization code.
+func newOAuthSecret() (string, error) {
+	b := make([]byte, oauthSecretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
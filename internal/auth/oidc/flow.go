@@ -0,0 +1,98 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	FlowDuration   = time.Minute * 10 // How long the user has to sign in with the provider
+	FlowAudience   = "oidc"           // Audience of flow tokens
+	flowSecretSize = 32               // 256 bits
+)
+
+var ErrInvalidFlow = errors.New("invalid or expired sign in attempt")
+
+// Flow is the state of an authorization code flow, kept by the browser between the start and the callback.
+type Flow struct {
+	jwt.RegisteredClaims
+
+	Provider string `json:"provider"`
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+
+	// Redirect is the path the user is sent to afterwards.
+	Redirect string `json:"redirect,omitempty"`
+
+	// Link is the ID of the signed in user the identity is linked to, if any.
+	Link int32 `json:"link,omitempty"`
+}
+
+// NewFlow starts a new flow with random state, nonce and PKCE verifier.
+func NewFlow(provider string, redirect string, link int32) (Flow, error) {
+	secrets := make([]string, 3) //nolint:mnd // State, nonce and verifier
+	for i := range secrets {
+		b := make([]byte, flowSecretSize)
+		if _, err := rand.Read(b); err != nil {
+			return Flow{}, err
+		}
+		secrets[i] = base64.RawURLEncoding.EncodeToString(b)
+	}
+
+	return Flow{
+		Provider: provider,
+		State:    secrets[0],
+		Nonce:    secrets[1],
+		Verifier: secrets[2],
+		Redirect: redirect,
+		Link:     link,
+	}, nil
+}
+
+// Signer signs state that is kept by the browser, like *auth.Auth does with the server's signing keys.
+type Signer interface {
+	SignState(claims jwt.Claims) (string, error)
+	ParseState(tokenString string, claims jwt.Claims, audience string) error
+}
+
+// Sign signs the flow, so the browser cannot change it.
+func (f Flow) Sign(signer Signer) (string, error) {
+	f.RegisteredClaims = jwt.RegisteredClaims{
+		Audience: jwt.ClaimStrings{FlowAudience},
+		IssuedAt: &jwt.NumericDate{
+			Time: time.Now(),
+		},
+		ExpiresAt: &jwt.NumericDate{
+			Time: time.Now().Add(FlowDuration),
+		},
+	}
+
+	return signer.SignState(f)
+}
+
+// ParseFlow parses a signed flow, making sure it belongs to the provider and state of the callback.
+func ParseFlow(signer Signer, tokenString string, provider string, state string) (Flow, error) {
+	flow := Flow{}
+	err := signer.ParseState(tokenString, &flow, FlowAudience)
+	if err != nil || flow.Provider != provider {
+		return Flow{}, ErrInvalidFlow
+	}
+	if subtle.ConstantTimeCompare([]byte(flow.State), []byte(state)) != 1 {
+		return Flow{}, ErrInvalidFlow
+	}
+
+	return flow, nil
+}
+
+// challenge derives the PKCE S256 code challenge from a verifier, as described in RFC 7636.
+func challenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
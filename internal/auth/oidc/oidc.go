@@ -0,0 +1,232 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	RequestTimeout  = time.Second * 10 // Timeout of requests to the provider
+	maxResponseSize = 1 << 20          // 1 MiB
+)
+
+var (
+	ErrInvalidIDToken = errors.New("invalid id token")
+	ErrNoIDToken      = errors.New("token response did not contain an id token")
+)
+
+//nolint:gochecknoglobals // Scopes requested when a provider does not configure any
+var DefaultScopes = []string{"openid", "email", "profile"}
+
+// Config configures an OpenID Connect provider.
+type Config struct {
+	// Name identifies the provider in URLs and in the identity table.
+	Name string
+
+	// Issuer is the URL the discovery document is found under.
+	Issuer string
+
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+}
+
+// discovery is the part of the discovery document that is used.
+type discovery struct {
+	Issuer                string   `json:"issuer"`
+	AuthorizationEndpoint string   `json:"authorization_endpoint"`
+	TokenEndpoint         string   `json:"token_endpoint"`
+	JWKSURI               string   `json:"jwks_uri"`
+	TokenAuthMethods      []string `json:"token_endpoint_auth_methods_supported"`
+}
+
+// Provider performs the authorization code flow with PKCE against an OpenID Connect provider.
+// The discovery document is fetched the first time it is needed.
+type Provider struct {
+	config Config
+	client *http.Client
+
+	discovery *discovery
+	keys      *keySet
+	mu        sync.Mutex
+}
+
+func NewProvider(config Config) *Provider {
+	if len(config.Scopes) == 0 {
+		config.Scopes = DefaultScopes
+	}
+
+	client := &http.Client{
+		Timeout: RequestTimeout,
+	}
+
+	return &Provider{
+		config: config,
+		client: client,
+		keys:   newKeySet(client),
+	}
+}
+
+func (p *Provider) Name() string {
+	return p.config.Name
+}
+
+// AuthCodeURL returns the URL the user is sent to to sign in with the provider.
+func (p *Provider) AuthCodeURL(ctx context.Context, redirectURI string, flow Flow) (string, error) {
+	d, err := p.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(d.AuthorizationEndpoint)
+	if err != nil {
+		return "", err
+	}
+
+	// Keep any parameters the endpoint already has
+	query := u.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", p.config.ClientID)
+	query.Set("redirect_uri", redirectURI)
+	query.Set("scope", strings.Join(p.config.Scopes, " "))
+	query.Set("state", flow.State)
+	query.Set("nonce", flow.Nonce)
+	query.Set("code_challenge", challenge(flow.Verifier))
+	query.Set("code_challenge_method", "S256")
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	IDToken     string `json:"id_token"`
+}
+
+type tokenError struct {
+	Error       string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+// Exchange exchanges an authorization code for the user's verified ID token claims.
+func (p *Provider) Exchange(ctx context.Context, redirectURI string, code string, flow Flow) (*Claims, error) {
+	d, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+		"code_verifier": {flow.Verifier},
+	}
+
+	// Client secret basic is the default, some providers only support sending the secret in the body
+	basic := p.config.ClientSecret != "" && (len(d.TokenAuthMethods) == 0 ||
+		slices.Contains(d.TokenAuthMethods, "client_secret_basic"))
+	if !basic {
+		form.Set("client_id", p.config.ClientID)
+		if p.config.ClientSecret != "" {
+			form.Set("client_secret", p.config.ClientSecret)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if basic {
+		req.SetBasicAuth(url.QueryEscape(p.config.ClientID), url.QueryEscape(p.config.ClientSecret))
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		var tokenErr tokenError
+		if json.Unmarshal(body, &tokenErr) == nil && tokenErr.Error != "" {
+			return nil, fmt.Errorf("token request failed: %s %s", tokenErr.Error, tokenErr.Description)
+		}
+		return nil, fmt.Errorf("token request failed: %s", res.Status)
+	}
+
+	var token tokenResponse
+	err = json.Unmarshal(body, &token)
+	if err != nil {
+		return nil, err
+	}
+	if token.IDToken == "" {
+		return nil, ErrNoIDToken
+	}
+
+	return p.verify(ctx, d, token.IDToken, flow.Nonce)
+}
+
+// getDiscovery retrieves the discovery document, fetching it if needed.
+func (p *Provider) getDiscovery(ctx context.Context) (*discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	wellKnown := strings.TrimSuffix(p.config.Issuer, "/") + "/.well-known/openid-configuration"
+	d := &discovery{}
+	err := getJSON(ctx, p.client, wellKnown, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// The issuer must match the one the document was fetched for
+	if strings.TrimSuffix(d.Issuer, "/") != strings.TrimSuffix(p.config.Issuer, "/") {
+		return nil, fmt.Errorf("discovery issuer %q does not match %q", d.Issuer, p.config.Issuer)
+	}
+	if d.AuthorizationEndpoint == "" || d.TokenEndpoint == "" || d.JWKSURI == "" {
+		return nil, errors.New("discovery document is missing endpoints")
+	}
+	p.discovery = d
+
+	return d, nil
+}
+
+// getJSON fetches a JSON document.
+func getJSON(ctx context.Context, client *http.Client, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("get %s: %s", url, res.Status)
+	}
+
+	return json.NewDecoder(io.LimitReader(res.Body, maxResponseSize)).Decode(v)
+}
@@ -0,0 +1,86 @@
+package oidc
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const clockSkew = time.Minute * 1 // Allowed difference between our clock and the provider's
+
+//nolint:gochecknoglobals // Algorithms ID tokens can be signed with
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// Claims are the claims of an ID token that identify the user.
+type Claims struct {
+	jwt.RegisteredClaims
+
+	Nonce             string   `json:"nonce"`
+	AuthorizedParty   string   `json:"azp"`
+	Email             string   `json:"email"`
+	EmailVerified     flexBool `json:"email_verified"`
+	Name              string   `json:"name"`
+	PreferredUsername string   `json:"preferred_username"`
+}
+
+// flexBool is a boolean claim that some providers send as a string.
+type flexBool bool
+
+func (b *flexBool) UnmarshalJSON(data []byte) error {
+	var s string
+	if json.Unmarshal(data, &s) == nil {
+		*b = s == "true"
+		return nil
+	}
+
+	var v bool
+	err := json.Unmarshal(data, &v)
+	*b = flexBool(v)
+
+	return err
+}
+
+// verify checks the signature and claims of an ID token as described in OpenID Connect Core 3.1.3.7.
+func (p *Provider) verify(ctx context.Context, d *discovery, rawIDToken string, nonce string) (*Claims, error) {
+	token, err := jwt.ParseWithClaims(
+		rawIDToken,
+		&Claims{},
+		func(token *jwt.Token) (any, error) {
+			kid, _ := token.Header["kid"].(string)
+			return p.keys.get(ctx, d.JWKSURI, kid)
+		},
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithIssuer(d.Issuer),
+		jwt.WithAudience(p.config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(clockSkew),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIDToken, err)
+	}
+	if !token.Valid {
+		return nil, ErrInvalidIDToken
+	}
+
+	claims, ok := token.Claims.(*Claims)
+	if !ok || claims.Subject == "" {
+		return nil, ErrInvalidIDToken
+	}
+
+	// Tokens issued to several clients must be authorized for this one
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != p.config.ClientID {
+		return nil, ErrInvalidIDToken
+	}
+
+	// The nonce binds the token to the flow that requested it
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return nil, ErrInvalidIDToken
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,136 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+)
+
+const DefaultPasskeyName = "Passkey"
+
+var (
+	ErrLastSignInMethod    = errors.New("cannot remove the last sign-in method")
+	ErrClonedAuthenticator = errors.New("sign count did not increase, the authenticator may have been cloned")
+)
+
+// HasPassword checks if the user can sign in with a password.
+func (u User) HasPassword() bool {
+	return u.Password != ""
+}
+
+// Passkeys retrieves the user's registered passkeys, newest first.
+func (u User) Passkeys(ctx context.Context) (models.CredentialSlice, error) {
+	return models.Credentials.Query(
+		models.SelectWhere.Credentials.UserID.EQ(u.ID),
+		sm.OrderBy(models.Credentials.Columns.CreatedAt).Desc(),
+	).All(ctx, u.db)
+}
+
+// AddPasskey saves a passkey the user just registered.
+func (u User) AddPasskey(ctx context.Context, name string, credential *webauthn.Credential) (*models.Credential, error) {
+	now := time.Now()
+
+	return models.Credentials.Insert(
+		&models.CredentialSetter{
+			CredID:                omit.From(EncodeCredentialID(credential.ID)),
+			CredPublicKey:         omit.From(credential.PublicKey),
+			SignCount:             omit.From(int32(credential.Authenticator.SignCount)),
+			Transports:            omitnull.From(EncodeTransports(credential.Transport)),
+			UserVerified:          omitnull.From(credential.Flags.UserVerified),
+			BackupEligible:        omitnull.From(credential.Flags.BackupEligible),
+			BackupState:           omitnull.From(credential.Flags.BackupState),
+			AttestationObject:     omitnull.From(credential.Attestation.Object),
+			AttestationClientData: omitnull.From(credential.Attestation.ClientDataJSON),
+			CreatedAt:             omit.From(now),
+			LastUsed:              omit.From(now),
+			UserID:                omit.From(u.ID),
+			Name:                  omit.From(name),
+		},
+	).One(ctx, u.db)
+}
+
+// RecordPasskeyLogin updates one of the user's passkeys after it was validated.
+// Logins where the library flagged a sign count that did not increase are rejected,
+// and the passkey is flagged so the user can see it.
+func (u User) RecordPasskeyLogin(ctx context.Context, credential *webauthn.Credential) error {
+	cred, err := models.Credentials.Query(
+		models.SelectWhere.Credentials.CredID.EQ(EncodeCredentialID(credential.ID)),
+		models.SelectWhere.Credentials.UserID.EQ(u.ID),
+	).One(ctx, u.db)
+	if err != nil {
+		return err
+	}
+
+	// Flag possibly cloned authenticators
+	if credential.Authenticator.CloneWarning {
+		err = cred.Update(ctx, u.db, &models.CredentialSetter{
+			CloneWarning: omit.From(true),
+		})
+		if err != nil {
+			return err
+		}
+
+		return ErrClonedAuthenticator
+	}
+
+	// Record use, the backup state can change when a passkey is synced
+	return cred.Update(ctx, u.db, &models.CredentialSetter{
+		LastUsed:     omit.From(time.Now()),
+		SignCount:    omit.From(int32(credential.Authenticator.SignCount)),
+		UserVerified: omitnull.From(credential.Flags.UserVerified),
+		BackupState:  omitnull.From(credential.Flags.BackupState),
+	})
+}
+
+// RenamePasskey sets the nickname of one of the user's passkeys.
+func (u User) RenamePasskey(ctx context.Context, credid string, name string) (*models.Credential, error) {
+	cred, err := models.Credentials.Query(
+		models.SelectWhere.Credentials.CredID.EQ(credid),
+		models.SelectWhere.Credentials.UserID.EQ(u.ID),
+	).One(ctx, u.db)
+	if err != nil {
+		return nil, err
+	}
+
+	err = cred.Update(ctx, u.db, &models.CredentialSetter{
+		Name: omit.From(name),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cred, nil
+}
+
+// DeletePasskey deletes one of the user's passkeys.
+// Users cannot delete their last sign-in method.
+func (u User) DeletePasskey(ctx context.Context, credid string) error {
+	return u.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		cred, err := models.Credentials.Query(
+			models.SelectWhere.Credentials.CredID.EQ(credid),
+			models.SelectWhere.Credentials.UserID.EQ(u.ID),
+		).One(ctx, exec)
+		if err != nil {
+			return err
+		}
+
+		// Make sure the user can still sign in
+		count, err := u.signInMethods(ctx, exec)
+		if err != nil {
+			return err
+		}
+		if count <= 1 {
+			return ErrLastSignInMethod
+		}
+
+		return cred.Delete(ctx, exec)
+	})
+}
@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"context"
+	"strings"
+)
+
+const (
+	PasswordMinLength = 8 // Default shortest password
+	PasswordMinScore  = 2 // Default weakest password score, from 0 to 4
+
+	PasswordProblemTooShort         = "too_short"
+	PasswordProblemTooWeak          = "too_weak"
+	PasswordProblemContainsUsername = "contains_username"
+	PasswordProblemBreached         = "breached"
+)
+
+// PasswordPolicy is what new passwords must meet.
+type PasswordPolicy struct {
+	MinLength int
+	MinScore  int
+
+	// AllowUsername allows passwords that contain the username.
+	AllowUsername bool
+
+	// Breached rejects passwords exposed in data breaches, nil disables the check.
+	Breached BreachedPasswords
+}
+
+// PasswordPolicyError describes why a password does not meet the policy.
+type PasswordPolicyError struct {
+	Problems    []string
+	Score       int
+	MinScore    int
+	MinLength   int
+	Suggestions []string
+}
+
+func (e *PasswordPolicyError) Error() string {
+	reasons := []string{}
+	for _, problem := range e.Problems {
+		switch problem {
+		case PasswordProblemTooShort:
+			reasons = append(reasons, "is too short")
+		case PasswordProblemTooWeak:
+			reasons = append(reasons, "is too easy to guess")
+		case PasswordProblemContainsUsername:
+			reasons = append(reasons, "contains the username")
+		case PasswordProblemBreached:
+			reasons = append(reasons, "appeared in a data breach")
+		}
+	}
+
+	return "password " + strings.Join(reasons, ", ")
+}
+
+// CheckPassword checks a new password against the password policy, returning a PasswordPolicyError if it fails.
+// The username and other inputs like the email address make passwords containing them weaker.
+func (a *Auth) CheckPassword(ctx context.Context, password string, username string, inputs ...string) error {
+	policy := a.passwords
+	score, suggestions := passwordScore(password, append(inputs, username)...)
+
+	problems := []string{}
+	if len([]rune(password)) < policy.MinLength {
+		problems = append(problems, PasswordProblemTooShort)
+	}
+	if score < policy.MinScore {
+		problems = append(problems, PasswordProblemTooWeak)
+	}
+	if !policy.AllowUsername && username != "" && strings.Contains(strings.ToLower(password), strings.ToLower(username)) {
+		problems = append(problems, PasswordProblemContainsUsername)
+	}
+	if policy.Breached != nil {
+		count, err := policy.Breached.Count(ctx, password)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			problems = append(problems, PasswordProblemBreached)
+			suggestions = append([]string{"Choose a password that has not appeared in a data breach"}, suggestions...)
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return &PasswordPolicyError{
+		Problems:    problems,
+		Score:       score,
+		MinScore:    policy.MinScore,
+		MinLength:   policy.MinLength,
+		Suggestions: suggestions,
+	}
+}
@@ -0,0 +1,183 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+)
+
+type Role string
+
+const (
+	RoleAdmin Role = "admin"
+	RoleUser  Role = "user"
+)
+
+type Permission string
+
+const (
+	PermissionUsersRead  Permission = "users:read"
+	PermissionUsersWrite Permission = "users:write"
+
+	PermissionClientsRead  Permission = "clients:read"
+	PermissionClientsWrite Permission = "clients:write"
+
+	PermissionAuditRead Permission = "audit:read"
+)
+
+var (
+	ErrUserDisabled          = errors.New("account disabled")
+	ErrPasswordResetRequired = errors.New("password reset required")
+)
+
+// Permissions returns the permissions granted to a role.
+func (r Role) Permissions() []Permission {
+	switch r {
+	case RoleAdmin:
+		return []Permission{
+			PermissionUsersRead,
+			PermissionUsersWrite,
+			PermissionClientsRead,
+			PermissionClientsWrite,
+			PermissionAuditRead,
+		}
+
+	case RoleUser:
+		return []Permission{}
+
+	default:
+		return []Permission{}
+	}
+}
+
+// Can checks if the user's role grants a permission.
+func (u User) Can(permission Permission) bool {
+	return slices.Contains(Role(u.Role).Permissions(), permission)
+}
+
+// Disabled checks if the user's account has been disabled.
+func (u User) Disabled() bool {
+	return u.DisabledAt.IsValue()
+}
+
+// SetRole changes the user's role.
+func (u User) SetRole(ctx context.Context, role Role) error {
+	err := u.Update(ctx, u.db, &models.UserSetter{
+		Role: omit.From(string(role)),
+	})
+	if err != nil {
+		return err
+	}
+	u.auth.cache.forget(u.ID)
+
+	return nil
+}
+
+// Disable disables the user's account and revokes all of their sessions.
+func (u User) Disable(ctx context.Context) error {
+	err := u.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		err := u.Update(ctx, exec, &models.UserSetter{
+			DisabledAt:   omitnull.From(time.Now()),
+			TokenVersion: omit.From(u.TokenVersion + 1),
+		})
+		if err != nil {
+			return err
+		}
+
+		return revokeSessions(ctx, exec, u.ID)
+	})
+	if err != nil {
+		return err
+	}
+	u.auth.cache.forget(u.ID)
+
+	return nil
+}
+
+// Enable re-enables the user's account.
+func (u User) Enable(ctx context.Context) error {
+	err := u.Update(ctx, u.db, &models.UserSetter{
+		DisabledAt: omitnull.FromPtr[time.Time](nil),
+	})
+	if err != nil {
+		return err
+	}
+	u.auth.cache.forget(u.ID)
+
+	return nil
+}
+
+// RequirePasswordReset forces the user to change their password the next time they sign in,
+// and revokes all of their sessions.
+func (u User) RequirePasswordReset(ctx context.Context) error {
+	err := u.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		err := u.Update(ctx, exec, &models.UserSetter{
+			PasswordResetRequired: omit.From(true),
+			TokenVersion:          omit.From(u.TokenVersion + 1),
+		})
+		if err != nil {
+			return err
+		}
+
+		return revokeSessions(ctx, exec, u.ID)
+	})
+	if err != nil {
+		return err
+	}
+	u.auth.cache.forget(u.ID)
+
+	return nil
+}
+
+// DeleteAccount deletes the user, everything they own is deleted with them.
+func (u User) DeleteAccount(ctx context.Context) error {
+	err := u.Delete(ctx, u.db)
+	if err != nil {
+		return err
+	}
+	u.auth.cache.forget(u.ID)
+
+	return nil
+}
+
+// Bootstrap makes sure the user with the given username is an admin, if they exist.
+func (a *Auth) Bootstrap(ctx context.Context, username string) error {
+	if username == "" {
+		return nil
+	}
+
+	user, err := a.GetUserByName(ctx, username)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	if Role(user.Role) == RoleAdmin {
+		return nil
+	}
+
+	return user.SetRole(ctx, RoleAdmin)
+}
+
+// revokeSessions revokes all of a user's sessions.
+func revokeSessions(ctx context.Context, exec bob.Executor, userid int32) error {
+	_, err := models.Sessions.Update(
+		(&models.SessionSetter{
+			RevokedAt: omitnull.From(time.Now()),
+		}).UpdateMod(),
+		models.UpdateWhere.Sessions.UserID.EQ(userid),
+		models.UpdateWhere.Sessions.RevokedAt.IsNull(),
+	).Exec(ctx, exec)
+
+	return err
+}
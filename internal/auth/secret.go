@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+)
+
+const secretBoxKeyLength = 32 // AES-256
+
+var ErrInvalidSecret = errors.New("invalid encrypted secret")
+
+// secretBox encrypts secrets that are stored in the database, like TOTP secrets, with AES-256-GCM.
+// Each secret is bound to the row it belongs to, so it cannot be copied to another row.
+type secretBox struct {
+	aead cipher.AEAD
+}
+
+// newSecretBox derives the encryption key from the server's secret key.
+func newSecretBox(key string) (*secretBox, error) {
+	derived, err := hkdf.Key(sha256.New, []byte(key), nil, "ts-server secret box", secretBoxKeyLength)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(derived)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secretBox{
+		aead: aead,
+	}, nil
+}
+
+// seal encrypts a secret, returning the nonce and ciphertext as base64.
+func (b *secretBox) seal(secret string, row string) (string, error) {
+	nonce := make([]byte, b.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := b.aead.Seal(nonce, nonce, []byte(secret), []byte(row))
+	return base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// open decrypts a secret encrypted by seal for the same row.
+func (b *secretBox) open(sealed string, row string) (string, error) {
+	data, err := base64.RawStdEncoding.DecodeString(sealed)
+	if err != nil || len(data) < b.aead.NonceSize() {
+		return "", ErrInvalidSecret
+	}
+
+	nonce, ciphertext := data[:b.aead.NonceSize()], data[b.aead.NonceSize():]
+	secret, err := b.aead.Open(nil, nonce, ciphertext, []byte(row))
+	if err != nil {
+		return "", ErrInvalidSecret
+	}
+
+	return string(secret), nil
+}
@@ -0,0 +1,365 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+)
+
+const (
+	AccessTokenDuration  = time.Minute * 15    // 15 minutes
+	RefreshTokenDuration = time.Hour * 24 * 30 // 30 days
+	RefreshGracePeriod   = time.Second * 30    // 30 seconds
+	refreshSecretLength  = 32                  // 256 bits
+	refreshTokenParts    = 2                   // <session id>.<secret>
+	CookieTokenName      = "token"             // Access token cookie
+	CookieRefreshName    = "refresh"           // Refresh token cookie
+	CookieRefreshMaxAge  = 86400 * 30          // 30 days
+)
+
+var (
+	ErrSessionRevoked      = errors.New("session revoked")
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+)
+
+type SessionParams struct {
+	UserAgent string
+	IP        string
+
+	// Expiration is how long the session lives, defaults to RefreshTokenDuration.
+	Expiration time.Duration
+
+	// ClientID is the OAuth client the session was created for, if any.
+	ClientID string
+	Scopes   []string
+}
+
+// Tokens are the access and refresh tokens for a session.
+type Tokens struct {
+	Session *models.Session
+	Access  string
+
+	// Refresh is empty when the refresh token was not rotated.
+	Refresh string
+}
+
+// Cookies returns the cookies that carry the tokens.
+func (t Tokens) Cookies() []*http.Cookie {
+	cookies := []*http.Cookie{
+		{
+			Name:     CookieTokenName,
+			Value:    t.Access,
+			Path:     "/",
+			MaxAge:   CookieMaxAge,
+			HttpOnly: true,
+			Secure:   true,
+			SameSite: http.SameSiteStrictMode,
+		},
+	}
+
+	if t.Refresh != "" {
+		cookies = append(cookies, &http.Cookie{
+			Name:     CookieRefreshName,
+			Value:    t.Refresh,
+			Path:     "/",
+			MaxAge:   CookieRefreshMaxAge,
+			HttpOnly: true,
+			Secure:   true,
+			SameSite: http.SameSiteStrictMode,
+		})
+	}
+
+	return cookies
+}
+
+// ClearCookies returns cookies that remove the session cookies from the client.
+func ClearCookies() []*http.Cookie {
+	cookies := []*http.Cookie{}
+	for _, name := range []string{CookieTokenName, CookieRefreshName} {
+		cookies = append(cookies, &http.Cookie{
+			Name:     name,
+			Value:    "",
+			Path:     "/",
+			MaxAge:   -1,
+			HttpOnly: true,
+			Secure:   true,
+			SameSite: http.SameSiteStrictMode,
+		})
+	}
+
+	return cookies
+}
+
+// NewSession creates a new session for the user and returns its tokens.
+func (u User) NewSession(ctx context.Context, params SessionParams) (Tokens, error) {
+	if u.Disabled() {
+		return Tokens{}, ErrUserDisabled
+	}
+	if params.Expiration == 0 {
+		params.Expiration = RefreshTokenDuration
+	}
+
+	secret, hash, err := newRefreshSecret()
+	if err != nil {
+		return Tokens{}, err
+	}
+
+	now := time.Now()
+	setter := &models.SessionSetter{
+		ID:          omit.From(uuid.New().String()),
+		RefreshHash: omit.From(hash),
+		CreatedAt:   omit.From(now),
+		LastSeen:    omit.From(now),
+		RotatedAt:   omit.From(now),
+		ExpiresAt:   omit.From(now.Add(params.Expiration)),
+		UserAgent:   omit.From(params.UserAgent),
+		IP:          omit.From(params.IP),
+		UserID:      omit.From(u.ID),
+	}
+	if params.ClientID != "" {
+		setter.ClientID = omitnull.From(params.ClientID)
+		setter.Scopes = omitnull.From(strings.Join(params.Scopes, " "))
+	}
+
+	session, err := models.Sessions.Insert(setter).One(ctx, u.db)
+	if err != nil {
+		return Tokens{}, err
+	}
+	access, err := u.Token(session.ID, now.Add(AccessTokenDuration))
+	if err != nil {
+		return Tokens{}, err
+	}
+
+	return Tokens{
+		Session: session,
+		Access:  access,
+		Refresh: session.ID + "." + secret,
+	}, nil
+}
+
+// Refresh exchanges a refresh token for a new access token, rotating the refresh token.
+// Presenting an already rotated refresh token outside of the grace period revokes the session.
+func (a *Auth) Refresh(ctx context.Context, refreshToken string) (User, Tokens, error) {
+	user, session, refresh, err := a.refreshSession(ctx, refreshToken, "")
+	if err != nil {
+		return User{}, Tokens{}, err
+	}
+	access, err := user.Token(session.ID, time.Now().Add(AccessTokenDuration))
+	if err != nil {
+		return User{}, Tokens{}, err
+	}
+
+	return user, Tokens{
+		Session: session,
+		Access:  access,
+		Refresh: refresh,
+	}, nil
+}
+
+// refreshSession checks a refresh token of a session that belongs to a client, or to no client,
+// returning the rotated refresh token. It is empty when the refresh token was not rotated.
+func (a *Auth) refreshSession(
+	ctx context.Context,
+	refreshToken string,
+	clientID string,
+) (User, *models.Session, string, error) {
+	parts := strings.SplitN(refreshToken, ".", refreshTokenParts)
+	if len(parts) != refreshTokenParts {
+		return User{}, nil, "", ErrInvalidRefreshToken
+	}
+
+	// Get session, refresh tokens of clients only work for that client
+	clientWhere := models.SelectWhere.Sessions.ClientID.IsNull()
+	if clientID != "" {
+		clientWhere = models.SelectWhere.Sessions.ClientID.EQ(clientID)
+	}
+	session, err := models.Sessions.Query(
+		models.SelectWhere.Sessions.ID.EQ(parts[0]),
+		models.SelectWhere.Sessions.RevokedAt.IsNull(),
+		models.SelectWhere.Sessions.ExpiresAt.GT(time.Now()),
+		clientWhere,
+	).One(ctx, a.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return User{}, nil, "", ErrInvalidRefreshToken
+		}
+		return User{}, nil, "", err
+	}
+
+	// Get user
+	user, err := a.GetUser(ctx, session.UserID)
+	if err != nil {
+		return User{}, nil, "", err
+	}
+	if user.Disabled() {
+		return User{}, nil, "", ErrInvalidRefreshToken
+	}
+	user.SessionID = session.ID
+
+	now := time.Now()
+	hash := hashSecret(parts[1])
+
+	// Rotate the refresh token if it is the current one
+	if subtle.ConstantTimeCompare(hash, session.RefreshHash) == 1 {
+		secret, newHash, err := newRefreshSecret()
+		if err != nil {
+			return User{}, nil, "", err
+		}
+
+		// Only rotate if no concurrent request rotated it first
+		rotated, err := models.Sessions.Update(
+			(&models.SessionSetter{
+				RefreshHash:         omit.From(newHash),
+				PreviousRefreshHash: omitnull.From(session.RefreshHash),
+				RotatedAt:           omit.From(now),
+				LastSeen:            omit.From(now),
+			}).UpdateMod(),
+			models.UpdateWhere.Sessions.ID.EQ(session.ID),
+			models.UpdateWhere.Sessions.RefreshHash.EQ(session.RefreshHash),
+		).All(ctx, a.db)
+		if err != nil {
+			return User{}, nil, "", err
+		}
+		if len(rotated) == 1 {
+			return user, session, session.ID + "." + secret, nil
+		}
+
+		return user, session, "", nil
+	}
+
+	// Allow the previous refresh token for a short time so concurrent requests don't revoke the session
+	if session.PreviousRefreshHash.IsValue() &&
+		subtle.ConstantTimeCompare(hash, session.PreviousRefreshHash.MustGet()) == 1 &&
+		now.Sub(session.RotatedAt) < RefreshGracePeriod {
+		return user, session, "", nil
+	}
+
+	// The refresh token was reused, assume it was stolen
+	err = session.Update(ctx, a.db, &models.SessionSetter{
+		RevokedAt: omitnull.From(now),
+	})
+	if err != nil {
+		return User{}, nil, "", err
+	}
+
+	return User{}, nil, "", ErrInvalidRefreshToken
+}
+
+// RevokeRefreshToken revokes the session that owns the refresh token.
+func (a *Auth) RevokeRefreshToken(ctx context.Context, refreshToken string) error {
+	parts := strings.SplitN(refreshToken, ".", refreshTokenParts)
+	if len(parts) != refreshTokenParts {
+		return ErrInvalidRefreshToken
+	}
+
+	session, err := models.Sessions.Query(
+		models.SelectWhere.Sessions.ID.EQ(parts[0]),
+	).One(ctx, a.db)
+	if err != nil {
+		return err
+	}
+
+	hash := hashSecret(parts[1])
+	if subtle.ConstantTimeCompare(hash, session.RefreshHash) != 1 &&
+		(!session.PreviousRefreshHash.IsValue() ||
+			subtle.ConstantTimeCompare(hash, session.PreviousRefreshHash.MustGet()) != 1) {
+		return ErrInvalidRefreshToken
+	}
+
+	return session.Update(ctx, a.db, &models.SessionSetter{
+		RevokedAt: omitnull.From(time.Now()),
+	})
+}
+
+// checkSession makes sure a session exists, belongs to the user, and has not been revoked.
+func (a *Auth) checkSession(ctx context.Context, userid int32, sessionid string) error {
+	exists, err := models.Sessions.Query(
+		models.SelectWhere.Sessions.ID.EQ(sessionid),
+		models.SelectWhere.Sessions.UserID.EQ(userid),
+		models.SelectWhere.Sessions.RevokedAt.IsNull(),
+		models.SelectWhere.Sessions.ExpiresAt.GT(time.Now()),
+	).Exists(ctx, a.db)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrSessionRevoked
+	}
+
+	return nil
+}
+
+// Sessions retrieves the user's active sessions, most recently seen first.
+func (u User) Sessions(ctx context.Context) (models.SessionSlice, error) {
+	return models.Sessions.Query(
+		models.SelectWhere.Sessions.UserID.EQ(u.ID),
+		models.SelectWhere.Sessions.RevokedAt.IsNull(),
+		models.SelectWhere.Sessions.ExpiresAt.GT(time.Now()),
+		sm.OrderBy(models.Sessions.Columns.LastSeen).Desc(),
+	).All(ctx, u.db)
+}
+
+// RevokeSession revokes one of the user's sessions.
+func (u User) RevokeSession(ctx context.Context, sessionid string) error {
+	session, err := models.Sessions.Query(
+		models.SelectWhere.Sessions.ID.EQ(sessionid),
+		models.SelectWhere.Sessions.UserID.EQ(u.ID),
+		models.SelectWhere.Sessions.RevokedAt.IsNull(),
+	).One(ctx, u.db)
+	if err != nil {
+		return err
+	}
+
+	return session.Update(ctx, u.db, &models.SessionSetter{
+		RevokedAt: omitnull.From(time.Now()),
+	})
+}
+
+// RevokeOtherSessions revokes all of the user's sessions except the current one.
+func (u User) RevokeOtherSessions(ctx context.Context) (int64, error) {
+	revoked, err := models.Sessions.Update(
+		(&models.SessionSetter{
+			RevokedAt: omitnull.From(time.Now()),
+		}).UpdateMod(),
+		models.UpdateWhere.Sessions.UserID.EQ(u.ID),
+		models.UpdateWhere.Sessions.ID.NE(u.SessionID),
+		models.UpdateWhere.Sessions.RevokedAt.IsNull(),
+	).All(ctx, u.db)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(len(revoked)), nil
+}
+
+// newRefreshSecret generates a random refresh secret and its hash.
+func newRefreshSecret() (string, []byte, error) {
+	b := make([]byte, refreshSecretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, err
+	}
+
+	secret := base64.RawURLEncoding.EncodeToString(b)
+	return secret, hashSecret(secret), nil
+}
+
+// hashSecret hashes a random secret for storage.
+// The secret is high entropy so a fast hash is sufficient.
+func hashSecret(secret string) []byte {
+	hash := sha256.Sum256([]byte(secret))
+	return hash[:]
+}
@@ -0,0 +1,270 @@
+package auth
+
+import (
+	_ "embed"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+const (
+	PasswordScoreMax = 4 // Strongest password score
+
+	minPatternLength  = 3 // Shortest repeat, sequence or word that counts as a pattern
+	minKeyboardLength = 4 // Shortest keyboard walk that counts as a pattern
+	keyboardPatterns  = 100
+)
+
+//go:embed passwords.txt
+var commonPasswordList string
+
+//nolint:gochecknoglobals // Ranks of common passwords and words, most common first
+var commonPasswords = sync.OnceValue(func() map[string]int {
+	ranks := make(map[string]int)
+	for i, word := range strings.Fields(commonPasswordList) {
+		ranks[word] = i + 1
+	}
+	return ranks
+})
+
+// Orders of magnitude of guesses each score needs to reach, the same as zxcvbn.
+//
+//nolint:gochecknoglobals // Constant
+var scoreThresholds = []float64{3, 6, 8, 10}
+
+//nolint:gochecknoglobals // Constant
+var keyboardRows = []string{"1234567890", "qwertyuiop", "asdfghjkl", "zxcvbnm"}
+
+//nolint:gochecknoglobals // Common substitutions of letters
+var leetSubstitutions = map[rune]rune{
+	'4': 'a', '@': 'a', '3': 'e', '1': 'i', '!': 'i', '0': 'o', '$': 's', '5': 's', '7': 't',
+}
+
+// passwordMatch is a part of a password, and the order of magnitude of guesses it takes to find.
+type passwordMatch struct {
+	length     int
+	guesses    float64
+	suggestion string
+}
+
+// passwordScore estimates how hard a password is to guess from 0 to 4, in the style of zxcvbn.
+// The password is split into common words, the user inputs, repeats, sequences, keyboard walks and random characters,
+// and the guesses for each part are multiplied. Suggestions describe how to make the password stronger.
+func passwordScore(password string, inputs ...string) (int, []string) {
+	runes := []rune(password)
+	lower := []rune(strings.ToLower(password))
+	cardinality := bruteforceCardinality(runes)
+
+	guesses := 0.0
+	suggestions := []string{}
+	for i := 0; i < len(lower); {
+		match := passwordMatch{
+			length:  1,
+			guesses: math.Log10(cardinality),
+		}
+		for _, m := range []passwordMatch{
+			matchWord(runes[i:], lower[i:], inputs),
+			matchRepeat(lower[i:]),
+			matchSequence(lower[i:]),
+			matchKeyboard(lower[i:]),
+		} {
+			if m.length > match.length || (m.length == match.length && m.length > 1 && m.guesses < match.guesses) {
+				match = m
+			}
+		}
+
+		guesses += match.guesses
+		if match.suggestion != "" && !slices.Contains(suggestions, match.suggestion) {
+			suggestions = append(suggestions, match.suggestion)
+		}
+		i += match.length
+	}
+
+	score := 0
+	for _, threshold := range scoreThresholds {
+		if guesses > threshold {
+			score++
+		}
+	}
+	if score < PasswordScoreMax {
+		suggestions = append(suggestions, "Add another word or two, uncommon words are better")
+	}
+
+	return score, suggestions
+}
+
+// matchWord matches the longest common word, or user input, at the start of the password.
+func matchWord(runes []rune, lower []rune, inputs []string) passwordMatch {
+	for j := len(lower); j >= minPatternLength; j-- {
+		unleeted, substitutions := unleet(lower[:j])
+		candidates := []struct {
+			word          string
+			substitutions int
+		}{
+			{string(lower[:j]), 0},
+			{unleeted, substitutions},
+		}
+		for _, candidate := range candidates {
+			word := candidate.word
+			for _, input := range inputs {
+				if len(input) >= minPatternLength && strings.EqualFold(word, input) {
+					return passwordMatch{
+						length:     j,
+						guesses:    caseGuesses(runes[:j]) + float64(candidate.substitutions),
+						suggestion: "Avoid using your username or email address",
+					}
+				}
+			}
+
+			if rank, ok := commonPasswords()[word]; ok {
+				return passwordMatch{
+					length:     j,
+					guesses:    math.Log10(float64(rank)) + caseGuesses(runes[:j]) + float64(candidate.substitutions),
+					suggestion: "Avoid common passwords and words",
+				}
+			}
+		}
+	}
+
+	return passwordMatch{}
+}
+
+// matchRepeat matches a character repeated at the start of the password, like aaa.
+func matchRepeat(lower []rune) passwordMatch {
+	n := 1
+	for n < len(lower) && lower[n] == lower[0] {
+		n++
+	}
+	if n < minPatternLength {
+		return passwordMatch{}
+	}
+
+	return passwordMatch{
+		length:     n,
+		guesses:    math.Log10(bruteforceCardinality(lower[:1]) * float64(n)),
+		suggestion: "Avoid repeated characters like aaa",
+	}
+}
+
+// matchSequence matches a sequence at the start of the password, like abc or 987.
+func matchSequence(lower []rune) passwordMatch {
+	if len(lower) < minPatternLength {
+		return passwordMatch{}
+	}
+
+	step := lower[1] - lower[0]
+	if step != 1 && step != -1 {
+		return passwordMatch{}
+	}
+	n := 2
+	for n < len(lower) && lower[n]-lower[n-1] == step {
+		n++
+	}
+	if n < minPatternLength {
+		return passwordMatch{}
+	}
+
+	return passwordMatch{
+		length:     n,
+		guesses:    math.Log10(bruteforceCardinality(lower[:1]) * float64(n) * 2), //nolint:mnd // Either direction
+		suggestion: "Avoid sequences like abc or 123",
+	}
+}
+
+// matchKeyboard matches a walk along a keyboard row at the start of the password, like qwer.
+func matchKeyboard(lower []rune) passwordMatch {
+	longest := 0
+	for _, row := range keyboardRows {
+		for j := len(lower); j > longest && j >= minKeyboardLength; j-- {
+			walk := string(lower[:j])
+			if strings.Contains(row, walk) || strings.Contains(reverse(row), walk) {
+				longest = j
+				break
+			}
+		}
+	}
+	if longest == 0 {
+		return passwordMatch{}
+	}
+
+	return passwordMatch{
+		length:     longest,
+		guesses:    math.Log10(keyboardPatterns * float64(longest)),
+		suggestion: "Avoid keyboard patterns like qwerty",
+	}
+}
+
+// bruteforceCardinality returns how many characters each random character of the password could be.
+func bruteforceCardinality(runes []rune) float64 {
+	var lower, upper, digit, symbol bool
+	for _, r := range runes {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	cardinality := 0.0
+	if lower {
+		cardinality += 26
+	}
+	if upper {
+		cardinality += 26
+	}
+	if digit {
+		cardinality += 10
+	}
+	if symbol {
+		cardinality += 33
+	}
+
+	return max(cardinality, 10) //nolint:mnd // Same as zxcvbn
+}
+
+// caseGuesses returns the order of magnitude of guesses to find the capitalization of a word.
+func caseGuesses(runes []rune) float64 {
+	upper := 0
+	for _, r := range runes {
+		if unicode.IsUpper(r) {
+			upper++
+		}
+	}
+
+	switch {
+	case upper == 0:
+		return 0
+	case upper == len(runes) || (upper == 1 && unicode.IsUpper(runes[0])):
+		return math.Log10(2) //nolint:mnd // Capitalized or all caps
+	default:
+		return float64(min(upper, len(runes)-upper)) * math.Log10(float64(len(runes)))
+	}
+}
+
+// unleet undoes common substitutions, returning the word and how many characters were substituted.
+func unleet(runes []rune) (string, int) {
+	substitutions := 0
+	word := make([]rune, len(runes))
+	for i, r := range runes {
+		word[i] = r
+		if l, ok := leetSubstitutions[r]; ok {
+			word[i] = l
+			substitutions++
+		}
+	}
+
+	return string(word), substitutions
+}
+
+func reverse(s string) string {
+	runes := []rune(s)
+	slices.Reverse(runes)
+	return string(runes)
+}
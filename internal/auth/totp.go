@@ -0,0 +1,449 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // RFC 6238 uses HMAC-SHA1, authenticator apps expect it
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+)
+
+const (
+	TOTPPeriod             = 30              // 30 seconds
+	TOTPDigits             = 6               // Length of a code
+	totpModulo             = 1_000_000       // 10^TOTPDigits
+	totpSkew               = 1               // Accept codes one period early or late
+	totpSecretLength       = 20              // 160 bits
+	RecoveryCodeCount      = 10              // Recovery codes generated at once
+	recoveryCodeLength     = 20              // 100 bits of base32
+	recoveryCodeGroup      = 5               // Characters between dashes
+	ChallengeTokenDuration = time.Minute * 5 // 5 minutes
+	ChallengeAudience      = "mfa"           // Audience of challenge tokens
+	ChallengeMaxFailures   = 5               // Failed second factors before a challenge is used up
+)
+
+var (
+	ErrInvalidCode         = errors.New("invalid code")
+	ErrInvalidChallenge    = errors.New("invalid challenge token")
+	ErrTOTPNotEnrolled     = errors.New("totp enrollment not started")
+	ErrTOTPAlreadyEnabled  = errors.New("totp already enabled")
+	ErrTwoFactorNotEnabled = errors.New("two-factor authentication not enabled")
+)
+
+//nolint:gochecknoglobals // Encoding used by authenticator apps
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// TwoFactorEnabled checks if the user must provide a second factor after their password.
+func (u User) TwoFactorEnabled() bool {
+	return u.TotpEnabled
+}
+
+// BeginTOTPEnrollment generates a new TOTP secret for the user, returning it with its provisioning URI.
+// The secret is not used until the enrollment is finished with a valid code.
+func (u User) BeginTOTPEnrollment(ctx context.Context) (string, string, error) {
+	if u.TotpEnabled {
+		return "", "", ErrTOTPAlreadyEnabled
+	}
+
+	b := make([]byte, totpSecretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	secret := totpEncoding.EncodeToString(b)
+
+	// The secret is encrypted at rest
+	sealed, err := u.auth.box.seal(secret, totpSecretRow(u.ID))
+	if err != nil {
+		return "", "", err
+	}
+	err = u.Update(ctx, u.db, &models.UserSetter{
+		TotpSecret: omitnull.From(sealed),
+	})
+	if err != nil {
+		return "", "", err
+	}
+	u.auth.cache.forget(u.ID)
+
+	// Create provisioning URI, usually shown as a QR code
+	uri := url.URL{
+		Scheme: "otpauth",
+		Host:   "totp",
+		Path:   "/" + u.auth.issuer + ":" + u.Username,
+		RawQuery: url.Values{
+			"secret":    {secret},
+			"issuer":    {u.auth.issuer},
+			"algorithm": {"SHA1"},
+			"digits":    {strconv.Itoa(TOTPDigits)},
+			"period":    {strconv.Itoa(TOTPPeriod)},
+		}.Encode(),
+	}
+
+	return secret, uri.String(), nil
+}
+
+// FinishTOTPEnrollment enables TOTP once the user proves they can generate codes,
+// returning a new set of recovery codes.
+func (u User) FinishTOTPEnrollment(ctx context.Context, code string) ([]string, error) {
+	if u.TotpEnabled {
+		return nil, ErrTOTPAlreadyEnabled
+	}
+	if !u.TotpSecret.IsValue() {
+		return nil, ErrTOTPNotEnrolled
+	}
+
+	secret, err := u.totpSecret()
+	if err != nil {
+		return nil, err
+	}
+	step, ok := validateTOTP(secret, code, time.Now())
+	if !ok {
+		return nil, ErrInvalidCode
+	}
+
+	var codes []string
+	err = u.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		err := u.Update(ctx, exec, &models.UserSetter{
+			TotpEnabled:  omit.From(true),
+			TotpLastStep: omit.From(step),
+		})
+		if err != nil {
+			return err
+		}
+
+		codes, err = newRecoveryCodes(ctx, exec, u.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.auth.cache.forget(u.ID)
+
+	return codes, nil
+}
+
+// DisableTOTP disables TOTP and deletes the user's recovery codes.
+func (u User) DisableTOTP(ctx context.Context) error {
+	err := u.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		err := u.Update(ctx, exec, &models.UserSetter{
+			TotpSecret:   omitnull.FromPtr[string](nil),
+			TotpEnabled:  omit.From(false),
+			TotpLastStep: omit.From(int32(0)),
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = models.RecoveryCodes.Delete(
+			models.DeleteWhere.RecoveryCodes.UserID.EQ(u.ID),
+		).Exec(ctx, exec)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	u.auth.cache.forget(u.ID)
+
+	return nil
+}
+
+// RegenerateRecoveryCodes replaces the user's recovery codes.
+func (u User) RegenerateRecoveryCodes(ctx context.Context) ([]string, error) {
+	if !u.TwoFactorEnabled() {
+		return nil, ErrTwoFactorNotEnabled
+	}
+
+	var codes []string
+	err := u.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		var err error
+		codes, err = newRecoveryCodes(ctx, exec, u.ID)
+		return err
+	})
+
+	return codes, err
+}
+
+// VerifyTOTP checks a TOTP code, making sure it has not been used before.
+func (u User) VerifyTOTP(ctx context.Context, code string) error {
+	if !u.TotpEnabled || !u.TotpSecret.IsValue() {
+		return ErrTwoFactorNotEnabled
+	}
+
+	secret, err := u.totpSecret()
+	if err != nil {
+		return err
+	}
+	step, ok := validateTOTP(secret, code, time.Now())
+	if !ok {
+		return ErrInvalidCode
+	}
+
+	// Only accept each code once, even with concurrent requests
+	updated, err := models.Users.Update(
+		(&models.UserSetter{
+			TotpLastStep: omit.From(step),
+		}).UpdateMod(),
+		models.UpdateWhere.Users.ID.EQ(u.ID),
+		models.UpdateWhere.Users.TotpLastStep.LT(step),
+	).All(ctx, u.db)
+	if err != nil {
+		return err
+	}
+	if len(updated) != 1 {
+		return ErrInvalidCode
+	}
+	u.auth.cache.forget(u.ID)
+
+	return nil
+}
+
+// UseRecoveryCode checks a recovery code and marks it as used.
+func (u User) UseRecoveryCode(ctx context.Context, code string) error {
+	if !u.TwoFactorEnabled() {
+		return ErrTwoFactorNotEnabled
+	}
+
+	used, err := models.RecoveryCodes.Update(
+		(&models.RecoveryCodeSetter{
+			UsedAt: omitnull.From(time.Now()),
+		}).UpdateMod(),
+		models.UpdateWhere.RecoveryCodes.Hash.EQ(hashSecret(normalizeRecoveryCode(code))),
+		models.UpdateWhere.RecoveryCodes.UserID.EQ(u.ID),
+		models.UpdateWhere.RecoveryCodes.UsedAt.IsNull(),
+	).All(ctx, u.db)
+	if err != nil {
+		return err
+	}
+	if len(used) != 1 {
+		return ErrInvalidCode
+	}
+
+	return nil
+}
+
+// NewChallenge starts a second factor challenge, returning a short lived token that proves the user entered
+// their password. The challenge is stored so it can be used up, by a session or by too many failed factors.
+func (u User) NewChallenge(ctx context.Context) (string, error) {
+	now := time.Now()
+
+	// Remove the user's expired challenges
+	_, err := models.LoginChallenges.Delete(
+		models.DeleteWhere.LoginChallenges.UserID.EQ(u.ID),
+		models.DeleteWhere.LoginChallenges.ExpiresAt.LT(now),
+	).All(ctx, u.db)
+	if err != nil {
+		return "", err
+	}
+
+	challenge, err := models.LoginChallenges.Insert(
+		&models.LoginChallengeSetter{
+			ID:        omit.From(uuid.New().String()),
+			CreatedAt: omit.From(now),
+			ExpiresAt: omit.From(now.Add(ChallengeTokenDuration)),
+			UserID:    omit.From(u.ID),
+		},
+	).One(ctx, u.db)
+	if err != nil {
+		return "", err
+	}
+
+	return u.auth.sign(Claims{
+		Version: u.TokenVersion,
+
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:   u.auth.issuer,
+			ID:       challenge.ID,
+			Subject:  strconv.Itoa(int(u.ID)),
+			Audience: jwt.ClaimStrings{ChallengeAudience},
+			IssuedAt: &jwt.NumericDate{
+				Time: now,
+			},
+			ExpiresAt: &jwt.NumericDate{
+				Time: challenge.ExpiresAt,
+			},
+		},
+	})
+}
+
+// GetUserFromChallenge retrieves a user from a challenge token, returning the ID of the challenge.
+// The challenge must not be used up.
+func (a *Auth) GetUserFromChallenge(ctx context.Context, tokenString string) (User, string, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, a.keyFunc, jwt.WithAudience(ChallengeAudience))
+	if err != nil || !token.Valid {
+		return User{}, "", ErrInvalidChallenge
+	}
+
+	claims, ok := token.Claims.(*Claims)
+	if !ok {
+		return User{}, "", ErrInvalidChallenge
+	}
+
+	userid, err := strconv.ParseInt(claims.Subject, 10, 32)
+	if err != nil {
+		return User{}, "", ErrInvalidChallenge
+	}
+
+	// Get challenge
+	_, err = models.LoginChallenges.Query(
+		models.SelectWhere.LoginChallenges.ID.EQ(claims.ID),
+		models.SelectWhere.LoginChallenges.UserID.EQ(int32(userid)),
+		models.SelectWhere.LoginChallenges.Failures.LT(ChallengeMaxFailures),
+		models.SelectWhere.LoginChallenges.ExpiresAt.GT(time.Now()),
+	).One(ctx, a.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return User{}, "", ErrInvalidChallenge
+	}
+	if err != nil {
+		return User{}, "", err
+	}
+
+	// Get user
+	user, err := a.GetUser(ctx, int32(userid))
+	if err != nil {
+		return User{}, "", err
+	}
+	if claims.Version != user.TokenVersion || !user.TwoFactorEnabled() {
+		return User{}, "", ErrInvalidChallenge
+	}
+
+	return user, claims.ID, nil
+}
+
+// FailChallenge counts a failed second factor against a challenge.
+func (a *Auth) FailChallenge(ctx context.Context, id string) error {
+	_, err := models.LoginChallenges.Update(
+		um.SetCol("failures").To(sqlite.Raw("failures + 1")),
+		models.UpdateWhere.LoginChallenges.ID.EQ(id),
+	).All(ctx, a.db)
+
+	return err
+}
+
+// UseChallenge uses up a challenge once its second factor is verified, so it cannot be used again,
+// even by concurrent requests.
+func (a *Auth) UseChallenge(ctx context.Context, id string) error {
+	used, err := models.LoginChallenges.Delete(
+		models.DeleteWhere.LoginChallenges.ID.EQ(id),
+		models.DeleteWhere.LoginChallenges.Failures.LT(ChallengeMaxFailures),
+		models.DeleteWhere.LoginChallenges.ExpiresAt.GT(time.Now()),
+	).All(ctx, a.db)
+	if err != nil {
+		return err
+	}
+	if len(used) != 1 {
+		return ErrInvalidChallenge
+	}
+
+	return nil
+}
+
+// totpSecret decrypts the user's TOTP secret.
+func (u User) totpSecret() (string, error) {
+	return u.auth.box.open(u.TotpSecret.MustGet(), totpSecretRow(u.ID))
+}
+
+// totpSecretRow identifies the row a TOTP secret is encrypted for.
+func totpSecretRow(userid int32) string {
+	return "user:" + strconv.Itoa(int(userid)) + ":totp_secret"
+}
+
+// validateTOTP checks a code against a secret, returning the time step it was generated for.
+func validateTOTP(secret string, code string, now time.Time) (int32, bool) {
+	key, err := totpEncoding.DecodeString(secret)
+	if err != nil {
+		return 0, false
+	}
+
+	current := now.Unix() / TOTPPeriod
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if subtle.ConstantTimeCompare([]byte(totpCode(key, step)), []byte(code)) == 1 {
+			return int32(step), true //nolint:gosec // Time steps fit in an int32 until the year 3990
+		}
+	}
+
+	return 0, false
+}
+
+// totpCode generates the code for a time step as described in RFC 4226 and RFC 6238.
+func totpCode(key []byte, step int64) string {
+	// The counter is the 64 bit big endian time step
+	msg := make([]byte, 8)                        //nolint:mnd // 64 bit counter
+	binary.BigEndian.PutUint64(msg, uint64(step)) //nolint:gosec // Time steps are never negative
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", TOTPDigits, value%totpModulo)
+}
+
+// newRecoveryCodes replaces a user's recovery codes with new ones, returning them.
+func newRecoveryCodes(ctx context.Context, exec bob.Executor, userid int32) ([]string, error) {
+	_, err := models.RecoveryCodes.Delete(
+		models.DeleteWhere.RecoveryCodes.UserID.EQ(userid),
+	).Exec(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, 0, RecoveryCodeCount)
+	setters := make([]*models.RecoveryCodeSetter, 0, RecoveryCodeCount)
+	for range RecoveryCodeCount {
+		b := make([]byte, recoveryCodeLength)
+		if _, err = rand.Read(b); err != nil {
+			return nil, err
+		}
+		code := strings.ToLower(totpEncoding.EncodeToString(b)[:recoveryCodeLength])
+
+		// Split into groups, like abcde-fghij-klmno-pqrst
+		groups := []string{}
+		for group := range slices.Chunk([]byte(code), recoveryCodeGroup) {
+			groups = append(groups, string(group))
+		}
+
+		codes = append(codes, strings.Join(groups, "-"))
+		setters = append(setters, &models.RecoveryCodeSetter{
+			Hash:   omit.From(hashSecret(code)),
+			UserID: omit.From(userid),
+		})
+	}
+
+	_, err = models.RecoveryCodes.Insert(bob.ToMods(setters...)).Exec(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// normalizeRecoveryCode removes the formatting a user may have added to a recovery code.
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.ReplaceAll(code, "-", "")
+	code = strings.ReplaceAll(code, " ", "")
+
+	return code
+}
@@ -0,0 +1,175 @@
+package auth_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+
+	"github.com/spotdemo4/ts-server/internal/auth"
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+)
+
+// rfcSecret is the SHA1 secret of the RFC 6238 test vectors, "12345678901234567890", in base32.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestValidateTOTP(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		secret string
+		code   string
+		now    int64
+		step   int32
+		ok     bool
+	}{
+		{
+			name:   "rfc 6238 vector",
+			secret: rfcSecret,
+			code:   "287082",
+			now:    59,
+			step:   1,
+			ok:     true,
+		},
+		{
+			name:   "rfc 6238 vector at a later step",
+			secret: rfcSecret,
+			code:   "081804",
+			now:    1111111109,
+			step:   37037036,
+			ok:     true,
+		},
+		{
+			name:   "one period early",
+			secret: rfcSecret,
+			code:   "287082",
+			now:    29,
+			step:   1,
+			ok:     true,
+		},
+		{
+			name:   "one period late",
+			secret: rfcSecret,
+			code:   "287082",
+			now:    89,
+			step:   1,
+			ok:     true,
+		},
+		{
+			name:   "two periods late",
+			secret: rfcSecret,
+			code:   "287082",
+			now:    119,
+			ok:     false,
+		},
+		{
+			name:   "wrong code",
+			secret: rfcSecret,
+			code:   "287083",
+			now:    59,
+			ok:     false,
+		},
+		{
+			name:   "short code",
+			secret: rfcSecret,
+			code:   "28708",
+			now:    59,
+			ok:     false,
+		},
+		{
+			name:   "invalid secret",
+			secret: "not base32!",
+			code:   "287082",
+			now:    59,
+			ok:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			step, ok := auth.ValidateTOTP(tt.secret, tt.code, time.Unix(tt.now, 0))
+			if ok != tt.ok || (ok && step != tt.step) {
+				t.Errorf("validateTOTP returned step %d and %v, want %d and %v", step, ok, tt.step, tt.ok)
+			}
+		})
+	}
+}
+
+// TestVerifyTOTPReplay checks each code is only accepted once, and codes of earlier steps are not accepted after it.
+func TestVerifyTOTPReplay(t *testing.T) {
+	t.Parallel()
+
+	a, db := auth.NewTestAuth(t)
+
+	row, err := models.Users.Insert(&models.UserSetter{
+		Username:   omit.From("alice"),
+		Password:   omit.From(""),
+		WebauthnID: omit.From(uuid.New().String()),
+	}).One(t.Context(), db)
+	if err != nil {
+		t.Fatalf("Error creating user: %v", err)
+	}
+	user, err := a.GetUser(t.Context(), row.ID)
+	if err != nil {
+		t.Fatalf("Error getting user: %v", err)
+	}
+
+	// Enrolling uses the code of the current step
+	secret, _, err := user.BeginTOTPEnrollment(t.Context())
+	if err != nil {
+		t.Fatalf("Error beginning enrollment: %v", err)
+	}
+	step := time.Now().Unix() / auth.TOTPPeriod
+	user, err = a.GetUser(t.Context(), row.ID)
+	if err != nil {
+		t.Fatalf("Error getting user: %v", err)
+	}
+	_, err = user.FinishTOTPEnrollment(t.Context(), auth.TOTPCode(secret, step))
+	if err != nil {
+		t.Fatalf("Error finishing enrollment: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		step int64
+		want error
+	}{
+		{
+			name: "enrollment code",
+			step: step,
+			want: auth.ErrInvalidCode,
+		},
+		{
+			name: "next code",
+			step: step + 1,
+			want: nil,
+		},
+		{
+			name: "next code again",
+			step: step + 1,
+			want: auth.ErrInvalidCode,
+		},
+		{
+			name: "earlier code",
+			step: step - 1,
+			want: auth.ErrInvalidCode,
+		},
+	}
+
+	// Each case depends on the last
+	for _, tt := range tests {
+		user, err := a.GetUser(t.Context(), row.ID)
+		if err != nil {
+			t.Fatalf("Error getting user: %v", err)
+		}
+
+		err = user.VerifyTOTP(t.Context(), auth.TOTPCode(secret, tt.step))
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: VerifyTOTP returned %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
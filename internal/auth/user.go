@@ -2,7 +2,7 @@ package auth
 
 import (
 	"context"
-	"net/http"
+	"errors"
 	"strconv"
 	"time"
 
@@ -10,17 +10,25 @@ import (
 	"github.com/aarondl/opt/omitnull"
 	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/stephenafamo/bob"
-	"golang.org/x/crypto/bcrypt"
 
 	"github.com/spotdemo4/ts-server/internal/bob/models"
 )
 
 const CookieMaxAge = 86400 // 1 day
 
+var ErrUsernameTaken = errors.New("username already taken")
+
 type User struct {
 	models.User
 
+	// SessionID is the session the user authenticated with, if any.
+	SessionID string
+
+	// APIKey is the API key the user authenticated with, if any.
+	APIKey *models.APIKey
+
 	db   *bob.DB
 	auth *Auth
 }
@@ -49,21 +57,20 @@ func (u User) WebAuthnCredentials() []webauthn.Credential {
 
 // Validate checks if the provided password matches the user's password.
 func (u User) Validate(password string) bool {
-	err := bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password))
-	return err == nil
+	valid, err := u.auth.hasher.Verify(u.Password, password)
+	return err == nil && valid
 }
 
-// Token generates a JWT token for the user.
-func (u User) Token(expiration time.Time) string {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, Claims{
-		Password:         u.Password,
-		ProfilePictureID: u.ProfilePictureID.Ptr(),
-		WebauthnID:       u.WebauthnID,
+// Token generates a JWT token for the user bound to a session.
+func (u User) Token(session string, expiration time.Time) (string, error) {
+	return u.auth.sign(Claims{
+		Session: session,
+		Version: u.TokenVersion,
 
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:  u.auth.issuer,
-			ID:      strconv.Itoa(int(u.ID)),
-			Subject: u.Username,
+			ID:      uuid.New().String(),
+			Subject: strconv.Itoa(int(u.ID)),
 			IssuedAt: &jwt.NumericDate{
 				Time: time.Now(),
 			},
@@ -75,23 +82,6 @@ func (u User) Token(expiration time.Time) string {
 			},
 		},
 	})
-
-	tokenString, _ := token.SignedString([]byte(u.auth.key))
-
-	return tokenString
-}
-
-// Cookie returns a cookie with the user's token.
-func (u User) Cookie(expiration time.Duration) *http.Cookie {
-	return &http.Cookie{
-		Name:     "token",
-		Value:    u.Token(time.Now().Add(expiration)),
-		Path:     "/",
-		MaxAge:   CookieMaxAge,
-		HttpOnly: true,
-		Secure:   true,
-		SameSite: http.SameSiteStrictMode,
-	}
 }
 
 // SetProfilePicture sets a users profile picture.
@@ -124,6 +114,7 @@ func (u User) SetProfilePicture(ctx context.Context, name string, data []byte) e
 		if err != nil {
 			return err
 		}
+		u.auth.cache.forget(u.ID)
 	} else {
 		// Update
 		err = file.Update(ctx, u.db, &models.FileSetter{
@@ -138,22 +129,81 @@ func (u User) SetProfilePicture(ctx context.Context, name string, data []byte) e
 	return nil
 }
 
-// SetPassword updates a users password.
+// rehashPassword hashes the user's current password again with the current algorithm and parameters.
+// Unlike SetPassword, outstanding tokens and sessions stay valid.
+func (u User) rehashPassword(ctx context.Context, password string) error {
+	hash, err := u.auth.hasher.Hash(password)
+	if err != nil {
+		return err
+	}
+
+	err = u.Update(ctx, u.db, &models.UserSetter{
+		Password: omit.From(hash),
+	})
+	if err != nil {
+		return err
+	}
+	u.auth.cache.forget(u.ID)
+
+	return nil
+}
+
+// SetPassword updates a users password, if it meets the password policy.
+// This bumps the user's token version, invalidating every outstanding token,
+// and revokes every session other than the current one.
 func (u User) SetPassword(ctx context.Context, password string) error {
-	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	err := u.auth.CheckPassword(ctx, password, u.Username, u.Email.GetOrZero())
+	if err != nil {
+		return err
+	}
+
+	hash, err := u.auth.hasher.Hash(password)
 	if err != nil {
 		return err
 	}
 
 	// Update user
 	err = u.Update(ctx, u.db, &models.UserSetter{
-		Password: omit.From(string(hash)),
+		Password:              omit.From(hash),
+		TokenVersion:          omit.From(u.TokenVersion + 1),
+		PasswordResetRequired: omit.From(false),
 	})
 	if err != nil {
 		return err
 	}
+	u.auth.cache.forget(u.ID)
+
+	// Revoke sessions
+	_, err = u.RevokeOtherSessions(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
 
-	u.Password = string(hash)
+// SetUsername changes the user's username, if no other user has it.
+func (u User) SetUsername(ctx context.Context, username string) error {
+	err := u.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		exists, err := models.Users.Query(
+			models.SelectWhere.Users.Username.EQ(username),
+			models.SelectWhere.Users.ID.NE(u.ID),
+		).Exists(ctx, exec)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return ErrUsernameTaken
+		}
+
+		return u.Update(ctx, exec, &models.UserSetter{
+			Username: omit.From(username),
+		})
+	})
+	if err != nil {
+		return err
+	}
+	u.auth.cache.forget(u.ID)
 
 	return nil
 }
@@ -0,0 +1,17 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var AccountExportErrors = &accountExportErrors{
+	ErrUniquePkMainAccountExport: &UniqueConstraintError{
+		schema:  "",
+		table:   "account_export",
+		columns: []string{"id"},
+		s:       "pk_main_account_export",
+	},
+}
+
+type accountExportErrors struct {
+	ErrUniquePkMainAccountExport *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var AccountExportPageErrors = &accountExportPageErrors{
+	ErrUniquePkMainAccountExportPage: &UniqueConstraintError{
+		schema:  "",
+		table:   "account_export_page",
+		columns: []string{"export_id", "page"},
+		s:       "pk_main_account_export_page",
+	},
+}
+
+type accountExportPageErrors struct {
+	ErrUniquePkMainAccountExportPage *UniqueConstraintError
+}
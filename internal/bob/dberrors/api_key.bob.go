@@ -0,0 +1,26 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var APIKeyErrors = &apiKeyErrors{
+	ErrUniquePkMainApiKey: &UniqueConstraintError{
+		schema:  "",
+		table:   "api_key",
+		columns: []string{"id"},
+		s:       "pk_main_api_key",
+	},
+
+	ErrUniqueSqliteAutoindexApiKey1: &UniqueConstraintError{
+		schema:  "",
+		table:   "api_key",
+		columns: []string{"prefix"},
+		s:       "sqlite_autoindex_api_key_1",
+	},
+}
+
+type apiKeyErrors struct {
+	ErrUniquePkMainApiKey *UniqueConstraintError
+
+	ErrUniqueSqliteAutoindexApiKey1 *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var AuditEventErrors = &auditEventErrors{
+	ErrUniquePkMainAuditEvent: &UniqueConstraintError{
+		schema:  "",
+		table:   "audit_event",
+		columns: []string{"id"},
+		s:       "pk_main_audit_event",
+	},
+}
+
+type auditEventErrors struct {
+	ErrUniquePkMainAuditEvent *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var CeremonyErrors = &ceremonyErrors{
+	ErrUniquePkMainCeremony: &UniqueConstraintError{
+		schema:  "",
+		table:   "ceremony",
+		columns: []string{"id"},
+		s:       "pk_main_ceremony",
+	},
+}
+
+type ceremonyErrors struct {
+	ErrUniquePkMainCeremony *UniqueConstraintError
+}
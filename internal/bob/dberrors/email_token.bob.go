@@ -0,0 +1,26 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var EmailTokenErrors = &emailTokenErrors{
+	ErrUniquePkMainEmailToken: &UniqueConstraintError{
+		schema:  "",
+		table:   "email_token",
+		columns: []string{"id"},
+		s:       "pk_main_email_token",
+	},
+
+	ErrUniqueSqliteAutoindexEmailToken1: &UniqueConstraintError{
+		schema:  "",
+		table:   "email_token",
+		columns: []string{"hash"},
+		s:       "sqlite_autoindex_email_token_1",
+	},
+}
+
+type emailTokenErrors struct {
+	ErrUniquePkMainEmailToken *UniqueConstraintError
+
+	ErrUniqueSqliteAutoindexEmailToken1 *UniqueConstraintError
+}
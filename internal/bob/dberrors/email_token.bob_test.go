@@ -0,0 +1,108 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	factory "github.com/spotdemo4/ts-server/internal/bob/factory"
+	models "github.com/spotdemo4/ts-server/internal/bob/models"
+	"github.com/stephenafamo/bob"
+)
+
+func TestEmailTokenUniqueConstraintErrors(t *testing.T) {
+	if testDB == nil {
+		t.Skip("No database connection provided")
+	}
+
+	f := factory.New()
+	tests := []struct {
+		name         string
+		expectedErr  *UniqueConstraintError
+		conflictMods func(context.Context, *testing.T, bob.Executor, *models.EmailToken) factory.EmailTokenModSlice
+	}{
+		{
+			name:        "ErrUniquePkMainEmailToken",
+			expectedErr: EmailTokenErrors.ErrUniquePkMainEmailToken,
+			conflictMods: func(ctx context.Context, t *testing.T, exec bob.Executor, obj *models.EmailToken) factory.EmailTokenModSlice {
+				shouldUpdate := false
+				updateMods := make(factory.EmailTokenModSlice, 0, 1)
+
+				if shouldUpdate {
+					if err := obj.Update(ctx, exec, f.NewEmailTokenWithContext(ctx, updateMods...).BuildSetter()); err != nil {
+						t.Fatalf("Error updating object: %v", err)
+					}
+				}
+
+				return factory.EmailTokenModSlice{
+					factory.EmailTokenMods.ID(obj.ID),
+				}
+			},
+		},
+		{
+			name:        "ErrUniqueSqliteAutoindexEmailToken1",
+			expectedErr: EmailTokenErrors.ErrUniqueSqliteAutoindexEmailToken1,
+			conflictMods: func(ctx context.Context, t *testing.T, exec bob.Executor, obj *models.EmailToken) factory.EmailTokenModSlice {
+				shouldUpdate := false
+				updateMods := make(factory.EmailTokenModSlice, 0, 1)
+
+				if shouldUpdate {
+					if err := obj.Update(ctx, exec, f.NewEmailTokenWithContext(ctx, updateMods...).BuildSetter()); err != nil {
+						t.Fatalf("Error updating object: %v", err)
+					}
+				}
+
+				return factory.EmailTokenModSlice{
+					factory.EmailTokenMods.Hash(obj.Hash),
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(t.Context())
+			t.Cleanup(cancel)
+
+			tx, err := testDB.Begin(ctx)
+			if err != nil {
+				t.Fatalf("Couldn't start database transaction: %v", err)
+			}
+
+			defer func() {
+				if err := tx.Rollback(ctx); err != nil {
+					t.Fatalf("Error rolling back transaction: %v", err)
+				}
+			}()
+
+			var exec bob.Executor = tx
+
+			obj, err := f.NewEmailTokenWithContext(ctx, factory.EmailTokenMods.WithParentsCascading()).Create(ctx, exec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			obj2, err := f.NewEmailTokenWithContext(ctx).Create(ctx, exec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = obj2.Update(ctx, exec, f.NewEmailTokenWithContext(ctx, tt.conflictMods(ctx, t, exec, obj)...).BuildSetter())
+			if !errors.Is(ErrUniqueConstraint, err) {
+				t.Fatalf("Expected: %s, Got: %v", tt.name, err)
+			}
+			if !errors.Is(tt.expectedErr, err) {
+				t.Fatalf("Expected: %s, Got: %v", tt.expectedErr.Error(), err)
+			}
+			if !ErrUniqueConstraint.Is(err) {
+				t.Fatalf("Expected: %s, Got: %v", tt.name, err)
+			}
+			if !tt.expectedErr.Is(err) {
+				t.Fatalf("Expected: %s, Got: %v", tt.expectedErr.Error(), err)
+			}
+		})
+	}
+}
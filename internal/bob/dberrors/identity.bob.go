@@ -0,0 +1,26 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var IdentityErrors = &identityErrors{
+	ErrUniquePkMainIdentity: &UniqueConstraintError{
+		schema:  "",
+		table:   "identity",
+		columns: []string{"id"},
+		s:       "pk_main_identity",
+	},
+
+	ErrUniqueSqliteAutoindexIdentity1: &UniqueConstraintError{
+		schema:  "",
+		table:   "identity",
+		columns: []string{"provider", "subject"},
+		s:       "sqlite_autoindex_identity_1",
+	},
+}
+
+type identityErrors struct {
+	ErrUniquePkMainIdentity *UniqueConstraintError
+
+	ErrUniqueSqliteAutoindexIdentity1 *UniqueConstraintError
+}
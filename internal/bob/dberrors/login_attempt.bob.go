@@ -0,0 +1,17 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var LoginAttemptErrors = &loginAttemptErrors{
+	ErrUniquePkMainLoginAttempt: &UniqueConstraintError{
+		schema:  "",
+		table:   "login_attempt",
+		columns: []string{"id"},
+		s:       "pk_main_login_attempt",
+	},
+}
+
+type loginAttemptErrors struct {
+	ErrUniquePkMainLoginAttempt *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var LoginChallengeErrors = &loginChallengeErrors{
+	ErrUniquePkMainLoginChallenge: &UniqueConstraintError{
+		schema:  "",
+		table:   "login_challenge",
+		columns: []string{"id"},
+		s:       "pk_main_login_challenge",
+	},
+}
+
+type loginChallengeErrors struct {
+	ErrUniquePkMainLoginChallenge *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var OauthClientErrors = &oauthClientErrors{
+	ErrUniquePkMainOauthClient: &UniqueConstraintError{
+		schema:  "",
+		table:   "oauth_client",
+		columns: []string{"id"},
+		s:       "pk_main_oauth_client",
+	},
+}
+
+type oauthClientErrors struct {
+	ErrUniquePkMainOauthClient *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var OauthCodeErrors = &oauthCodeErrors{
+	ErrUniquePkMainOauthCode: &UniqueConstraintError{
+		schema:  "",
+		table:   "oauth_code",
+		columns: []string{"hash"},
+		s:       "pk_main_oauth_code",
+	},
+}
+
+type oauthCodeErrors struct {
+	ErrUniquePkMainOauthCode *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var OauthConsentErrors = &oauthConsentErrors{
+	ErrUniquePkMainOauthConsent: &UniqueConstraintError{
+		schema:  "",
+		table:   "oauth_consent",
+		columns: []string{"client_id", "user_id"},
+		s:       "pk_main_oauth_consent",
+	},
+}
+
+type oauthConsentErrors struct {
+	ErrUniquePkMainOauthConsent *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var RateLimitErrors = &rateLimitErrors{
+	ErrUniquePkMainRateLimit: &UniqueConstraintError{
+		schema:  "",
+		table:   "rate_limit",
+		columns: []string{"key"},
+		s:       "pk_main_rate_limit",
+	},
+}
+
+type rateLimitErrors struct {
+	ErrUniquePkMainRateLimit *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var RecoveryCodeErrors = &recoveryCodeErrors{
+	ErrUniquePkMainRecoveryCode: &UniqueConstraintError{
+		schema:  "",
+		table:   "recovery_code",
+		columns: []string{"id"},
+		s:       "pk_main_recovery_code",
+	},
+}
+
+type recoveryCodeErrors struct {
+	ErrUniquePkMainRecoveryCode *UniqueConstraintError
+}
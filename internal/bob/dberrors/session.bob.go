@@ -0,0 +1,17 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var SessionErrors = &sessionErrors{
+	ErrUniquePkMainSession: &UniqueConstraintError{
+		schema:  "",
+		table:   "session",
+		columns: []string{"id"},
+		s:       "pk_main_session",
+	},
+}
+
+type sessionErrors struct {
+	ErrUniquePkMainSession *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var SigningKeyErrors = &signingKeyErrors{
+	ErrUniquePkMainSigningKey: &UniqueConstraintError{
+		schema:  "",
+		table:   "signing_key",
+		columns: []string{"id"},
+		s:       "pk_main_signing_key",
+	},
+}
+
+type signingKeyErrors struct {
+	ErrUniquePkMainSigningKey *UniqueConstraintError
+}
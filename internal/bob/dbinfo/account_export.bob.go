@@ -0,0 +1,204 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var AccountExports = Table[
+	accountExportColumns,
+	accountExportIndexes,
+	accountExportForeignKeys,
+	accountExportUniques,
+	accountExportChecks,
+]{
+	Schema: "",
+	Name:   "account_export",
+	Columns: accountExportColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Status: column{
+			Name:      "status",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Size: column{
+			Name:      "size",
+			DBType:    "INTEGER",
+			Default:   "0",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Error: column{
+			Name:      "error",
+			DBType:    "TEXT",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "DATETIME",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		FinishedAt: column{
+			Name:      "finished_at",
+			DBType:    "DATETIME",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		HeartbeatAt: column{
+			Name:      "heartbeat_at",
+			DBType:    "DATETIME",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ExpiresAt: column{
+			Name:      "expires_at",
+			DBType:    "DATETIME",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: accountExportIndexes{
+		AccountExportUserID: index{
+			Type: "c",
+			Name: "account_export_user_id",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "created_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		SqliteAutoindexAccountExport1: index{
+			Type: "pk",
+			Name: "sqlite_autoindex_account_export_1",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_account_export",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: accountExportForeignKeys{
+		FKAccountExport0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_account_export_0",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "user",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type accountExportColumns struct {
+	ID          column
+	Status      column
+	Size        column
+	Error       column
+	CreatedAt   column
+	FinishedAt  column
+	HeartbeatAt column
+	ExpiresAt   column
+	UserID      column
+}
+
+func (c accountExportColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.Status, c.Size, c.Error, c.CreatedAt, c.FinishedAt, c.HeartbeatAt, c.ExpiresAt, c.UserID,
+	}
+}
+
+type accountExportIndexes struct {
+	AccountExportUserID           index
+	SqliteAutoindexAccountExport1 index
+}
+
+func (i accountExportIndexes) AsSlice() []index {
+	return []index{
+		i.AccountExportUserID, i.SqliteAutoindexAccountExport1,
+	}
+}
+
+type accountExportForeignKeys struct {
+	FKAccountExport0 foreignKey
+}
+
+func (f accountExportForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FKAccountExport0,
+	}
+}
+
+type accountExportUniques struct{}
+
+func (u accountExportUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type accountExportChecks struct{}
+
+func (c accountExportChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,129 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var AccountExportPages = Table[
+	accountExportPageColumns,
+	accountExportPageIndexes,
+	accountExportPageForeignKeys,
+	accountExportPageUniques,
+	accountExportPageChecks,
+]{
+	Schema: "",
+	Name:   "account_export_page",
+	Columns: accountExportPageColumns{
+		ExportID: column{
+			Name:      "export_id",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Page: column{
+			Name:      "page",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Data: column{
+			Name:      "data",
+			DBType:    "BLOB",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: accountExportPageIndexes{
+		SqliteAutoindexAccountExportPage1: index{
+			Type: "pk",
+			Name: "sqlite_autoindex_account_export_page_1",
+			Columns: []indexColumn{
+				{
+					Name:         "export_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "page",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_account_export_page",
+		Columns: []string{"export_id", "page"},
+		Comment: "",
+	},
+	ForeignKeys: accountExportPageForeignKeys{
+		FKAccountExportPage0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_account_export_page_0",
+				Columns: []string{"export_id"},
+				Comment: "",
+			},
+			ForeignTable:   "account_export",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type accountExportPageColumns struct {
+	ExportID column
+	Page     column
+	Data     column
+}
+
+func (c accountExportPageColumns) AsSlice() []column {
+	return []column{
+		c.ExportID, c.Page, c.Data,
+	}
+}
+
+type accountExportPageIndexes struct {
+	SqliteAutoindexAccountExportPage1 index
+}
+
+func (i accountExportPageIndexes) AsSlice() []index {
+	return []index{
+		i.SqliteAutoindexAccountExportPage1,
+	}
+}
+
+type accountExportPageForeignKeys struct {
+	FKAccountExportPage0 foreignKey
+}
+
+func (f accountExportPageForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FKAccountExportPage0,
+	}
+}
+
+type accountExportPageUniques struct{}
+
+func (u accountExportPageUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type accountExportPageChecks struct{}
+
+func (c accountExportPageChecks) AsSlice() []check {
+	return []check{}
+}
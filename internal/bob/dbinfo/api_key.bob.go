@@ -0,0 +1,210 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var APIKeys = Table[
+	apiKeyColumns,
+	apiKeyIndexes,
+	apiKeyForeignKeys,
+	apiKeyUniques,
+	apiKeyChecks,
+]{
+	Schema: "",
+	Name:   "api_key",
+	Columns: apiKeyColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "INTEGER",
+			Default:   "auto_increment",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Name: column{
+			Name:      "name",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Prefix: column{
+			Name:      "prefix",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Hash: column{
+			Name:      "hash",
+			DBType:    "BLOB",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Scopes: column{
+			Name:      "scopes",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "DATETIME",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ExpiresAt: column{
+			Name:      "expires_at",
+			DBType:    "DATETIME",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		LastUsed: column{
+			Name:      "last_used",
+			DBType:    "DATETIME",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: apiKeyIndexes{
+		PKMainAPIKey: index{
+			Type: "pk",
+			Name: "pk_main_api_key",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+		SqliteAutoindexAPIKey1: index{
+			Type: "u",
+			Name: "sqlite_autoindex_api_key_1",
+			Columns: []indexColumn{
+				{
+					Name:         "prefix",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_api_key",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: apiKeyForeignKeys{
+		FKAPIKey0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_api_key_0",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "user",
+			ForeignColumns: []string{"id"},
+		},
+	},
+	Uniques: apiKeyUniques{
+		SqliteAutoindexAPIKey1: constraint{
+			Name:    "sqlite_autoindex_api_key_1",
+			Columns: []string{"prefix"},
+			Comment: "",
+		},
+	},
+
+	Comment: "",
+}
+
+type apiKeyColumns struct {
+	ID        column
+	Name      column
+	Prefix    column
+	Hash      column
+	Scopes    column
+	CreatedAt column
+	ExpiresAt column
+	LastUsed  column
+	UserID    column
+}
+
+func (c apiKeyColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.Name, c.Prefix, c.Hash, c.Scopes, c.CreatedAt, c.ExpiresAt, c.LastUsed, c.UserID,
+	}
+}
+
+type apiKeyIndexes struct {
+	PKMainAPIKey           index
+	SqliteAutoindexAPIKey1 index
+}
+
+func (i apiKeyIndexes) AsSlice() []index {
+	return []index{
+		i.PKMainAPIKey, i.SqliteAutoindexAPIKey1,
+	}
+}
+
+type apiKeyForeignKeys struct {
+	FKAPIKey0 foreignKey
+}
+
+func (f apiKeyForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FKAPIKey0,
+	}
+}
+
+type apiKeyUniques struct {
+	SqliteAutoindexAPIKey1 constraint
+}
+
+func (u apiKeyUniques) AsSlice() []constraint {
+	return []constraint{
+		u.SqliteAutoindexAPIKey1,
+	}
+}
+
+type apiKeyChecks struct{}
+
+func (c apiKeyChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,239 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var AuditEvents = Table[
+	auditEventColumns,
+	auditEventIndexes,
+	auditEventForeignKeys,
+	auditEventUniques,
+	auditEventChecks,
+]{
+	Schema: "",
+	Name:   "audit_event",
+	Columns: auditEventColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "INTEGER",
+			Default:   "auto_increment",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ActorID: column{
+			Name:      "actor_id",
+			DBType:    "INTEGER",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "INTEGER",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Action: column{
+			Name:      "action",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Target: column{
+			Name:      "target",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		IP: column{
+			Name:      "ip",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserAgent: column{
+			Name:      "user_agent",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Outcome: column{
+			Name:      "outcome",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Metadata: column{
+			Name:      "metadata",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "DATETIME",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: auditEventIndexes{
+		PKMainAuditEvent: index{
+			Type: "pk",
+			Name: "pk_main_audit_event",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+		AuditEventAction: index{
+			Type: "c",
+			Name: "audit_event_action",
+			Columns: []indexColumn{
+				{
+					Name:         "action",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "created_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		AuditEventActorID: index{
+			Type: "c",
+			Name: "audit_event_actor_id",
+			Columns: []indexColumn{
+				{
+					Name:         "actor_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "created_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		AuditEventUserID: index{
+			Type: "c",
+			Name: "audit_event_user_id",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "created_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_audit_event",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+
+	Comment: "",
+}
+
+type auditEventColumns struct {
+	ID        column
+	ActorID   column
+	UserID    column
+	Action    column
+	Target    column
+	IP        column
+	UserAgent column
+	Outcome   column
+	Metadata  column
+	CreatedAt column
+}
+
+func (c auditEventColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.ActorID, c.UserID, c.Action, c.Target, c.IP, c.UserAgent, c.Outcome, c.Metadata, c.CreatedAt,
+	}
+}
+
+type auditEventIndexes struct {
+	PKMainAuditEvent  index
+	AuditEventAction  index
+	AuditEventActorID index
+	AuditEventUserID  index
+}
+
+func (i auditEventIndexes) AsSlice() []index {
+	return []index{
+		i.PKMainAuditEvent, i.AuditEventAction, i.AuditEventActorID, i.AuditEventUserID,
+	}
+}
+
+type auditEventForeignKeys struct{}
+
+func (f auditEventForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{}
+}
+
+type auditEventUniques struct{}
+
+func (u auditEventUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type auditEventChecks struct{}
+
+func (c auditEventChecks) AsSlice() []check {
+	return []check{}
+}
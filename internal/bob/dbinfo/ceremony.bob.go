@@ -0,0 +1,109 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var Ceremonies = Table[
+	ceremonyColumns,
+	ceremonyIndexes,
+	ceremonyForeignKeys,
+	ceremonyUniques,
+	ceremonyChecks,
+]{
+	Schema: "",
+	Name:   "ceremony",
+	Columns: ceremonyColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Data: column{
+			Name:      "data",
+			DBType:    "BLOB",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ExpiresAt: column{
+			Name:      "expires_at",
+			DBType:    "DATETIME",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: ceremonyIndexes{
+		SqliteAutoindexCeremony1: index{
+			Type: "pk",
+			Name: "sqlite_autoindex_ceremony_1",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_ceremony",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+
+	Comment: "",
+}
+
+type ceremonyColumns struct {
+	ID        column
+	Data      column
+	ExpiresAt column
+}
+
+func (c ceremonyColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.Data, c.ExpiresAt,
+	}
+}
+
+type ceremonyIndexes struct {
+	SqliteAutoindexCeremony1 index
+}
+
+func (i ceremonyIndexes) AsSlice() []index {
+	return []index{
+		i.SqliteAutoindexCeremony1,
+	}
+}
+
+type ceremonyForeignKeys struct{}
+
+func (f ceremonyForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{}
+}
+
+type ceremonyUniques struct{}
+
+func (u ceremonyUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type ceremonyChecks struct{}
+
+func (c ceremonyChecks) AsSlice() []check {
+	return []check{}
+}
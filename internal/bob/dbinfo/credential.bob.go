@@ -123,6 +123,24 @@ var Credentials = Table[
 			Generated: false,
 			AutoIncr:  false,
 		},
+		Name: column{
+			Name:      "name",
+			DBType:    "TEXT",
+			Default:   "''",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CloneWarning: column{
+			Name:      "clone_warning",
+			DBType:    "BOOLEAN",
+			Default:   "FALSE",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
 	},
 	Indexes: credentialIndexes{
 		SqliteAutoindexCredential1: index{
@@ -173,11 +191,13 @@ type credentialColumns struct {
 	CreatedAt             column
 	LastUsed              column
 	UserID                column
+	Name                  column
+	CloneWarning          column
 }
 
 func (c credentialColumns) AsSlice() []column {
 	return []column{
-		c.CredID, c.CredPublicKey, c.SignCount, c.Transports, c.UserVerified, c.BackupEligible, c.BackupState, c.AttestationObject, c.AttestationClientData, c.CreatedAt, c.LastUsed, c.UserID,
+		c.CredID, c.CredPublicKey, c.SignCount, c.Transports, c.UserVerified, c.BackupEligible, c.BackupState, c.AttestationObject, c.AttestationClientData, c.CreatedAt, c.LastUsed, c.UserID, c.Name, c.CloneWarning,
 	}
 }
 
@@ -0,0 +1,200 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var EmailTokens = Table[
+	emailTokenColumns,
+	emailTokenIndexes,
+	emailTokenForeignKeys,
+	emailTokenUniques,
+	emailTokenChecks,
+]{
+	Schema: "",
+	Name:   "email_token",
+	Columns: emailTokenColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "INTEGER",
+			Default:   "auto_increment",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Hash: column{
+			Name:      "hash",
+			DBType:    "BLOB",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Purpose: column{
+			Name:      "purpose",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Email: column{
+			Name:      "email",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "DATETIME",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ExpiresAt: column{
+			Name:      "expires_at",
+			DBType:    "DATETIME",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UsedAt: column{
+			Name:      "used_at",
+			DBType:    "DATETIME",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: emailTokenIndexes{
+		PKMainEmailToken: index{
+			Type: "pk",
+			Name: "pk_main_email_token",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+		SqliteAutoindexEmailToken1: index{
+			Type: "u",
+			Name: "sqlite_autoindex_email_token_1",
+			Columns: []indexColumn{
+				{
+					Name:         "hash",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_email_token",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: emailTokenForeignKeys{
+		FKEmailToken0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_email_token_0",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "user",
+			ForeignColumns: []string{"id"},
+		},
+	},
+	Uniques: emailTokenUniques{
+		SqliteAutoindexEmailToken1: constraint{
+			Name:    "sqlite_autoindex_email_token_1",
+			Columns: []string{"hash"},
+			Comment: "",
+		},
+	},
+
+	Comment: "",
+}
+
+type emailTokenColumns struct {
+	ID        column
+	Hash      column
+	Purpose   column
+	Email     column
+	CreatedAt column
+	ExpiresAt column
+	UsedAt    column
+	UserID    column
+}
+
+func (c emailTokenColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.Hash, c.Purpose, c.Email, c.CreatedAt, c.ExpiresAt, c.UsedAt, c.UserID,
+	}
+}
+
+type emailTokenIndexes struct {
+	PKMainEmailToken           index
+	SqliteAutoindexEmailToken1 index
+}
+
+func (i emailTokenIndexes) AsSlice() []index {
+	return []index{
+		i.PKMainEmailToken, i.SqliteAutoindexEmailToken1,
+	}
+}
+
+type emailTokenForeignKeys struct {
+	FKEmailToken0 foreignKey
+}
+
+func (f emailTokenForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FKEmailToken0,
+	}
+}
+
+type emailTokenUniques struct {
+	SqliteAutoindexEmailToken1 constraint
+}
+
+func (u emailTokenUniques) AsSlice() []constraint {
+	return []constraint{
+		u.SqliteAutoindexEmailToken1,
+	}
+}
+
+type emailTokenChecks struct{}
+
+func (c emailTokenChecks) AsSlice() []check {
+	return []check{}
+}
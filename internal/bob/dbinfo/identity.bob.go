@@ -0,0 +1,195 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var Identities = Table[
+	identityColumns,
+	identityIndexes,
+	identityForeignKeys,
+	identityUniques,
+	identityChecks,
+]{
+	Schema: "",
+	Name:   "identity",
+	Columns: identityColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "INTEGER",
+			Default:   "auto_increment",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Provider: column{
+			Name:      "provider",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Subject: column{
+			Name:      "subject",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Email: column{
+			Name:      "email",
+			DBType:    "TEXT",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "DATETIME",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		LastUsed: column{
+			Name:      "last_used",
+			DBType:    "DATETIME",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: identityIndexes{
+		PKMainIdentity: index{
+			Type: "pk",
+			Name: "pk_main_identity",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+		SqliteAutoindexIdentity1: index{
+			Type: "u",
+			Name: "sqlite_autoindex_identity_1",
+			Columns: []indexColumn{
+				{
+					Name:         "provider",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "subject",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_identity",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: identityForeignKeys{
+		FKIdentity0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_identity_0",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "user",
+			ForeignColumns: []string{"id"},
+		},
+	},
+	Uniques: identityUniques{
+		SqliteAutoindexIdentity1: constraint{
+			Name:    "sqlite_autoindex_identity_1",
+			Columns: []string{"provider", "subject"},
+			Comment: "",
+		},
+	},
+
+	Comment: "",
+}
+
+type identityColumns struct {
+	ID        column
+	Provider  column
+	Subject   column
+	Email     column
+	CreatedAt column
+	LastUsed  column
+	UserID    column
+}
+
+func (c identityColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.Provider, c.Subject, c.Email, c.CreatedAt, c.LastUsed, c.UserID,
+	}
+}
+
+type identityIndexes struct {
+	PKMainIdentity           index
+	SqliteAutoindexIdentity1 index
+}
+
+func (i identityIndexes) AsSlice() []index {
+	return []index{
+		i.PKMainIdentity, i.SqliteAutoindexIdentity1,
+	}
+}
+
+type identityForeignKeys struct {
+	FKIdentity0 foreignKey
+}
+
+func (f identityForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FKIdentity0,
+	}
+}
+
+type identityUniques struct {
+	SqliteAutoindexIdentity1 constraint
+}
+
+func (u identityUniques) AsSlice() []constraint {
+	return []constraint{
+		u.SqliteAutoindexIdentity1,
+	}
+}
+
+type identityChecks struct{}
+
+func (c identityChecks) AsSlice() []check {
+	return []check{}
+}
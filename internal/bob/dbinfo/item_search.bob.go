@@ -0,0 +1,92 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+var ItemSearches = Table[
+	itemSearchColumns,
+	itemSearchIndexes,
+	itemSearchForeignKeys,
+	itemSearchUniques,
+	itemSearchChecks,
+]{
+	Schema: "",
+	Name:   "item_search",
+	Columns: itemSearchColumns{
+		Name: column{
+			Name:      "name",
+			DBType:    "",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Description: column{
+			Name:      "description",
+			DBType:    "",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ItemSearch: column{
+			Name:      "item_search",
+			DBType:    "",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Rank: column{
+			Name:      "rank",
+			DBType:    "",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+
+	Comment: "",
+}
+
+type itemSearchColumns struct {
+	Name        column
+	Description column
+	ItemSearch  column
+	Rank        column
+}
+
+func (c itemSearchColumns) AsSlice() []column {
+	return []column{
+		c.Name, c.Description, c.ItemSearch, c.Rank,
+	}
+}
+
+type itemSearchIndexes struct{}
+
+func (i itemSearchIndexes) AsSlice() []index {
+	return []index{}
+}
+
+type itemSearchForeignKeys struct{}
+
+func (f itemSearchForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{}
+}
+
+type itemSearchUniques struct{}
+
+func (u itemSearchUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type itemSearchChecks struct{}
+
+func (c itemSearchChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,194 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var LoginAttempts = Table[
+	loginAttemptColumns,
+	loginAttemptIndexes,
+	loginAttemptForeignKeys,
+	loginAttemptUniques,
+	loginAttemptChecks,
+]{
+	Schema: "",
+	Name:   "login_attempt",
+	Columns: loginAttemptColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "INTEGER",
+			Default:   "auto_increment",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Username: column{
+			Name:      "username",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		IP: column{
+			Name:      "ip",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserAgent: column{
+			Name:      "user_agent",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Factor: column{
+			Name:      "factor",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Success: column{
+			Name:      "success",
+			DBType:    "BOOLEAN",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "DATETIME",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: loginAttemptIndexes{
+		PKMainLoginAttempt: index{
+			Type: "pk",
+			Name: "pk_main_login_attempt",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+		LoginAttemptIP: index{
+			Type: "c",
+			Name: "login_attempt_ip",
+			Columns: []indexColumn{
+				{
+					Name:         "ip",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "created_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		LoginAttemptUsername: index{
+			Type: "c",
+			Name: "login_attempt_username",
+			Columns: []indexColumn{
+				{
+					Name:         "username",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "factor",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "created_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_login_attempt",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+
+	Comment: "",
+}
+
+type loginAttemptColumns struct {
+	ID        column
+	Username  column
+	IP        column
+	UserAgent column
+	Factor    column
+	Success   column
+	CreatedAt column
+}
+
+func (c loginAttemptColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.Username, c.IP, c.UserAgent, c.Factor, c.Success, c.CreatedAt,
+	}
+}
+
+type loginAttemptIndexes struct {
+	PKMainLoginAttempt   index
+	LoginAttemptIP       index
+	LoginAttemptUsername index
+}
+
+func (i loginAttemptIndexes) AsSlice() []index {
+	return []index{
+		i.PKMainLoginAttempt, i.LoginAttemptIP, i.LoginAttemptUsername,
+	}
+}
+
+type loginAttemptForeignKeys struct{}
+
+func (f loginAttemptForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{}
+}
+
+type loginAttemptUniques struct{}
+
+func (u loginAttemptUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type loginAttemptChecks struct{}
+
+func (c loginAttemptChecks) AsSlice() []check {
+	return []check{}
+}
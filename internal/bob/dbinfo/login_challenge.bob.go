@@ -0,0 +1,144 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var LoginChallenges = Table[
+	loginChallengeColumns,
+	loginChallengeIndexes,
+	loginChallengeForeignKeys,
+	loginChallengeUniques,
+	loginChallengeChecks,
+]{
+	Schema: "",
+	Name:   "login_challenge",
+	Columns: loginChallengeColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Failures: column{
+			Name:      "failures",
+			DBType:    "INTEGER",
+			Default:   "0",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "DATETIME",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ExpiresAt: column{
+			Name:      "expires_at",
+			DBType:    "DATETIME",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: loginChallengeIndexes{
+		SqliteAutoindexLoginChallenge1: index{
+			Type: "pk",
+			Name: "sqlite_autoindex_login_challenge_1",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_login_challenge",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: loginChallengeForeignKeys{
+		FKLoginChallenge0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_login_challenge_0",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "user",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type loginChallengeColumns struct {
+	ID        column
+	Failures  column
+	CreatedAt column
+	ExpiresAt column
+	UserID    column
+}
+
+func (c loginChallengeColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.Failures, c.CreatedAt, c.ExpiresAt, c.UserID,
+	}
+}
+
+type loginChallengeIndexes struct {
+	SqliteAutoindexLoginChallenge1 index
+}
+
+func (i loginChallengeIndexes) AsSlice() []index {
+	return []index{
+		i.SqliteAutoindexLoginChallenge1,
+	}
+}
+
+type loginChallengeForeignKeys struct {
+	FKLoginChallenge0 foreignKey
+}
+
+func (f loginChallengeForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FKLoginChallenge0,
+	}
+}
+
+type loginChallengeUniques struct{}
+
+func (u loginChallengeUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type loginChallengeChecks struct{}
+
+func (c loginChallengeChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,139 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var OauthClients = Table[
+	oauthClientColumns,
+	oauthClientIndexes,
+	oauthClientForeignKeys,
+	oauthClientUniques,
+	oauthClientChecks,
+]{
+	Schema: "",
+	Name:   "oauth_client",
+	Columns: oauthClientColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Name: column{
+			Name:      "name",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		SecretHash: column{
+			Name:      "secret_hash",
+			DBType:    "BLOB",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		RedirectUris: column{
+			Name:      "redirect_uris",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Scopes: column{
+			Name:      "scopes",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "DATETIME",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: oauthClientIndexes{
+		SqliteAutoindexOauthClient1: index{
+			Type: "pk",
+			Name: "sqlite_autoindex_oauth_client_1",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_oauth_client",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+
+	Comment: "",
+}
+
+type oauthClientColumns struct {
+	ID           column
+	Name         column
+	SecretHash   column
+	RedirectUris column
+	Scopes       column
+	CreatedAt    column
+}
+
+func (c oauthClientColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.Name, c.SecretHash, c.RedirectUris, c.Scopes, c.CreatedAt,
+	}
+}
+
+type oauthClientIndexes struct {
+	SqliteAutoindexOauthClient1 index
+}
+
+func (i oauthClientIndexes) AsSlice() []index {
+	return []index{
+		i.SqliteAutoindexOauthClient1,
+	}
+}
+
+type oauthClientForeignKeys struct{}
+
+func (f oauthClientForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{}
+}
+
+type oauthClientUniques struct{}
+
+func (u oauthClientUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type oauthClientChecks struct{}
+
+func (c oauthClientChecks) AsSlice() []check {
+	return []check{}
+}
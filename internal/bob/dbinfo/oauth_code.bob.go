@@ -0,0 +1,184 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var OauthCodes = Table[
+	oauthCodeColumns,
+	oauthCodeIndexes,
+	oauthCodeForeignKeys,
+	oauthCodeUniques,
+	oauthCodeChecks,
+]{
+	Schema: "",
+	Name:   "oauth_code",
+	Columns: oauthCodeColumns{
+		Hash: column{
+			Name:      "hash",
+			DBType:    "BLOB",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		RedirectURI: column{
+			Name:      "redirect_uri",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Scopes: column{
+			Name:      "scopes",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Nonce: column{
+			Name:      "nonce",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CodeChallenge: column{
+			Name:      "code_challenge",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ExpiresAt: column{
+			Name:      "expires_at",
+			DBType:    "DATETIME",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ClientID: column{
+			Name:      "client_id",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: oauthCodeIndexes{
+		SqliteAutoindexOauthCode1: index{
+			Type: "pk",
+			Name: "sqlite_autoindex_oauth_code_1",
+			Columns: []indexColumn{
+				{
+					Name:         "hash",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_oauth_code",
+		Columns: []string{"hash"},
+		Comment: "",
+	},
+	ForeignKeys: oauthCodeForeignKeys{
+		FKOauthCode0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_oauth_code_0",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "user",
+			ForeignColumns: []string{"id"},
+		},
+		FKOauthCode1: foreignKey{
+			constraint: constraint{
+				Name:    "fk_oauth_code_1",
+				Columns: []string{"client_id"},
+				Comment: "",
+			},
+			ForeignTable:   "oauth_client",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type oauthCodeColumns struct {
+	Hash          column
+	RedirectURI   column
+	Scopes        column
+	Nonce         column
+	CodeChallenge column
+	ExpiresAt     column
+	ClientID      column
+	UserID        column
+}
+
+func (c oauthCodeColumns) AsSlice() []column {
+	return []column{
+		c.Hash, c.RedirectURI, c.Scopes, c.Nonce, c.CodeChallenge, c.ExpiresAt, c.ClientID, c.UserID,
+	}
+}
+
+type oauthCodeIndexes struct {
+	SqliteAutoindexOauthCode1 index
+}
+
+func (i oauthCodeIndexes) AsSlice() []index {
+	return []index{
+		i.SqliteAutoindexOauthCode1,
+	}
+}
+
+type oauthCodeForeignKeys struct {
+	FKOauthCode0 foreignKey
+	FKOauthCode1 foreignKey
+}
+
+func (f oauthCodeForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FKOauthCode0, f.FKOauthCode1,
+	}
+}
+
+type oauthCodeUniques struct{}
+
+func (u oauthCodeUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type oauthCodeChecks struct{}
+
+func (c oauthCodeChecks) AsSlice() []check {
+	return []check{}
+}
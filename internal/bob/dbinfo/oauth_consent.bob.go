@@ -0,0 +1,149 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var OauthConsents = Table[
+	oauthConsentColumns,
+	oauthConsentIndexes,
+	oauthConsentForeignKeys,
+	oauthConsentUniques,
+	oauthConsentChecks,
+]{
+	Schema: "",
+	Name:   "oauth_consent",
+	Columns: oauthConsentColumns{
+		Scopes: column{
+			Name:      "scopes",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "DATETIME",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ClientID: column{
+			Name:      "client_id",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: oauthConsentIndexes{
+		SqliteAutoindexOauthConsent1: index{
+			Type: "pk",
+			Name: "sqlite_autoindex_oauth_consent_1",
+			Columns: []indexColumn{
+				{
+					Name:         "client_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_oauth_consent",
+		Columns: []string{"client_id", "user_id"},
+		Comment: "",
+	},
+	ForeignKeys: oauthConsentForeignKeys{
+		FKOauthConsent0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_oauth_consent_0",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "user",
+			ForeignColumns: []string{"id"},
+		},
+		FKOauthConsent1: foreignKey{
+			constraint: constraint{
+				Name:    "fk_oauth_consent_1",
+				Columns: []string{"client_id"},
+				Comment: "",
+			},
+			ForeignTable:   "oauth_client",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type oauthConsentColumns struct {
+	Scopes    column
+	CreatedAt column
+	ClientID  column
+	UserID    column
+}
+
+func (c oauthConsentColumns) AsSlice() []column {
+	return []column{
+		c.Scopes, c.CreatedAt, c.ClientID, c.UserID,
+	}
+}
+
+type oauthConsentIndexes struct {
+	SqliteAutoindexOauthConsent1 index
+}
+
+func (i oauthConsentIndexes) AsSlice() []index {
+	return []index{
+		i.SqliteAutoindexOauthConsent1,
+	}
+}
+
+type oauthConsentForeignKeys struct {
+	FKOauthConsent0 foreignKey
+	FKOauthConsent1 foreignKey
+}
+
+func (f oauthConsentForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FKOauthConsent0, f.FKOauthConsent1,
+	}
+}
+
+type oauthConsentUniques struct{}
+
+func (u oauthConsentUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type oauthConsentChecks struct{}
+
+func (c oauthConsentChecks) AsSlice() []check {
+	return []check{}
+}
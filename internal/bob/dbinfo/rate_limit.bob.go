@@ -0,0 +1,124 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var RateLimits = Table[
+	rateLimitColumns,
+	rateLimitIndexes,
+	rateLimitForeignKeys,
+	rateLimitUniques,
+	rateLimitChecks,
+]{
+	Schema: "",
+	Name:   "rate_limit",
+	Columns: rateLimitColumns{
+		Key: column{
+			Name:      "key",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Tokens: column{
+			Name:      "tokens",
+			DBType:    "REAL",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UpdatedAt: column{
+			Name:      "updated_at",
+			DBType:    "DATETIME",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: rateLimitIndexes{
+		RateLimitUpdatedAt: index{
+			Type: "c",
+			Name: "rate_limit_updated_at",
+			Columns: []indexColumn{
+				{
+					Name:         "updated_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		SqliteAutoindexRateLimit1: index{
+			Type: "pk",
+			Name: "sqlite_autoindex_rate_limit_1",
+			Columns: []indexColumn{
+				{
+					Name:         "key",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_rate_limit",
+		Columns: []string{"key"},
+		Comment: "",
+	},
+
+	Comment: "",
+}
+
+type rateLimitColumns struct {
+	Key       column
+	Tokens    column
+	UpdatedAt column
+}
+
+func (c rateLimitColumns) AsSlice() []column {
+	return []column{
+		c.Key, c.Tokens, c.UpdatedAt,
+	}
+}
+
+type rateLimitIndexes struct {
+	RateLimitUpdatedAt        index
+	SqliteAutoindexRateLimit1 index
+}
+
+func (i rateLimitIndexes) AsSlice() []index {
+	return []index{
+		i.RateLimitUpdatedAt, i.SqliteAutoindexRateLimit1,
+	}
+}
+
+type rateLimitForeignKeys struct{}
+
+func (f rateLimitForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{}
+}
+
+type rateLimitUniques struct{}
+
+func (u rateLimitUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type rateLimitChecks struct{}
+
+func (c rateLimitChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,134 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var RecoveryCodes = Table[
+	recoveryCodeColumns,
+	recoveryCodeIndexes,
+	recoveryCodeForeignKeys,
+	recoveryCodeUniques,
+	recoveryCodeChecks,
+]{
+	Schema: "",
+	Name:   "recovery_code",
+	Columns: recoveryCodeColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "INTEGER",
+			Default:   "auto_increment",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Hash: column{
+			Name:      "hash",
+			DBType:    "BLOB",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UsedAt: column{
+			Name:      "used_at",
+			DBType:    "DATETIME",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: recoveryCodeIndexes{
+		PKMainRecoveryCode: index{
+			Type: "pk",
+			Name: "pk_main_recovery_code",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_recovery_code",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: recoveryCodeForeignKeys{
+		FKRecoveryCode0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_recovery_code_0",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "user",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type recoveryCodeColumns struct {
+	ID     column
+	Hash   column
+	UsedAt column
+	UserID column
+}
+
+func (c recoveryCodeColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.Hash, c.UsedAt, c.UserID,
+	}
+}
+
+type recoveryCodeIndexes struct {
+	PKMainRecoveryCode index
+}
+
+func (i recoveryCodeIndexes) AsSlice() []index {
+	return []index{
+		i.PKMainRecoveryCode,
+	}
+}
+
+type recoveryCodeForeignKeys struct {
+	FKRecoveryCode0 foreignKey
+}
+
+func (f recoveryCodeForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FKRecoveryCode0,
+	}
+}
+
+type recoveryCodeUniques struct{}
+
+func (u recoveryCodeUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type recoveryCodeChecks struct{}
+
+func (c recoveryCodeChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,234 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var Sessions = Table[
+	sessionColumns,
+	sessionIndexes,
+	sessionForeignKeys,
+	sessionUniques,
+	sessionChecks,
+]{
+	Schema: "",
+	Name:   "session",
+	Columns: sessionColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		RefreshHash: column{
+			Name:      "refresh_hash",
+			DBType:    "BLOB",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		PreviousRefreshHash: column{
+			Name:      "previous_refresh_hash",
+			DBType:    "BLOB",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "DATETIME",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		LastSeen: column{
+			Name:      "last_seen",
+			DBType:    "DATETIME",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		RotatedAt: column{
+			Name:      "rotated_at",
+			DBType:    "DATETIME",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ExpiresAt: column{
+			Name:      "expires_at",
+			DBType:    "DATETIME",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		RevokedAt: column{
+			Name:      "revoked_at",
+			DBType:    "DATETIME",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserAgent: column{
+			Name:      "user_agent",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		IP: column{
+			Name:      "ip",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ClientID: column{
+			Name:      "client_id",
+			DBType:    "TEXT",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Scopes: column{
+			Name:      "scopes",
+			DBType:    "TEXT",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: sessionIndexes{
+		SqliteAutoindexSession1: index{
+			Type: "pk",
+			Name: "sqlite_autoindex_session_1",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_session",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: sessionForeignKeys{
+		FKSession0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_session_0",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "user",
+			ForeignColumns: []string{"id"},
+		},
+		FKSession1: foreignKey{
+			constraint: constraint{
+				Name:    "fk_session_1",
+				Columns: []string{"client_id"},
+				Comment: "",
+			},
+			ForeignTable:   "oauth_client",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type sessionColumns struct {
+	ID                  column
+	RefreshHash         column
+	PreviousRefreshHash column
+	CreatedAt           column
+	LastSeen            column
+	RotatedAt           column
+	ExpiresAt           column
+	RevokedAt           column
+	UserAgent           column
+	IP                  column
+	UserID              column
+	ClientID            column
+	Scopes              column
+}
+
+func (c sessionColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.RefreshHash, c.PreviousRefreshHash, c.CreatedAt, c.LastSeen, c.RotatedAt, c.ExpiresAt, c.RevokedAt, c.UserAgent, c.IP, c.UserID, c.ClientID, c.Scopes,
+	}
+}
+
+type sessionIndexes struct {
+	SqliteAutoindexSession1 index
+}
+
+func (i sessionIndexes) AsSlice() []index {
+	return []index{
+		i.SqliteAutoindexSession1,
+	}
+}
+
+type sessionForeignKeys struct {
+	FKSession0 foreignKey
+	FKSession1 foreignKey
+}
+
+func (f sessionForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FKSession0, f.FKSession1,
+	}
+}
+
+type sessionUniques struct{}
+
+func (u sessionUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type sessionChecks struct{}
+
+func (c sessionChecks) AsSlice() []check {
+	return []check{}
+}
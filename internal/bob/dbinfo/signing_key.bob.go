@@ -0,0 +1,119 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var SigningKeys = Table[
+	signingKeyColumns,
+	signingKeyIndexes,
+	signingKeyForeignKeys,
+	signingKeyUniques,
+	signingKeyChecks,
+]{
+	Schema: "",
+	Name:   "signing_key",
+	Columns: signingKeyColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Algorithm: column{
+			Name:      "algorithm",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		PrivateKey: column{
+			Name:      "private_key",
+			DBType:    "BLOB",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "DATETIME",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: signingKeyIndexes{
+		SqliteAutoindexSigningKey1: index{
+			Type: "pk",
+			Name: "sqlite_autoindex_signing_key_1",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_signing_key",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+
+	Comment: "",
+}
+
+type signingKeyColumns struct {
+	ID         column
+	Algorithm  column
+	PrivateKey column
+	CreatedAt  column
+}
+
+func (c signingKeyColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.Algorithm, c.PrivateKey, c.CreatedAt,
+	}
+}
+
+type signingKeyIndexes struct {
+	SqliteAutoindexSigningKey1 index
+}
+
+func (i signingKeyIndexes) AsSlice() []index {
+	return []index{
+		i.SqliteAutoindexSigningKey1,
+	}
+}
+
+type signingKeyForeignKeys struct{}
+
+func (f signingKeyForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{}
+}
+
+type signingKeyUniques struct{}
+
+func (u signingKeyUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type signingKeyChecks struct{}
+
+func (c signingKeyChecks) AsSlice() []check {
+	return []check{}
+}
@@ -60,6 +60,87 @@ var Users = Table[
 			Generated: false,
 			AutoIncr:  false,
 		},
+		TokenVersion: column{
+			Name:      "token_version",
+			DBType:    "INTEGER",
+			Default:   "0",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Role: column{
+			Name:      "role",
+			DBType:    "TEXT",
+			Default:   "'user'",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		DisabledAt: column{
+			Name:      "disabled_at",
+			DBType:    "DATETIME",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		PasswordResetRequired: column{
+			Name:      "password_reset_required",
+			DBType:    "BOOLEAN",
+			Default:   "FALSE",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TotpSecret: column{
+			Name:      "totp_secret",
+			DBType:    "TEXT",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TotpEnabled: column{
+			Name:      "totp_enabled",
+			DBType:    "BOOLEAN",
+			Default:   "FALSE",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TotpLastStep: column{
+			Name:      "totp_last_step",
+			DBType:    "INTEGER",
+			Default:   "0",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Email: column{
+			Name:      "email",
+			DBType:    "TEXT",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		EmailVerifiedAt: column{
+			Name:      "email_verified_at",
+			DBType:    "DATETIME",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
 	},
 	Indexes: userIndexes{
 		PKMainUser: index{
@@ -76,6 +157,34 @@ var Users = Table[
 			Comment: "",
 			Partial: false,
 		},
+		UserUsername: index{
+			Type: "c",
+			Name: "user_username",
+			Columns: []indexColumn{
+				{
+					Name:         "username",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+		UserVerifiedEmail: index{
+			Type: "c",
+			Name: "user_verified_email",
+			Columns: []indexColumn{
+				{
+					Name:         "email",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: true,
+		},
 	},
 	PrimaryKey: &constraint{
 		Name:    "pk_main_user",
@@ -98,26 +207,37 @@ var Users = Table[
 }
 
 type userColumns struct {
-	ID               column
-	Username         column
-	Password         column
-	ProfilePictureID column
-	WebauthnID       column
+	ID                    column
+	Username              column
+	Password              column
+	ProfilePictureID      column
+	WebauthnID            column
+	TokenVersion          column
+	Role                  column
+	DisabledAt            column
+	PasswordResetRequired column
+	TotpSecret            column
+	TotpEnabled           column
+	TotpLastStep          column
+	Email                 column
+	EmailVerifiedAt       column
 }
 
 func (c userColumns) AsSlice() []column {
 	return []column{
-		c.ID, c.Username, c.Password, c.ProfilePictureID, c.WebauthnID,
+		c.ID, c.Username, c.Password, c.ProfilePictureID, c.WebauthnID, c.TokenVersion, c.Role, c.DisabledAt, c.PasswordResetRequired, c.TotpSecret, c.TotpEnabled, c.TotpLastStep, c.Email, c.EmailVerifiedAt,
 	}
 }
 
 type userIndexes struct {
-	PKMainUser index
+	PKMainUser        index
+	UserUsername      index
+	UserVerifiedEmail index
 }
 
 func (i userIndexes) AsSlice() []index {
 	return []index{
-		i.PKMainUser,
+		i.PKMainUser, i.UserUsername, i.UserVerifiedEmail,
 	}
 }
 
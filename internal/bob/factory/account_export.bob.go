@@ -0,0 +1,798 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/spotdemo4/ts-server/internal/bob/models"
+	"github.com/stephenafamo/bob"
+)
+
+type AccountExportMod interface {
+	Apply(context.Context, *AccountExportTemplate)
+}
+
+type AccountExportModFunc func(context.Context, *AccountExportTemplate)
+
+func (f AccountExportModFunc) Apply(ctx context.Context, n *AccountExportTemplate) {
+	f(ctx, n)
+}
+
+type AccountExportModSlice []AccountExportMod
+
+func (mods AccountExportModSlice) Apply(ctx context.Context, n *AccountExportTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// AccountExportTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type AccountExportTemplate struct {
+	ID          func() string
+	Status      func() string
+	Size        func() int32
+	Error       func() null.Val[string]
+	CreatedAt   func() time.Time
+	FinishedAt  func() null.Val[time.Time]
+	HeartbeatAt func() time.Time
+	ExpiresAt   func() time.Time
+	UserID      func() int32
+
+	r accountExportR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type accountExportR struct {
+	User                     *accountExportRUserR
+	ExportAccountExportPages []*accountExportRExportAccountExportPagesR
+}
+
+type accountExportRUserR struct {
+	o *UserTemplate
+}
+type accountExportRExportAccountExportPagesR struct {
+	number int
+	o      *AccountExportPageTemplate
+}
+
+// Apply mods to the AccountExportTemplate
+func (o *AccountExportTemplate) Apply(ctx context.Context, mods ...AccountExportMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.AccountExport
+// according to the relationships in the template. Nothing is inserted into the db
+func (t AccountExportTemplate) setModelRels(o *models.AccountExport) {
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.AccountExports = append(rel.R.AccountExports, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+
+	if t.r.ExportAccountExportPages != nil {
+		rel := models.AccountExportPageSlice{}
+		for _, r := range t.r.ExportAccountExportPages {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.ExportID = o.ID // h2
+				rel.R.ExportAccountExport = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.ExportAccountExportPages = rel
+	}
+}
+
+// BuildSetter returns an *models.AccountExportSetter
+// this does nothing with the relationship templates
+func (o AccountExportTemplate) BuildSetter() *models.AccountExportSetter {
+	m := &models.AccountExportSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.Status != nil {
+		val := o.Status()
+		m.Status = omit.From(val)
+	}
+	if o.Size != nil {
+		val := o.Size()
+		m.Size = omit.From(val)
+	}
+	if o.Error != nil {
+		val := o.Error()
+		m.Error = omitnull.FromNull(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omit.From(val)
+	}
+	if o.FinishedAt != nil {
+		val := o.FinishedAt()
+		m.FinishedAt = omitnull.FromNull(val)
+	}
+	if o.HeartbeatAt != nil {
+		val := o.HeartbeatAt()
+		m.HeartbeatAt = omit.From(val)
+	}
+	if o.ExpiresAt != nil {
+		val := o.ExpiresAt()
+		m.ExpiresAt = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.AccountExportSetter
+// this does nothing with the relationship templates
+func (o AccountExportTemplate) BuildManySetter(number int) []*models.AccountExportSetter {
+	m := make([]*models.AccountExportSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.AccountExport
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use AccountExportTemplate.Create
+func (o AccountExportTemplate) Build() *models.AccountExport {
+	m := &models.AccountExport{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.Status != nil {
+		m.Status = o.Status()
+	}
+	if o.Size != nil {
+		m.Size = o.Size()
+	}
+	if o.Error != nil {
+		m.Error = o.Error()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+	if o.FinishedAt != nil {
+		m.FinishedAt = o.FinishedAt()
+	}
+	if o.HeartbeatAt != nil {
+		m.HeartbeatAt = o.HeartbeatAt()
+	}
+	if o.ExpiresAt != nil {
+		m.ExpiresAt = o.ExpiresAt()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.AccountExportSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use AccountExportTemplate.CreateMany
+func (o AccountExportTemplate) BuildMany(number int) models.AccountExportSlice {
+	m := make(models.AccountExportSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableAccountExport(m *models.AccountExportSetter) {
+	if !(m.ID.IsValue()) {
+		val := random_string(nil)
+		m.ID = omit.From(val)
+	}
+	if !(m.Status.IsValue()) {
+		val := random_string(nil)
+		m.Status = omit.From(val)
+	}
+	if !(m.CreatedAt.IsValue()) {
+		val := random_time_Time(nil)
+		m.CreatedAt = omit.From(val)
+	}
+	if !(m.HeartbeatAt.IsValue()) {
+		val := random_time_Time(nil)
+		m.HeartbeatAt = omit.From(val)
+	}
+	if !(m.ExpiresAt.IsValue()) {
+		val := random_time_Time(nil)
+		m.ExpiresAt = omit.From(val)
+	}
+	if !(m.UserID.IsValue()) {
+		val := random_int32(nil)
+		m.UserID = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.AccountExport
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *AccountExportTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.AccountExport) error {
+	var err error
+
+	isExportAccountExportPagesDone, _ := accountExportRelExportAccountExportPagesCtx.Value(ctx)
+	if !isExportAccountExportPagesDone && o.r.ExportAccountExportPages != nil {
+		ctx = accountExportRelExportAccountExportPagesCtx.WithValue(ctx, true)
+		for _, r := range o.r.ExportAccountExportPages {
+			if r.o.alreadyPersisted {
+				m.R.ExportAccountExportPages = append(m.R.ExportAccountExportPages, r.o.Build())
+			} else {
+				rel1, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachExportAccountExportPages(ctx, exec, rel1...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return err
+}
+
+// Create builds a accountExport and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *AccountExportTemplate) Create(ctx context.Context, exec bob.Executor) (*models.AccountExport, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableAccountExport(opt)
+
+	if o.r.User == nil {
+		AccountExportMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel0 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel0 = o.r.User.o.Build()
+	} else {
+		rel0, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel0.ID)
+
+	m, err := models.AccountExports.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.User = rel0
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a accountExport and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *AccountExportTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.AccountExport {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a accountExport and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *AccountExportTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.AccountExport {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple accountExports and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o AccountExportTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.AccountExportSlice, error) {
+	var err error
+	m := make(models.AccountExportSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple accountExports and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o AccountExportTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.AccountExportSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple accountExports and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o AccountExportTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.AccountExportSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// AccountExport has methods that act as mods for the AccountExportTemplate
+var AccountExportMods accountExportMods
+
+type accountExportMods struct{}
+
+func (m accountExportMods) RandomizeAllColumns(f *faker.Faker) AccountExportMod {
+	return AccountExportModSlice{
+		AccountExportMods.RandomID(f),
+		AccountExportMods.RandomStatus(f),
+		AccountExportMods.RandomSize(f),
+		AccountExportMods.RandomError(f),
+		AccountExportMods.RandomCreatedAt(f),
+		AccountExportMods.RandomFinishedAt(f),
+		AccountExportMods.RandomHeartbeatAt(f),
+		AccountExportMods.RandomExpiresAt(f),
+		AccountExportMods.RandomUserID(f),
+	}
+}
+
+// Set the model columns to this value
+func (m accountExportMods) ID(val string) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.ID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m accountExportMods) IDFunc(f func() string) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m accountExportMods) UnsetID() AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m accountExportMods) RandomID(f *faker.Faker) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.ID = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m accountExportMods) Status(val string) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.Status = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m accountExportMods) StatusFunc(f func() string) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.Status = f
+	})
+}
+
+// Clear any values for the column
+func (m accountExportMods) UnsetStatus() AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.Status = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m accountExportMods) RandomStatus(f *faker.Faker) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.Status = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m accountExportMods) Size(val int32) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.Size = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m accountExportMods) SizeFunc(f func() int32) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.Size = f
+	})
+}
+
+// Clear any values for the column
+func (m accountExportMods) UnsetSize() AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.Size = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m accountExportMods) RandomSize(f *faker.Faker) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.Size = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m accountExportMods) Error(val null.Val[string]) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.Error = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m accountExportMods) ErrorFunc(f func() null.Val[string]) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.Error = f
+	})
+}
+
+// Clear any values for the column
+func (m accountExportMods) UnsetError() AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.Error = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m accountExportMods) RandomError(f *faker.Faker) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.Error = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m accountExportMods) RandomErrorNotNull(f *faker.Faker) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.Error = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m accountExportMods) CreatedAt(val time.Time) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m accountExportMods) CreatedAtFunc(f func() time.Time) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m accountExportMods) UnsetCreatedAt() AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m accountExportMods) RandomCreatedAt(f *faker.Faker) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m accountExportMods) FinishedAt(val null.Val[time.Time]) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.FinishedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m accountExportMods) FinishedAtFunc(f func() null.Val[time.Time]) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.FinishedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m accountExportMods) UnsetFinishedAt() AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.FinishedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m accountExportMods) RandomFinishedAt(f *faker.Faker) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.FinishedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m accountExportMods) RandomFinishedAtNotNull(f *faker.Faker) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.FinishedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m accountExportMods) HeartbeatAt(val time.Time) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.HeartbeatAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m accountExportMods) HeartbeatAtFunc(f func() time.Time) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.HeartbeatAt = f
+	})
+}
+
+// Clear any values for the column
+func (m accountExportMods) UnsetHeartbeatAt() AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.HeartbeatAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m accountExportMods) RandomHeartbeatAt(f *faker.Faker) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.HeartbeatAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m accountExportMods) ExpiresAt(val time.Time) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.ExpiresAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m accountExportMods) ExpiresAtFunc(f func() time.Time) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.ExpiresAt = f
+	})
+}
+
+// Clear any values for the column
+func (m accountExportMods) UnsetExpiresAt() AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.ExpiresAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m accountExportMods) RandomExpiresAt(f *faker.Faker) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.ExpiresAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m accountExportMods) UserID(val int32) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.UserID = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m accountExportMods) UserIDFunc(f func() int32) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m accountExportMods) UnsetUserID() AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m accountExportMods) RandomUserID(f *faker.Faker) AccountExportMod {
+	return AccountExportModFunc(func(_ context.Context, o *AccountExportTemplate) {
+		o.UserID = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+func (m accountExportMods) WithParentsCascading() AccountExportMod {
+	return AccountExportModFunc(func(ctx context.Context, o *AccountExportTemplate) {
+		if isDone, _ := accountExportWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = accountExportWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m accountExportMods) WithUser(rel *UserTemplate) AccountExportMod {
+	return AccountExportModFunc(func(ctx context.Context, o *AccountExportTemplate) {
+		o.r.User = &accountExportRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m accountExportMods) WithNewUser(mods ...UserMod) AccountExportMod {
+	return AccountExportModFunc(func(ctx context.Context, o *AccountExportTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m accountExportMods) WithExistingUser(em *models.User) AccountExportMod {
+	return AccountExportModFunc(func(ctx context.Context, o *AccountExportTemplate) {
+		o.r.User = &accountExportRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m accountExportMods) WithoutUser() AccountExportMod {
+	return AccountExportModFunc(func(ctx context.Context, o *AccountExportTemplate) {
+		o.r.User = nil
+	})
+}
+
+func (m accountExportMods) WithExportAccountExportPages(number int, related *AccountExportPageTemplate) AccountExportMod {
+	return AccountExportModFunc(func(ctx context.Context, o *AccountExportTemplate) {
+		o.r.ExportAccountExportPages = []*accountExportRExportAccountExportPagesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m accountExportMods) WithNewExportAccountExportPages(number int, mods ...AccountExportPageMod) AccountExportMod {
+	return AccountExportModFunc(func(ctx context.Context, o *AccountExportTemplate) {
+		related := o.f.NewAccountExportPageWithContext(ctx, mods...)
+		m.WithExportAccountExportPages(number, related).Apply(ctx, o)
+	})
+}
+
+func (m accountExportMods) AddExportAccountExportPages(number int, related *AccountExportPageTemplate) AccountExportMod {
+	return AccountExportModFunc(func(ctx context.Context, o *AccountExportTemplate) {
+		o.r.ExportAccountExportPages = append(o.r.ExportAccountExportPages, &accountExportRExportAccountExportPagesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m accountExportMods) AddNewExportAccountExportPages(number int, mods ...AccountExportPageMod) AccountExportMod {
+	return AccountExportModFunc(func(ctx context.Context, o *AccountExportTemplate) {
+		related := o.f.NewAccountExportPageWithContext(ctx, mods...)
+		m.AddExportAccountExportPages(number, related).Apply(ctx, o)
+	})
+}
+
+func (m accountExportMods) AddExistingExportAccountExportPages(existingModels ...*models.AccountExportPage) AccountExportMod {
+	return AccountExportModFunc(func(ctx context.Context, o *AccountExportTemplate) {
+		for _, em := range existingModels {
+			o.r.ExportAccountExportPages = append(o.r.ExportAccountExportPages, &accountExportRExportAccountExportPagesR{
+				o: o.f.FromExistingAccountExportPage(em),
+			})
+		}
+	})
+}
+
+func (m accountExportMods) WithoutExportAccountExportPages() AccountExportMod {
+	return AccountExportModFunc(func(ctx context.Context, o *AccountExportTemplate) {
+		o.r.ExportAccountExportPages = nil
+	})
+}
@@ -0,0 +1,413 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/spotdemo4/ts-server/internal/bob/models"
+	"github.com/stephenafamo/bob"
+)
+
+type AccountExportPageMod interface {
+	Apply(context.Context, *AccountExportPageTemplate)
+}
+
+type AccountExportPageModFunc func(context.Context, *AccountExportPageTemplate)
+
+func (f AccountExportPageModFunc) Apply(ctx context.Context, n *AccountExportPageTemplate) {
+	f(ctx, n)
+}
+
+type AccountExportPageModSlice []AccountExportPageMod
+
+func (mods AccountExportPageModSlice) Apply(ctx context.Context, n *AccountExportPageTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// AccountExportPageTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type AccountExportPageTemplate struct {
+	ExportID func() string
+	Page     func() int32
+	Data     func() []byte
+
+	r accountExportPageR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type accountExportPageR struct {
+	ExportAccountExport *accountExportPageRExportAccountExportR
+}
+
+type accountExportPageRExportAccountExportR struct {
+	o *AccountExportTemplate
+}
+
+// Apply mods to the AccountExportPageTemplate
+func (o *AccountExportPageTemplate) Apply(ctx context.Context, mods ...AccountExportPageMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.AccountExportPage
+// according to the relationships in the template. Nothing is inserted into the db
+func (t AccountExportPageTemplate) setModelRels(o *models.AccountExportPage) {
+	if t.r.ExportAccountExport != nil {
+		rel := t.r.ExportAccountExport.o.Build()
+		rel.R.ExportAccountExportPages = append(rel.R.ExportAccountExportPages, o)
+		o.ExportID = rel.ID // h2
+		o.R.ExportAccountExport = rel
+	}
+}
+
+// BuildSetter returns an *models.AccountExportPageSetter
+// this does nothing with the relationship templates
+func (o AccountExportPageTemplate) BuildSetter() *models.AccountExportPageSetter {
+	m := &models.AccountExportPageSetter{}
+
+	if o.ExportID != nil {
+		val := o.ExportID()
+		m.ExportID = omit.From(val)
+	}
+	if o.Page != nil {
+		val := o.Page()
+		m.Page = omit.From(val)
+	}
+	if o.Data != nil {
+		val := o.Data()
+		m.Data = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.AccountExportPageSetter
+// this does nothing with the relationship templates
+func (o AccountExportPageTemplate) BuildManySetter(number int) []*models.AccountExportPageSetter {
+	m := make([]*models.AccountExportPageSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.AccountExportPage
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use AccountExportPageTemplate.Create
+func (o AccountExportPageTemplate) Build() *models.AccountExportPage {
+	m := &models.AccountExportPage{}
+
+	if o.ExportID != nil {
+		m.ExportID = o.ExportID()
+	}
+	if o.Page != nil {
+		m.Page = o.Page()
+	}
+	if o.Data != nil {
+		m.Data = o.Data()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.AccountExportPageSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use AccountExportPageTemplate.CreateMany
+func (o AccountExportPageTemplate) BuildMany(number int) models.AccountExportPageSlice {
+	m := make(models.AccountExportPageSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableAccountExportPage(m *models.AccountExportPageSetter) {
+	if !(m.ExportID.IsValue()) {
+		val := random_string(nil)
+		m.ExportID = omit.From(val)
+	}
+	if !(m.Page.IsValue()) {
+		val := random_int32(nil)
+		m.Page = omit.From(val)
+	}
+	if !(m.Data.IsValue()) {
+		val := random___byte(nil)
+		m.Data = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.AccountExportPage
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *AccountExportPageTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.AccountExportPage) error {
+	var err error
+
+	return err
+}
+
+// Create builds a accountExportPage and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *AccountExportPageTemplate) Create(ctx context.Context, exec bob.Executor) (*models.AccountExportPage, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableAccountExportPage(opt)
+
+	if o.r.ExportAccountExport == nil {
+		AccountExportPageMods.WithNewExportAccountExport().Apply(ctx, o)
+	}
+
+	var rel0 *models.AccountExport
+
+	if o.r.ExportAccountExport.o.alreadyPersisted {
+		rel0 = o.r.ExportAccountExport.o.Build()
+	} else {
+		rel0, err = o.r.ExportAccountExport.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.ExportID = omit.From(rel0.ID)
+
+	m, err := models.AccountExportPages.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.ExportAccountExport = rel0
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a accountExportPage and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *AccountExportPageTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.AccountExportPage {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a accountExportPage and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *AccountExportPageTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.AccountExportPage {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple accountExportPages and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o AccountExportPageTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.AccountExportPageSlice, error) {
+	var err error
+	m := make(models.AccountExportPageSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple accountExportPages and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o AccountExportPageTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.AccountExportPageSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple accountExportPages and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o AccountExportPageTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.AccountExportPageSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// AccountExportPage has methods that act as mods for the AccountExportPageTemplate
+var AccountExportPageMods accountExportPageMods
+
+type accountExportPageMods struct{}
+
+func (m accountExportPageMods) RandomizeAllColumns(f *faker.Faker) AccountExportPageMod {
+	return AccountExportPageModSlice{
+		AccountExportPageMods.RandomExportID(f),
+		AccountExportPageMods.RandomPage(f),
+		AccountExportPageMods.RandomData(f),
+	}
+}
+
+// Set the model columns to this value
+func (m accountExportPageMods) ExportID(val string) AccountExportPageMod {
+	return AccountExportPageModFunc(func(_ context.Context, o *AccountExportPageTemplate) {
+		o.ExportID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m accountExportPageMods) ExportIDFunc(f func() string) AccountExportPageMod {
+	return AccountExportPageModFunc(func(_ context.Context, o *AccountExportPageTemplate) {
+		o.ExportID = f
+	})
+}
+
+// Clear any values for the column
+func (m accountExportPageMods) UnsetExportID() AccountExportPageMod {
+	return AccountExportPageModFunc(func(_ context.Context, o *AccountExportPageTemplate) {
+		o.ExportID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m accountExportPageMods) RandomExportID(f *faker.Faker) AccountExportPageMod {
+	return AccountExportPageModFunc(func(_ context.Context, o *AccountExportPageTemplate) {
+		o.ExportID = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m accountExportPageMods) Page(val int32) AccountExportPageMod {
+	return AccountExportPageModFunc(func(_ context.Context, o *AccountExportPageTemplate) {
+		o.Page = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m accountExportPageMods) PageFunc(f func() int32) AccountExportPageMod {
+	return AccountExportPageModFunc(func(_ context.Context, o *AccountExportPageTemplate) {
+		o.Page = f
+	})
+}
+
+// Clear any values for the column
+func (m accountExportPageMods) UnsetPage() AccountExportPageMod {
+	return AccountExportPageModFunc(func(_ context.Context, o *AccountExportPageTemplate) {
+		o.Page = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m accountExportPageMods) RandomPage(f *faker.Faker) AccountExportPageMod {
+	return AccountExportPageModFunc(func(_ context.Context, o *AccountExportPageTemplate) {
+		o.Page = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m accountExportPageMods) Data(val []byte) AccountExportPageMod {
+	return AccountExportPageModFunc(func(_ context.Context, o *AccountExportPageTemplate) {
+		o.Data = func() []byte { return val }
+	})
+}
+
+// Set the Column from the function
+func (m accountExportPageMods) DataFunc(f func() []byte) AccountExportPageMod {
+	return AccountExportPageModFunc(func(_ context.Context, o *AccountExportPageTemplate) {
+		o.Data = f
+	})
+}
+
+// Clear any values for the column
+func (m accountExportPageMods) UnsetData() AccountExportPageMod {
+	return AccountExportPageModFunc(func(_ context.Context, o *AccountExportPageTemplate) {
+		o.Data = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m accountExportPageMods) RandomData(f *faker.Faker) AccountExportPageMod {
+	return AccountExportPageModFunc(func(_ context.Context, o *AccountExportPageTemplate) {
+		o.Data = func() []byte {
+			return random___byte(f)
+		}
+	})
+}
+
+func (m accountExportPageMods) WithParentsCascading() AccountExportPageMod {
+	return AccountExportPageModFunc(func(ctx context.Context, o *AccountExportPageTemplate) {
+		if isDone, _ := accountExportPageWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = accountExportPageWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewAccountExportWithContext(ctx, AccountExportMods.WithParentsCascading())
+			m.WithExportAccountExport(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m accountExportPageMods) WithExportAccountExport(rel *AccountExportTemplate) AccountExportPageMod {
+	return AccountExportPageModFunc(func(ctx context.Context, o *AccountExportPageTemplate) {
+		o.r.ExportAccountExport = &accountExportPageRExportAccountExportR{
+			o: rel,
+		}
+	})
+}
+
+func (m accountExportPageMods) WithNewExportAccountExport(mods ...AccountExportMod) AccountExportPageMod {
+	return AccountExportPageModFunc(func(ctx context.Context, o *AccountExportPageTemplate) {
+		related := o.f.NewAccountExportWithContext(ctx, mods...)
+
+		m.WithExportAccountExport(related).Apply(ctx, o)
+	})
+}
+
+func (m accountExportPageMods) WithExistingExportAccountExport(em *models.AccountExport) AccountExportPageMod {
+	return AccountExportPageModFunc(func(ctx context.Context, o *AccountExportPageTemplate) {
+		o.r.ExportAccountExport = &accountExportPageRExportAccountExportR{
+			o: o.f.FromExistingAccountExport(em),
+		}
+	})
+}
+
+func (m accountExportPageMods) WithoutExportAccountExport() AccountExportPageMod {
+	return AccountExportPageModFunc(func(ctx context.Context, o *AccountExportPageTemplate) {
+		o.r.ExportAccountExport = nil
+	})
+}
@@ -0,0 +1,686 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/spotdemo4/ts-server/internal/bob/models"
+	"github.com/stephenafamo/bob"
+)
+
+type AuditEventMod interface {
+	Apply(context.Context, *AuditEventTemplate)
+}
+
+type AuditEventModFunc func(context.Context, *AuditEventTemplate)
+
+func (f AuditEventModFunc) Apply(ctx context.Context, n *AuditEventTemplate) {
+	f(ctx, n)
+}
+
+type AuditEventModSlice []AuditEventMod
+
+func (mods AuditEventModSlice) Apply(ctx context.Context, n *AuditEventTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// AuditEventTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type AuditEventTemplate struct {
+	ID        func() int32
+	ActorID   func() null.Val[int32]
+	UserID    func() null.Val[int32]
+	Action    func() string
+	Target    func() string
+	IP        func() string
+	UserAgent func() string
+	Outcome   func() string
+	Metadata  func() string
+	CreatedAt func() time.Time
+
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+// Apply mods to the AuditEventTemplate
+func (o *AuditEventTemplate) Apply(ctx context.Context, mods ...AuditEventMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.AuditEvent
+// according to the relationships in the template. Nothing is inserted into the db
+func (t AuditEventTemplate) setModelRels(o *models.AuditEvent) {}
+
+// BuildSetter returns an *models.AuditEventSetter
+// this does nothing with the relationship templates
+func (o AuditEventTemplate) BuildSetter() *models.AuditEventSetter {
+	m := &models.AuditEventSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.ActorID != nil {
+		val := o.ActorID()
+		m.ActorID = omitnull.FromNull(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omitnull.FromNull(val)
+	}
+	if o.Action != nil {
+		val := o.Action()
+		m.Action = omit.From(val)
+	}
+	if o.Target != nil {
+		val := o.Target()
+		m.Target = omit.From(val)
+	}
+	if o.IP != nil {
+		val := o.IP()
+		m.IP = omit.From(val)
+	}
+	if o.UserAgent != nil {
+		val := o.UserAgent()
+		m.UserAgent = omit.From(val)
+	}
+	if o.Outcome != nil {
+		val := o.Outcome()
+		m.Outcome = omit.From(val)
+	}
+	if o.Metadata != nil {
+		val := o.Metadata()
+		m.Metadata = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.AuditEventSetter
+// this does nothing with the relationship templates
+func (o AuditEventTemplate) BuildManySetter(number int) []*models.AuditEventSetter {
+	m := make([]*models.AuditEventSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.AuditEvent
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use AuditEventTemplate.Create
+func (o AuditEventTemplate) Build() *models.AuditEvent {
+	m := &models.AuditEvent{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.ActorID != nil {
+		m.ActorID = o.ActorID()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.Action != nil {
+		m.Action = o.Action()
+	}
+	if o.Target != nil {
+		m.Target = o.Target()
+	}
+	if o.IP != nil {
+		m.IP = o.IP()
+	}
+	if o.UserAgent != nil {
+		m.UserAgent = o.UserAgent()
+	}
+	if o.Outcome != nil {
+		m.Outcome = o.Outcome()
+	}
+	if o.Metadata != nil {
+		m.Metadata = o.Metadata()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.AuditEventSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use AuditEventTemplate.CreateMany
+func (o AuditEventTemplate) BuildMany(number int) models.AuditEventSlice {
+	m := make(models.AuditEventSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableAuditEvent(m *models.AuditEventSetter) {
+	if !(m.Action.IsValue()) {
+		val := random_string(nil)
+		m.Action = omit.From(val)
+	}
+	if !(m.Target.IsValue()) {
+		val := random_string(nil)
+		m.Target = omit.From(val)
+	}
+	if !(m.IP.IsValue()) {
+		val := random_string(nil)
+		m.IP = omit.From(val)
+	}
+	if !(m.UserAgent.IsValue()) {
+		val := random_string(nil)
+		m.UserAgent = omit.From(val)
+	}
+	if !(m.Outcome.IsValue()) {
+		val := random_string(nil)
+		m.Outcome = omit.From(val)
+	}
+	if !(m.Metadata.IsValue()) {
+		val := random_string(nil)
+		m.Metadata = omit.From(val)
+	}
+	if !(m.CreatedAt.IsValue()) {
+		val := random_time_Time(nil)
+		m.CreatedAt = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.AuditEvent
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *AuditEventTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.AuditEvent) error {
+	var err error
+
+	return err
+}
+
+// Create builds a auditEvent and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *AuditEventTemplate) Create(ctx context.Context, exec bob.Executor) (*models.AuditEvent, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableAuditEvent(opt)
+
+	m, err := models.AuditEvents.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a auditEvent and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *AuditEventTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.AuditEvent {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a auditEvent and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *AuditEventTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.AuditEvent {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple auditEvents and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o AuditEventTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.AuditEventSlice, error) {
+	var err error
+	m := make(models.AuditEventSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple auditEvents and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o AuditEventTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.AuditEventSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple auditEvents and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o AuditEventTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.AuditEventSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// AuditEvent has methods that act as mods for the AuditEventTemplate
+var AuditEventMods auditEventMods
+
+type auditEventMods struct{}
+
+func (m auditEventMods) RandomizeAllColumns(f *faker.Faker) AuditEventMod {
+	return AuditEventModSlice{
+		AuditEventMods.RandomID(f),
+		AuditEventMods.RandomActorID(f),
+		AuditEventMods.RandomUserID(f),
+		AuditEventMods.RandomAction(f),
+		AuditEventMods.RandomTarget(f),
+		AuditEventMods.RandomIP(f),
+		AuditEventMods.RandomUserAgent(f),
+		AuditEventMods.RandomOutcome(f),
+		AuditEventMods.RandomMetadata(f),
+		AuditEventMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m auditEventMods) ID(val int32) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ID = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) IDFunc(f func() int32) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetID() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m auditEventMods) RandomID(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ID = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m auditEventMods) ActorID(val null.Val[int32]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ActorID = func() null.Val[int32] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) ActorIDFunc(f func() null.Val[int32]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ActorID = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetActorID() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ActorID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m auditEventMods) RandomActorID(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ActorID = func() null.Val[int32] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int32(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m auditEventMods) RandomActorIDNotNull(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.ActorID = func() null.Val[int32] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int32(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m auditEventMods) UserID(val null.Val[int32]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.UserID = func() null.Val[int32] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) UserIDFunc(f func() null.Val[int32]) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetUserID() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m auditEventMods) RandomUserID(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.UserID = func() null.Val[int32] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int32(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m auditEventMods) RandomUserIDNotNull(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.UserID = func() null.Val[int32] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int32(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m auditEventMods) Action(val string) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Action = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) ActionFunc(f func() string) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Action = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetAction() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Action = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m auditEventMods) RandomAction(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Action = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m auditEventMods) Target(val string) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Target = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) TargetFunc(f func() string) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Target = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetTarget() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Target = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m auditEventMods) RandomTarget(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Target = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m auditEventMods) IP(val string) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.IP = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) IPFunc(f func() string) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.IP = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetIP() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.IP = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m auditEventMods) RandomIP(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.IP = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m auditEventMods) UserAgent(val string) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.UserAgent = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) UserAgentFunc(f func() string) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.UserAgent = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetUserAgent() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.UserAgent = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m auditEventMods) RandomUserAgent(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.UserAgent = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m auditEventMods) Outcome(val string) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Outcome = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) OutcomeFunc(f func() string) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Outcome = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetOutcome() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Outcome = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m auditEventMods) RandomOutcome(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Outcome = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m auditEventMods) Metadata(val string) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Metadata = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) MetadataFunc(f func() string) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Metadata = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetMetadata() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Metadata = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m auditEventMods) RandomMetadata(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.Metadata = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m auditEventMods) CreatedAt(val time.Time) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m auditEventMods) CreatedAtFunc(f func() time.Time) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m auditEventMods) UnsetCreatedAt() AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m auditEventMods) RandomCreatedAt(f *faker.Faker) AuditEventMod {
+	return AuditEventModFunc(func(_ context.Context, o *AuditEventTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m auditEventMods) WithParentsCascading() AuditEventMod {
+	return AuditEventModFunc(func(ctx context.Context, o *AuditEventTemplate) {
+		if isDone, _ := auditEventWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = auditEventWithParentsCascadingCtx.WithValue(ctx, true)
+	})
+}
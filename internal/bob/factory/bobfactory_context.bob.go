@@ -8,27 +8,104 @@ import "context"
 type contextKey string
 
 var (
+	// Relationship Contexts for account_export
+	accountExportWithParentsCascadingCtx        = newContextual[bool]("accountExportWithParentsCascading")
+	accountExportRelUserCtx                     = newContextual[bool]("account_export.user.fk_account_export_0")
+	accountExportRelExportAccountExportPagesCtx = newContextual[bool]("account_export.account_export_page.fk_account_export_page_0")
+
+	// Relationship Contexts for account_export_page
+	accountExportPageWithParentsCascadingCtx   = newContextual[bool]("accountExportPageWithParentsCascading")
+	accountExportPageRelExportAccountExportCtx = newContextual[bool]("account_export.account_export_page.fk_account_export_page_0")
+
+	// Relationship Contexts for api_key
+	apiKeyWithParentsCascadingCtx = newContextual[bool]("apiKeyWithParentsCascading")
+	apiKeyRelUserCtx              = newContextual[bool]("api_key.user.fk_api_key_0")
+
+	// Relationship Contexts for audit_event
+	auditEventWithParentsCascadingCtx = newContextual[bool]("auditEventWithParentsCascading")
+
+	// Relationship Contexts for ceremony
+	ceremonyWithParentsCascadingCtx = newContextual[bool]("ceremonyWithParentsCascading")
+
 	// Relationship Contexts for credential
 	credentialWithParentsCascadingCtx = newContextual[bool]("credentialWithParentsCascading")
 	credentialRelUserCtx              = newContextual[bool]("credential.user.fk_credential_0")
 
+	// Relationship Contexts for email_token
+	emailTokenWithParentsCascadingCtx = newContextual[bool]("emailTokenWithParentsCascading")
+	emailTokenRelUserCtx              = newContextual[bool]("email_token.user.fk_email_token_0")
+
 	// Relationship Contexts for file
 	fileWithParentsCascadingCtx   = newContextual[bool]("fileWithParentsCascading")
 	fileRelUserCtx                = newContextual[bool]("file.user.fk_file_0")
 	fileRelProfilePictureUsersCtx = newContextual[bool]("file.user.fk_user_0")
 
+	// Relationship Contexts for identity
+	identityWithParentsCascadingCtx = newContextual[bool]("identityWithParentsCascading")
+	identityRelUserCtx              = newContextual[bool]("identity.user.fk_identity_0")
+
 	// Relationship Contexts for item
 	itemWithParentsCascadingCtx = newContextual[bool]("itemWithParentsCascading")
 	itemRelUserCtx              = newContextual[bool]("item.user.fk_item_0")
 
+	// Relationship Contexts for item_search
+	itemSearchWithParentsCascadingCtx = newContextual[bool]("itemSearchWithParentsCascading")
+
+	// Relationship Contexts for login_attempt
+	loginAttemptWithParentsCascadingCtx = newContextual[bool]("loginAttemptWithParentsCascading")
+
+	// Relationship Contexts for login_challenge
+	loginChallengeWithParentsCascadingCtx = newContextual[bool]("loginChallengeWithParentsCascading")
+	loginChallengeRelUserCtx              = newContextual[bool]("login_challenge.user.fk_login_challenge_0")
+
+	// Relationship Contexts for oauth_client
+	oauthClientWithParentsCascadingCtx   = newContextual[bool]("oauthClientWithParentsCascading")
+	oauthClientRelClientOauthCodesCtx    = newContextual[bool]("oauth_client.oauth_code.fk_oauth_code_1")
+	oauthClientRelClientOauthConsentsCtx = newContextual[bool]("oauth_client.oauth_consent.fk_oauth_consent_1")
+	oauthClientRelClientSessionsCtx      = newContextual[bool]("oauth_client.session.fk_session_1")
+
+	// Relationship Contexts for oauth_code
+	oauthCodeWithParentsCascadingCtx = newContextual[bool]("oauthCodeWithParentsCascading")
+	oauthCodeRelUserCtx              = newContextual[bool]("oauth_code.user.fk_oauth_code_0")
+	oauthCodeRelClientOauthClientCtx = newContextual[bool]("oauth_client.oauth_code.fk_oauth_code_1")
+
+	// Relationship Contexts for oauth_consent
+	oauthConsentWithParentsCascadingCtx = newContextual[bool]("oauthConsentWithParentsCascading")
+	oauthConsentRelUserCtx              = newContextual[bool]("oauth_consent.user.fk_oauth_consent_0")
+	oauthConsentRelClientOauthClientCtx = newContextual[bool]("oauth_client.oauth_consent.fk_oauth_consent_1")
+
+	// Relationship Contexts for rate_limit
+	rateLimitWithParentsCascadingCtx = newContextual[bool]("rateLimitWithParentsCascading")
+
+	// Relationship Contexts for recovery_code
+	recoveryCodeWithParentsCascadingCtx = newContextual[bool]("recoveryCodeWithParentsCascading")
+	recoveryCodeRelUserCtx              = newContextual[bool]("recovery_code.user.fk_recovery_code_0")
+
 	// Relationship Contexts for schema_migrations
 	schemaMigrationWithParentsCascadingCtx = newContextual[bool]("schemaMigrationWithParentsCascading")
 
+	// Relationship Contexts for session
+	sessionWithParentsCascadingCtx = newContextual[bool]("sessionWithParentsCascading")
+	sessionRelUserCtx              = newContextual[bool]("session.user.fk_session_0")
+	sessionRelClientOauthClientCtx = newContextual[bool]("oauth_client.session.fk_session_1")
+
+	// Relationship Contexts for signing_key
+	signingKeyWithParentsCascadingCtx = newContextual[bool]("signingKeyWithParentsCascading")
+
 	// Relationship Contexts for user
 	userWithParentsCascadingCtx  = newContextual[bool]("userWithParentsCascading")
+	userRelAccountExportsCtx     = newContextual[bool]("account_export.user.fk_account_export_0")
+	userRelAPIKeysCtx            = newContextual[bool]("api_key.user.fk_api_key_0")
 	userRelCredentialsCtx        = newContextual[bool]("credential.user.fk_credential_0")
+	userRelEmailTokensCtx        = newContextual[bool]("email_token.user.fk_email_token_0")
 	userRelFilesCtx              = newContextual[bool]("file.user.fk_file_0")
+	userRelIdentitiesCtx         = newContextual[bool]("identity.user.fk_identity_0")
 	userRelItemsCtx              = newContextual[bool]("item.user.fk_item_0")
+	userRelLoginChallengesCtx    = newContextual[bool]("login_challenge.user.fk_login_challenge_0")
+	userRelOauthCodesCtx         = newContextual[bool]("oauth_code.user.fk_oauth_code_0")
+	userRelOauthConsentsCtx      = newContextual[bool]("oauth_consent.user.fk_oauth_consent_0")
+	userRelRecoveryCodesCtx      = newContextual[bool]("recovery_code.user.fk_recovery_code_0")
+	userRelSessionsCtx           = newContextual[bool]("session.user.fk_session_0")
 	userRelProfilePictureFileCtx = newContextual[bool]("file.user.fk_user_0")
 )
 
@@ -12,17 +12,201 @@ import (
 )
 
 type Factory struct {
-	baseCredentialMods      CredentialModSlice
-	baseFileMods            FileModSlice
-	baseItemMods            ItemModSlice
-	baseSchemaMigrationMods SchemaMigrationModSlice
-	baseUserMods            UserModSlice
+	baseAccountExportMods     AccountExportModSlice
+	baseAccountExportPageMods AccountExportPageModSlice
+	baseAPIKeyMods            APIKeyModSlice
+	baseAuditEventMods        AuditEventModSlice
+	baseCeremonyMods          CeremonyModSlice
+	baseCredentialMods        CredentialModSlice
+	baseEmailTokenMods        EmailTokenModSlice
+	baseFileMods              FileModSlice
+	baseIdentityMods          IdentityModSlice
+	baseItemMods              ItemModSlice
+	baseItemSearchMods        ItemSearchModSlice
+	baseLoginAttemptMods      LoginAttemptModSlice
+	baseLoginChallengeMods    LoginChallengeModSlice
+	baseOauthClientMods       OauthClientModSlice
+	baseOauthCodeMods         OauthCodeModSlice
+	baseOauthConsentMods      OauthConsentModSlice
+	baseRateLimitMods         RateLimitModSlice
+	baseRecoveryCodeMods      RecoveryCodeModSlice
+	baseSchemaMigrationMods   SchemaMigrationModSlice
+	baseSessionMods           SessionModSlice
+	baseSigningKeyMods        SigningKeyModSlice
+	baseUserMods              UserModSlice
 }
 
 func New() *Factory {
 	return &Factory{}
 }
 
+func (f *Factory) NewAccountExport(mods ...AccountExportMod) *AccountExportTemplate {
+	return f.NewAccountExportWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewAccountExportWithContext(ctx context.Context, mods ...AccountExportMod) *AccountExportTemplate {
+	o := &AccountExportTemplate{f: f}
+
+	if f != nil {
+		f.baseAccountExportMods.Apply(ctx, o)
+	}
+
+	AccountExportModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingAccountExport(m *models.AccountExport) *AccountExportTemplate {
+	o := &AccountExportTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.Status = func() string { return m.Status }
+	o.Size = func() int32 { return m.Size }
+	o.Error = func() null.Val[string] { return m.Error }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.FinishedAt = func() null.Val[time.Time] { return m.FinishedAt }
+	o.HeartbeatAt = func() time.Time { return m.HeartbeatAt }
+	o.ExpiresAt = func() time.Time { return m.ExpiresAt }
+	o.UserID = func() int32 { return m.UserID }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		AccountExportMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+	if len(m.R.ExportAccountExportPages) > 0 {
+		AccountExportMods.AddExistingExportAccountExportPages(m.R.ExportAccountExportPages...).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewAccountExportPage(mods ...AccountExportPageMod) *AccountExportPageTemplate {
+	return f.NewAccountExportPageWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewAccountExportPageWithContext(ctx context.Context, mods ...AccountExportPageMod) *AccountExportPageTemplate {
+	o := &AccountExportPageTemplate{f: f}
+
+	if f != nil {
+		f.baseAccountExportPageMods.Apply(ctx, o)
+	}
+
+	AccountExportPageModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingAccountExportPage(m *models.AccountExportPage) *AccountExportPageTemplate {
+	o := &AccountExportPageTemplate{f: f, alreadyPersisted: true}
+
+	o.ExportID = func() string { return m.ExportID }
+	o.Page = func() int32 { return m.Page }
+	o.Data = func() []byte { return m.Data }
+
+	ctx := context.Background()
+	if m.R.ExportAccountExport != nil {
+		AccountExportPageMods.WithExistingExportAccountExport(m.R.ExportAccountExport).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewAPIKey(mods ...APIKeyMod) *APIKeyTemplate {
+	return f.NewAPIKeyWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewAPIKeyWithContext(ctx context.Context, mods ...APIKeyMod) *APIKeyTemplate {
+	o := &APIKeyTemplate{f: f}
+
+	if f != nil {
+		f.baseAPIKeyMods.Apply(ctx, o)
+	}
+
+	APIKeyModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingAPIKey(m *models.APIKey) *APIKeyTemplate {
+	o := &APIKeyTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int32 { return m.ID }
+	o.Name = func() string { return m.Name }
+	o.Prefix = func() string { return m.Prefix }
+	o.Hash = func() []byte { return m.Hash }
+	o.Scopes = func() string { return m.Scopes }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.ExpiresAt = func() null.Val[time.Time] { return m.ExpiresAt }
+	o.LastUsed = func() null.Val[time.Time] { return m.LastUsed }
+	o.UserID = func() int32 { return m.UserID }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		APIKeyMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewAuditEvent(mods ...AuditEventMod) *AuditEventTemplate {
+	return f.NewAuditEventWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewAuditEventWithContext(ctx context.Context, mods ...AuditEventMod) *AuditEventTemplate {
+	o := &AuditEventTemplate{f: f}
+
+	if f != nil {
+		f.baseAuditEventMods.Apply(ctx, o)
+	}
+
+	AuditEventModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingAuditEvent(m *models.AuditEvent) *AuditEventTemplate {
+	o := &AuditEventTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int32 { return m.ID }
+	o.ActorID = func() null.Val[int32] { return m.ActorID }
+	o.UserID = func() null.Val[int32] { return m.UserID }
+	o.Action = func() string { return m.Action }
+	o.Target = func() string { return m.Target }
+	o.IP = func() string { return m.IP }
+	o.UserAgent = func() string { return m.UserAgent }
+	o.Outcome = func() string { return m.Outcome }
+	o.Metadata = func() string { return m.Metadata }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+
+	return o
+}
+
+func (f *Factory) NewCeremony(mods ...CeremonyMod) *CeremonyTemplate {
+	return f.NewCeremonyWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewCeremonyWithContext(ctx context.Context, mods ...CeremonyMod) *CeremonyTemplate {
+	o := &CeremonyTemplate{f: f}
+
+	if f != nil {
+		f.baseCeremonyMods.Apply(ctx, o)
+	}
+
+	CeremonyModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingCeremony(m *models.Ceremony) *CeremonyTemplate {
+	o := &CeremonyTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.Data = func() []byte { return m.Data }
+	o.ExpiresAt = func() time.Time { return m.ExpiresAt }
+
+	return o
+}
+
 func (f *Factory) NewCredential(mods ...CredentialMod) *CredentialTemplate {
 	return f.NewCredentialWithContext(context.Background(), mods...)
 }
@@ -54,6 +238,8 @@ func (f *Factory) FromExistingCredential(m *models.Credential) *CredentialTempla
 	o.CreatedAt = func() time.Time { return m.CreatedAt }
 	o.LastUsed = func() time.Time { return m.LastUsed }
 	o.UserID = func() int32 { return m.UserID }
+	o.Name = func() string { return m.Name }
+	o.CloneWarning = func() bool { return m.CloneWarning }
 
 	ctx := context.Background()
 	if m.R.User != nil {
@@ -63,6 +249,42 @@ func (f *Factory) FromExistingCredential(m *models.Credential) *CredentialTempla
 	return o
 }
 
+func (f *Factory) NewEmailToken(mods ...EmailTokenMod) *EmailTokenTemplate {
+	return f.NewEmailTokenWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewEmailTokenWithContext(ctx context.Context, mods ...EmailTokenMod) *EmailTokenTemplate {
+	o := &EmailTokenTemplate{f: f}
+
+	if f != nil {
+		f.baseEmailTokenMods.Apply(ctx, o)
+	}
+
+	EmailTokenModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingEmailToken(m *models.EmailToken) *EmailTokenTemplate {
+	o := &EmailTokenTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int32 { return m.ID }
+	o.Hash = func() []byte { return m.Hash }
+	o.Purpose = func() string { return m.Purpose }
+	o.Email = func() string { return m.Email }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.ExpiresAt = func() time.Time { return m.ExpiresAt }
+	o.UsedAt = func() null.Val[time.Time] { return m.UsedAt }
+	o.UserID = func() int32 { return m.UserID }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		EmailTokenMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
 func (f *Factory) NewFile(mods ...FileMod) *FileTemplate {
 	return f.NewFileWithContext(context.Background(), mods...)
 }
@@ -98,6 +320,41 @@ func (f *Factory) FromExistingFile(m *models.File) *FileTemplate {
 	return o
 }
 
+func (f *Factory) NewIdentity(mods ...IdentityMod) *IdentityTemplate {
+	return f.NewIdentityWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewIdentityWithContext(ctx context.Context, mods ...IdentityMod) *IdentityTemplate {
+	o := &IdentityTemplate{f: f}
+
+	if f != nil {
+		f.baseIdentityMods.Apply(ctx, o)
+	}
+
+	IdentityModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingIdentity(m *models.Identity) *IdentityTemplate {
+	o := &IdentityTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int32 { return m.ID }
+	o.Provider = func() string { return m.Provider }
+	o.Subject = func() string { return m.Subject }
+	o.Email = func() null.Val[string] { return m.Email }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.LastUsed = func() time.Time { return m.LastUsed }
+	o.UserID = func() int32 { return m.UserID }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		IdentityMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
 func (f *Factory) NewItem(mods ...ItemMod) *ItemTemplate {
 	return f.NewItemWithContext(context.Background(), mods...)
 }
@@ -133,6 +390,268 @@ func (f *Factory) FromExistingItem(m *models.Item) *ItemTemplate {
 	return o
 }
 
+func (f *Factory) NewItemSearch(mods ...ItemSearchMod) *ItemSearchTemplate {
+	return f.NewItemSearchWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewItemSearchWithContext(ctx context.Context, mods ...ItemSearchMod) *ItemSearchTemplate {
+	o := &ItemSearchTemplate{f: f}
+
+	if f != nil {
+		f.baseItemSearchMods.Apply(ctx, o)
+	}
+
+	ItemSearchModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingItemSearch(m *models.ItemSearch) *ItemSearchTemplate {
+	o := &ItemSearchTemplate{f: f, alreadyPersisted: true}
+
+	o.Name = func() null.Val[string] { return m.Name }
+	o.Description = func() null.Val[string] { return m.Description }
+	o.ItemSearch = func() null.Val[string] { return m.ItemSearch }
+	o.Rank = func() null.Val[string] { return m.Rank }
+
+	return o
+}
+
+func (f *Factory) NewLoginAttempt(mods ...LoginAttemptMod) *LoginAttemptTemplate {
+	return f.NewLoginAttemptWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewLoginAttemptWithContext(ctx context.Context, mods ...LoginAttemptMod) *LoginAttemptTemplate {
+	o := &LoginAttemptTemplate{f: f}
+
+	if f != nil {
+		f.baseLoginAttemptMods.Apply(ctx, o)
+	}
+
+	LoginAttemptModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingLoginAttempt(m *models.LoginAttempt) *LoginAttemptTemplate {
+	o := &LoginAttemptTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int32 { return m.ID }
+	o.Username = func() string { return m.Username }
+	o.IP = func() string { return m.IP }
+	o.UserAgent = func() string { return m.UserAgent }
+	o.Factor = func() string { return m.Factor }
+	o.Success = func() bool { return m.Success }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+
+	return o
+}
+
+func (f *Factory) NewLoginChallenge(mods ...LoginChallengeMod) *LoginChallengeTemplate {
+	return f.NewLoginChallengeWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewLoginChallengeWithContext(ctx context.Context, mods ...LoginChallengeMod) *LoginChallengeTemplate {
+	o := &LoginChallengeTemplate{f: f}
+
+	if f != nil {
+		f.baseLoginChallengeMods.Apply(ctx, o)
+	}
+
+	LoginChallengeModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingLoginChallenge(m *models.LoginChallenge) *LoginChallengeTemplate {
+	o := &LoginChallengeTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.Failures = func() int32 { return m.Failures }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.ExpiresAt = func() time.Time { return m.ExpiresAt }
+	o.UserID = func() int32 { return m.UserID }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		LoginChallengeMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewOauthClient(mods ...OauthClientMod) *OauthClientTemplate {
+	return f.NewOauthClientWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewOauthClientWithContext(ctx context.Context, mods ...OauthClientMod) *OauthClientTemplate {
+	o := &OauthClientTemplate{f: f}
+
+	if f != nil {
+		f.baseOauthClientMods.Apply(ctx, o)
+	}
+
+	OauthClientModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingOauthClient(m *models.OauthClient) *OauthClientTemplate {
+	o := &OauthClientTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.Name = func() string { return m.Name }
+	o.SecretHash = func() null.Val[[]byte] { return m.SecretHash }
+	o.RedirectUris = func() string { return m.RedirectUris }
+	o.Scopes = func() string { return m.Scopes }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+
+	ctx := context.Background()
+	if len(m.R.ClientOauthCodes) > 0 {
+		OauthClientMods.AddExistingClientOauthCodes(m.R.ClientOauthCodes...).Apply(ctx, o)
+	}
+	if len(m.R.ClientOauthConsents) > 0 {
+		OauthClientMods.AddExistingClientOauthConsents(m.R.ClientOauthConsents...).Apply(ctx, o)
+	}
+	if len(m.R.ClientSessions) > 0 {
+		OauthClientMods.AddExistingClientSessions(m.R.ClientSessions...).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewOauthCode(mods ...OauthCodeMod) *OauthCodeTemplate {
+	return f.NewOauthCodeWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewOauthCodeWithContext(ctx context.Context, mods ...OauthCodeMod) *OauthCodeTemplate {
+	o := &OauthCodeTemplate{f: f}
+
+	if f != nil {
+		f.baseOauthCodeMods.Apply(ctx, o)
+	}
+
+	OauthCodeModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingOauthCode(m *models.OauthCode) *OauthCodeTemplate {
+	o := &OauthCodeTemplate{f: f, alreadyPersisted: true}
+
+	o.Hash = func() []byte { return m.Hash }
+	o.RedirectURI = func() string { return m.RedirectURI }
+	o.Scopes = func() string { return m.Scopes }
+	o.Nonce = func() string { return m.Nonce }
+	o.CodeChallenge = func() string { return m.CodeChallenge }
+	o.ExpiresAt = func() time.Time { return m.ExpiresAt }
+	o.ClientID = func() string { return m.ClientID }
+	o.UserID = func() int32 { return m.UserID }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		OauthCodeMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+	if m.R.ClientOauthClient != nil {
+		OauthCodeMods.WithExistingClientOauthClient(m.R.ClientOauthClient).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewOauthConsent(mods ...OauthConsentMod) *OauthConsentTemplate {
+	return f.NewOauthConsentWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewOauthConsentWithContext(ctx context.Context, mods ...OauthConsentMod) *OauthConsentTemplate {
+	o := &OauthConsentTemplate{f: f}
+
+	if f != nil {
+		f.baseOauthConsentMods.Apply(ctx, o)
+	}
+
+	OauthConsentModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingOauthConsent(m *models.OauthConsent) *OauthConsentTemplate {
+	o := &OauthConsentTemplate{f: f, alreadyPersisted: true}
+
+	o.Scopes = func() string { return m.Scopes }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.ClientID = func() string { return m.ClientID }
+	o.UserID = func() int32 { return m.UserID }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		OauthConsentMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+	if m.R.ClientOauthClient != nil {
+		OauthConsentMods.WithExistingClientOauthClient(m.R.ClientOauthClient).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewRateLimit(mods ...RateLimitMod) *RateLimitTemplate {
+	return f.NewRateLimitWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewRateLimitWithContext(ctx context.Context, mods ...RateLimitMod) *RateLimitTemplate {
+	o := &RateLimitTemplate{f: f}
+
+	if f != nil {
+		f.baseRateLimitMods.Apply(ctx, o)
+	}
+
+	RateLimitModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingRateLimit(m *models.RateLimit) *RateLimitTemplate {
+	o := &RateLimitTemplate{f: f, alreadyPersisted: true}
+
+	o.Key = func() string { return m.Key }
+	o.Tokens = func() float32 { return m.Tokens }
+	o.UpdatedAt = func() time.Time { return m.UpdatedAt }
+
+	return o
+}
+
+func (f *Factory) NewRecoveryCode(mods ...RecoveryCodeMod) *RecoveryCodeTemplate {
+	return f.NewRecoveryCodeWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewRecoveryCodeWithContext(ctx context.Context, mods ...RecoveryCodeMod) *RecoveryCodeTemplate {
+	o := &RecoveryCodeTemplate{f: f}
+
+	if f != nil {
+		f.baseRecoveryCodeMods.Apply(ctx, o)
+	}
+
+	RecoveryCodeModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingRecoveryCode(m *models.RecoveryCode) *RecoveryCodeTemplate {
+	o := &RecoveryCodeTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int32 { return m.ID }
+	o.Hash = func() []byte { return m.Hash }
+	o.UsedAt = func() null.Val[time.Time] { return m.UsedAt }
+	o.UserID = func() int32 { return m.UserID }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		RecoveryCodeMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
 func (f *Factory) NewSchemaMigration(mods ...SchemaMigrationMod) *SchemaMigrationTemplate {
 	return f.NewSchemaMigrationWithContext(context.Background(), mods...)
 }
@@ -157,6 +676,77 @@ func (f *Factory) FromExistingSchemaMigration(m *models.SchemaMigration) *Schema
 	return o
 }
 
+func (f *Factory) NewSession(mods ...SessionMod) *SessionTemplate {
+	return f.NewSessionWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewSessionWithContext(ctx context.Context, mods ...SessionMod) *SessionTemplate {
+	o := &SessionTemplate{f: f}
+
+	if f != nil {
+		f.baseSessionMods.Apply(ctx, o)
+	}
+
+	SessionModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingSession(m *models.Session) *SessionTemplate {
+	o := &SessionTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.RefreshHash = func() []byte { return m.RefreshHash }
+	o.PreviousRefreshHash = func() null.Val[[]byte] { return m.PreviousRefreshHash }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.LastSeen = func() time.Time { return m.LastSeen }
+	o.RotatedAt = func() time.Time { return m.RotatedAt }
+	o.ExpiresAt = func() time.Time { return m.ExpiresAt }
+	o.RevokedAt = func() null.Val[time.Time] { return m.RevokedAt }
+	o.UserAgent = func() string { return m.UserAgent }
+	o.IP = func() string { return m.IP }
+	o.UserID = func() int32 { return m.UserID }
+	o.ClientID = func() null.Val[string] { return m.ClientID }
+	o.Scopes = func() null.Val[string] { return m.Scopes }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		SessionMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+	if m.R.ClientOauthClient != nil {
+		SessionMods.WithExistingClientOauthClient(m.R.ClientOauthClient).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewSigningKey(mods ...SigningKeyMod) *SigningKeyTemplate {
+	return f.NewSigningKeyWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewSigningKeyWithContext(ctx context.Context, mods ...SigningKeyMod) *SigningKeyTemplate {
+	o := &SigningKeyTemplate{f: f}
+
+	if f != nil {
+		f.baseSigningKeyMods.Apply(ctx, o)
+	}
+
+	SigningKeyModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingSigningKey(m *models.SigningKey) *SigningKeyTemplate {
+	o := &SigningKeyTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.Algorithm = func() string { return m.Algorithm }
+	o.PrivateKey = func() []byte { return m.PrivateKey }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+
+	return o
+}
+
 func (f *Factory) NewUser(mods ...UserMod) *UserTemplate {
 	return f.NewUserWithContext(context.Background(), mods...)
 }
@@ -181,17 +771,53 @@ func (f *Factory) FromExistingUser(m *models.User) *UserTemplate {
 	o.Password = func() string { return m.Password }
 	o.ProfilePictureID = func() null.Val[int32] { return m.ProfilePictureID }
 	o.WebauthnID = func() string { return m.WebauthnID }
+	o.TokenVersion = func() int32 { return m.TokenVersion }
+	o.Role = func() string { return m.Role }
+	o.DisabledAt = func() null.Val[time.Time] { return m.DisabledAt }
+	o.PasswordResetRequired = func() bool { return m.PasswordResetRequired }
+	o.TotpSecret = func() null.Val[string] { return m.TotpSecret }
+	o.TotpEnabled = func() bool { return m.TotpEnabled }
+	o.TotpLastStep = func() int32 { return m.TotpLastStep }
+	o.Email = func() null.Val[string] { return m.Email }
+	o.EmailVerifiedAt = func() null.Val[time.Time] { return m.EmailVerifiedAt }
 
 	ctx := context.Background()
+	if len(m.R.AccountExports) > 0 {
+		UserMods.AddExistingAccountExports(m.R.AccountExports...).Apply(ctx, o)
+	}
+	if len(m.R.APIKeys) > 0 {
+		UserMods.AddExistingAPIKeys(m.R.APIKeys...).Apply(ctx, o)
+	}
 	if len(m.R.Credentials) > 0 {
 		UserMods.AddExistingCredentials(m.R.Credentials...).Apply(ctx, o)
 	}
+	if len(m.R.EmailTokens) > 0 {
+		UserMods.AddExistingEmailTokens(m.R.EmailTokens...).Apply(ctx, o)
+	}
 	if len(m.R.Files) > 0 {
 		UserMods.AddExistingFiles(m.R.Files...).Apply(ctx, o)
 	}
+	if len(m.R.Identities) > 0 {
+		UserMods.AddExistingIdentities(m.R.Identities...).Apply(ctx, o)
+	}
 	if len(m.R.Items) > 0 {
 		UserMods.AddExistingItems(m.R.Items...).Apply(ctx, o)
 	}
+	if len(m.R.LoginChallenges) > 0 {
+		UserMods.AddExistingLoginChallenges(m.R.LoginChallenges...).Apply(ctx, o)
+	}
+	if len(m.R.OauthCodes) > 0 {
+		UserMods.AddExistingOauthCodes(m.R.OauthCodes...).Apply(ctx, o)
+	}
+	if len(m.R.OauthConsents) > 0 {
+		UserMods.AddExistingOauthConsents(m.R.OauthConsents...).Apply(ctx, o)
+	}
+	if len(m.R.RecoveryCodes) > 0 {
+		UserMods.AddExistingRecoveryCodes(m.R.RecoveryCodes...).Apply(ctx, o)
+	}
+	if len(m.R.Sessions) > 0 {
+		UserMods.AddExistingSessions(m.R.Sessions...).Apply(ctx, o)
+	}
 	if m.R.ProfilePictureFile != nil {
 		UserMods.WithExistingProfilePictureFile(m.R.ProfilePictureFile).Apply(ctx, o)
 	}
@@ -199,6 +825,46 @@ func (f *Factory) FromExistingUser(m *models.User) *UserTemplate {
 	return o
 }
 
+func (f *Factory) ClearBaseAccountExportMods() {
+	f.baseAccountExportMods = nil
+}
+
+func (f *Factory) AddBaseAccountExportMod(mods ...AccountExportMod) {
+	f.baseAccountExportMods = append(f.baseAccountExportMods, mods...)
+}
+
+func (f *Factory) ClearBaseAccountExportPageMods() {
+	f.baseAccountExportPageMods = nil
+}
+
+func (f *Factory) AddBaseAccountExportPageMod(mods ...AccountExportPageMod) {
+	f.baseAccountExportPageMods = append(f.baseAccountExportPageMods, mods...)
+}
+
+func (f *Factory) ClearBaseAPIKeyMods() {
+	f.baseAPIKeyMods = nil
+}
+
+func (f *Factory) AddBaseAPIKeyMod(mods ...APIKeyMod) {
+	f.baseAPIKeyMods = append(f.baseAPIKeyMods, mods...)
+}
+
+func (f *Factory) ClearBaseAuditEventMods() {
+	f.baseAuditEventMods = nil
+}
+
+func (f *Factory) AddBaseAuditEventMod(mods ...AuditEventMod) {
+	f.baseAuditEventMods = append(f.baseAuditEventMods, mods...)
+}
+
+func (f *Factory) ClearBaseCeremonyMods() {
+	f.baseCeremonyMods = nil
+}
+
+func (f *Factory) AddBaseCeremonyMod(mods ...CeremonyMod) {
+	f.baseCeremonyMods = append(f.baseCeremonyMods, mods...)
+}
+
 func (f *Factory) ClearBaseCredentialMods() {
 	f.baseCredentialMods = nil
 }
@@ -207,6 +873,14 @@ func (f *Factory) AddBaseCredentialMod(mods ...CredentialMod) {
 	f.baseCredentialMods = append(f.baseCredentialMods, mods...)
 }
 
+func (f *Factory) ClearBaseEmailTokenMods() {
+	f.baseEmailTokenMods = nil
+}
+
+func (f *Factory) AddBaseEmailTokenMod(mods ...EmailTokenMod) {
+	f.baseEmailTokenMods = append(f.baseEmailTokenMods, mods...)
+}
+
 func (f *Factory) ClearBaseFileMods() {
 	f.baseFileMods = nil
 }
@@ -215,6 +889,14 @@ func (f *Factory) AddBaseFileMod(mods ...FileMod) {
 	f.baseFileMods = append(f.baseFileMods, mods...)
 }
 
+func (f *Factory) ClearBaseIdentityMods() {
+	f.baseIdentityMods = nil
+}
+
+func (f *Factory) AddBaseIdentityMod(mods ...IdentityMod) {
+	f.baseIdentityMods = append(f.baseIdentityMods, mods...)
+}
+
 func (f *Factory) ClearBaseItemMods() {
 	f.baseItemMods = nil
 }
@@ -223,6 +905,70 @@ func (f *Factory) AddBaseItemMod(mods ...ItemMod) {
 	f.baseItemMods = append(f.baseItemMods, mods...)
 }
 
+func (f *Factory) ClearBaseItemSearchMods() {
+	f.baseItemSearchMods = nil
+}
+
+func (f *Factory) AddBaseItemSearchMod(mods ...ItemSearchMod) {
+	f.baseItemSearchMods = append(f.baseItemSearchMods, mods...)
+}
+
+func (f *Factory) ClearBaseLoginAttemptMods() {
+	f.baseLoginAttemptMods = nil
+}
+
+func (f *Factory) AddBaseLoginAttemptMod(mods ...LoginAttemptMod) {
+	f.baseLoginAttemptMods = append(f.baseLoginAttemptMods, mods...)
+}
+
+func (f *Factory) ClearBaseLoginChallengeMods() {
+	f.baseLoginChallengeMods = nil
+}
+
+func (f *Factory) AddBaseLoginChallengeMod(mods ...LoginChallengeMod) {
+	f.baseLoginChallengeMods = append(f.baseLoginChallengeMods, mods...)
+}
+
+func (f *Factory) ClearBaseOauthClientMods() {
+	f.baseOauthClientMods = nil
+}
+
+func (f *Factory) AddBaseOauthClientMod(mods ...OauthClientMod) {
+	f.baseOauthClientMods = append(f.baseOauthClientMods, mods...)
+}
+
+func (f *Factory) ClearBaseOauthCodeMods() {
+	f.baseOauthCodeMods = nil
+}
+
+func (f *Factory) AddBaseOauthCodeMod(mods ...OauthCodeMod) {
+	f.baseOauthCodeMods = append(f.baseOauthCodeMods, mods...)
+}
+
+func (f *Factory) ClearBaseOauthConsentMods() {
+	f.baseOauthConsentMods = nil
+}
+
+func (f *Factory) AddBaseOauthConsentMod(mods ...OauthConsentMod) {
+	f.baseOauthConsentMods = append(f.baseOauthConsentMods, mods...)
+}
+
+func (f *Factory) ClearBaseRateLimitMods() {
+	f.baseRateLimitMods = nil
+}
+
+func (f *Factory) AddBaseRateLimitMod(mods ...RateLimitMod) {
+	f.baseRateLimitMods = append(f.baseRateLimitMods, mods...)
+}
+
+func (f *Factory) ClearBaseRecoveryCodeMods() {
+	f.baseRecoveryCodeMods = nil
+}
+
+func (f *Factory) AddBaseRecoveryCodeMod(mods ...RecoveryCodeMod) {
+	f.baseRecoveryCodeMods = append(f.baseRecoveryCodeMods, mods...)
+}
+
 func (f *Factory) ClearBaseSchemaMigrationMods() {
 	f.baseSchemaMigrationMods = nil
 }
@@ -231,6 +977,22 @@ func (f *Factory) AddBaseSchemaMigrationMod(mods ...SchemaMigrationMod) {
 	f.baseSchemaMigrationMods = append(f.baseSchemaMigrationMods, mods...)
 }
 
+func (f *Factory) ClearBaseSessionMods() {
+	f.baseSessionMods = nil
+}
+
+func (f *Factory) AddBaseSessionMod(mods ...SessionMod) {
+	f.baseSessionMods = append(f.baseSessionMods, mods...)
+}
+
+func (f *Factory) ClearBaseSigningKeyMods() {
+	f.baseSigningKeyMods = nil
+}
+
+func (f *Factory) AddBaseSigningKeyMod(mods ...SigningKeyMod) {
+	f.baseSigningKeyMods = append(f.baseSigningKeyMods, mods...)
+}
+
 func (f *Factory) ClearBaseUserMods() {
 	f.baseUserMods = nil
 }
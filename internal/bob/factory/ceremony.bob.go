@@ -0,0 +1,344 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/spotdemo4/ts-server/internal/bob/models"
+	"github.com/stephenafamo/bob"
+)
+
+type CeremonyMod interface {
+	Apply(context.Context, *CeremonyTemplate)
+}
+
+type CeremonyModFunc func(context.Context, *CeremonyTemplate)
+
+func (f CeremonyModFunc) Apply(ctx context.Context, n *CeremonyTemplate) {
+	f(ctx, n)
+}
+
+type CeremonyModSlice []CeremonyMod
+
+func (mods CeremonyModSlice) Apply(ctx context.Context, n *CeremonyTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// CeremonyTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type CeremonyTemplate struct {
+	ID        func() string
+	Data      func() []byte
+	ExpiresAt func() time.Time
+
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+// Apply mods to the CeremonyTemplate
+func (o *CeremonyTemplate) Apply(ctx context.Context, mods ...CeremonyMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.Ceremony
+// according to the relationships in the template. Nothing is inserted into the db
+func (t CeremonyTemplate) setModelRels(o *models.Ceremony) {}
+
+// BuildSetter returns an *models.CeremonySetter
+// this does nothing with the relationship templates
+func (o CeremonyTemplate) BuildSetter() *models.CeremonySetter {
+	m := &models.CeremonySetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.Data != nil {
+		val := o.Data()
+		m.Data = omit.From(val)
+	}
+	if o.ExpiresAt != nil {
+		val := o.ExpiresAt()
+		m.ExpiresAt = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.CeremonySetter
+// this does nothing with the relationship templates
+func (o CeremonyTemplate) BuildManySetter(number int) []*models.CeremonySetter {
+	m := make([]*models.CeremonySetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.Ceremony
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use CeremonyTemplate.Create
+func (o CeremonyTemplate) Build() *models.Ceremony {
+	m := &models.Ceremony{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.Data != nil {
+		m.Data = o.Data()
+	}
+	if o.ExpiresAt != nil {
+		m.ExpiresAt = o.ExpiresAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.CeremonySlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use CeremonyTemplate.CreateMany
+func (o CeremonyTemplate) BuildMany(number int) models.CeremonySlice {
+	m := make(models.CeremonySlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableCeremony(m *models.CeremonySetter) {
+	if !(m.ID.IsValue()) {
+		val := random_string(nil)
+		m.ID = omit.From(val)
+	}
+	if !(m.Data.IsValue()) {
+		val := random___byte(nil)
+		m.Data = omit.From(val)
+	}
+	if !(m.ExpiresAt.IsValue()) {
+		val := random_time_Time(nil)
+		m.ExpiresAt = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.Ceremony
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *CeremonyTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Ceremony) error {
+	var err error
+
+	return err
+}
+
+// Create builds a ceremony and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *CeremonyTemplate) Create(ctx context.Context, exec bob.Executor) (*models.Ceremony, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableCeremony(opt)
+
+	m, err := models.Ceremonies.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a ceremony and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *CeremonyTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.Ceremony {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a ceremony and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *CeremonyTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.Ceremony {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple ceremonies and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o CeremonyTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.CeremonySlice, error) {
+	var err error
+	m := make(models.CeremonySlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple ceremonies and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o CeremonyTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.CeremonySlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple ceremonies and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o CeremonyTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.CeremonySlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// Ceremony has methods that act as mods for the CeremonyTemplate
+var CeremonyMods ceremonyMods
+
+type ceremonyMods struct{}
+
+func (m ceremonyMods) RandomizeAllColumns(f *faker.Faker) CeremonyMod {
+	return CeremonyModSlice{
+		CeremonyMods.RandomID(f),
+		CeremonyMods.RandomData(f),
+		CeremonyMods.RandomExpiresAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m ceremonyMods) ID(val string) CeremonyMod {
+	return CeremonyModFunc(func(_ context.Context, o *CeremonyTemplate) {
+		o.ID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m ceremonyMods) IDFunc(f func() string) CeremonyMod {
+	return CeremonyModFunc(func(_ context.Context, o *CeremonyTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m ceremonyMods) UnsetID() CeremonyMod {
+	return CeremonyModFunc(func(_ context.Context, o *CeremonyTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m ceremonyMods) RandomID(f *faker.Faker) CeremonyMod {
+	return CeremonyModFunc(func(_ context.Context, o *CeremonyTemplate) {
+		o.ID = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m ceremonyMods) Data(val []byte) CeremonyMod {
+	return CeremonyModFunc(func(_ context.Context, o *CeremonyTemplate) {
+		o.Data = func() []byte { return val }
+	})
+}
+
+// Set the Column from the function
+func (m ceremonyMods) DataFunc(f func() []byte) CeremonyMod {
+	return CeremonyModFunc(func(_ context.Context, o *CeremonyTemplate) {
+		o.Data = f
+	})
+}
+
+// Clear any values for the column
+func (m ceremonyMods) UnsetData() CeremonyMod {
+	return CeremonyModFunc(func(_ context.Context, o *CeremonyTemplate) {
+		o.Data = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m ceremonyMods) RandomData(f *faker.Faker) CeremonyMod {
+	return CeremonyModFunc(func(_ context.Context, o *CeremonyTemplate) {
+		o.Data = func() []byte {
+			return random___byte(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m ceremonyMods) ExpiresAt(val time.Time) CeremonyMod {
+	return CeremonyModFunc(func(_ context.Context, o *CeremonyTemplate) {
+		o.ExpiresAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m ceremonyMods) ExpiresAtFunc(f func() time.Time) CeremonyMod {
+	return CeremonyModFunc(func(_ context.Context, o *CeremonyTemplate) {
+		o.ExpiresAt = f
+	})
+}
+
+// Clear any values for the column
+func (m ceremonyMods) UnsetExpiresAt() CeremonyMod {
+	return CeremonyModFunc(func(_ context.Context, o *CeremonyTemplate) {
+		o.ExpiresAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m ceremonyMods) RandomExpiresAt(f *faker.Faker) CeremonyMod {
+	return CeremonyModFunc(func(_ context.Context, o *CeremonyTemplate) {
+		o.ExpiresAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m ceremonyMods) WithParentsCascading() CeremonyMod {
+	return CeremonyModFunc(func(ctx context.Context, o *CeremonyTemplate) {
+		if isDone, _ := ceremonyWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = ceremonyWithParentsCascadingCtx.WithValue(ctx, true)
+	})
+}
@@ -49,6 +49,8 @@ type CredentialTemplate struct {
 	CreatedAt             func() time.Time
 	LastUsed              func() time.Time
 	UserID                func() int32
+	Name                  func() string
+	CloneWarning          func() bool
 
 	r credentialR
 	f *Factory
@@ -135,6 +137,14 @@ func (o CredentialTemplate) BuildSetter() *models.CredentialSetter {
 		val := o.UserID()
 		m.UserID = omit.From(val)
 	}
+	if o.Name != nil {
+		val := o.Name()
+		m.Name = omit.From(val)
+	}
+	if o.CloneWarning != nil {
+		val := o.CloneWarning()
+		m.CloneWarning = omit.From(val)
+	}
 
 	return m
 }
@@ -193,6 +203,12 @@ func (o CredentialTemplate) Build() *models.Credential {
 	if o.UserID != nil {
 		m.UserID = o.UserID()
 	}
+	if o.Name != nil {
+		m.Name = o.Name()
+	}
+	if o.CloneWarning != nil {
+		m.CloneWarning = o.CloneWarning()
+	}
 
 	o.setModelRels(m)
 
@@ -368,6 +384,8 @@ func (m credentialMods) RandomizeAllColumns(f *faker.Faker) CredentialMod {
 		CredentialMods.RandomCreatedAt(f),
 		CredentialMods.RandomLastUsed(f),
 		CredentialMods.RandomUserID(f),
+		CredentialMods.RandomName(f),
+		CredentialMods.RandomCloneWarning(f),
 	}
 }
 
@@ -875,6 +893,68 @@ func (m credentialMods) RandomUserID(f *faker.Faker) CredentialMod {
 	})
 }
 
+// Set the model columns to this value
+func (m credentialMods) Name(val string) CredentialMod {
+	return CredentialModFunc(func(_ context.Context, o *CredentialTemplate) {
+		o.Name = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m credentialMods) NameFunc(f func() string) CredentialMod {
+	return CredentialModFunc(func(_ context.Context, o *CredentialTemplate) {
+		o.Name = f
+	})
+}
+
+// Clear any values for the column
+func (m credentialMods) UnsetName() CredentialMod {
+	return CredentialModFunc(func(_ context.Context, o *CredentialTemplate) {
+		o.Name = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m credentialMods) RandomName(f *faker.Faker) CredentialMod {
+	return CredentialModFunc(func(_ context.Context, o *CredentialTemplate) {
+		o.Name = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m credentialMods) CloneWarning(val bool) CredentialMod {
+	return CredentialModFunc(func(_ context.Context, o *CredentialTemplate) {
+		o.CloneWarning = func() bool { return val }
+	})
+}
+
+// Set the Column from the function
+func (m credentialMods) CloneWarningFunc(f func() bool) CredentialMod {
+	return CredentialModFunc(func(_ context.Context, o *CredentialTemplate) {
+		o.CloneWarning = f
+	})
+}
+
+// Clear any values for the column
+func (m credentialMods) UnsetCloneWarning() CredentialMod {
+	return CredentialModFunc(func(_ context.Context, o *CredentialTemplate) {
+		o.CloneWarning = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m credentialMods) RandomCloneWarning(f *faker.Faker) CredentialMod {
+	return CredentialModFunc(func(_ context.Context, o *CredentialTemplate) {
+		o.CloneWarning = func() bool {
+			return random_bool(f)
+		}
+	})
+}
+
 func (m credentialMods) WithParentsCascading() CredentialMod {
 	return CredentialModFunc(func(ctx context.Context, o *CredentialTemplate) {
 		if isDone, _ := credentialWithParentsCascadingCtx.Value(ctx); isDone {
@@ -0,0 +1,650 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/spotdemo4/ts-server/internal/bob/models"
+	"github.com/stephenafamo/bob"
+)
+
+type EmailTokenMod interface {
+	Apply(context.Context, *EmailTokenTemplate)
+}
+
+type EmailTokenModFunc func(context.Context, *EmailTokenTemplate)
+
+func (f EmailTokenModFunc) Apply(ctx context.Context, n *EmailTokenTemplate) {
+	f(ctx, n)
+}
+
+type EmailTokenModSlice []EmailTokenMod
+
+func (mods EmailTokenModSlice) Apply(ctx context.Context, n *EmailTokenTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// EmailTokenTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type EmailTokenTemplate struct {
+	ID        func() int32
+	Hash      func() []byte
+	Purpose   func() string
+	Email     func() string
+	CreatedAt func() time.Time
+	ExpiresAt func() time.Time
+	UsedAt    func() null.Val[time.Time]
+	UserID    func() int32
+
+	r emailTokenR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type emailTokenR struct {
+	User *emailTokenRUserR
+}
+
+type emailTokenRUserR struct {
+	o *UserTemplate
+}
+
+// Apply mods to the EmailTokenTemplate
+func (o *EmailTokenTemplate) Apply(ctx context.Context, mods ...EmailTokenMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.EmailToken
+// according to the relationships in the template. Nothing is inserted into the db
+func (t EmailTokenTemplate) setModelRels(o *models.EmailToken) {
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.EmailTokens = append(rel.R.EmailTokens, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+}
+
+// BuildSetter returns an *models.EmailTokenSetter
+// this does nothing with the relationship templates
+func (o EmailTokenTemplate) BuildSetter() *models.EmailTokenSetter {
+	m := &models.EmailTokenSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.Hash != nil {
+		val := o.Hash()
+		m.Hash = omit.From(val)
+	}
+	if o.Purpose != nil {
+		val := o.Purpose()
+		m.Purpose = omit.From(val)
+	}
+	if o.Email != nil {
+		val := o.Email()
+		m.Email = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omit.From(val)
+	}
+	if o.ExpiresAt != nil {
+		val := o.ExpiresAt()
+		m.ExpiresAt = omit.From(val)
+	}
+	if o.UsedAt != nil {
+		val := o.UsedAt()
+		m.UsedAt = omitnull.FromNull(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.EmailTokenSetter
+// this does nothing with the relationship templates
+func (o EmailTokenTemplate) BuildManySetter(number int) []*models.EmailTokenSetter {
+	m := make([]*models.EmailTokenSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.EmailToken
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use EmailTokenTemplate.Create
+func (o EmailTokenTemplate) Build() *models.EmailToken {
+	m := &models.EmailToken{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.Hash != nil {
+		m.Hash = o.Hash()
+	}
+	if o.Purpose != nil {
+		m.Purpose = o.Purpose()
+	}
+	if o.Email != nil {
+		m.Email = o.Email()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+	if o.ExpiresAt != nil {
+		m.ExpiresAt = o.ExpiresAt()
+	}
+	if o.UsedAt != nil {
+		m.UsedAt = o.UsedAt()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.EmailTokenSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use EmailTokenTemplate.CreateMany
+func (o EmailTokenTemplate) BuildMany(number int) models.EmailTokenSlice {
+	m := make(models.EmailTokenSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableEmailToken(m *models.EmailTokenSetter) {
+	if !(m.Hash.IsValue()) {
+		val := random___byte(nil)
+		m.Hash = omit.From(val)
+	}
+	if !(m.Purpose.IsValue()) {
+		val := random_string(nil)
+		m.Purpose = omit.From(val)
+	}
+	if !(m.Email.IsValue()) {
+		val := random_string(nil)
+		m.Email = omit.From(val)
+	}
+	if !(m.CreatedAt.IsValue()) {
+		val := random_time_Time(nil)
+		m.CreatedAt = omit.From(val)
+	}
+	if !(m.ExpiresAt.IsValue()) {
+		val := random_time_Time(nil)
+		m.ExpiresAt = omit.From(val)
+	}
+	if !(m.UserID.IsValue()) {
+		val := random_int32(nil)
+		m.UserID = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.EmailToken
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *EmailTokenTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.EmailToken) error {
+	var err error
+
+	return err
+}
+
+// Create builds a emailToken and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *EmailTokenTemplate) Create(ctx context.Context, exec bob.Executor) (*models.EmailToken, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableEmailToken(opt)
+
+	if o.r.User == nil {
+		EmailTokenMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel0 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel0 = o.r.User.o.Build()
+	} else {
+		rel0, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel0.ID)
+
+	m, err := models.EmailTokens.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.User = rel0
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a emailToken and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *EmailTokenTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.EmailToken {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a emailToken and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *EmailTokenTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.EmailToken {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple emailTokens and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o EmailTokenTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.EmailTokenSlice, error) {
+	var err error
+	m := make(models.EmailTokenSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple emailTokens and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o EmailTokenTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.EmailTokenSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple emailTokens and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o EmailTokenTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.EmailTokenSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// EmailToken has methods that act as mods for the EmailTokenTemplate
+var EmailTokenMods emailTokenMods
+
+type emailTokenMods struct{}
+
+func (m emailTokenMods) RandomizeAllColumns(f *faker.Faker) EmailTokenMod {
+	return EmailTokenModSlice{
+		EmailTokenMods.RandomID(f),
+		EmailTokenMods.RandomHash(f),
+		EmailTokenMods.RandomPurpose(f),
+		EmailTokenMods.RandomEmail(f),
+		EmailTokenMods.RandomCreatedAt(f),
+		EmailTokenMods.RandomExpiresAt(f),
+		EmailTokenMods.RandomUsedAt(f),
+		EmailTokenMods.RandomUserID(f),
+	}
+}
+
+// Set the model columns to this value
+func (m emailTokenMods) ID(val int32) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.ID = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m emailTokenMods) IDFunc(f func() int32) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m emailTokenMods) UnsetID() EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m emailTokenMods) RandomID(f *faker.Faker) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.ID = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m emailTokenMods) Hash(val []byte) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.Hash = func() []byte { return val }
+	})
+}
+
+// Set the Column from the function
+func (m emailTokenMods) HashFunc(f func() []byte) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.Hash = f
+	})
+}
+
+// Clear any values for the column
+func (m emailTokenMods) UnsetHash() EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.Hash = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m emailTokenMods) RandomHash(f *faker.Faker) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.Hash = func() []byte {
+			return random___byte(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m emailTokenMods) Purpose(val string) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.Purpose = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m emailTokenMods) PurposeFunc(f func() string) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.Purpose = f
+	})
+}
+
+// Clear any values for the column
+func (m emailTokenMods) UnsetPurpose() EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.Purpose = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m emailTokenMods) RandomPurpose(f *faker.Faker) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.Purpose = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m emailTokenMods) Email(val string) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.Email = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m emailTokenMods) EmailFunc(f func() string) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.Email = f
+	})
+}
+
+// Clear any values for the column
+func (m emailTokenMods) UnsetEmail() EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.Email = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m emailTokenMods) RandomEmail(f *faker.Faker) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.Email = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m emailTokenMods) CreatedAt(val time.Time) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m emailTokenMods) CreatedAtFunc(f func() time.Time) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m emailTokenMods) UnsetCreatedAt() EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m emailTokenMods) RandomCreatedAt(f *faker.Faker) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m emailTokenMods) ExpiresAt(val time.Time) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.ExpiresAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m emailTokenMods) ExpiresAtFunc(f func() time.Time) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.ExpiresAt = f
+	})
+}
+
+// Clear any values for the column
+func (m emailTokenMods) UnsetExpiresAt() EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.ExpiresAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m emailTokenMods) RandomExpiresAt(f *faker.Faker) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.ExpiresAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m emailTokenMods) UsedAt(val null.Val[time.Time]) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.UsedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m emailTokenMods) UsedAtFunc(f func() null.Val[time.Time]) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.UsedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m emailTokenMods) UnsetUsedAt() EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.UsedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m emailTokenMods) RandomUsedAt(f *faker.Faker) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.UsedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m emailTokenMods) RandomUsedAtNotNull(f *faker.Faker) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.UsedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m emailTokenMods) UserID(val int32) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.UserID = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m emailTokenMods) UserIDFunc(f func() int32) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m emailTokenMods) UnsetUserID() EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m emailTokenMods) RandomUserID(f *faker.Faker) EmailTokenMod {
+	return EmailTokenModFunc(func(_ context.Context, o *EmailTokenTemplate) {
+		o.UserID = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+func (m emailTokenMods) WithParentsCascading() EmailTokenMod {
+	return EmailTokenModFunc(func(ctx context.Context, o *EmailTokenTemplate) {
+		if isDone, _ := emailTokenWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = emailTokenWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m emailTokenMods) WithUser(rel *UserTemplate) EmailTokenMod {
+	return EmailTokenModFunc(func(ctx context.Context, o *EmailTokenTemplate) {
+		o.r.User = &emailTokenRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m emailTokenMods) WithNewUser(mods ...UserMod) EmailTokenMod {
+	return EmailTokenModFunc(func(ctx context.Context, o *EmailTokenTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m emailTokenMods) WithExistingUser(em *models.User) EmailTokenMod {
+	return EmailTokenModFunc(func(ctx context.Context, o *EmailTokenTemplate) {
+		o.r.User = &emailTokenRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m emailTokenMods) WithoutUser() EmailTokenMod {
+	return EmailTokenModFunc(func(ctx context.Context, o *EmailTokenTemplate) {
+		o.r.User = nil
+	})
+}
@@ -0,0 +1,606 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/spotdemo4/ts-server/internal/bob/models"
+	"github.com/stephenafamo/bob"
+)
+
+type IdentityMod interface {
+	Apply(context.Context, *IdentityTemplate)
+}
+
+type IdentityModFunc func(context.Context, *IdentityTemplate)
+
+func (f IdentityModFunc) Apply(ctx context.Context, n *IdentityTemplate) {
+	f(ctx, n)
+}
+
+type IdentityModSlice []IdentityMod
+
+func (mods IdentityModSlice) Apply(ctx context.Context, n *IdentityTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// IdentityTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type IdentityTemplate struct {
+	ID        func() int32
+	Provider  func() string
+	Subject   func() string
+	Email     func() null.Val[string]
+	CreatedAt func() time.Time
+	LastUsed  func() time.Time
+	UserID    func() int32
+
+	r identityR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type identityR struct {
+	User *identityRUserR
+}
+
+type identityRUserR struct {
+	o *UserTemplate
+}
+
+// Apply mods to the IdentityTemplate
+func (o *IdentityTemplate) Apply(ctx context.Context, mods ...IdentityMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.Identity
+// according to the relationships in the template. Nothing is inserted into the db
+func (t IdentityTemplate) setModelRels(o *models.Identity) {
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.Identities = append(rel.R.Identities, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+}
+
+// BuildSetter returns an *models.IdentitySetter
+// this does nothing with the relationship templates
+func (o IdentityTemplate) BuildSetter() *models.IdentitySetter {
+	m := &models.IdentitySetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.Provider != nil {
+		val := o.Provider()
+		m.Provider = omit.From(val)
+	}
+	if o.Subject != nil {
+		val := o.Subject()
+		m.Subject = omit.From(val)
+	}
+	if o.Email != nil {
+		val := o.Email()
+		m.Email = omitnull.FromNull(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omit.From(val)
+	}
+	if o.LastUsed != nil {
+		val := o.LastUsed()
+		m.LastUsed = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.IdentitySetter
+// this does nothing with the relationship templates
+func (o IdentityTemplate) BuildManySetter(number int) []*models.IdentitySetter {
+	m := make([]*models.IdentitySetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.Identity
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use IdentityTemplate.Create
+func (o IdentityTemplate) Build() *models.Identity {
+	m := &models.Identity{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.Provider != nil {
+		m.Provider = o.Provider()
+	}
+	if o.Subject != nil {
+		m.Subject = o.Subject()
+	}
+	if o.Email != nil {
+		m.Email = o.Email()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+	if o.LastUsed != nil {
+		m.LastUsed = o.LastUsed()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.IdentitySlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use IdentityTemplate.CreateMany
+func (o IdentityTemplate) BuildMany(number int) models.IdentitySlice {
+	m := make(models.IdentitySlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableIdentity(m *models.IdentitySetter) {
+	if !(m.Provider.IsValue()) {
+		val := random_string(nil)
+		m.Provider = omit.From(val)
+	}
+	if !(m.Subject.IsValue()) {
+		val := random_string(nil)
+		m.Subject = omit.From(val)
+	}
+	if !(m.CreatedAt.IsValue()) {
+		val := random_time_Time(nil)
+		m.CreatedAt = omit.From(val)
+	}
+	if !(m.LastUsed.IsValue()) {
+		val := random_time_Time(nil)
+		m.LastUsed = omit.From(val)
+	}
+	if !(m.UserID.IsValue()) {
+		val := random_int32(nil)
+		m.UserID = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.Identity
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *IdentityTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Identity) error {
+	var err error
+
+	return err
+}
+
+// Create builds a identity and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *IdentityTemplate) Create(ctx context.Context, exec bob.Executor) (*models.Identity, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableIdentity(opt)
+
+	if o.r.User == nil {
+		IdentityMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel0 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel0 = o.r.User.o.Build()
+	} else {
+		rel0, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel0.ID)
+
+	m, err := models.Identities.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.User = rel0
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a identity and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *IdentityTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.Identity {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a identity and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *IdentityTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.Identity {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple identities and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o IdentityTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.IdentitySlice, error) {
+	var err error
+	m := make(models.IdentitySlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple identities and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o IdentityTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.IdentitySlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple identities and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o IdentityTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.IdentitySlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// Identity has methods that act as mods for the IdentityTemplate
+var IdentityMods identityMods
+
+type identityMods struct{}
+
+func (m identityMods) RandomizeAllColumns(f *faker.Faker) IdentityMod {
+	return IdentityModSlice{
+		IdentityMods.RandomID(f),
+		IdentityMods.RandomProvider(f),
+		IdentityMods.RandomSubject(f),
+		IdentityMods.RandomEmail(f),
+		IdentityMods.RandomCreatedAt(f),
+		IdentityMods.RandomLastUsed(f),
+		IdentityMods.RandomUserID(f),
+	}
+}
+
+// Set the model columns to this value
+func (m identityMods) ID(val int32) IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.ID = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m identityMods) IDFunc(f func() int32) IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m identityMods) UnsetID() IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m identityMods) RandomID(f *faker.Faker) IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.ID = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m identityMods) Provider(val string) IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.Provider = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m identityMods) ProviderFunc(f func() string) IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.Provider = f
+	})
+}
+
+// Clear any values for the column
+func (m identityMods) UnsetProvider() IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.Provider = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m identityMods) RandomProvider(f *faker.Faker) IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.Provider = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m identityMods) Subject(val string) IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.Subject = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m identityMods) SubjectFunc(f func() string) IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.Subject = f
+	})
+}
+
+// Clear any values for the column
+func (m identityMods) UnsetSubject() IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.Subject = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m identityMods) RandomSubject(f *faker.Faker) IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.Subject = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m identityMods) Email(val null.Val[string]) IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.Email = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m identityMods) EmailFunc(f func() null.Val[string]) IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.Email = f
+	})
+}
+
+// Clear any values for the column
+func (m identityMods) UnsetEmail() IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.Email = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m identityMods) RandomEmail(f *faker.Faker) IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.Email = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m identityMods) RandomEmailNotNull(f *faker.Faker) IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.Email = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m identityMods) CreatedAt(val time.Time) IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m identityMods) CreatedAtFunc(f func() time.Time) IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m identityMods) UnsetCreatedAt() IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m identityMods) RandomCreatedAt(f *faker.Faker) IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m identityMods) LastUsed(val time.Time) IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.LastUsed = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m identityMods) LastUsedFunc(f func() time.Time) IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.LastUsed = f
+	})
+}
+
+// Clear any values for the column
+func (m identityMods) UnsetLastUsed() IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.LastUsed = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m identityMods) RandomLastUsed(f *faker.Faker) IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.LastUsed = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m identityMods) UserID(val int32) IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.UserID = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m identityMods) UserIDFunc(f func() int32) IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m identityMods) UnsetUserID() IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m identityMods) RandomUserID(f *faker.Faker) IdentityMod {
+	return IdentityModFunc(func(_ context.Context, o *IdentityTemplate) {
+		o.UserID = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+func (m identityMods) WithParentsCascading() IdentityMod {
+	return IdentityModFunc(func(ctx context.Context, o *IdentityTemplate) {
+		if isDone, _ := identityWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = identityWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m identityMods) WithUser(rel *UserTemplate) IdentityMod {
+	return IdentityModFunc(func(ctx context.Context, o *IdentityTemplate) {
+		o.r.User = &identityRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m identityMods) WithNewUser(mods ...UserMod) IdentityMod {
+	return IdentityModFunc(func(ctx context.Context, o *IdentityTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m identityMods) WithExistingUser(em *models.User) IdentityMod {
+	return IdentityModFunc(func(ctx context.Context, o *IdentityTemplate) {
+		o.r.User = &identityRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m identityMods) WithoutUser() IdentityMod {
+	return IdentityModFunc(func(ctx context.Context, o *IdentityTemplate) {
+		o.r.User = nil
+	})
+}
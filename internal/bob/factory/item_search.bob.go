@@ -0,0 +1,326 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+
+	"github.com/aarondl/opt/null"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/spotdemo4/ts-server/internal/bob/models"
+)
+
+type ItemSearchMod interface {
+	Apply(context.Context, *ItemSearchTemplate)
+}
+
+type ItemSearchModFunc func(context.Context, *ItemSearchTemplate)
+
+func (f ItemSearchModFunc) Apply(ctx context.Context, n *ItemSearchTemplate) {
+	f(ctx, n)
+}
+
+type ItemSearchModSlice []ItemSearchMod
+
+func (mods ItemSearchModSlice) Apply(ctx context.Context, n *ItemSearchTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// ItemSearchTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type ItemSearchTemplate struct {
+	Name        func() null.Val[string]
+	Description func() null.Val[string]
+	ItemSearch  func() null.Val[string]
+	Rank        func() null.Val[string]
+
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+// Apply mods to the ItemSearchTemplate
+func (o *ItemSearchTemplate) Apply(ctx context.Context, mods ...ItemSearchMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.ItemSearch
+// according to the relationships in the template. Nothing is inserted into the db
+func (t ItemSearchTemplate) setModelRels(o *models.ItemSearch) {}
+
+// Build returns an *models.ItemSearch
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use ItemSearchTemplate.Create
+func (o ItemSearchTemplate) Build() *models.ItemSearch {
+	m := &models.ItemSearch{}
+
+	if o.Name != nil {
+		m.Name = o.Name()
+	}
+	if o.Description != nil {
+		m.Description = o.Description()
+	}
+	if o.ItemSearch != nil {
+		m.ItemSearch = o.ItemSearch()
+	}
+	if o.Rank != nil {
+		m.Rank = o.Rank()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.ItemSearchSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use ItemSearchTemplate.CreateMany
+func (o ItemSearchTemplate) BuildMany(number int) models.ItemSearchSlice {
+	m := make(models.ItemSearchSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+// ItemSearch has methods that act as mods for the ItemSearchTemplate
+var ItemSearchMods itemSearchMods
+
+type itemSearchMods struct{}
+
+func (m itemSearchMods) RandomizeAllColumns(f *faker.Faker) ItemSearchMod {
+	return ItemSearchModSlice{
+		ItemSearchMods.RandomName(f),
+		ItemSearchMods.RandomDescription(f),
+		ItemSearchMods.RandomItemSearch(f),
+		ItemSearchMods.RandomRank(f),
+	}
+}
+
+// Set the model columns to this value
+func (m itemSearchMods) Name(val null.Val[string]) ItemSearchMod {
+	return ItemSearchModFunc(func(_ context.Context, o *ItemSearchTemplate) {
+		o.Name = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m itemSearchMods) NameFunc(f func() null.Val[string]) ItemSearchMod {
+	return ItemSearchModFunc(func(_ context.Context, o *ItemSearchTemplate) {
+		o.Name = f
+	})
+}
+
+// Clear any values for the column
+func (m itemSearchMods) UnsetName() ItemSearchMod {
+	return ItemSearchModFunc(func(_ context.Context, o *ItemSearchTemplate) {
+		o.Name = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m itemSearchMods) RandomName(f *faker.Faker) ItemSearchMod {
+	return ItemSearchModFunc(func(_ context.Context, o *ItemSearchTemplate) {
+		o.Name = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m itemSearchMods) RandomNameNotNull(f *faker.Faker) ItemSearchMod {
+	return ItemSearchModFunc(func(_ context.Context, o *ItemSearchTemplate) {
+		o.Name = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m itemSearchMods) Description(val null.Val[string]) ItemSearchMod {
+	return ItemSearchModFunc(func(_ context.Context, o *ItemSearchTemplate) {
+		o.Description = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m itemSearchMods) DescriptionFunc(f func() null.Val[string]) ItemSearchMod {
+	return ItemSearchModFunc(func(_ context.Context, o *ItemSearchTemplate) {
+		o.Description = f
+	})
+}
+
+// Clear any values for the column
+func (m itemSearchMods) UnsetDescription() ItemSearchMod {
+	return ItemSearchModFunc(func(_ context.Context, o *ItemSearchTemplate) {
+		o.Description = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m itemSearchMods) RandomDescription(f *faker.Faker) ItemSearchMod {
+	return ItemSearchModFunc(func(_ context.Context, o *ItemSearchTemplate) {
+		o.Description = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m itemSearchMods) RandomDescriptionNotNull(f *faker.Faker) ItemSearchMod {
+	return ItemSearchModFunc(func(_ context.Context, o *ItemSearchTemplate) {
+		o.Description = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m itemSearchMods) ItemSearch(val null.Val[string]) ItemSearchMod {
+	return ItemSearchModFunc(func(_ context.Context, o *ItemSearchTemplate) {
+		o.ItemSearch = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m itemSearchMods) ItemSearchFunc(f func() null.Val[string]) ItemSearchMod {
+	return ItemSearchModFunc(func(_ context.Context, o *ItemSearchTemplate) {
+		o.ItemSearch = f
+	})
+}
+
+// Clear any values for the column
+func (m itemSearchMods) UnsetItemSearch() ItemSearchMod {
+	return ItemSearchModFunc(func(_ context.Context, o *ItemSearchTemplate) {
+		o.ItemSearch = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m itemSearchMods) RandomItemSearch(f *faker.Faker) ItemSearchMod {
+	return ItemSearchModFunc(func(_ context.Context, o *ItemSearchTemplate) {
+		o.ItemSearch = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m itemSearchMods) RandomItemSearchNotNull(f *faker.Faker) ItemSearchMod {
+	return ItemSearchModFunc(func(_ context.Context, o *ItemSearchTemplate) {
+		o.ItemSearch = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m itemSearchMods) Rank(val null.Val[string]) ItemSearchMod {
+	return ItemSearchModFunc(func(_ context.Context, o *ItemSearchTemplate) {
+		o.Rank = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m itemSearchMods) RankFunc(f func() null.Val[string]) ItemSearchMod {
+	return ItemSearchModFunc(func(_ context.Context, o *ItemSearchTemplate) {
+		o.Rank = f
+	})
+}
+
+// Clear any values for the column
+func (m itemSearchMods) UnsetRank() ItemSearchMod {
+	return ItemSearchModFunc(func(_ context.Context, o *ItemSearchTemplate) {
+		o.Rank = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m itemSearchMods) RandomRank(f *faker.Faker) ItemSearchMod {
+	return ItemSearchModFunc(func(_ context.Context, o *ItemSearchTemplate) {
+		o.Rank = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m itemSearchMods) RandomRankNotNull(f *faker.Faker) ItemSearchMod {
+	return ItemSearchModFunc(func(_ context.Context, o *ItemSearchTemplate) {
+		o.Rank = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m itemSearchMods) WithParentsCascading() ItemSearchMod {
+	return ItemSearchModFunc(func(ctx context.Context, o *ItemSearchTemplate) {
+		if isDone, _ := itemSearchWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = itemSearchWithParentsCascadingCtx.WithValue(ctx, true)
+	})
+}
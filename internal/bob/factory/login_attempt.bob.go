@@ -0,0 +1,516 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/spotdemo4/ts-server/internal/bob/models"
+	"github.com/stephenafamo/bob"
+)
+
+type LoginAttemptMod interface {
+	Apply(context.Context, *LoginAttemptTemplate)
+}
+
+type LoginAttemptModFunc func(context.Context, *LoginAttemptTemplate)
+
+func (f LoginAttemptModFunc) Apply(ctx context.Context, n *LoginAttemptTemplate) {
+	f(ctx, n)
+}
+
+type LoginAttemptModSlice []LoginAttemptMod
+
+func (mods LoginAttemptModSlice) Apply(ctx context.Context, n *LoginAttemptTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// LoginAttemptTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type LoginAttemptTemplate struct {
+	ID        func() int32
+	Username  func() string
+	IP        func() string
+	UserAgent func() string
+	Factor    func() string
+	Success   func() bool
+	CreatedAt func() time.Time
+
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+// Apply mods to the LoginAttemptTemplate
+func (o *LoginAttemptTemplate) Apply(ctx context.Context, mods ...LoginAttemptMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.LoginAttempt
+// according to the relationships in the template. Nothing is inserted into the db
+func (t LoginAttemptTemplate) setModelRels(o *models.LoginAttempt) {}
+
+// BuildSetter returns an *models.LoginAttemptSetter
+// this does nothing with the relationship templates
+func (o LoginAttemptTemplate) BuildSetter() *models.LoginAttemptSetter {
+	m := &models.LoginAttemptSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.Username != nil {
+		val := o.Username()
+		m.Username = omit.From(val)
+	}
+	if o.IP != nil {
+		val := o.IP()
+		m.IP = omit.From(val)
+	}
+	if o.UserAgent != nil {
+		val := o.UserAgent()
+		m.UserAgent = omit.From(val)
+	}
+	if o.Factor != nil {
+		val := o.Factor()
+		m.Factor = omit.From(val)
+	}
+	if o.Success != nil {
+		val := o.Success()
+		m.Success = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.LoginAttemptSetter
+// this does nothing with the relationship templates
+func (o LoginAttemptTemplate) BuildManySetter(number int) []*models.LoginAttemptSetter {
+	m := make([]*models.LoginAttemptSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.LoginAttempt
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use LoginAttemptTemplate.Create
+func (o LoginAttemptTemplate) Build() *models.LoginAttempt {
+	m := &models.LoginAttempt{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.Username != nil {
+		m.Username = o.Username()
+	}
+	if o.IP != nil {
+		m.IP = o.IP()
+	}
+	if o.UserAgent != nil {
+		m.UserAgent = o.UserAgent()
+	}
+	if o.Factor != nil {
+		m.Factor = o.Factor()
+	}
+	if o.Success != nil {
+		m.Success = o.Success()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.LoginAttemptSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use LoginAttemptTemplate.CreateMany
+func (o LoginAttemptTemplate) BuildMany(number int) models.LoginAttemptSlice {
+	m := make(models.LoginAttemptSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableLoginAttempt(m *models.LoginAttemptSetter) {
+	if !(m.Username.IsValue()) {
+		val := random_string(nil)
+		m.Username = omit.From(val)
+	}
+	if !(m.IP.IsValue()) {
+		val := random_string(nil)
+		m.IP = omit.From(val)
+	}
+	if !(m.UserAgent.IsValue()) {
+		val := random_string(nil)
+		m.UserAgent = omit.From(val)
+	}
+	if !(m.Factor.IsValue()) {
+		val := random_string(nil)
+		m.Factor = omit.From(val)
+	}
+	if !(m.Success.IsValue()) {
+		val := random_bool(nil)
+		m.Success = omit.From(val)
+	}
+	if !(m.CreatedAt.IsValue()) {
+		val := random_time_Time(nil)
+		m.CreatedAt = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.LoginAttempt
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *LoginAttemptTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.LoginAttempt) error {
+	var err error
+
+	return err
+}
+
+// Create builds a loginAttempt and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *LoginAttemptTemplate) Create(ctx context.Context, exec bob.Executor) (*models.LoginAttempt, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableLoginAttempt(opt)
+
+	m, err := models.LoginAttempts.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a loginAttempt and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *LoginAttemptTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.LoginAttempt {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a loginAttempt and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *LoginAttemptTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.LoginAttempt {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple loginAttempts and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o LoginAttemptTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.LoginAttemptSlice, error) {
+	var err error
+	m := make(models.LoginAttemptSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple loginAttempts and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o LoginAttemptTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.LoginAttemptSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple loginAttempts and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o LoginAttemptTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.LoginAttemptSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// LoginAttempt has methods that act as mods for the LoginAttemptTemplate
+var LoginAttemptMods loginAttemptMods
+
+type loginAttemptMods struct{}
+
+func (m loginAttemptMods) RandomizeAllColumns(f *faker.Faker) LoginAttemptMod {
+	return LoginAttemptModSlice{
+		LoginAttemptMods.RandomID(f),
+		LoginAttemptMods.RandomUsername(f),
+		LoginAttemptMods.RandomIP(f),
+		LoginAttemptMods.RandomUserAgent(f),
+		LoginAttemptMods.RandomFactor(f),
+		LoginAttemptMods.RandomSuccess(f),
+		LoginAttemptMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m loginAttemptMods) ID(val int32) LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.ID = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m loginAttemptMods) IDFunc(f func() int32) LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m loginAttemptMods) UnsetID() LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m loginAttemptMods) RandomID(f *faker.Faker) LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.ID = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m loginAttemptMods) Username(val string) LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.Username = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m loginAttemptMods) UsernameFunc(f func() string) LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.Username = f
+	})
+}
+
+// Clear any values for the column
+func (m loginAttemptMods) UnsetUsername() LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.Username = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m loginAttemptMods) RandomUsername(f *faker.Faker) LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.Username = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m loginAttemptMods) IP(val string) LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.IP = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m loginAttemptMods) IPFunc(f func() string) LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.IP = f
+	})
+}
+
+// Clear any values for the column
+func (m loginAttemptMods) UnsetIP() LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.IP = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m loginAttemptMods) RandomIP(f *faker.Faker) LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.IP = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m loginAttemptMods) UserAgent(val string) LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.UserAgent = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m loginAttemptMods) UserAgentFunc(f func() string) LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.UserAgent = f
+	})
+}
+
+// Clear any values for the column
+func (m loginAttemptMods) UnsetUserAgent() LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.UserAgent = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m loginAttemptMods) RandomUserAgent(f *faker.Faker) LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.UserAgent = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m loginAttemptMods) Factor(val string) LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.Factor = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m loginAttemptMods) FactorFunc(f func() string) LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.Factor = f
+	})
+}
+
+// Clear any values for the column
+func (m loginAttemptMods) UnsetFactor() LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.Factor = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m loginAttemptMods) RandomFactor(f *faker.Faker) LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.Factor = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m loginAttemptMods) Success(val bool) LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.Success = func() bool { return val }
+	})
+}
+
+// Set the Column from the function
+func (m loginAttemptMods) SuccessFunc(f func() bool) LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.Success = f
+	})
+}
+
+// Clear any values for the column
+func (m loginAttemptMods) UnsetSuccess() LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.Success = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m loginAttemptMods) RandomSuccess(f *faker.Faker) LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.Success = func() bool {
+			return random_bool(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m loginAttemptMods) CreatedAt(val time.Time) LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m loginAttemptMods) CreatedAtFunc(f func() time.Time) LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m loginAttemptMods) UnsetCreatedAt() LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m loginAttemptMods) RandomCreatedAt(f *faker.Faker) LoginAttemptMod {
+	return LoginAttemptModFunc(func(_ context.Context, o *LoginAttemptTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m loginAttemptMods) WithParentsCascading() LoginAttemptMod {
+	return LoginAttemptModFunc(func(ctx context.Context, o *LoginAttemptTemplate) {
+		if isDone, _ := loginAttemptWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = loginAttemptWithParentsCascadingCtx.WithValue(ctx, true)
+	})
+}
@@ -0,0 +1,498 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/spotdemo4/ts-server/internal/bob/models"
+	"github.com/stephenafamo/bob"
+)
+
+type LoginChallengeMod interface {
+	Apply(context.Context, *LoginChallengeTemplate)
+}
+
+type LoginChallengeModFunc func(context.Context, *LoginChallengeTemplate)
+
+func (f LoginChallengeModFunc) Apply(ctx context.Context, n *LoginChallengeTemplate) {
+	f(ctx, n)
+}
+
+type LoginChallengeModSlice []LoginChallengeMod
+
+func (mods LoginChallengeModSlice) Apply(ctx context.Context, n *LoginChallengeTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// LoginChallengeTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type LoginChallengeTemplate struct {
+	ID        func() string
+	Failures  func() int32
+	CreatedAt func() time.Time
+	ExpiresAt func() time.Time
+	UserID    func() int32
+
+	r loginChallengeR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type loginChallengeR struct {
+	User *loginChallengeRUserR
+}
+
+type loginChallengeRUserR struct {
+	o *UserTemplate
+}
+
+// Apply mods to the LoginChallengeTemplate
+func (o *LoginChallengeTemplate) Apply(ctx context.Context, mods ...LoginChallengeMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.LoginChallenge
+// according to the relationships in the template. Nothing is inserted into the db
+func (t LoginChallengeTemplate) setModelRels(o *models.LoginChallenge) {
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.LoginChallenges = append(rel.R.LoginChallenges, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+}
+
+// BuildSetter returns an *models.LoginChallengeSetter
+// this does nothing with the relationship templates
+func (o LoginChallengeTemplate) BuildSetter() *models.LoginChallengeSetter {
+	m := &models.LoginChallengeSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.Failures != nil {
+		val := o.Failures()
+		m.Failures = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omit.From(val)
+	}
+	if o.ExpiresAt != nil {
+		val := o.ExpiresAt()
+		m.ExpiresAt = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.LoginChallengeSetter
+// this does nothing with the relationship templates
+func (o LoginChallengeTemplate) BuildManySetter(number int) []*models.LoginChallengeSetter {
+	m := make([]*models.LoginChallengeSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.LoginChallenge
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use LoginChallengeTemplate.Create
+func (o LoginChallengeTemplate) Build() *models.LoginChallenge {
+	m := &models.LoginChallenge{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.Failures != nil {
+		m.Failures = o.Failures()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+	if o.ExpiresAt != nil {
+		m.ExpiresAt = o.ExpiresAt()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.LoginChallengeSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use LoginChallengeTemplate.CreateMany
+func (o LoginChallengeTemplate) BuildMany(number int) models.LoginChallengeSlice {
+	m := make(models.LoginChallengeSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableLoginChallenge(m *models.LoginChallengeSetter) {
+	if !(m.ID.IsValue()) {
+		val := random_string(nil)
+		m.ID = omit.From(val)
+	}
+	if !(m.CreatedAt.IsValue()) {
+		val := random_time_Time(nil)
+		m.CreatedAt = omit.From(val)
+	}
+	if !(m.ExpiresAt.IsValue()) {
+		val := random_time_Time(nil)
+		m.ExpiresAt = omit.From(val)
+	}
+	if !(m.UserID.IsValue()) {
+		val := random_int32(nil)
+		m.UserID = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.LoginChallenge
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *LoginChallengeTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.LoginChallenge) error {
+	var err error
+
+	return err
+}
+
+// Create builds a loginChallenge and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *LoginChallengeTemplate) Create(ctx context.Context, exec bob.Executor) (*models.LoginChallenge, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableLoginChallenge(opt)
+
+	if o.r.User == nil {
+		LoginChallengeMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel0 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel0 = o.r.User.o.Build()
+	} else {
+		rel0, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel0.ID)
+
+	m, err := models.LoginChallenges.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.User = rel0
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a loginChallenge and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *LoginChallengeTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.LoginChallenge {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a loginChallenge and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *LoginChallengeTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.LoginChallenge {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple loginChallenges and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o LoginChallengeTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.LoginChallengeSlice, error) {
+	var err error
+	m := make(models.LoginChallengeSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple loginChallenges and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o LoginChallengeTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.LoginChallengeSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple loginChallenges and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o LoginChallengeTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.LoginChallengeSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// LoginChallenge has methods that act as mods for the LoginChallengeTemplate
+var LoginChallengeMods loginChallengeMods
+
+type loginChallengeMods struct{}
+
+func (m loginChallengeMods) RandomizeAllColumns(f *faker.Faker) LoginChallengeMod {
+	return LoginChallengeModSlice{
+		LoginChallengeMods.RandomID(f),
+		LoginChallengeMods.RandomFailures(f),
+		LoginChallengeMods.RandomCreatedAt(f),
+		LoginChallengeMods.RandomExpiresAt(f),
+		LoginChallengeMods.RandomUserID(f),
+	}
+}
+
+// Set the model columns to this value
+func (m loginChallengeMods) ID(val string) LoginChallengeMod {
+	return LoginChallengeModFunc(func(_ context.Context, o *LoginChallengeTemplate) {
+		o.ID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m loginChallengeMods) IDFunc(f func() string) LoginChallengeMod {
+	return LoginChallengeModFunc(func(_ context.Context, o *LoginChallengeTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m loginChallengeMods) UnsetID() LoginChallengeMod {
+	return LoginChallengeModFunc(func(_ context.Context, o *LoginChallengeTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m loginChallengeMods) RandomID(f *faker.Faker) LoginChallengeMod {
+	return LoginChallengeModFunc(func(_ context.Context, o *LoginChallengeTemplate) {
+		o.ID = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m loginChallengeMods) Failures(val int32) LoginChallengeMod {
+	return LoginChallengeModFunc(func(_ context.Context, o *LoginChallengeTemplate) {
+		o.Failures = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m loginChallengeMods) FailuresFunc(f func() int32) LoginChallengeMod {
+	return LoginChallengeModFunc(func(_ context.Context, o *LoginChallengeTemplate) {
+		o.Failures = f
+	})
+}
+
+// Clear any values for the column
+func (m loginChallengeMods) UnsetFailures() LoginChallengeMod {
+	return LoginChallengeModFunc(func(_ context.Context, o *LoginChallengeTemplate) {
+		o.Failures = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m loginChallengeMods) RandomFailures(f *faker.Faker) LoginChallengeMod {
+	return LoginChallengeModFunc(func(_ context.Context, o *LoginChallengeTemplate) {
+		o.Failures = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m loginChallengeMods) CreatedAt(val time.Time) LoginChallengeMod {
+	return LoginChallengeModFunc(func(_ context.Context, o *LoginChallengeTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m loginChallengeMods) CreatedAtFunc(f func() time.Time) LoginChallengeMod {
+	return LoginChallengeModFunc(func(_ context.Context, o *LoginChallengeTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m loginChallengeMods) UnsetCreatedAt() LoginChallengeMod {
+	return LoginChallengeModFunc(func(_ context.Context, o *LoginChallengeTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m loginChallengeMods) RandomCreatedAt(f *faker.Faker) LoginChallengeMod {
+	return LoginChallengeModFunc(func(_ context.Context, o *LoginChallengeTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m loginChallengeMods) ExpiresAt(val time.Time) LoginChallengeMod {
+	return LoginChallengeModFunc(func(_ context.Context, o *LoginChallengeTemplate) {
+		o.ExpiresAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m loginChallengeMods) ExpiresAtFunc(f func() time.Time) LoginChallengeMod {
+	return LoginChallengeModFunc(func(_ context.Context, o *LoginChallengeTemplate) {
+		o.ExpiresAt = f
+	})
+}
+
+// Clear any values for the column
+func (m loginChallengeMods) UnsetExpiresAt() LoginChallengeMod {
+	return LoginChallengeModFunc(func(_ context.Context, o *LoginChallengeTemplate) {
+		o.ExpiresAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m loginChallengeMods) RandomExpiresAt(f *faker.Faker) LoginChallengeMod {
+	return LoginChallengeModFunc(func(_ context.Context, o *LoginChallengeTemplate) {
+		o.ExpiresAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m loginChallengeMods) UserID(val int32) LoginChallengeMod {
+	return LoginChallengeModFunc(func(_ context.Context, o *LoginChallengeTemplate) {
+		o.UserID = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m loginChallengeMods) UserIDFunc(f func() int32) LoginChallengeMod {
+	return LoginChallengeModFunc(func(_ context.Context, o *LoginChallengeTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m loginChallengeMods) UnsetUserID() LoginChallengeMod {
+	return LoginChallengeModFunc(func(_ context.Context, o *LoginChallengeTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m loginChallengeMods) RandomUserID(f *faker.Faker) LoginChallengeMod {
+	return LoginChallengeModFunc(func(_ context.Context, o *LoginChallengeTemplate) {
+		o.UserID = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+func (m loginChallengeMods) WithParentsCascading() LoginChallengeMod {
+	return LoginChallengeModFunc(func(ctx context.Context, o *LoginChallengeTemplate) {
+		if isDone, _ := loginChallengeWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = loginChallengeWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m loginChallengeMods) WithUser(rel *UserTemplate) LoginChallengeMod {
+	return LoginChallengeModFunc(func(ctx context.Context, o *LoginChallengeTemplate) {
+		o.r.User = &loginChallengeRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m loginChallengeMods) WithNewUser(mods ...UserMod) LoginChallengeMod {
+	return LoginChallengeModFunc(func(ctx context.Context, o *LoginChallengeTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m loginChallengeMods) WithExistingUser(em *models.User) LoginChallengeMod {
+	return LoginChallengeModFunc(func(ctx context.Context, o *LoginChallengeTemplate) {
+		o.r.User = &loginChallengeRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m loginChallengeMods) WithoutUser() LoginChallengeMod {
+	return LoginChallengeModFunc(func(ctx context.Context, o *LoginChallengeTemplate) {
+		o.r.User = nil
+	})
+}
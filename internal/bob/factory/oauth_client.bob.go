@@ -0,0 +1,759 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/spotdemo4/ts-server/internal/bob/models"
+	"github.com/stephenafamo/bob"
+)
+
+type OauthClientMod interface {
+	Apply(context.Context, *OauthClientTemplate)
+}
+
+type OauthClientModFunc func(context.Context, *OauthClientTemplate)
+
+func (f OauthClientModFunc) Apply(ctx context.Context, n *OauthClientTemplate) {
+	f(ctx, n)
+}
+
+type OauthClientModSlice []OauthClientMod
+
+func (mods OauthClientModSlice) Apply(ctx context.Context, n *OauthClientTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// OauthClientTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type OauthClientTemplate struct {
+	ID           func() string
+	Name         func() string
+	SecretHash   func() null.Val[[]byte]
+	RedirectUris func() string
+	Scopes       func() string
+	CreatedAt    func() time.Time
+
+	r oauthClientR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type oauthClientR struct {
+	ClientOauthCodes    []*oauthClientRClientOauthCodesR
+	ClientOauthConsents []*oauthClientRClientOauthConsentsR
+	ClientSessions      []*oauthClientRClientSessionsR
+}
+
+type oauthClientRClientOauthCodesR struct {
+	number int
+	o      *OauthCodeTemplate
+}
+type oauthClientRClientOauthConsentsR struct {
+	number int
+	o      *OauthConsentTemplate
+}
+type oauthClientRClientSessionsR struct {
+	number int
+	o      *SessionTemplate
+}
+
+// Apply mods to the OauthClientTemplate
+func (o *OauthClientTemplate) Apply(ctx context.Context, mods ...OauthClientMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.OauthClient
+// according to the relationships in the template. Nothing is inserted into the db
+func (t OauthClientTemplate) setModelRels(o *models.OauthClient) {
+	if t.r.ClientOauthCodes != nil {
+		rel := models.OauthCodeSlice{}
+		for _, r := range t.r.ClientOauthCodes {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.ClientID = o.ID // h2
+				rel.R.ClientOauthClient = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.ClientOauthCodes = rel
+	}
+
+	if t.r.ClientOauthConsents != nil {
+		rel := models.OauthConsentSlice{}
+		for _, r := range t.r.ClientOauthConsents {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.ClientID = o.ID // h2
+				rel.R.ClientOauthClient = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.ClientOauthConsents = rel
+	}
+
+	if t.r.ClientSessions != nil {
+		rel := models.SessionSlice{}
+		for _, r := range t.r.ClientSessions {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.ClientID = null.From(o.ID) // h2
+				rel.R.ClientOauthClient = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.ClientSessions = rel
+	}
+}
+
+// BuildSetter returns an *models.OauthClientSetter
+// this does nothing with the relationship templates
+func (o OauthClientTemplate) BuildSetter() *models.OauthClientSetter {
+	m := &models.OauthClientSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.Name != nil {
+		val := o.Name()
+		m.Name = omit.From(val)
+	}
+	if o.SecretHash != nil {
+		val := o.SecretHash()
+		m.SecretHash = omitnull.FromNull(val)
+	}
+	if o.RedirectUris != nil {
+		val := o.RedirectUris()
+		m.RedirectUris = omit.From(val)
+	}
+	if o.Scopes != nil {
+		val := o.Scopes()
+		m.Scopes = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.OauthClientSetter
+// this does nothing with the relationship templates
+func (o OauthClientTemplate) BuildManySetter(number int) []*models.OauthClientSetter {
+	m := make([]*models.OauthClientSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.OauthClient
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use OauthClientTemplate.Create
+func (o OauthClientTemplate) Build() *models.OauthClient {
+	m := &models.OauthClient{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.Name != nil {
+		m.Name = o.Name()
+	}
+	if o.SecretHash != nil {
+		m.SecretHash = o.SecretHash()
+	}
+	if o.RedirectUris != nil {
+		m.RedirectUris = o.RedirectUris()
+	}
+	if o.Scopes != nil {
+		m.Scopes = o.Scopes()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.OauthClientSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use OauthClientTemplate.CreateMany
+func (o OauthClientTemplate) BuildMany(number int) models.OauthClientSlice {
+	m := make(models.OauthClientSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableOauthClient(m *models.OauthClientSetter) {
+	if !(m.ID.IsValue()) {
+		val := random_string(nil)
+		m.ID = omit.From(val)
+	}
+	if !(m.Name.IsValue()) {
+		val := random_string(nil)
+		m.Name = omit.From(val)
+	}
+	if !(m.RedirectUris.IsValue()) {
+		val := random_string(nil)
+		m.RedirectUris = omit.From(val)
+	}
+	if !(m.Scopes.IsValue()) {
+		val := random_string(nil)
+		m.Scopes = omit.From(val)
+	}
+	if !(m.CreatedAt.IsValue()) {
+		val := random_time_Time(nil)
+		m.CreatedAt = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.OauthClient
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *OauthClientTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.OauthClient) error {
+	var err error
+
+	isClientOauthCodesDone, _ := oauthClientRelClientOauthCodesCtx.Value(ctx)
+	if !isClientOauthCodesDone && o.r.ClientOauthCodes != nil {
+		ctx = oauthClientRelClientOauthCodesCtx.WithValue(ctx, true)
+		for _, r := range o.r.ClientOauthCodes {
+			if r.o.alreadyPersisted {
+				m.R.ClientOauthCodes = append(m.R.ClientOauthCodes, r.o.Build())
+			} else {
+				rel0, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachClientOauthCodes(ctx, exec, rel0...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isClientOauthConsentsDone, _ := oauthClientRelClientOauthConsentsCtx.Value(ctx)
+	if !isClientOauthConsentsDone && o.r.ClientOauthConsents != nil {
+		ctx = oauthClientRelClientOauthConsentsCtx.WithValue(ctx, true)
+		for _, r := range o.r.ClientOauthConsents {
+			if r.o.alreadyPersisted {
+				m.R.ClientOauthConsents = append(m.R.ClientOauthConsents, r.o.Build())
+			} else {
+				rel1, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachClientOauthConsents(ctx, exec, rel1...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isClientSessionsDone, _ := oauthClientRelClientSessionsCtx.Value(ctx)
+	if !isClientSessionsDone && o.r.ClientSessions != nil {
+		ctx = oauthClientRelClientSessionsCtx.WithValue(ctx, true)
+		for _, r := range o.r.ClientSessions {
+			if r.o.alreadyPersisted {
+				m.R.ClientSessions = append(m.R.ClientSessions, r.o.Build())
+			} else {
+				rel2, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachClientSessions(ctx, exec, rel2...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return err
+}
+
+// Create builds a oauthClient and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *OauthClientTemplate) Create(ctx context.Context, exec bob.Executor) (*models.OauthClient, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableOauthClient(opt)
+
+	m, err := models.OauthClients.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a oauthClient and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *OauthClientTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.OauthClient {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a oauthClient and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *OauthClientTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.OauthClient {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple oauthClients and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o OauthClientTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.OauthClientSlice, error) {
+	var err error
+	m := make(models.OauthClientSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple oauthClients and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o OauthClientTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.OauthClientSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple oauthClients and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o OauthClientTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.OauthClientSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// OauthClient has methods that act as mods for the OauthClientTemplate
+var OauthClientMods oauthClientMods
+
+type oauthClientMods struct{}
+
+func (m oauthClientMods) RandomizeAllColumns(f *faker.Faker) OauthClientMod {
+	return OauthClientModSlice{
+		OauthClientMods.RandomID(f),
+		OauthClientMods.RandomName(f),
+		OauthClientMods.RandomSecretHash(f),
+		OauthClientMods.RandomRedirectUris(f),
+		OauthClientMods.RandomScopes(f),
+		OauthClientMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m oauthClientMods) ID(val string) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.ID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthClientMods) IDFunc(f func() string) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthClientMods) UnsetID() OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthClientMods) RandomID(f *faker.Faker) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.ID = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthClientMods) Name(val string) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.Name = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthClientMods) NameFunc(f func() string) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.Name = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthClientMods) UnsetName() OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.Name = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthClientMods) RandomName(f *faker.Faker) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.Name = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthClientMods) SecretHash(val null.Val[[]byte]) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.SecretHash = func() null.Val[[]byte] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthClientMods) SecretHashFunc(f func() null.Val[[]byte]) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.SecretHash = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthClientMods) UnsetSecretHash() OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.SecretHash = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m oauthClientMods) RandomSecretHash(f *faker.Faker) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.SecretHash = func() null.Val[[]byte] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random___byte(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m oauthClientMods) RandomSecretHashNotNull(f *faker.Faker) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.SecretHash = func() null.Val[[]byte] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random___byte(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthClientMods) RedirectUris(val string) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.RedirectUris = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthClientMods) RedirectUrisFunc(f func() string) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.RedirectUris = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthClientMods) UnsetRedirectUris() OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.RedirectUris = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthClientMods) RandomRedirectUris(f *faker.Faker) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.RedirectUris = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthClientMods) Scopes(val string) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.Scopes = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthClientMods) ScopesFunc(f func() string) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.Scopes = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthClientMods) UnsetScopes() OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.Scopes = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthClientMods) RandomScopes(f *faker.Faker) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.Scopes = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthClientMods) CreatedAt(val time.Time) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthClientMods) CreatedAtFunc(f func() time.Time) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthClientMods) UnsetCreatedAt() OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthClientMods) RandomCreatedAt(f *faker.Faker) OauthClientMod {
+	return OauthClientModFunc(func(_ context.Context, o *OauthClientTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m oauthClientMods) WithParentsCascading() OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		if isDone, _ := oauthClientWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = oauthClientWithParentsCascadingCtx.WithValue(ctx, true)
+	})
+}
+
+func (m oauthClientMods) WithClientOauthCodes(number int, related *OauthCodeTemplate) OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		o.r.ClientOauthCodes = []*oauthClientRClientOauthCodesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m oauthClientMods) WithNewClientOauthCodes(number int, mods ...OauthCodeMod) OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		related := o.f.NewOauthCodeWithContext(ctx, mods...)
+		m.WithClientOauthCodes(number, related).Apply(ctx, o)
+	})
+}
+
+func (m oauthClientMods) AddClientOauthCodes(number int, related *OauthCodeTemplate) OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		o.r.ClientOauthCodes = append(o.r.ClientOauthCodes, &oauthClientRClientOauthCodesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m oauthClientMods) AddNewClientOauthCodes(number int, mods ...OauthCodeMod) OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		related := o.f.NewOauthCodeWithContext(ctx, mods...)
+		m.AddClientOauthCodes(number, related).Apply(ctx, o)
+	})
+}
+
+func (m oauthClientMods) AddExistingClientOauthCodes(existingModels ...*models.OauthCode) OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		for _, em := range existingModels {
+			o.r.ClientOauthCodes = append(o.r.ClientOauthCodes, &oauthClientRClientOauthCodesR{
+				o: o.f.FromExistingOauthCode(em),
+			})
+		}
+	})
+}
+
+func (m oauthClientMods) WithoutClientOauthCodes() OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		o.r.ClientOauthCodes = nil
+	})
+}
+
+func (m oauthClientMods) WithClientOauthConsents(number int, related *OauthConsentTemplate) OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		o.r.ClientOauthConsents = []*oauthClientRClientOauthConsentsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m oauthClientMods) WithNewClientOauthConsents(number int, mods ...OauthConsentMod) OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		related := o.f.NewOauthConsentWithContext(ctx, mods...)
+		m.WithClientOauthConsents(number, related).Apply(ctx, o)
+	})
+}
+
+func (m oauthClientMods) AddClientOauthConsents(number int, related *OauthConsentTemplate) OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		o.r.ClientOauthConsents = append(o.r.ClientOauthConsents, &oauthClientRClientOauthConsentsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m oauthClientMods) AddNewClientOauthConsents(number int, mods ...OauthConsentMod) OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		related := o.f.NewOauthConsentWithContext(ctx, mods...)
+		m.AddClientOauthConsents(number, related).Apply(ctx, o)
+	})
+}
+
+func (m oauthClientMods) AddExistingClientOauthConsents(existingModels ...*models.OauthConsent) OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		for _, em := range existingModels {
+			o.r.ClientOauthConsents = append(o.r.ClientOauthConsents, &oauthClientRClientOauthConsentsR{
+				o: o.f.FromExistingOauthConsent(em),
+			})
+		}
+	})
+}
+
+func (m oauthClientMods) WithoutClientOauthConsents() OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		o.r.ClientOauthConsents = nil
+	})
+}
+
+func (m oauthClientMods) WithClientSessions(number int, related *SessionTemplate) OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		o.r.ClientSessions = []*oauthClientRClientSessionsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m oauthClientMods) WithNewClientSessions(number int, mods ...SessionMod) OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		related := o.f.NewSessionWithContext(ctx, mods...)
+		m.WithClientSessions(number, related).Apply(ctx, o)
+	})
+}
+
+func (m oauthClientMods) AddClientSessions(number int, related *SessionTemplate) OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		o.r.ClientSessions = append(o.r.ClientSessions, &oauthClientRClientSessionsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m oauthClientMods) AddNewClientSessions(number int, mods ...SessionMod) OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		related := o.f.NewSessionWithContext(ctx, mods...)
+		m.AddClientSessions(number, related).Apply(ctx, o)
+	})
+}
+
+func (m oauthClientMods) AddExistingClientSessions(existingModels ...*models.Session) OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		for _, em := range existingModels {
+			o.r.ClientSessions = append(o.r.ClientSessions, &oauthClientRClientSessionsR{
+				o: o.f.FromExistingSession(em),
+			})
+		}
+	})
+}
+
+func (m oauthClientMods) WithoutClientSessions() OauthClientMod {
+	return OauthClientModFunc(func(ctx context.Context, o *OauthClientTemplate) {
+		o.r.ClientSessions = nil
+	})
+}
@@ -0,0 +1,698 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/spotdemo4/ts-server/internal/bob/models"
+	"github.com/stephenafamo/bob"
+)
+
+type OauthCodeMod interface {
+	Apply(context.Context, *OauthCodeTemplate)
+}
+
+type OauthCodeModFunc func(context.Context, *OauthCodeTemplate)
+
+func (f OauthCodeModFunc) Apply(ctx context.Context, n *OauthCodeTemplate) {
+	f(ctx, n)
+}
+
+type OauthCodeModSlice []OauthCodeMod
+
+func (mods OauthCodeModSlice) Apply(ctx context.Context, n *OauthCodeTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// OauthCodeTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type OauthCodeTemplate struct {
+	Hash          func() []byte
+	RedirectURI   func() string
+	Scopes        func() string
+	Nonce         func() string
+	CodeChallenge func() string
+	ExpiresAt     func() time.Time
+	ClientID      func() string
+	UserID        func() int32
+
+	r oauthCodeR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type oauthCodeR struct {
+	User              *oauthCodeRUserR
+	ClientOauthClient *oauthCodeRClientOauthClientR
+}
+
+type oauthCodeRUserR struct {
+	o *UserTemplate
+}
+type oauthCodeRClientOauthClientR struct {
+	o *OauthClientTemplate
+}
+
+// Apply mods to the OauthCodeTemplate
+func (o *OauthCodeTemplate) Apply(ctx context.Context, mods ...OauthCodeMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.OauthCode
+// according to the relationships in the template. Nothing is inserted into the db
+func (t OauthCodeTemplate) setModelRels(o *models.OauthCode) {
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.OauthCodes = append(rel.R.OauthCodes, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+
+	if t.r.ClientOauthClient != nil {
+		rel := t.r.ClientOauthClient.o.Build()
+		rel.R.ClientOauthCodes = append(rel.R.ClientOauthCodes, o)
+		o.ClientID = rel.ID // h2
+		o.R.ClientOauthClient = rel
+	}
+}
+
+// BuildSetter returns an *models.OauthCodeSetter
+// this does nothing with the relationship templates
+func (o OauthCodeTemplate) BuildSetter() *models.OauthCodeSetter {
+	m := &models.OauthCodeSetter{}
+
+	if o.Hash != nil {
+		val := o.Hash()
+		m.Hash = omit.From(val)
+	}
+	if o.RedirectURI != nil {
+		val := o.RedirectURI()
+		m.RedirectURI = omit.From(val)
+	}
+	if o.Scopes != nil {
+		val := o.Scopes()
+		m.Scopes = omit.From(val)
+	}
+	if o.Nonce != nil {
+		val := o.Nonce()
+		m.Nonce = omit.From(val)
+	}
+	if o.CodeChallenge != nil {
+		val := o.CodeChallenge()
+		m.CodeChallenge = omit.From(val)
+	}
+	if o.ExpiresAt != nil {
+		val := o.ExpiresAt()
+		m.ExpiresAt = omit.From(val)
+	}
+	if o.ClientID != nil {
+		val := o.ClientID()
+		m.ClientID = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.OauthCodeSetter
+// this does nothing with the relationship templates
+func (o OauthCodeTemplate) BuildManySetter(number int) []*models.OauthCodeSetter {
+	m := make([]*models.OauthCodeSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.OauthCode
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use OauthCodeTemplate.Create
+func (o OauthCodeTemplate) Build() *models.OauthCode {
+	m := &models.OauthCode{}
+
+	if o.Hash != nil {
+		m.Hash = o.Hash()
+	}
+	if o.RedirectURI != nil {
+		m.RedirectURI = o.RedirectURI()
+	}
+	if o.Scopes != nil {
+		m.Scopes = o.Scopes()
+	}
+	if o.Nonce != nil {
+		m.Nonce = o.Nonce()
+	}
+	if o.CodeChallenge != nil {
+		m.CodeChallenge = o.CodeChallenge()
+	}
+	if o.ExpiresAt != nil {
+		m.ExpiresAt = o.ExpiresAt()
+	}
+	if o.ClientID != nil {
+		m.ClientID = o.ClientID()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.OauthCodeSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use OauthCodeTemplate.CreateMany
+func (o OauthCodeTemplate) BuildMany(number int) models.OauthCodeSlice {
+	m := make(models.OauthCodeSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableOauthCode(m *models.OauthCodeSetter) {
+	if !(m.Hash.IsValue()) {
+		val := random___byte(nil)
+		m.Hash = omit.From(val)
+	}
+	if !(m.RedirectURI.IsValue()) {
+		val := random_string(nil)
+		m.RedirectURI = omit.From(val)
+	}
+	if !(m.Scopes.IsValue()) {
+		val := random_string(nil)
+		m.Scopes = omit.From(val)
+	}
+	if !(m.Nonce.IsValue()) {
+		val := random_string(nil)
+		m.Nonce = omit.From(val)
+	}
+	if !(m.CodeChallenge.IsValue()) {
+		val := random_string(nil)
+		m.CodeChallenge = omit.From(val)
+	}
+	if !(m.ExpiresAt.IsValue()) {
+		val := random_time_Time(nil)
+		m.ExpiresAt = omit.From(val)
+	}
+	if !(m.ClientID.IsValue()) {
+		val := random_string(nil)
+		m.ClientID = omit.From(val)
+	}
+	if !(m.UserID.IsValue()) {
+		val := random_int32(nil)
+		m.UserID = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.OauthCode
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *OauthCodeTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.OauthCode) error {
+	var err error
+
+	return err
+}
+
+// Create builds a oauthCode and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *OauthCodeTemplate) Create(ctx context.Context, exec bob.Executor) (*models.OauthCode, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableOauthCode(opt)
+
+	if o.r.User == nil {
+		OauthCodeMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel0 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel0 = o.r.User.o.Build()
+	} else {
+		rel0, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel0.ID)
+
+	if o.r.ClientOauthClient == nil {
+		OauthCodeMods.WithNewClientOauthClient().Apply(ctx, o)
+	}
+
+	var rel1 *models.OauthClient
+
+	if o.r.ClientOauthClient.o.alreadyPersisted {
+		rel1 = o.r.ClientOauthClient.o.Build()
+	} else {
+		rel1, err = o.r.ClientOauthClient.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.ClientID = omit.From(rel1.ID)
+
+	m, err := models.OauthCodes.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.User = rel0
+	m.R.ClientOauthClient = rel1
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a oauthCode and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *OauthCodeTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.OauthCode {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a oauthCode and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *OauthCodeTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.OauthCode {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple oauthCodes and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o OauthCodeTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.OauthCodeSlice, error) {
+	var err error
+	m := make(models.OauthCodeSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple oauthCodes and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o OauthCodeTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.OauthCodeSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple oauthCodes and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o OauthCodeTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.OauthCodeSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// OauthCode has methods that act as mods for the OauthCodeTemplate
+var OauthCodeMods oauthCodeMods
+
+type oauthCodeMods struct{}
+
+func (m oauthCodeMods) RandomizeAllColumns(f *faker.Faker) OauthCodeMod {
+	return OauthCodeModSlice{
+		OauthCodeMods.RandomHash(f),
+		OauthCodeMods.RandomRedirectURI(f),
+		OauthCodeMods.RandomScopes(f),
+		OauthCodeMods.RandomNonce(f),
+		OauthCodeMods.RandomCodeChallenge(f),
+		OauthCodeMods.RandomExpiresAt(f),
+		OauthCodeMods.RandomClientID(f),
+		OauthCodeMods.RandomUserID(f),
+	}
+}
+
+// Set the model columns to this value
+func (m oauthCodeMods) Hash(val []byte) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.Hash = func() []byte { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthCodeMods) HashFunc(f func() []byte) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.Hash = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthCodeMods) UnsetHash() OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.Hash = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthCodeMods) RandomHash(f *faker.Faker) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.Hash = func() []byte {
+			return random___byte(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthCodeMods) RedirectURI(val string) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.RedirectURI = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthCodeMods) RedirectURIFunc(f func() string) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.RedirectURI = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthCodeMods) UnsetRedirectURI() OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.RedirectURI = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthCodeMods) RandomRedirectURI(f *faker.Faker) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.RedirectURI = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthCodeMods) Scopes(val string) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.Scopes = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthCodeMods) ScopesFunc(f func() string) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.Scopes = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthCodeMods) UnsetScopes() OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.Scopes = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthCodeMods) RandomScopes(f *faker.Faker) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.Scopes = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthCodeMods) Nonce(val string) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.Nonce = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthCodeMods) NonceFunc(f func() string) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.Nonce = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthCodeMods) UnsetNonce() OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.Nonce = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthCodeMods) RandomNonce(f *faker.Faker) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.Nonce = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthCodeMods) CodeChallenge(val string) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.CodeChallenge = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthCodeMods) CodeChallengeFunc(f func() string) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.CodeChallenge = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthCodeMods) UnsetCodeChallenge() OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.CodeChallenge = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthCodeMods) RandomCodeChallenge(f *faker.Faker) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.CodeChallenge = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthCodeMods) ExpiresAt(val time.Time) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.ExpiresAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthCodeMods) ExpiresAtFunc(f func() time.Time) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.ExpiresAt = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthCodeMods) UnsetExpiresAt() OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.ExpiresAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthCodeMods) RandomExpiresAt(f *faker.Faker) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.ExpiresAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthCodeMods) ClientID(val string) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.ClientID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthCodeMods) ClientIDFunc(f func() string) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.ClientID = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthCodeMods) UnsetClientID() OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.ClientID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthCodeMods) RandomClientID(f *faker.Faker) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.ClientID = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthCodeMods) UserID(val int32) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.UserID = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthCodeMods) UserIDFunc(f func() int32) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthCodeMods) UnsetUserID() OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthCodeMods) RandomUserID(f *faker.Faker) OauthCodeMod {
+	return OauthCodeModFunc(func(_ context.Context, o *OauthCodeTemplate) {
+		o.UserID = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+func (m oauthCodeMods) WithParentsCascading() OauthCodeMod {
+	return OauthCodeModFunc(func(ctx context.Context, o *OauthCodeTemplate) {
+		if isDone, _ := oauthCodeWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = oauthCodeWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewOauthClientWithContext(ctx, OauthClientMods.WithParentsCascading())
+			m.WithClientOauthClient(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m oauthCodeMods) WithUser(rel *UserTemplate) OauthCodeMod {
+	return OauthCodeModFunc(func(ctx context.Context, o *OauthCodeTemplate) {
+		o.r.User = &oauthCodeRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m oauthCodeMods) WithNewUser(mods ...UserMod) OauthCodeMod {
+	return OauthCodeModFunc(func(ctx context.Context, o *OauthCodeTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m oauthCodeMods) WithExistingUser(em *models.User) OauthCodeMod {
+	return OauthCodeModFunc(func(ctx context.Context, o *OauthCodeTemplate) {
+		o.r.User = &oauthCodeRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m oauthCodeMods) WithoutUser() OauthCodeMod {
+	return OauthCodeModFunc(func(ctx context.Context, o *OauthCodeTemplate) {
+		o.r.User = nil
+	})
+}
+
+func (m oauthCodeMods) WithClientOauthClient(rel *OauthClientTemplate) OauthCodeMod {
+	return OauthCodeModFunc(func(ctx context.Context, o *OauthCodeTemplate) {
+		o.r.ClientOauthClient = &oauthCodeRClientOauthClientR{
+			o: rel,
+		}
+	})
+}
+
+func (m oauthCodeMods) WithNewClientOauthClient(mods ...OauthClientMod) OauthCodeMod {
+	return OauthCodeModFunc(func(ctx context.Context, o *OauthCodeTemplate) {
+		related := o.f.NewOauthClientWithContext(ctx, mods...)
+
+		m.WithClientOauthClient(related).Apply(ctx, o)
+	})
+}
+
+func (m oauthCodeMods) WithExistingClientOauthClient(em *models.OauthClient) OauthCodeMod {
+	return OauthCodeModFunc(func(ctx context.Context, o *OauthCodeTemplate) {
+		o.r.ClientOauthClient = &oauthCodeRClientOauthClientR{
+			o: o.f.FromExistingOauthClient(em),
+		}
+	})
+}
+
+func (m oauthCodeMods) WithoutClientOauthClient() OauthCodeMod {
+	return OauthCodeModFunc(func(ctx context.Context, o *OauthCodeTemplate) {
+		o.r.ClientOauthClient = nil
+	})
+}
@@ -0,0 +1,522 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/spotdemo4/ts-server/internal/bob/models"
+	"github.com/stephenafamo/bob"
+)
+
+type OauthConsentMod interface {
+	Apply(context.Context, *OauthConsentTemplate)
+}
+
+type OauthConsentModFunc func(context.Context, *OauthConsentTemplate)
+
+func (f OauthConsentModFunc) Apply(ctx context.Context, n *OauthConsentTemplate) {
+	f(ctx, n)
+}
+
+type OauthConsentModSlice []OauthConsentMod
+
+func (mods OauthConsentModSlice) Apply(ctx context.Context, n *OauthConsentTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// OauthConsentTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type OauthConsentTemplate struct {
+	Scopes    func() string
+	CreatedAt func() time.Time
+	ClientID  func() string
+	UserID    func() int32
+
+	r oauthConsentR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type oauthConsentR struct {
+	User              *oauthConsentRUserR
+	ClientOauthClient *oauthConsentRClientOauthClientR
+}
+
+type oauthConsentRUserR struct {
+	o *UserTemplate
+}
+type oauthConsentRClientOauthClientR struct {
+	o *OauthClientTemplate
+}
+
+// Apply mods to the OauthConsentTemplate
+func (o *OauthConsentTemplate) Apply(ctx context.Context, mods ...OauthConsentMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.OauthConsent
+// according to the relationships in the template. Nothing is inserted into the db
+func (t OauthConsentTemplate) setModelRels(o *models.OauthConsent) {
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.OauthConsents = append(rel.R.OauthConsents, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+
+	if t.r.ClientOauthClient != nil {
+		rel := t.r.ClientOauthClient.o.Build()
+		rel.R.ClientOauthConsents = append(rel.R.ClientOauthConsents, o)
+		o.ClientID = rel.ID // h2
+		o.R.ClientOauthClient = rel
+	}
+}
+
+// BuildSetter returns an *models.OauthConsentSetter
+// this does nothing with the relationship templates
+func (o OauthConsentTemplate) BuildSetter() *models.OauthConsentSetter {
+	m := &models.OauthConsentSetter{}
+
+	if o.Scopes != nil {
+		val := o.Scopes()
+		m.Scopes = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omit.From(val)
+	}
+	if o.ClientID != nil {
+		val := o.ClientID()
+		m.ClientID = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.OauthConsentSetter
+// this does nothing with the relationship templates
+func (o OauthConsentTemplate) BuildManySetter(number int) []*models.OauthConsentSetter {
+	m := make([]*models.OauthConsentSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.OauthConsent
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use OauthConsentTemplate.Create
+func (o OauthConsentTemplate) Build() *models.OauthConsent {
+	m := &models.OauthConsent{}
+
+	if o.Scopes != nil {
+		m.Scopes = o.Scopes()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+	if o.ClientID != nil {
+		m.ClientID = o.ClientID()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.OauthConsentSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use OauthConsentTemplate.CreateMany
+func (o OauthConsentTemplate) BuildMany(number int) models.OauthConsentSlice {
+	m := make(models.OauthConsentSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableOauthConsent(m *models.OauthConsentSetter) {
+	if !(m.Scopes.IsValue()) {
+		val := random_string(nil)
+		m.Scopes = omit.From(val)
+	}
+	if !(m.CreatedAt.IsValue()) {
+		val := random_time_Time(nil)
+		m.CreatedAt = omit.From(val)
+	}
+	if !(m.ClientID.IsValue()) {
+		val := random_string(nil)
+		m.ClientID = omit.From(val)
+	}
+	if !(m.UserID.IsValue()) {
+		val := random_int32(nil)
+		m.UserID = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.OauthConsent
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *OauthConsentTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.OauthConsent) error {
+	var err error
+
+	return err
+}
+
+// Create builds a oauthConsent and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *OauthConsentTemplate) Create(ctx context.Context, exec bob.Executor) (*models.OauthConsent, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableOauthConsent(opt)
+
+	if o.r.User == nil {
+		OauthConsentMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel0 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel0 = o.r.User.o.Build()
+	} else {
+		rel0, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel0.ID)
+
+	if o.r.ClientOauthClient == nil {
+		OauthConsentMods.WithNewClientOauthClient().Apply(ctx, o)
+	}
+
+	var rel1 *models.OauthClient
+
+	if o.r.ClientOauthClient.o.alreadyPersisted {
+		rel1 = o.r.ClientOauthClient.o.Build()
+	} else {
+		rel1, err = o.r.ClientOauthClient.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.ClientID = omit.From(rel1.ID)
+
+	m, err := models.OauthConsents.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.User = rel0
+	m.R.ClientOauthClient = rel1
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a oauthConsent and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *OauthConsentTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.OauthConsent {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a oauthConsent and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *OauthConsentTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.OauthConsent {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple oauthConsents and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o OauthConsentTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.OauthConsentSlice, error) {
+	var err error
+	m := make(models.OauthConsentSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple oauthConsents and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o OauthConsentTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.OauthConsentSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple oauthConsents and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o OauthConsentTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.OauthConsentSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// OauthConsent has methods that act as mods for the OauthConsentTemplate
+var OauthConsentMods oauthConsentMods
+
+type oauthConsentMods struct{}
+
+func (m oauthConsentMods) RandomizeAllColumns(f *faker.Faker) OauthConsentMod {
+	return OauthConsentModSlice{
+		OauthConsentMods.RandomScopes(f),
+		OauthConsentMods.RandomCreatedAt(f),
+		OauthConsentMods.RandomClientID(f),
+		OauthConsentMods.RandomUserID(f),
+	}
+}
+
+// Set the model columns to this value
+func (m oauthConsentMods) Scopes(val string) OauthConsentMod {
+	return OauthConsentModFunc(func(_ context.Context, o *OauthConsentTemplate) {
+		o.Scopes = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthConsentMods) ScopesFunc(f func() string) OauthConsentMod {
+	return OauthConsentModFunc(func(_ context.Context, o *OauthConsentTemplate) {
+		o.Scopes = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthConsentMods) UnsetScopes() OauthConsentMod {
+	return OauthConsentModFunc(func(_ context.Context, o *OauthConsentTemplate) {
+		o.Scopes = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthConsentMods) RandomScopes(f *faker.Faker) OauthConsentMod {
+	return OauthConsentModFunc(func(_ context.Context, o *OauthConsentTemplate) {
+		o.Scopes = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthConsentMods) CreatedAt(val time.Time) OauthConsentMod {
+	return OauthConsentModFunc(func(_ context.Context, o *OauthConsentTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthConsentMods) CreatedAtFunc(f func() time.Time) OauthConsentMod {
+	return OauthConsentModFunc(func(_ context.Context, o *OauthConsentTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthConsentMods) UnsetCreatedAt() OauthConsentMod {
+	return OauthConsentModFunc(func(_ context.Context, o *OauthConsentTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthConsentMods) RandomCreatedAt(f *faker.Faker) OauthConsentMod {
+	return OauthConsentModFunc(func(_ context.Context, o *OauthConsentTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthConsentMods) ClientID(val string) OauthConsentMod {
+	return OauthConsentModFunc(func(_ context.Context, o *OauthConsentTemplate) {
+		o.ClientID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthConsentMods) ClientIDFunc(f func() string) OauthConsentMod {
+	return OauthConsentModFunc(func(_ context.Context, o *OauthConsentTemplate) {
+		o.ClientID = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthConsentMods) UnsetClientID() OauthConsentMod {
+	return OauthConsentModFunc(func(_ context.Context, o *OauthConsentTemplate) {
+		o.ClientID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthConsentMods) RandomClientID(f *faker.Faker) OauthConsentMod {
+	return OauthConsentModFunc(func(_ context.Context, o *OauthConsentTemplate) {
+		o.ClientID = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oauthConsentMods) UserID(val int32) OauthConsentMod {
+	return OauthConsentModFunc(func(_ context.Context, o *OauthConsentTemplate) {
+		o.UserID = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oauthConsentMods) UserIDFunc(f func() int32) OauthConsentMod {
+	return OauthConsentModFunc(func(_ context.Context, o *OauthConsentTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m oauthConsentMods) UnsetUserID() OauthConsentMod {
+	return OauthConsentModFunc(func(_ context.Context, o *OauthConsentTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oauthConsentMods) RandomUserID(f *faker.Faker) OauthConsentMod {
+	return OauthConsentModFunc(func(_ context.Context, o *OauthConsentTemplate) {
+		o.UserID = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+func (m oauthConsentMods) WithParentsCascading() OauthConsentMod {
+	return OauthConsentModFunc(func(ctx context.Context, o *OauthConsentTemplate) {
+		if isDone, _ := oauthConsentWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = oauthConsentWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewOauthClientWithContext(ctx, OauthClientMods.WithParentsCascading())
+			m.WithClientOauthClient(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m oauthConsentMods) WithUser(rel *UserTemplate) OauthConsentMod {
+	return OauthConsentModFunc(func(ctx context.Context, o *OauthConsentTemplate) {
+		o.r.User = &oauthConsentRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m oauthConsentMods) WithNewUser(mods ...UserMod) OauthConsentMod {
+	return OauthConsentModFunc(func(ctx context.Context, o *OauthConsentTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m oauthConsentMods) WithExistingUser(em *models.User) OauthConsentMod {
+	return OauthConsentModFunc(func(ctx context.Context, o *OauthConsentTemplate) {
+		o.r.User = &oauthConsentRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m oauthConsentMods) WithoutUser() OauthConsentMod {
+	return OauthConsentModFunc(func(ctx context.Context, o *OauthConsentTemplate) {
+		o.r.User = nil
+	})
+}
+
+func (m oauthConsentMods) WithClientOauthClient(rel *OauthClientTemplate) OauthConsentMod {
+	return OauthConsentModFunc(func(ctx context.Context, o *OauthConsentTemplate) {
+		o.r.ClientOauthClient = &oauthConsentRClientOauthClientR{
+			o: rel,
+		}
+	})
+}
+
+func (m oauthConsentMods) WithNewClientOauthClient(mods ...OauthClientMod) OauthConsentMod {
+	return OauthConsentModFunc(func(ctx context.Context, o *OauthConsentTemplate) {
+		related := o.f.NewOauthClientWithContext(ctx, mods...)
+
+		m.WithClientOauthClient(related).Apply(ctx, o)
+	})
+}
+
+func (m oauthConsentMods) WithExistingClientOauthClient(em *models.OauthClient) OauthConsentMod {
+	return OauthConsentModFunc(func(ctx context.Context, o *OauthConsentTemplate) {
+		o.r.ClientOauthClient = &oauthConsentRClientOauthClientR{
+			o: o.f.FromExistingOauthClient(em),
+		}
+	})
+}
+
+func (m oauthConsentMods) WithoutClientOauthClient() OauthConsentMod {
+	return OauthConsentModFunc(func(ctx context.Context, o *OauthConsentTemplate) {
+		o.r.ClientOauthClient = nil
+	})
+}
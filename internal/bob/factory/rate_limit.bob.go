@@ -0,0 +1,344 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/spotdemo4/ts-server/internal/bob/models"
+	"github.com/stephenafamo/bob"
+)
+
+type RateLimitMod interface {
+	Apply(context.Context, *RateLimitTemplate)
+}
+
+type RateLimitModFunc func(context.Context, *RateLimitTemplate)
+
+func (f RateLimitModFunc) Apply(ctx context.Context, n *RateLimitTemplate) {
+	f(ctx, n)
+}
+
+type RateLimitModSlice []RateLimitMod
+
+func (mods RateLimitModSlice) Apply(ctx context.Context, n *RateLimitTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// RateLimitTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type RateLimitTemplate struct {
+	Key       func() string
+	Tokens    func() float32
+	UpdatedAt func() time.Time
+
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+// Apply mods to the RateLimitTemplate
+func (o *RateLimitTemplate) Apply(ctx context.Context, mods ...RateLimitMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.RateLimit
+// according to the relationships in the template. Nothing is inserted into the db
+func (t RateLimitTemplate) setModelRels(o *models.RateLimit) {}
+
+// BuildSetter returns an *models.RateLimitSetter
+// this does nothing with the relationship templates
+func (o RateLimitTemplate) BuildSetter() *models.RateLimitSetter {
+	m := &models.RateLimitSetter{}
+
+	if o.Key != nil {
+		val := o.Key()
+		m.Key = omit.From(val)
+	}
+	if o.Tokens != nil {
+		val := o.Tokens()
+		m.Tokens = omit.From(val)
+	}
+	if o.UpdatedAt != nil {
+		val := o.UpdatedAt()
+		m.UpdatedAt = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.RateLimitSetter
+// this does nothing with the relationship templates
+func (o RateLimitTemplate) BuildManySetter(number int) []*models.RateLimitSetter {
+	m := make([]*models.RateLimitSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.RateLimit
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use RateLimitTemplate.Create
+func (o RateLimitTemplate) Build() *models.RateLimit {
+	m := &models.RateLimit{}
+
+	if o.Key != nil {
+		m.Key = o.Key()
+	}
+	if o.Tokens != nil {
+		m.Tokens = o.Tokens()
+	}
+	if o.UpdatedAt != nil {
+		m.UpdatedAt = o.UpdatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.RateLimitSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use RateLimitTemplate.CreateMany
+func (o RateLimitTemplate) BuildMany(number int) models.RateLimitSlice {
+	m := make(models.RateLimitSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableRateLimit(m *models.RateLimitSetter) {
+	if !(m.Key.IsValue()) {
+		val := random_string(nil)
+		m.Key = omit.From(val)
+	}
+	if !(m.Tokens.IsValue()) {
+		val := random_float32(nil)
+		m.Tokens = omit.From(val)
+	}
+	if !(m.UpdatedAt.IsValue()) {
+		val := random_time_Time(nil)
+		m.UpdatedAt = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.RateLimit
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *RateLimitTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.RateLimit) error {
+	var err error
+
+	return err
+}
+
+// Create builds a rateLimit and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *RateLimitTemplate) Create(ctx context.Context, exec bob.Executor) (*models.RateLimit, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableRateLimit(opt)
+
+	m, err := models.RateLimits.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a rateLimit and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *RateLimitTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.RateLimit {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a rateLimit and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *RateLimitTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.RateLimit {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple rateLimits and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o RateLimitTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.RateLimitSlice, error) {
+	var err error
+	m := make(models.RateLimitSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple rateLimits and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o RateLimitTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.RateLimitSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple rateLimits and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o RateLimitTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.RateLimitSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// RateLimit has methods that act as mods for the RateLimitTemplate
+var RateLimitMods rateLimitMods
+
+type rateLimitMods struct{}
+
+func (m rateLimitMods) RandomizeAllColumns(f *faker.Faker) RateLimitMod {
+	return RateLimitModSlice{
+		RateLimitMods.RandomKey(f),
+		RateLimitMods.RandomTokens(f),
+		RateLimitMods.RandomUpdatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m rateLimitMods) Key(val string) RateLimitMod {
+	return RateLimitModFunc(func(_ context.Context, o *RateLimitTemplate) {
+		o.Key = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m rateLimitMods) KeyFunc(f func() string) RateLimitMod {
+	return RateLimitModFunc(func(_ context.Context, o *RateLimitTemplate) {
+		o.Key = f
+	})
+}
+
+// Clear any values for the column
+func (m rateLimitMods) UnsetKey() RateLimitMod {
+	return RateLimitModFunc(func(_ context.Context, o *RateLimitTemplate) {
+		o.Key = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m rateLimitMods) RandomKey(f *faker.Faker) RateLimitMod {
+	return RateLimitModFunc(func(_ context.Context, o *RateLimitTemplate) {
+		o.Key = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m rateLimitMods) Tokens(val float32) RateLimitMod {
+	return RateLimitModFunc(func(_ context.Context, o *RateLimitTemplate) {
+		o.Tokens = func() float32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m rateLimitMods) TokensFunc(f func() float32) RateLimitMod {
+	return RateLimitModFunc(func(_ context.Context, o *RateLimitTemplate) {
+		o.Tokens = f
+	})
+}
+
+// Clear any values for the column
+func (m rateLimitMods) UnsetTokens() RateLimitMod {
+	return RateLimitModFunc(func(_ context.Context, o *RateLimitTemplate) {
+		o.Tokens = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m rateLimitMods) RandomTokens(f *faker.Faker) RateLimitMod {
+	return RateLimitModFunc(func(_ context.Context, o *RateLimitTemplate) {
+		o.Tokens = func() float32 {
+			return random_float32(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m rateLimitMods) UpdatedAt(val time.Time) RateLimitMod {
+	return RateLimitModFunc(func(_ context.Context, o *RateLimitTemplate) {
+		o.UpdatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m rateLimitMods) UpdatedAtFunc(f func() time.Time) RateLimitMod {
+	return RateLimitModFunc(func(_ context.Context, o *RateLimitTemplate) {
+		o.UpdatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m rateLimitMods) UnsetUpdatedAt() RateLimitMod {
+	return RateLimitModFunc(func(_ context.Context, o *RateLimitTemplate) {
+		o.UpdatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m rateLimitMods) RandomUpdatedAt(f *faker.Faker) RateLimitMod {
+	return RateLimitModFunc(func(_ context.Context, o *RateLimitTemplate) {
+		o.UpdatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m rateLimitMods) WithParentsCascading() RateLimitMod {
+	return RateLimitModFunc(func(ctx context.Context, o *RateLimitTemplate) {
+		if isDone, _ := rateLimitWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = rateLimitWithParentsCascadingCtx.WithValue(ctx, true)
+	})
+}
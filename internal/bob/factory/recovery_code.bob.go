@@ -0,0 +1,474 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/spotdemo4/ts-server/internal/bob/models"
+	"github.com/stephenafamo/bob"
+)
+
+type RecoveryCodeMod interface {
+	Apply(context.Context, *RecoveryCodeTemplate)
+}
+
+type RecoveryCodeModFunc func(context.Context, *RecoveryCodeTemplate)
+
+func (f RecoveryCodeModFunc) Apply(ctx context.Context, n *RecoveryCodeTemplate) {
+	f(ctx, n)
+}
+
+type RecoveryCodeModSlice []RecoveryCodeMod
+
+func (mods RecoveryCodeModSlice) Apply(ctx context.Context, n *RecoveryCodeTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// RecoveryCodeTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type RecoveryCodeTemplate struct {
+	ID     func() int32
+	Hash   func() []byte
+	UsedAt func() null.Val[time.Time]
+	UserID func() int32
+
+	r recoveryCodeR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type recoveryCodeR struct {
+	User *recoveryCodeRUserR
+}
+
+type recoveryCodeRUserR struct {
+	o *UserTemplate
+}
+
+// Apply mods to the RecoveryCodeTemplate
+func (o *RecoveryCodeTemplate) Apply(ctx context.Context, mods ...RecoveryCodeMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.RecoveryCode
+// according to the relationships in the template. Nothing is inserted into the db
+func (t RecoveryCodeTemplate) setModelRels(o *models.RecoveryCode) {
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.RecoveryCodes = append(rel.R.RecoveryCodes, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+}
+
+// BuildSetter returns an *models.RecoveryCodeSetter
+// this does nothing with the relationship templates
+func (o RecoveryCodeTemplate) BuildSetter() *models.RecoveryCodeSetter {
+	m := &models.RecoveryCodeSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.Hash != nil {
+		val := o.Hash()
+		m.Hash = omit.From(val)
+	}
+	if o.UsedAt != nil {
+		val := o.UsedAt()
+		m.UsedAt = omitnull.FromNull(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.RecoveryCodeSetter
+// this does nothing with the relationship templates
+func (o RecoveryCodeTemplate) BuildManySetter(number int) []*models.RecoveryCodeSetter {
+	m := make([]*models.RecoveryCodeSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.RecoveryCode
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use RecoveryCodeTemplate.Create
+func (o RecoveryCodeTemplate) Build() *models.RecoveryCode {
+	m := &models.RecoveryCode{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.Hash != nil {
+		m.Hash = o.Hash()
+	}
+	if o.UsedAt != nil {
+		m.UsedAt = o.UsedAt()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.RecoveryCodeSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use RecoveryCodeTemplate.CreateMany
+func (o RecoveryCodeTemplate) BuildMany(number int) models.RecoveryCodeSlice {
+	m := make(models.RecoveryCodeSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableRecoveryCode(m *models.RecoveryCodeSetter) {
+	if !(m.Hash.IsValue()) {
+		val := random___byte(nil)
+		m.Hash = omit.From(val)
+	}
+	if !(m.UserID.IsValue()) {
+		val := random_int32(nil)
+		m.UserID = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.RecoveryCode
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *RecoveryCodeTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.RecoveryCode) error {
+	var err error
+
+	return err
+}
+
+// Create builds a recoveryCode and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *RecoveryCodeTemplate) Create(ctx context.Context, exec bob.Executor) (*models.RecoveryCode, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableRecoveryCode(opt)
+
+	if o.r.User == nil {
+		RecoveryCodeMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel0 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel0 = o.r.User.o.Build()
+	} else {
+		rel0, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel0.ID)
+
+	m, err := models.RecoveryCodes.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.User = rel0
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a recoveryCode and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *RecoveryCodeTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.RecoveryCode {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a recoveryCode and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *RecoveryCodeTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.RecoveryCode {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple recoveryCodes and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o RecoveryCodeTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.RecoveryCodeSlice, error) {
+	var err error
+	m := make(models.RecoveryCodeSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple recoveryCodes and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o RecoveryCodeTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.RecoveryCodeSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple recoveryCodes and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o RecoveryCodeTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.RecoveryCodeSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// RecoveryCode has methods that act as mods for the RecoveryCodeTemplate
+var RecoveryCodeMods recoveryCodeMods
+
+type recoveryCodeMods struct{}
+
+func (m recoveryCodeMods) RandomizeAllColumns(f *faker.Faker) RecoveryCodeMod {
+	return RecoveryCodeModSlice{
+		RecoveryCodeMods.RandomID(f),
+		RecoveryCodeMods.RandomHash(f),
+		RecoveryCodeMods.RandomUsedAt(f),
+		RecoveryCodeMods.RandomUserID(f),
+	}
+}
+
+// Set the model columns to this value
+func (m recoveryCodeMods) ID(val int32) RecoveryCodeMod {
+	return RecoveryCodeModFunc(func(_ context.Context, o *RecoveryCodeTemplate) {
+		o.ID = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m recoveryCodeMods) IDFunc(f func() int32) RecoveryCodeMod {
+	return RecoveryCodeModFunc(func(_ context.Context, o *RecoveryCodeTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m recoveryCodeMods) UnsetID() RecoveryCodeMod {
+	return RecoveryCodeModFunc(func(_ context.Context, o *RecoveryCodeTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m recoveryCodeMods) RandomID(f *faker.Faker) RecoveryCodeMod {
+	return RecoveryCodeModFunc(func(_ context.Context, o *RecoveryCodeTemplate) {
+		o.ID = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m recoveryCodeMods) Hash(val []byte) RecoveryCodeMod {
+	return RecoveryCodeModFunc(func(_ context.Context, o *RecoveryCodeTemplate) {
+		o.Hash = func() []byte { return val }
+	})
+}
+
+// Set the Column from the function
+func (m recoveryCodeMods) HashFunc(f func() []byte) RecoveryCodeMod {
+	return RecoveryCodeModFunc(func(_ context.Context, o *RecoveryCodeTemplate) {
+		o.Hash = f
+	})
+}
+
+// Clear any values for the column
+func (m recoveryCodeMods) UnsetHash() RecoveryCodeMod {
+	return RecoveryCodeModFunc(func(_ context.Context, o *RecoveryCodeTemplate) {
+		o.Hash = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m recoveryCodeMods) RandomHash(f *faker.Faker) RecoveryCodeMod {
+	return RecoveryCodeModFunc(func(_ context.Context, o *RecoveryCodeTemplate) {
+		o.Hash = func() []byte {
+			return random___byte(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m recoveryCodeMods) UsedAt(val null.Val[time.Time]) RecoveryCodeMod {
+	return RecoveryCodeModFunc(func(_ context.Context, o *RecoveryCodeTemplate) {
+		o.UsedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m recoveryCodeMods) UsedAtFunc(f func() null.Val[time.Time]) RecoveryCodeMod {
+	return RecoveryCodeModFunc(func(_ context.Context, o *RecoveryCodeTemplate) {
+		o.UsedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m recoveryCodeMods) UnsetUsedAt() RecoveryCodeMod {
+	return RecoveryCodeModFunc(func(_ context.Context, o *RecoveryCodeTemplate) {
+		o.UsedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m recoveryCodeMods) RandomUsedAt(f *faker.Faker) RecoveryCodeMod {
+	return RecoveryCodeModFunc(func(_ context.Context, o *RecoveryCodeTemplate) {
+		o.UsedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m recoveryCodeMods) RandomUsedAtNotNull(f *faker.Faker) RecoveryCodeMod {
+	return RecoveryCodeModFunc(func(_ context.Context, o *RecoveryCodeTemplate) {
+		o.UsedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m recoveryCodeMods) UserID(val int32) RecoveryCodeMod {
+	return RecoveryCodeModFunc(func(_ context.Context, o *RecoveryCodeTemplate) {
+		o.UserID = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m recoveryCodeMods) UserIDFunc(f func() int32) RecoveryCodeMod {
+	return RecoveryCodeModFunc(func(_ context.Context, o *RecoveryCodeTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m recoveryCodeMods) UnsetUserID() RecoveryCodeMod {
+	return RecoveryCodeModFunc(func(_ context.Context, o *RecoveryCodeTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m recoveryCodeMods) RandomUserID(f *faker.Faker) RecoveryCodeMod {
+	return RecoveryCodeModFunc(func(_ context.Context, o *RecoveryCodeTemplate) {
+		o.UserID = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+func (m recoveryCodeMods) WithParentsCascading() RecoveryCodeMod {
+	return RecoveryCodeModFunc(func(ctx context.Context, o *RecoveryCodeTemplate) {
+		if isDone, _ := recoveryCodeWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = recoveryCodeWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m recoveryCodeMods) WithUser(rel *UserTemplate) RecoveryCodeMod {
+	return RecoveryCodeModFunc(func(ctx context.Context, o *RecoveryCodeTemplate) {
+		o.r.User = &recoveryCodeRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m recoveryCodeMods) WithNewUser(mods ...UserMod) RecoveryCodeMod {
+	return RecoveryCodeModFunc(func(ctx context.Context, o *RecoveryCodeTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m recoveryCodeMods) WithExistingUser(em *models.User) RecoveryCodeMod {
+	return RecoveryCodeModFunc(func(ctx context.Context, o *RecoveryCodeTemplate) {
+		o.r.User = &recoveryCodeRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m recoveryCodeMods) WithoutUser() RecoveryCodeMod {
+	return RecoveryCodeModFunc(func(ctx context.Context, o *RecoveryCodeTemplate) {
+		o.r.User = nil
+	})
+}
@@ -0,0 +1,993 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/spotdemo4/ts-server/internal/bob/models"
+	"github.com/stephenafamo/bob"
+)
+
+type SessionMod interface {
+	Apply(context.Context, *SessionTemplate)
+}
+
+type SessionModFunc func(context.Context, *SessionTemplate)
+
+func (f SessionModFunc) Apply(ctx context.Context, n *SessionTemplate) {
+	f(ctx, n)
+}
+
+type SessionModSlice []SessionMod
+
+func (mods SessionModSlice) Apply(ctx context.Context, n *SessionTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// SessionTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type SessionTemplate struct {
+	ID                  func() string
+	RefreshHash         func() []byte
+	PreviousRefreshHash func() null.Val[[]byte]
+	CreatedAt           func() time.Time
+	LastSeen            func() time.Time
+	RotatedAt           func() time.Time
+	ExpiresAt           func() time.Time
+	RevokedAt           func() null.Val[time.Time]
+	UserAgent           func() string
+	IP                  func() string
+	UserID              func() int32
+	ClientID            func() null.Val[string]
+	Scopes              func() null.Val[string]
+
+	r sessionR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type sessionR struct {
+	User              *sessionRUserR
+	ClientOauthClient *sessionRClientOauthClientR
+}
+
+type sessionRUserR struct {
+	o *UserTemplate
+}
+type sessionRClientOauthClientR struct {
+	o *OauthClientTemplate
+}
+
+// Apply mods to the SessionTemplate
+func (o *SessionTemplate) Apply(ctx context.Context, mods ...SessionMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.Session
+// according to the relationships in the template. Nothing is inserted into the db
+func (t SessionTemplate) setModelRels(o *models.Session) {
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.Sessions = append(rel.R.Sessions, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+
+	if t.r.ClientOauthClient != nil {
+		rel := t.r.ClientOauthClient.o.Build()
+		rel.R.ClientSessions = append(rel.R.ClientSessions, o)
+		o.ClientID = null.From(rel.ID) // h2
+		o.R.ClientOauthClient = rel
+	}
+}
+
+// BuildSetter returns an *models.SessionSetter
+// this does nothing with the relationship templates
+func (o SessionTemplate) BuildSetter() *models.SessionSetter {
+	m := &models.SessionSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.RefreshHash != nil {
+		val := o.RefreshHash()
+		m.RefreshHash = omit.From(val)
+	}
+	if o.PreviousRefreshHash != nil {
+		val := o.PreviousRefreshHash()
+		m.PreviousRefreshHash = omitnull.FromNull(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omit.From(val)
+	}
+	if o.LastSeen != nil {
+		val := o.LastSeen()
+		m.LastSeen = omit.From(val)
+	}
+	if o.RotatedAt != nil {
+		val := o.RotatedAt()
+		m.RotatedAt = omit.From(val)
+	}
+	if o.ExpiresAt != nil {
+		val := o.ExpiresAt()
+		m.ExpiresAt = omit.From(val)
+	}
+	if o.RevokedAt != nil {
+		val := o.RevokedAt()
+		m.RevokedAt = omitnull.FromNull(val)
+	}
+	if o.UserAgent != nil {
+		val := o.UserAgent()
+		m.UserAgent = omit.From(val)
+	}
+	if o.IP != nil {
+		val := o.IP()
+		m.IP = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+	if o.ClientID != nil {
+		val := o.ClientID()
+		m.ClientID = omitnull.FromNull(val)
+	}
+	if o.Scopes != nil {
+		val := o.Scopes()
+		m.Scopes = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.SessionSetter
+// this does nothing with the relationship templates
+func (o SessionTemplate) BuildManySetter(number int) []*models.SessionSetter {
+	m := make([]*models.SessionSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.Session
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use SessionTemplate.Create
+func (o SessionTemplate) Build() *models.Session {
+	m := &models.Session{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.RefreshHash != nil {
+		m.RefreshHash = o.RefreshHash()
+	}
+	if o.PreviousRefreshHash != nil {
+		m.PreviousRefreshHash = o.PreviousRefreshHash()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+	if o.LastSeen != nil {
+		m.LastSeen = o.LastSeen()
+	}
+	if o.RotatedAt != nil {
+		m.RotatedAt = o.RotatedAt()
+	}
+	if o.ExpiresAt != nil {
+		m.ExpiresAt = o.ExpiresAt()
+	}
+	if o.RevokedAt != nil {
+		m.RevokedAt = o.RevokedAt()
+	}
+	if o.UserAgent != nil {
+		m.UserAgent = o.UserAgent()
+	}
+	if o.IP != nil {
+		m.IP = o.IP()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.ClientID != nil {
+		m.ClientID = o.ClientID()
+	}
+	if o.Scopes != nil {
+		m.Scopes = o.Scopes()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.SessionSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use SessionTemplate.CreateMany
+func (o SessionTemplate) BuildMany(number int) models.SessionSlice {
+	m := make(models.SessionSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableSession(m *models.SessionSetter) {
+	if !(m.ID.IsValue()) {
+		val := random_string(nil)
+		m.ID = omit.From(val)
+	}
+	if !(m.RefreshHash.IsValue()) {
+		val := random___byte(nil)
+		m.RefreshHash = omit.From(val)
+	}
+	if !(m.CreatedAt.IsValue()) {
+		val := random_time_Time(nil)
+		m.CreatedAt = omit.From(val)
+	}
+	if !(m.LastSeen.IsValue()) {
+		val := random_time_Time(nil)
+		m.LastSeen = omit.From(val)
+	}
+	if !(m.RotatedAt.IsValue()) {
+		val := random_time_Time(nil)
+		m.RotatedAt = omit.From(val)
+	}
+	if !(m.ExpiresAt.IsValue()) {
+		val := random_time_Time(nil)
+		m.ExpiresAt = omit.From(val)
+	}
+	if !(m.UserAgent.IsValue()) {
+		val := random_string(nil)
+		m.UserAgent = omit.From(val)
+	}
+	if !(m.IP.IsValue()) {
+		val := random_string(nil)
+		m.IP = omit.From(val)
+	}
+	if !(m.UserID.IsValue()) {
+		val := random_int32(nil)
+		m.UserID = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.Session
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *SessionTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Session) error {
+	var err error
+
+	isClientOauthClientDone, _ := sessionRelClientOauthClientCtx.Value(ctx)
+	if !isClientOauthClientDone && o.r.ClientOauthClient != nil {
+		ctx = sessionRelClientOauthClientCtx.WithValue(ctx, true)
+		if o.r.ClientOauthClient.o.alreadyPersisted {
+			m.R.ClientOauthClient = o.r.ClientOauthClient.o.Build()
+		} else {
+			var rel1 *models.OauthClient
+			rel1, err = o.r.ClientOauthClient.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachClientOauthClient(ctx, exec, rel1)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return err
+}
+
+// Create builds a session and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *SessionTemplate) Create(ctx context.Context, exec bob.Executor) (*models.Session, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableSession(opt)
+
+	if o.r.User == nil {
+		SessionMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel0 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel0 = o.r.User.o.Build()
+	} else {
+		rel0, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel0.ID)
+
+	m, err := models.Sessions.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.User = rel0
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a session and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *SessionTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.Session {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a session and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *SessionTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.Session {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple sessions and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o SessionTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.SessionSlice, error) {
+	var err error
+	m := make(models.SessionSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple sessions and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o SessionTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.SessionSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple sessions and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o SessionTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.SessionSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// Session has methods that act as mods for the SessionTemplate
+var SessionMods sessionMods
+
+type sessionMods struct{}
+
+func (m sessionMods) RandomizeAllColumns(f *faker.Faker) SessionMod {
+	return SessionModSlice{
+		SessionMods.RandomID(f),
+		SessionMods.RandomRefreshHash(f),
+		SessionMods.RandomPreviousRefreshHash(f),
+		SessionMods.RandomCreatedAt(f),
+		SessionMods.RandomLastSeen(f),
+		SessionMods.RandomRotatedAt(f),
+		SessionMods.RandomExpiresAt(f),
+		SessionMods.RandomRevokedAt(f),
+		SessionMods.RandomUserAgent(f),
+		SessionMods.RandomIP(f),
+		SessionMods.RandomUserID(f),
+		SessionMods.RandomClientID(f),
+		SessionMods.RandomScopes(f),
+	}
+}
+
+// Set the model columns to this value
+func (m sessionMods) ID(val string) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.ID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m sessionMods) IDFunc(f func() string) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m sessionMods) UnsetID() SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m sessionMods) RandomID(f *faker.Faker) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.ID = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m sessionMods) RefreshHash(val []byte) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.RefreshHash = func() []byte { return val }
+	})
+}
+
+// Set the Column from the function
+func (m sessionMods) RefreshHashFunc(f func() []byte) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.RefreshHash = f
+	})
+}
+
+// Clear any values for the column
+func (m sessionMods) UnsetRefreshHash() SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.RefreshHash = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m sessionMods) RandomRefreshHash(f *faker.Faker) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.RefreshHash = func() []byte {
+			return random___byte(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m sessionMods) PreviousRefreshHash(val null.Val[[]byte]) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.PreviousRefreshHash = func() null.Val[[]byte] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m sessionMods) PreviousRefreshHashFunc(f func() null.Val[[]byte]) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.PreviousRefreshHash = f
+	})
+}
+
+// Clear any values for the column
+func (m sessionMods) UnsetPreviousRefreshHash() SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.PreviousRefreshHash = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m sessionMods) RandomPreviousRefreshHash(f *faker.Faker) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.PreviousRefreshHash = func() null.Val[[]byte] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random___byte(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m sessionMods) RandomPreviousRefreshHashNotNull(f *faker.Faker) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.PreviousRefreshHash = func() null.Val[[]byte] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random___byte(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m sessionMods) CreatedAt(val time.Time) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m sessionMods) CreatedAtFunc(f func() time.Time) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m sessionMods) UnsetCreatedAt() SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m sessionMods) RandomCreatedAt(f *faker.Faker) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m sessionMods) LastSeen(val time.Time) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.LastSeen = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m sessionMods) LastSeenFunc(f func() time.Time) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.LastSeen = f
+	})
+}
+
+// Clear any values for the column
+func (m sessionMods) UnsetLastSeen() SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.LastSeen = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m sessionMods) RandomLastSeen(f *faker.Faker) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.LastSeen = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m sessionMods) RotatedAt(val time.Time) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.RotatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m sessionMods) RotatedAtFunc(f func() time.Time) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.RotatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m sessionMods) UnsetRotatedAt() SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.RotatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m sessionMods) RandomRotatedAt(f *faker.Faker) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.RotatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m sessionMods) ExpiresAt(val time.Time) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.ExpiresAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m sessionMods) ExpiresAtFunc(f func() time.Time) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.ExpiresAt = f
+	})
+}
+
+// Clear any values for the column
+func (m sessionMods) UnsetExpiresAt() SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.ExpiresAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m sessionMods) RandomExpiresAt(f *faker.Faker) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.ExpiresAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m sessionMods) RevokedAt(val null.Val[time.Time]) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.RevokedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m sessionMods) RevokedAtFunc(f func() null.Val[time.Time]) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.RevokedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m sessionMods) UnsetRevokedAt() SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.RevokedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m sessionMods) RandomRevokedAt(f *faker.Faker) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.RevokedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m sessionMods) RandomRevokedAtNotNull(f *faker.Faker) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.RevokedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m sessionMods) UserAgent(val string) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.UserAgent = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m sessionMods) UserAgentFunc(f func() string) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.UserAgent = f
+	})
+}
+
+// Clear any values for the column
+func (m sessionMods) UnsetUserAgent() SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.UserAgent = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m sessionMods) RandomUserAgent(f *faker.Faker) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.UserAgent = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m sessionMods) IP(val string) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.IP = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m sessionMods) IPFunc(f func() string) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.IP = f
+	})
+}
+
+// Clear any values for the column
+func (m sessionMods) UnsetIP() SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.IP = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m sessionMods) RandomIP(f *faker.Faker) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.IP = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m sessionMods) UserID(val int32) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.UserID = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m sessionMods) UserIDFunc(f func() int32) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m sessionMods) UnsetUserID() SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m sessionMods) RandomUserID(f *faker.Faker) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.UserID = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m sessionMods) ClientID(val null.Val[string]) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.ClientID = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m sessionMods) ClientIDFunc(f func() null.Val[string]) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.ClientID = f
+	})
+}
+
+// Clear any values for the column
+func (m sessionMods) UnsetClientID() SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.ClientID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m sessionMods) RandomClientID(f *faker.Faker) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.ClientID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m sessionMods) RandomClientIDNotNull(f *faker.Faker) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.ClientID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m sessionMods) Scopes(val null.Val[string]) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.Scopes = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m sessionMods) ScopesFunc(f func() null.Val[string]) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.Scopes = f
+	})
+}
+
+// Clear any values for the column
+func (m sessionMods) UnsetScopes() SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.Scopes = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m sessionMods) RandomScopes(f *faker.Faker) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.Scopes = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m sessionMods) RandomScopesNotNull(f *faker.Faker) SessionMod {
+	return SessionModFunc(func(_ context.Context, o *SessionTemplate) {
+		o.Scopes = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m sessionMods) WithParentsCascading() SessionMod {
+	return SessionModFunc(func(ctx context.Context, o *SessionTemplate) {
+		if isDone, _ := sessionWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = sessionWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewOauthClientWithContext(ctx, OauthClientMods.WithParentsCascading())
+			m.WithClientOauthClient(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m sessionMods) WithUser(rel *UserTemplate) SessionMod {
+	return SessionModFunc(func(ctx context.Context, o *SessionTemplate) {
+		o.r.User = &sessionRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m sessionMods) WithNewUser(mods ...UserMod) SessionMod {
+	return SessionModFunc(func(ctx context.Context, o *SessionTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m sessionMods) WithExistingUser(em *models.User) SessionMod {
+	return SessionModFunc(func(ctx context.Context, o *SessionTemplate) {
+		o.r.User = &sessionRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m sessionMods) WithoutUser() SessionMod {
+	return SessionModFunc(func(ctx context.Context, o *SessionTemplate) {
+		o.r.User = nil
+	})
+}
+
+func (m sessionMods) WithClientOauthClient(rel *OauthClientTemplate) SessionMod {
+	return SessionModFunc(func(ctx context.Context, o *SessionTemplate) {
+		o.r.ClientOauthClient = &sessionRClientOauthClientR{
+			o: rel,
+		}
+	})
+}
+
+func (m sessionMods) WithNewClientOauthClient(mods ...OauthClientMod) SessionMod {
+	return SessionModFunc(func(ctx context.Context, o *SessionTemplate) {
+		related := o.f.NewOauthClientWithContext(ctx, mods...)
+
+		m.WithClientOauthClient(related).Apply(ctx, o)
+	})
+}
+
+func (m sessionMods) WithExistingClientOauthClient(em *models.OauthClient) SessionMod {
+	return SessionModFunc(func(ctx context.Context, o *SessionTemplate) {
+		o.r.ClientOauthClient = &sessionRClientOauthClientR{
+			o: o.f.FromExistingOauthClient(em),
+		}
+	})
+}
+
+func (m sessionMods) WithoutClientOauthClient() SessionMod {
+	return SessionModFunc(func(ctx context.Context, o *SessionTemplate) {
+		o.r.ClientOauthClient = nil
+	})
+}
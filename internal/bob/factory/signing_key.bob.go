@@ -0,0 +1,388 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/spotdemo4/ts-server/internal/bob/models"
+	"github.com/stephenafamo/bob"
+)
+
+type SigningKeyMod interface {
+	Apply(context.Context, *SigningKeyTemplate)
+}
+
+type SigningKeyModFunc func(context.Context, *SigningKeyTemplate)
+
+func (f SigningKeyModFunc) Apply(ctx context.Context, n *SigningKeyTemplate) {
+	f(ctx, n)
+}
+
+type SigningKeyModSlice []SigningKeyMod
+
+func (mods SigningKeyModSlice) Apply(ctx context.Context, n *SigningKeyTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// SigningKeyTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type SigningKeyTemplate struct {
+	ID         func() string
+	Algorithm  func() string
+	PrivateKey func() []byte
+	CreatedAt  func() time.Time
+
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+// Apply mods to the SigningKeyTemplate
+func (o *SigningKeyTemplate) Apply(ctx context.Context, mods ...SigningKeyMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.SigningKey
+// according to the relationships in the template. Nothing is inserted into the db
+func (t SigningKeyTemplate) setModelRels(o *models.SigningKey) {}
+
+// BuildSetter returns an *models.SigningKeySetter
+// this does nothing with the relationship templates
+func (o SigningKeyTemplate) BuildSetter() *models.SigningKeySetter {
+	m := &models.SigningKeySetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.Algorithm != nil {
+		val := o.Algorithm()
+		m.Algorithm = omit.From(val)
+	}
+	if o.PrivateKey != nil {
+		val := o.PrivateKey()
+		m.PrivateKey = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.SigningKeySetter
+// this does nothing with the relationship templates
+func (o SigningKeyTemplate) BuildManySetter(number int) []*models.SigningKeySetter {
+	m := make([]*models.SigningKeySetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.SigningKey
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use SigningKeyTemplate.Create
+func (o SigningKeyTemplate) Build() *models.SigningKey {
+	m := &models.SigningKey{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.Algorithm != nil {
+		m.Algorithm = o.Algorithm()
+	}
+	if o.PrivateKey != nil {
+		m.PrivateKey = o.PrivateKey()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.SigningKeySlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use SigningKeyTemplate.CreateMany
+func (o SigningKeyTemplate) BuildMany(number int) models.SigningKeySlice {
+	m := make(models.SigningKeySlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableSigningKey(m *models.SigningKeySetter) {
+	if !(m.ID.IsValue()) {
+		val := random_string(nil)
+		m.ID = omit.From(val)
+	}
+	if !(m.Algorithm.IsValue()) {
+		val := random_string(nil)
+		m.Algorithm = omit.From(val)
+	}
+	if !(m.PrivateKey.IsValue()) {
+		val := random___byte(nil)
+		m.PrivateKey = omit.From(val)
+	}
+	if !(m.CreatedAt.IsValue()) {
+		val := random_time_Time(nil)
+		m.CreatedAt = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.SigningKey
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *SigningKeyTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.SigningKey) error {
+	var err error
+
+	return err
+}
+
+// Create builds a signingKey and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *SigningKeyTemplate) Create(ctx context.Context, exec bob.Executor) (*models.SigningKey, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableSigningKey(opt)
+
+	m, err := models.SigningKeys.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a signingKey and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *SigningKeyTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.SigningKey {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a signingKey and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *SigningKeyTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.SigningKey {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple signingKeys and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o SigningKeyTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.SigningKeySlice, error) {
+	var err error
+	m := make(models.SigningKeySlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple signingKeys and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o SigningKeyTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.SigningKeySlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple signingKeys and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o SigningKeyTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.SigningKeySlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// SigningKey has methods that act as mods for the SigningKeyTemplate
+var SigningKeyMods signingKeyMods
+
+type signingKeyMods struct{}
+
+func (m signingKeyMods) RandomizeAllColumns(f *faker.Faker) SigningKeyMod {
+	return SigningKeyModSlice{
+		SigningKeyMods.RandomID(f),
+		SigningKeyMods.RandomAlgorithm(f),
+		SigningKeyMods.RandomPrivateKey(f),
+		SigningKeyMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m signingKeyMods) ID(val string) SigningKeyMod {
+	return SigningKeyModFunc(func(_ context.Context, o *SigningKeyTemplate) {
+		o.ID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m signingKeyMods) IDFunc(f func() string) SigningKeyMod {
+	return SigningKeyModFunc(func(_ context.Context, o *SigningKeyTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m signingKeyMods) UnsetID() SigningKeyMod {
+	return SigningKeyModFunc(func(_ context.Context, o *SigningKeyTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m signingKeyMods) RandomID(f *faker.Faker) SigningKeyMod {
+	return SigningKeyModFunc(func(_ context.Context, o *SigningKeyTemplate) {
+		o.ID = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m signingKeyMods) Algorithm(val string) SigningKeyMod {
+	return SigningKeyModFunc(func(_ context.Context, o *SigningKeyTemplate) {
+		o.Algorithm = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m signingKeyMods) AlgorithmFunc(f func() string) SigningKeyMod {
+	return SigningKeyModFunc(func(_ context.Context, o *SigningKeyTemplate) {
+		o.Algorithm = f
+	})
+}
+
+// Clear any values for the column
+func (m signingKeyMods) UnsetAlgorithm() SigningKeyMod {
+	return SigningKeyModFunc(func(_ context.Context, o *SigningKeyTemplate) {
+		o.Algorithm = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m signingKeyMods) RandomAlgorithm(f *faker.Faker) SigningKeyMod {
+	return SigningKeyModFunc(func(_ context.Context, o *SigningKeyTemplate) {
+		o.Algorithm = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m signingKeyMods) PrivateKey(val []byte) SigningKeyMod {
+	return SigningKeyModFunc(func(_ context.Context, o *SigningKeyTemplate) {
+		o.PrivateKey = func() []byte { return val }
+	})
+}
+
+// Set the Column from the function
+func (m signingKeyMods) PrivateKeyFunc(f func() []byte) SigningKeyMod {
+	return SigningKeyModFunc(func(_ context.Context, o *SigningKeyTemplate) {
+		o.PrivateKey = f
+	})
+}
+
+// Clear any values for the column
+func (m signingKeyMods) UnsetPrivateKey() SigningKeyMod {
+	return SigningKeyModFunc(func(_ context.Context, o *SigningKeyTemplate) {
+		o.PrivateKey = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m signingKeyMods) RandomPrivateKey(f *faker.Faker) SigningKeyMod {
+	return SigningKeyModFunc(func(_ context.Context, o *SigningKeyTemplate) {
+		o.PrivateKey = func() []byte {
+			return random___byte(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m signingKeyMods) CreatedAt(val time.Time) SigningKeyMod {
+	return SigningKeyModFunc(func(_ context.Context, o *SigningKeyTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m signingKeyMods) CreatedAtFunc(f func() time.Time) SigningKeyMod {
+	return SigningKeyModFunc(func(_ context.Context, o *SigningKeyTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m signingKeyMods) UnsetCreatedAt() SigningKeyMod {
+	return SigningKeyModFunc(func(_ context.Context, o *SigningKeyTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m signingKeyMods) RandomCreatedAt(f *faker.Faker) SigningKeyMod {
+	return SigningKeyModFunc(func(_ context.Context, o *SigningKeyTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m signingKeyMods) WithParentsCascading() SigningKeyMod {
+	return SigningKeyModFunc(func(ctx context.Context, o *SigningKeyTemplate) {
+		if isDone, _ := signingKeyWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = signingKeyWithParentsCascadingCtx.WithValue(ctx, true)
+	})
+}
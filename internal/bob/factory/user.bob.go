@@ -6,6 +6,7 @@ package factory
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/aarondl/opt/null"
 	"github.com/aarondl/opt/omit"
@@ -36,11 +37,20 @@ func (mods UserModSlice) Apply(ctx context.Context, n *UserTemplate) {
 // UserTemplate is an object representing the database table.
 // all columns are optional and should be set by mods
 type UserTemplate struct {
-	ID               func() int32
-	Username         func() string
-	Password         func() string
-	ProfilePictureID func() null.Val[int32]
-	WebauthnID       func() string
+	ID                    func() int32
+	Username              func() string
+	Password              func() string
+	ProfilePictureID      func() null.Val[int32]
+	WebauthnID            func() string
+	TokenVersion          func() int32
+	Role                  func() string
+	DisabledAt            func() null.Val[time.Time]
+	PasswordResetRequired func() bool
+	TotpSecret            func() null.Val[string]
+	TotpEnabled           func() bool
+	TotpLastStep          func() int32
+	Email                 func() null.Val[string]
+	EmailVerifiedAt       func() null.Val[time.Time]
 
 	r userR
 	f *Factory
@@ -49,24 +59,69 @@ type UserTemplate struct {
 }
 
 type userR struct {
+	AccountExports     []*userRAccountExportsR
+	APIKeys            []*userRAPIKeysR
 	Credentials        []*userRCredentialsR
+	EmailTokens        []*userREmailTokensR
 	Files              []*userRFilesR
+	Identities         []*userRIdentitiesR
 	Items              []*userRItemsR
+	LoginChallenges    []*userRLoginChallengesR
+	OauthCodes         []*userROauthCodesR
+	OauthConsents      []*userROauthConsentsR
+	RecoveryCodes      []*userRRecoveryCodesR
+	Sessions           []*userRSessionsR
 	ProfilePictureFile *userRProfilePictureFileR
 }
 
+type userRAccountExportsR struct {
+	number int
+	o      *AccountExportTemplate
+}
+type userRAPIKeysR struct {
+	number int
+	o      *APIKeyTemplate
+}
 type userRCredentialsR struct {
 	number int
 	o      *CredentialTemplate
 }
+type userREmailTokensR struct {
+	number int
+	o      *EmailTokenTemplate
+}
 type userRFilesR struct {
 	number int
 	o      *FileTemplate
 }
+type userRIdentitiesR struct {
+	number int
+	o      *IdentityTemplate
+}
 type userRItemsR struct {
 	number int
 	o      *ItemTemplate
 }
+type userRLoginChallengesR struct {
+	number int
+	o      *LoginChallengeTemplate
+}
+type userROauthCodesR struct {
+	number int
+	o      *OauthCodeTemplate
+}
+type userROauthConsentsR struct {
+	number int
+	o      *OauthConsentTemplate
+}
+type userRRecoveryCodesR struct {
+	number int
+	o      *RecoveryCodeTemplate
+}
+type userRSessionsR struct {
+	number int
+	o      *SessionTemplate
+}
 type userRProfilePictureFileR struct {
 	o *FileTemplate
 }
@@ -81,6 +136,32 @@ func (o *UserTemplate) Apply(ctx context.Context, mods ...UserMod) {
 // setModelRels creates and sets the relationships on *models.User
 // according to the relationships in the template. Nothing is inserted into the db
 func (t UserTemplate) setModelRels(o *models.User) {
+	if t.r.AccountExports != nil {
+		rel := models.AccountExportSlice{}
+		for _, r := range t.r.AccountExports {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.AccountExports = rel
+	}
+
+	if t.r.APIKeys != nil {
+		rel := models.APIKeySlice{}
+		for _, r := range t.r.APIKeys {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.APIKeys = rel
+	}
+
 	if t.r.Credentials != nil {
 		rel := models.CredentialSlice{}
 		for _, r := range t.r.Credentials {
@@ -94,6 +175,19 @@ func (t UserTemplate) setModelRels(o *models.User) {
 		o.R.Credentials = rel
 	}
 
+	if t.r.EmailTokens != nil {
+		rel := models.EmailTokenSlice{}
+		for _, r := range t.r.EmailTokens {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.EmailTokens = rel
+	}
+
 	if t.r.Files != nil {
 		rel := models.FileSlice{}
 		for _, r := range t.r.Files {
@@ -107,6 +201,19 @@ func (t UserTemplate) setModelRels(o *models.User) {
 		o.R.Files = rel
 	}
 
+	if t.r.Identities != nil {
+		rel := models.IdentitySlice{}
+		for _, r := range t.r.Identities {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Identities = rel
+	}
+
 	if t.r.Items != nil {
 		rel := models.ItemSlice{}
 		for _, r := range t.r.Items {
@@ -120,6 +227,71 @@ func (t UserTemplate) setModelRels(o *models.User) {
 		o.R.Items = rel
 	}
 
+	if t.r.LoginChallenges != nil {
+		rel := models.LoginChallengeSlice{}
+		for _, r := range t.r.LoginChallenges {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.LoginChallenges = rel
+	}
+
+	if t.r.OauthCodes != nil {
+		rel := models.OauthCodeSlice{}
+		for _, r := range t.r.OauthCodes {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.OauthCodes = rel
+	}
+
+	if t.r.OauthConsents != nil {
+		rel := models.OauthConsentSlice{}
+		for _, r := range t.r.OauthConsents {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.OauthConsents = rel
+	}
+
+	if t.r.RecoveryCodes != nil {
+		rel := models.RecoveryCodeSlice{}
+		for _, r := range t.r.RecoveryCodes {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.RecoveryCodes = rel
+	}
+
+	if t.r.Sessions != nil {
+		rel := models.SessionSlice{}
+		for _, r := range t.r.Sessions {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Sessions = rel
+	}
+
 	if t.r.ProfilePictureFile != nil {
 		rel := t.r.ProfilePictureFile.o.Build()
 		rel.R.ProfilePictureUsers = append(rel.R.ProfilePictureUsers, o)
@@ -153,6 +325,42 @@ func (o UserTemplate) BuildSetter() *models.UserSetter {
 		val := o.WebauthnID()
 		m.WebauthnID = omit.From(val)
 	}
+	if o.TokenVersion != nil {
+		val := o.TokenVersion()
+		m.TokenVersion = omit.From(val)
+	}
+	if o.Role != nil {
+		val := o.Role()
+		m.Role = omit.From(val)
+	}
+	if o.DisabledAt != nil {
+		val := o.DisabledAt()
+		m.DisabledAt = omitnull.FromNull(val)
+	}
+	if o.PasswordResetRequired != nil {
+		val := o.PasswordResetRequired()
+		m.PasswordResetRequired = omit.From(val)
+	}
+	if o.TotpSecret != nil {
+		val := o.TotpSecret()
+		m.TotpSecret = omitnull.FromNull(val)
+	}
+	if o.TotpEnabled != nil {
+		val := o.TotpEnabled()
+		m.TotpEnabled = omit.From(val)
+	}
+	if o.TotpLastStep != nil {
+		val := o.TotpLastStep()
+		m.TotpLastStep = omit.From(val)
+	}
+	if o.Email != nil {
+		val := o.Email()
+		m.Email = omitnull.FromNull(val)
+	}
+	if o.EmailVerifiedAt != nil {
+		val := o.EmailVerifiedAt()
+		m.EmailVerifiedAt = omitnull.FromNull(val)
+	}
 
 	return m
 }
@@ -190,6 +398,33 @@ func (o UserTemplate) Build() *models.User {
 	if o.WebauthnID != nil {
 		m.WebauthnID = o.WebauthnID()
 	}
+	if o.TokenVersion != nil {
+		m.TokenVersion = o.TokenVersion()
+	}
+	if o.Role != nil {
+		m.Role = o.Role()
+	}
+	if o.DisabledAt != nil {
+		m.DisabledAt = o.DisabledAt()
+	}
+	if o.PasswordResetRequired != nil {
+		m.PasswordResetRequired = o.PasswordResetRequired()
+	}
+	if o.TotpSecret != nil {
+		m.TotpSecret = o.TotpSecret()
+	}
+	if o.TotpEnabled != nil {
+		m.TotpEnabled = o.TotpEnabled()
+	}
+	if o.TotpLastStep != nil {
+		m.TotpLastStep = o.TotpLastStep()
+	}
+	if o.Email != nil {
+		m.Email = o.Email()
+	}
+	if o.EmailVerifiedAt != nil {
+		m.EmailVerifiedAt = o.EmailVerifiedAt()
+	}
 
 	o.setModelRels(m)
 
@@ -230,6 +465,46 @@ func ensureCreatableUser(m *models.UserSetter) {
 func (o *UserTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.User) error {
 	var err error
 
+	isAccountExportsDone, _ := userRelAccountExportsCtx.Value(ctx)
+	if !isAccountExportsDone && o.r.AccountExports != nil {
+		ctx = userRelAccountExportsCtx.WithValue(ctx, true)
+		for _, r := range o.r.AccountExports {
+			if r.o.alreadyPersisted {
+				m.R.AccountExports = append(m.R.AccountExports, r.o.Build())
+			} else {
+				rel0, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachAccountExports(ctx, exec, rel0...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isAPIKeysDone, _ := userRelAPIKeysCtx.Value(ctx)
+	if !isAPIKeysDone && o.r.APIKeys != nil {
+		ctx = userRelAPIKeysCtx.WithValue(ctx, true)
+		for _, r := range o.r.APIKeys {
+			if r.o.alreadyPersisted {
+				m.R.APIKeys = append(m.R.APIKeys, r.o.Build())
+			} else {
+				rel1, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachAPIKeys(ctx, exec, rel1...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
 	isCredentialsDone, _ := userRelCredentialsCtx.Value(ctx)
 	if !isCredentialsDone && o.r.Credentials != nil {
 		ctx = userRelCredentialsCtx.WithValue(ctx, true)
@@ -237,12 +512,32 @@ func (o *UserTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *
 			if r.o.alreadyPersisted {
 				m.R.Credentials = append(m.R.Credentials, r.o.Build())
 			} else {
-				rel0, err := r.o.CreateMany(ctx, exec, r.number)
+				rel2, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachCredentials(ctx, exec, rel2...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isEmailTokensDone, _ := userRelEmailTokensCtx.Value(ctx)
+	if !isEmailTokensDone && o.r.EmailTokens != nil {
+		ctx = userRelEmailTokensCtx.WithValue(ctx, true)
+		for _, r := range o.r.EmailTokens {
+			if r.o.alreadyPersisted {
+				m.R.EmailTokens = append(m.R.EmailTokens, r.o.Build())
+			} else {
+				rel3, err := r.o.CreateMany(ctx, exec, r.number)
 				if err != nil {
 					return err
 				}
 
-				err = m.AttachCredentials(ctx, exec, rel0...)
+				err = m.AttachEmailTokens(ctx, exec, rel3...)
 				if err != nil {
 					return err
 				}
@@ -257,12 +552,32 @@ func (o *UserTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *
 			if r.o.alreadyPersisted {
 				m.R.Files = append(m.R.Files, r.o.Build())
 			} else {
-				rel1, err := r.o.CreateMany(ctx, exec, r.number)
+				rel4, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachFiles(ctx, exec, rel4...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isIdentitiesDone, _ := userRelIdentitiesCtx.Value(ctx)
+	if !isIdentitiesDone && o.r.Identities != nil {
+		ctx = userRelIdentitiesCtx.WithValue(ctx, true)
+		for _, r := range o.r.Identities {
+			if r.o.alreadyPersisted {
+				m.R.Identities = append(m.R.Identities, r.o.Build())
+			} else {
+				rel5, err := r.o.CreateMany(ctx, exec, r.number)
 				if err != nil {
 					return err
 				}
 
-				err = m.AttachFiles(ctx, exec, rel1...)
+				err = m.AttachIdentities(ctx, exec, rel5...)
 				if err != nil {
 					return err
 				}
@@ -277,12 +592,112 @@ func (o *UserTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *
 			if r.o.alreadyPersisted {
 				m.R.Items = append(m.R.Items, r.o.Build())
 			} else {
-				rel2, err := r.o.CreateMany(ctx, exec, r.number)
+				rel6, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachItems(ctx, exec, rel6...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isLoginChallengesDone, _ := userRelLoginChallengesCtx.Value(ctx)
+	if !isLoginChallengesDone && o.r.LoginChallenges != nil {
+		ctx = userRelLoginChallengesCtx.WithValue(ctx, true)
+		for _, r := range o.r.LoginChallenges {
+			if r.o.alreadyPersisted {
+				m.R.LoginChallenges = append(m.R.LoginChallenges, r.o.Build())
+			} else {
+				rel7, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachLoginChallenges(ctx, exec, rel7...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isOauthCodesDone, _ := userRelOauthCodesCtx.Value(ctx)
+	if !isOauthCodesDone && o.r.OauthCodes != nil {
+		ctx = userRelOauthCodesCtx.WithValue(ctx, true)
+		for _, r := range o.r.OauthCodes {
+			if r.o.alreadyPersisted {
+				m.R.OauthCodes = append(m.R.OauthCodes, r.o.Build())
+			} else {
+				rel8, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachOauthCodes(ctx, exec, rel8...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isOauthConsentsDone, _ := userRelOauthConsentsCtx.Value(ctx)
+	if !isOauthConsentsDone && o.r.OauthConsents != nil {
+		ctx = userRelOauthConsentsCtx.WithValue(ctx, true)
+		for _, r := range o.r.OauthConsents {
+			if r.o.alreadyPersisted {
+				m.R.OauthConsents = append(m.R.OauthConsents, r.o.Build())
+			} else {
+				rel9, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachOauthConsents(ctx, exec, rel9...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isRecoveryCodesDone, _ := userRelRecoveryCodesCtx.Value(ctx)
+	if !isRecoveryCodesDone && o.r.RecoveryCodes != nil {
+		ctx = userRelRecoveryCodesCtx.WithValue(ctx, true)
+		for _, r := range o.r.RecoveryCodes {
+			if r.o.alreadyPersisted {
+				m.R.RecoveryCodes = append(m.R.RecoveryCodes, r.o.Build())
+			} else {
+				rel10, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachRecoveryCodes(ctx, exec, rel10...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isSessionsDone, _ := userRelSessionsCtx.Value(ctx)
+	if !isSessionsDone && o.r.Sessions != nil {
+		ctx = userRelSessionsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Sessions {
+			if r.o.alreadyPersisted {
+				m.R.Sessions = append(m.R.Sessions, r.o.Build())
+			} else {
+				rel11, err := r.o.CreateMany(ctx, exec, r.number)
 				if err != nil {
 					return err
 				}
 
-				err = m.AttachItems(ctx, exec, rel2...)
+				err = m.AttachSessions(ctx, exec, rel11...)
 				if err != nil {
 					return err
 				}
@@ -296,12 +711,12 @@ func (o *UserTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *
 		if o.r.ProfilePictureFile.o.alreadyPersisted {
 			m.R.ProfilePictureFile = o.r.ProfilePictureFile.o.Build()
 		} else {
-			var rel3 *models.File
-			rel3, err = o.r.ProfilePictureFile.o.Create(ctx, exec)
+			var rel12 *models.File
+			rel12, err = o.r.ProfilePictureFile.o.Create(ctx, exec)
 			if err != nil {
 				return err
 			}
-			err = m.AttachProfilePictureFile(ctx, exec, rel3)
+			err = m.AttachProfilePictureFile(ctx, exec, rel12)
 			if err != nil {
 				return err
 			}
@@ -406,6 +821,15 @@ func (m userMods) RandomizeAllColumns(f *faker.Faker) UserMod {
 		UserMods.RandomPassword(f),
 		UserMods.RandomProfilePictureID(f),
 		UserMods.RandomWebauthnID(f),
+		UserMods.RandomTokenVersion(f),
+		UserMods.RandomRole(f),
+		UserMods.RandomDisabledAt(f),
+		UserMods.RandomPasswordResetRequired(f),
+		UserMods.RandomTotpSecret(f),
+		UserMods.RandomTotpEnabled(f),
+		UserMods.RandomTotpLastStep(f),
+		UserMods.RandomEmail(f),
+		UserMods.RandomEmailVerifiedAt(f),
 	}
 }
 
@@ -586,47 +1010,510 @@ func (m userMods) RandomWebauthnID(f *faker.Faker) UserMod {
 	})
 }
 
-func (m userMods) WithParentsCascading() UserMod {
-	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		if isDone, _ := userWithParentsCascadingCtx.Value(ctx); isDone {
-			return
-		}
-		ctx = userWithParentsCascadingCtx.WithValue(ctx, true)
-		{
+// Set the model columns to this value
+func (m userMods) TokenVersion(val int32) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TokenVersion = func() int32 { return val }
+	})
+}
 
-			related := o.f.NewFileWithContext(ctx, FileMods.WithParentsCascading())
-			m.WithProfilePictureFile(related).Apply(ctx, o)
-		}
+// Set the Column from the function
+func (m userMods) TokenVersionFunc(f func() int32) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TokenVersion = f
+	})
+}
+
+// Clear any values for the column
+func (m userMods) UnsetTokenVersion() UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TokenVersion = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m userMods) RandomTokenVersion(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TokenVersion = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m userMods) Role(val string) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.Role = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userMods) RoleFunc(f func() string) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.Role = f
+	})
+}
+
+// Clear any values for the column
+func (m userMods) UnsetRole() UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.Role = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m userMods) RandomRole(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.Role = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m userMods) DisabledAt(val null.Val[time.Time]) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.DisabledAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userMods) DisabledAtFunc(f func() null.Val[time.Time]) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.DisabledAt = f
+	})
+}
+
+// Clear any values for the column
+func (m userMods) UnsetDisabledAt() UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.DisabledAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m userMods) RandomDisabledAt(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.DisabledAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m userMods) RandomDisabledAtNotNull(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.DisabledAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m userMods) PasswordResetRequired(val bool) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.PasswordResetRequired = func() bool { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userMods) PasswordResetRequiredFunc(f func() bool) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.PasswordResetRequired = f
+	})
+}
+
+// Clear any values for the column
+func (m userMods) UnsetPasswordResetRequired() UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.PasswordResetRequired = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m userMods) RandomPasswordResetRequired(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.PasswordResetRequired = func() bool {
+			return random_bool(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m userMods) TotpSecret(val null.Val[string]) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TotpSecret = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userMods) TotpSecretFunc(f func() null.Val[string]) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TotpSecret = f
+	})
+}
+
+// Clear any values for the column
+func (m userMods) UnsetTotpSecret() UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TotpSecret = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m userMods) RandomTotpSecret(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TotpSecret = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m userMods) RandomTotpSecretNotNull(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TotpSecret = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m userMods) TotpEnabled(val bool) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TotpEnabled = func() bool { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userMods) TotpEnabledFunc(f func() bool) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TotpEnabled = f
+	})
+}
+
+// Clear any values for the column
+func (m userMods) UnsetTotpEnabled() UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TotpEnabled = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m userMods) RandomTotpEnabled(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TotpEnabled = func() bool {
+			return random_bool(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m userMods) TotpLastStep(val int32) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TotpLastStep = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userMods) TotpLastStepFunc(f func() int32) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TotpLastStep = f
+	})
+}
+
+// Clear any values for the column
+func (m userMods) UnsetTotpLastStep() UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TotpLastStep = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m userMods) RandomTotpLastStep(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TotpLastStep = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m userMods) Email(val null.Val[string]) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.Email = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userMods) EmailFunc(f func() null.Val[string]) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.Email = f
+	})
+}
+
+// Clear any values for the column
+func (m userMods) UnsetEmail() UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.Email = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m userMods) RandomEmail(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.Email = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m userMods) RandomEmailNotNull(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.Email = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m userMods) EmailVerifiedAt(val null.Val[time.Time]) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.EmailVerifiedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userMods) EmailVerifiedAtFunc(f func() null.Val[time.Time]) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.EmailVerifiedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m userMods) UnsetEmailVerifiedAt() UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.EmailVerifiedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m userMods) RandomEmailVerifiedAt(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.EmailVerifiedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m userMods) RandomEmailVerifiedAtNotNull(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.EmailVerifiedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m userMods) WithParentsCascading() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		if isDone, _ := userWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = userWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewFileWithContext(ctx, FileMods.WithParentsCascading())
+			m.WithProfilePictureFile(related).Apply(ctx, o)
+		}
 	})
 }
 
 func (m userMods) WithProfilePictureFile(rel *FileTemplate) UserMod {
 	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		o.r.ProfilePictureFile = &userRProfilePictureFileR{
-			o: rel,
-		}
+		o.r.ProfilePictureFile = &userRProfilePictureFileR{
+			o: rel,
+		}
+	})
+}
+
+func (m userMods) WithNewProfilePictureFile(mods ...FileMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewFileWithContext(ctx, mods...)
+
+		m.WithProfilePictureFile(related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) WithExistingProfilePictureFile(em *models.File) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ProfilePictureFile = &userRProfilePictureFileR{
+			o: o.f.FromExistingFile(em),
+		}
+	})
+}
+
+func (m userMods) WithoutProfilePictureFile() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ProfilePictureFile = nil
+	})
+}
+
+func (m userMods) WithAccountExports(number int, related *AccountExportTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AccountExports = []*userRAccountExportsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewAccountExports(number int, mods ...AccountExportMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewAccountExportWithContext(ctx, mods...)
+		m.WithAccountExports(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddAccountExports(number int, related *AccountExportTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AccountExports = append(o.r.AccountExports, &userRAccountExportsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewAccountExports(number int, mods ...AccountExportMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewAccountExportWithContext(ctx, mods...)
+		m.AddAccountExports(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingAccountExports(existingModels ...*models.AccountExport) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.AccountExports = append(o.r.AccountExports, &userRAccountExportsR{
+				o: o.f.FromExistingAccountExport(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutAccountExports() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AccountExports = nil
+	})
+}
+
+func (m userMods) WithAPIKeys(number int, related *APIKeyTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.APIKeys = []*userRAPIKeysR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewAPIKeys(number int, mods ...APIKeyMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewAPIKeyWithContext(ctx, mods...)
+		m.WithAPIKeys(number, related).Apply(ctx, o)
 	})
 }
 
-func (m userMods) WithNewProfilePictureFile(mods ...FileMod) UserMod {
+func (m userMods) AddAPIKeys(number int, related *APIKeyTemplate) UserMod {
 	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		related := o.f.NewFileWithContext(ctx, mods...)
+		o.r.APIKeys = append(o.r.APIKeys, &userRAPIKeysR{
+			number: number,
+			o:      related,
+		})
+	})
+}
 
-		m.WithProfilePictureFile(related).Apply(ctx, o)
+func (m userMods) AddNewAPIKeys(number int, mods ...APIKeyMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewAPIKeyWithContext(ctx, mods...)
+		m.AddAPIKeys(number, related).Apply(ctx, o)
 	})
 }
 
-func (m userMods) WithExistingProfilePictureFile(em *models.File) UserMod {
+func (m userMods) AddExistingAPIKeys(existingModels ...*models.APIKey) UserMod {
 	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		o.r.ProfilePictureFile = &userRProfilePictureFileR{
-			o: o.f.FromExistingFile(em),
+		for _, em := range existingModels {
+			o.r.APIKeys = append(o.r.APIKeys, &userRAPIKeysR{
+				o: o.f.FromExistingAPIKey(em),
+			})
 		}
 	})
 }
 
-func (m userMods) WithoutProfilePictureFile() UserMod {
+func (m userMods) WithoutAPIKeys() UserMod {
 	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		o.r.ProfilePictureFile = nil
+		o.r.APIKeys = nil
 	})
 }
 
@@ -678,6 +1565,54 @@ func (m userMods) WithoutCredentials() UserMod {
 	})
 }
 
+func (m userMods) WithEmailTokens(number int, related *EmailTokenTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.EmailTokens = []*userREmailTokensR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewEmailTokens(number int, mods ...EmailTokenMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewEmailTokenWithContext(ctx, mods...)
+		m.WithEmailTokens(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddEmailTokens(number int, related *EmailTokenTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.EmailTokens = append(o.r.EmailTokens, &userREmailTokensR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewEmailTokens(number int, mods ...EmailTokenMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewEmailTokenWithContext(ctx, mods...)
+		m.AddEmailTokens(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingEmailTokens(existingModels ...*models.EmailToken) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.EmailTokens = append(o.r.EmailTokens, &userREmailTokensR{
+				o: o.f.FromExistingEmailToken(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutEmailTokens() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.EmailTokens = nil
+	})
+}
+
 func (m userMods) WithFiles(number int, related *FileTemplate) UserMod {
 	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
 		o.r.Files = []*userRFilesR{{
@@ -726,6 +1661,54 @@ func (m userMods) WithoutFiles() UserMod {
 	})
 }
 
+func (m userMods) WithIdentities(number int, related *IdentityTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Identities = []*userRIdentitiesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewIdentities(number int, mods ...IdentityMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewIdentityWithContext(ctx, mods...)
+		m.WithIdentities(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddIdentities(number int, related *IdentityTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Identities = append(o.r.Identities, &userRIdentitiesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewIdentities(number int, mods ...IdentityMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewIdentityWithContext(ctx, mods...)
+		m.AddIdentities(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingIdentities(existingModels ...*models.Identity) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.Identities = append(o.r.Identities, &userRIdentitiesR{
+				o: o.f.FromExistingIdentity(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutIdentities() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Identities = nil
+	})
+}
+
 func (m userMods) WithItems(number int, related *ItemTemplate) UserMod {
 	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
 		o.r.Items = []*userRItemsR{{
@@ -773,3 +1756,243 @@ func (m userMods) WithoutItems() UserMod {
 		o.r.Items = nil
 	})
 }
+
+func (m userMods) WithLoginChallenges(number int, related *LoginChallengeTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.LoginChallenges = []*userRLoginChallengesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewLoginChallenges(number int, mods ...LoginChallengeMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewLoginChallengeWithContext(ctx, mods...)
+		m.WithLoginChallenges(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddLoginChallenges(number int, related *LoginChallengeTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.LoginChallenges = append(o.r.LoginChallenges, &userRLoginChallengesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewLoginChallenges(number int, mods ...LoginChallengeMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewLoginChallengeWithContext(ctx, mods...)
+		m.AddLoginChallenges(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingLoginChallenges(existingModels ...*models.LoginChallenge) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.LoginChallenges = append(o.r.LoginChallenges, &userRLoginChallengesR{
+				o: o.f.FromExistingLoginChallenge(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutLoginChallenges() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.LoginChallenges = nil
+	})
+}
+
+func (m userMods) WithOauthCodes(number int, related *OauthCodeTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.OauthCodes = []*userROauthCodesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewOauthCodes(number int, mods ...OauthCodeMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewOauthCodeWithContext(ctx, mods...)
+		m.WithOauthCodes(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddOauthCodes(number int, related *OauthCodeTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.OauthCodes = append(o.r.OauthCodes, &userROauthCodesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewOauthCodes(number int, mods ...OauthCodeMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewOauthCodeWithContext(ctx, mods...)
+		m.AddOauthCodes(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingOauthCodes(existingModels ...*models.OauthCode) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.OauthCodes = append(o.r.OauthCodes, &userROauthCodesR{
+				o: o.f.FromExistingOauthCode(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutOauthCodes() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.OauthCodes = nil
+	})
+}
+
+func (m userMods) WithOauthConsents(number int, related *OauthConsentTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.OauthConsents = []*userROauthConsentsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewOauthConsents(number int, mods ...OauthConsentMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewOauthConsentWithContext(ctx, mods...)
+		m.WithOauthConsents(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddOauthConsents(number int, related *OauthConsentTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.OauthConsents = append(o.r.OauthConsents, &userROauthConsentsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewOauthConsents(number int, mods ...OauthConsentMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewOauthConsentWithContext(ctx, mods...)
+		m.AddOauthConsents(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingOauthConsents(existingModels ...*models.OauthConsent) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.OauthConsents = append(o.r.OauthConsents, &userROauthConsentsR{
+				o: o.f.FromExistingOauthConsent(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutOauthConsents() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.OauthConsents = nil
+	})
+}
+
+func (m userMods) WithRecoveryCodes(number int, related *RecoveryCodeTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.RecoveryCodes = []*userRRecoveryCodesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewRecoveryCodes(number int, mods ...RecoveryCodeMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewRecoveryCodeWithContext(ctx, mods...)
+		m.WithRecoveryCodes(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddRecoveryCodes(number int, related *RecoveryCodeTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.RecoveryCodes = append(o.r.RecoveryCodes, &userRRecoveryCodesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewRecoveryCodes(number int, mods ...RecoveryCodeMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewRecoveryCodeWithContext(ctx, mods...)
+		m.AddRecoveryCodes(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingRecoveryCodes(existingModels ...*models.RecoveryCode) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.RecoveryCodes = append(o.r.RecoveryCodes, &userRRecoveryCodesR{
+				o: o.f.FromExistingRecoveryCode(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutRecoveryCodes() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.RecoveryCodes = nil
+	})
+}
+
+func (m userMods) WithSessions(number int, related *SessionTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Sessions = []*userRSessionsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewSessions(number int, mods ...SessionMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewSessionWithContext(ctx, mods...)
+		m.WithSessions(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddSessions(number int, related *SessionTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Sessions = append(o.r.Sessions, &userRSessionsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewSessions(number int, mods ...SessionMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewSessionWithContext(ctx, mods...)
+		m.AddSessions(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingSessions(existingModels ...*models.Session) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.Sessions = append(o.r.Sessions, &userRSessionsR{
+				o: o.f.FromExistingSession(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutSessions() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Sessions = nil
+	})
+}
@@ -0,0 +1,954 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// AccountExport is an object representing the database table.
+type AccountExport struct {
+	ID          string              `db:"id,pk" `
+	Status      string              `db:"status" `
+	Size        int32               `db:"size" `
+	Error       null.Val[string]    `db:"error" `
+	CreatedAt   time.Time           `db:"created_at" `
+	FinishedAt  null.Val[time.Time] `db:"finished_at" `
+	HeartbeatAt time.Time           `db:"heartbeat_at" `
+	ExpiresAt   time.Time           `db:"expires_at" `
+	UserID      int32               `db:"user_id" `
+
+	R accountExportR `db:"-" `
+}
+
+// AccountExportSlice is an alias for a slice of pointers to AccountExport.
+// This should almost always be used instead of []*AccountExport.
+type AccountExportSlice []*AccountExport
+
+// AccountExports contains methods to work with the account_export table
+var AccountExports = sqlite.NewTablex[*AccountExport, AccountExportSlice, *AccountExportSetter]("", "account_export", buildAccountExportColumns("account_export"))
+
+// AccountExportsQuery is a query on the account_export table
+type AccountExportsQuery = *sqlite.ViewQuery[*AccountExport, AccountExportSlice]
+
+// accountExportR is where relationships are stored.
+type accountExportR struct {
+	User                     *User                  // fk_account_export_0
+	ExportAccountExportPages AccountExportPageSlice // fk_account_export_page_0
+}
+
+func buildAccountExportColumns(alias string) accountExportColumns {
+	return accountExportColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "status", "size", "error", "created_at", "finished_at", "heartbeat_at", "expires_at", "user_id",
+		).WithParent("account_export"),
+		tableAlias:  alias,
+		ID:          sqlite.Quote(alias, "id"),
+		Status:      sqlite.Quote(alias, "status"),
+		Size:        sqlite.Quote(alias, "size"),
+		Error:       sqlite.Quote(alias, "error"),
+		CreatedAt:   sqlite.Quote(alias, "created_at"),
+		FinishedAt:  sqlite.Quote(alias, "finished_at"),
+		HeartbeatAt: sqlite.Quote(alias, "heartbeat_at"),
+		ExpiresAt:   sqlite.Quote(alias, "expires_at"),
+		UserID:      sqlite.Quote(alias, "user_id"),
+	}
+}
+
+type accountExportColumns struct {
+	expr.ColumnsExpr
+	tableAlias  string
+	ID          sqlite.Expression
+	Status      sqlite.Expression
+	Size        sqlite.Expression
+	Error       sqlite.Expression
+	CreatedAt   sqlite.Expression
+	FinishedAt  sqlite.Expression
+	HeartbeatAt sqlite.Expression
+	ExpiresAt   sqlite.Expression
+	UserID      sqlite.Expression
+}
+
+func (c accountExportColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (accountExportColumns) AliasedAs(alias string) accountExportColumns {
+	return buildAccountExportColumns(alias)
+}
+
+// AccountExportSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type AccountExportSetter struct {
+	ID          omit.Val[string]        `db:"id,pk" `
+	Status      omit.Val[string]        `db:"status" `
+	Size        omit.Val[int32]         `db:"size" `
+	Error       omitnull.Val[string]    `db:"error" `
+	CreatedAt   omit.Val[time.Time]     `db:"created_at" `
+	FinishedAt  omitnull.Val[time.Time] `db:"finished_at" `
+	HeartbeatAt omit.Val[time.Time]     `db:"heartbeat_at" `
+	ExpiresAt   omit.Val[time.Time]     `db:"expires_at" `
+	UserID      omit.Val[int32]         `db:"user_id" `
+}
+
+func (s AccountExportSetter) SetColumns() []string {
+	vals := make([]string, 0, 9)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.Status.IsValue() {
+		vals = append(vals, "status")
+	}
+	if s.Size.IsValue() {
+		vals = append(vals, "size")
+	}
+	if !s.Error.IsUnset() {
+		vals = append(vals, "error")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	if !s.FinishedAt.IsUnset() {
+		vals = append(vals, "finished_at")
+	}
+	if s.HeartbeatAt.IsValue() {
+		vals = append(vals, "heartbeat_at")
+	}
+	if s.ExpiresAt.IsValue() {
+		vals = append(vals, "expires_at")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	return vals
+}
+
+func (s AccountExportSetter) Overwrite(t *AccountExport) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.Status.IsValue() {
+		t.Status = s.Status.MustGet()
+	}
+	if s.Size.IsValue() {
+		t.Size = s.Size.MustGet()
+	}
+	if !s.Error.IsUnset() {
+		t.Error = s.Error.MustGetNull()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+	if !s.FinishedAt.IsUnset() {
+		t.FinishedAt = s.FinishedAt.MustGetNull()
+	}
+	if s.HeartbeatAt.IsValue() {
+		t.HeartbeatAt = s.HeartbeatAt.MustGet()
+	}
+	if s.ExpiresAt.IsValue() {
+		t.ExpiresAt = s.ExpiresAt.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+}
+
+func (s *AccountExportSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return AccountExports.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 9)
+		if s.ID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
+		}
+
+		if s.Status.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Status.MustGet()))
+		}
+
+		if s.Size.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Size.MustGet()))
+		}
+
+		if !s.Error.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.Error.MustGetNull()))
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.CreatedAt.MustGet()))
+		}
+
+		if !s.FinishedAt.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.FinishedAt.MustGetNull()))
+		}
+
+		if s.HeartbeatAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.HeartbeatAt.MustGet()))
+		}
+
+		if s.ExpiresAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ExpiresAt.MustGet()))
+		}
+
+		if s.UserID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UserID.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s AccountExportSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s AccountExportSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 9)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "id")...),
+			sqlite.Arg(s.ID),
+		}})
+	}
+
+	if s.Status.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "status")...),
+			sqlite.Arg(s.Status),
+		}})
+	}
+
+	if s.Size.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "size")...),
+			sqlite.Arg(s.Size),
+		}})
+	}
+
+	if !s.Error.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "error")...),
+			sqlite.Arg(s.Error),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "created_at")...),
+			sqlite.Arg(s.CreatedAt),
+		}})
+	}
+
+	if !s.FinishedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "finished_at")...),
+			sqlite.Arg(s.FinishedAt),
+		}})
+	}
+
+	if s.HeartbeatAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "heartbeat_at")...),
+			sqlite.Arg(s.HeartbeatAt),
+		}})
+	}
+
+	if s.ExpiresAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "expires_at")...),
+			sqlite.Arg(s.ExpiresAt),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "user_id")...),
+			sqlite.Arg(s.UserID),
+		}})
+	}
+
+	return exprs
+}
+
+// FindAccountExport retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindAccountExport(ctx context.Context, exec bob.Executor, IDPK string, cols ...string) (*AccountExport, error) {
+	if len(cols) == 0 {
+		return AccountExports.Query(
+			sm.Where(AccountExports.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return AccountExports.Query(
+		sm.Where(AccountExports.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		sm.Columns(AccountExports.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// AccountExportExists checks the presence of a single record by primary key
+func AccountExportExists(ctx context.Context, exec bob.Executor, IDPK string) (bool, error) {
+	return AccountExports.Query(
+		sm.Where(AccountExports.Columns.ID.EQ(sqlite.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after AccountExport is retrieved from the database
+func (o *AccountExport) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = AccountExports.AfterSelectHooks.RunHooks(ctx, exec, AccountExportSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = AccountExports.AfterInsertHooks.RunHooks(ctx, exec, AccountExportSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = AccountExports.AfterUpdateHooks.RunHooks(ctx, exec, AccountExportSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = AccountExports.AfterDeleteHooks.RunHooks(ctx, exec, AccountExportSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the AccountExport
+func (o *AccountExport) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.ID)
+}
+
+func (o *AccountExport) pkEQ() dialect.Expression {
+	return sqlite.Quote("account_export", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the AccountExport
+func (o *AccountExport) Update(ctx context.Context, exec bob.Executor, s *AccountExportSetter) error {
+	v, err := AccountExports.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single AccountExport record with an executor
+func (o *AccountExport) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := AccountExports.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the AccountExport using the executor
+func (o *AccountExport) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := AccountExports.Query(
+		sm.Where(AccountExports.Columns.ID.EQ(sqlite.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after AccountExportSlice is retrieved from the database
+func (o AccountExportSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = AccountExports.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = AccountExports.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = AccountExports.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = AccountExports.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o AccountExportSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("account_export", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o AccountExportSlice) copyMatchingRows(from ...*AccountExport) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o AccountExportSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return AccountExports.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *AccountExport:
+				o.copyMatchingRows(retrieved)
+			case []*AccountExport:
+				o.copyMatchingRows(retrieved...)
+			case AccountExportSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a AccountExport or a slice of AccountExport
+				// then run the AfterUpdateHooks on the slice
+				_, err = AccountExports.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o AccountExportSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return AccountExports.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *AccountExport:
+				o.copyMatchingRows(retrieved)
+			case []*AccountExport:
+				o.copyMatchingRows(retrieved...)
+			case AccountExportSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a AccountExport or a slice of AccountExport
+				// then run the AfterDeleteHooks on the slice
+				_, err = AccountExports.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o AccountExportSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals AccountExportSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := AccountExports.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o AccountExportSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := AccountExports.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o AccountExportSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := AccountExports.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// User starts a query for related objects on user
+func (o *AccountExport) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(sqlite.Arg(o.UserID))),
+	)...)
+}
+
+func (os AccountExportSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.UserID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Users.Query(append(mods,
+		sm.Where(sqlite.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// ExportAccountExportPages starts a query for related objects on account_export_page
+func (o *AccountExport) ExportAccountExportPages(mods ...bob.Mod[*dialect.SelectQuery]) AccountExportPagesQuery {
+	return AccountExportPages.Query(append(mods,
+		sm.Where(AccountExportPages.Columns.ExportID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os AccountExportSlice) ExportAccountExportPages(mods ...bob.Mod[*dialect.SelectQuery]) AccountExportPagesQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return AccountExportPages.Query(append(mods,
+		sm.Where(sqlite.Group(AccountExportPages.Columns.ExportID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachAccountExportUser0(ctx context.Context, exec bob.Executor, count int, accountExport0 *AccountExport, user1 *User) (*AccountExport, error) {
+	setter := &AccountExportSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := accountExport0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachAccountExportUser0: %w", err)
+	}
+
+	return accountExport0, nil
+}
+
+func (accountExport0 *AccountExport) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachAccountExportUser0(ctx, exec, 1, accountExport0, user1)
+	if err != nil {
+		return err
+	}
+
+	accountExport0.R.User = user1
+
+	user1.R.AccountExports = append(user1.R.AccountExports, accountExport0)
+
+	return nil
+}
+
+func (accountExport0 *AccountExport) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachAccountExportUser0(ctx, exec, 1, accountExport0, user1)
+	if err != nil {
+		return err
+	}
+
+	accountExport0.R.User = user1
+
+	user1.R.AccountExports = append(user1.R.AccountExports, accountExport0)
+
+	return nil
+}
+
+func insertAccountExportExportAccountExportPages0(ctx context.Context, exec bob.Executor, accountExportPages1 []*AccountExportPageSetter, accountExport0 *AccountExport) (AccountExportPageSlice, error) {
+	for i := range accountExportPages1 {
+		accountExportPages1[i].ExportID = omit.From(accountExport0.ID)
+	}
+
+	ret, err := AccountExportPages.Insert(bob.ToMods(accountExportPages1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertAccountExportExportAccountExportPages0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachAccountExportExportAccountExportPages0(ctx context.Context, exec bob.Executor, count int, accountExportPages1 AccountExportPageSlice, accountExport0 *AccountExport) (AccountExportPageSlice, error) {
+	setter := &AccountExportPageSetter{
+		ExportID: omit.From(accountExport0.ID),
+	}
+
+	err := accountExportPages1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachAccountExportExportAccountExportPages0: %w", err)
+	}
+
+	return accountExportPages1, nil
+}
+
+func (accountExport0 *AccountExport) InsertExportAccountExportPages(ctx context.Context, exec bob.Executor, related ...*AccountExportPageSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	accountExportPages1, err := insertAccountExportExportAccountExportPages0(ctx, exec, related, accountExport0)
+	if err != nil {
+		return err
+	}
+
+	accountExport0.R.ExportAccountExportPages = append(accountExport0.R.ExportAccountExportPages, accountExportPages1...)
+
+	for _, rel := range accountExportPages1 {
+		rel.R.ExportAccountExport = accountExport0
+	}
+	return nil
+}
+
+func (accountExport0 *AccountExport) AttachExportAccountExportPages(ctx context.Context, exec bob.Executor, related ...*AccountExportPage) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	accountExportPages1 := AccountExportPageSlice(related)
+
+	_, err = attachAccountExportExportAccountExportPages0(ctx, exec, len(related), accountExportPages1, accountExport0)
+	if err != nil {
+		return err
+	}
+
+	accountExport0.R.ExportAccountExportPages = append(accountExport0.R.ExportAccountExportPages, accountExportPages1...)
+
+	for _, rel := range related {
+		rel.R.ExportAccountExport = accountExport0
+	}
+
+	return nil
+}
+
+type accountExportWhere[Q sqlite.Filterable] struct {
+	ID          sqlite.WhereMod[Q, string]
+	Status      sqlite.WhereMod[Q, string]
+	Size        sqlite.WhereMod[Q, int32]
+	Error       sqlite.WhereNullMod[Q, string]
+	CreatedAt   sqlite.WhereMod[Q, time.Time]
+	FinishedAt  sqlite.WhereNullMod[Q, time.Time]
+	HeartbeatAt sqlite.WhereMod[Q, time.Time]
+	ExpiresAt   sqlite.WhereMod[Q, time.Time]
+	UserID      sqlite.WhereMod[Q, int32]
+}
+
+func (accountExportWhere[Q]) AliasedAs(alias string) accountExportWhere[Q] {
+	return buildAccountExportWhere[Q](buildAccountExportColumns(alias))
+}
+
+func buildAccountExportWhere[Q sqlite.Filterable](cols accountExportColumns) accountExportWhere[Q] {
+	return accountExportWhere[Q]{
+		ID:          sqlite.Where[Q, string](cols.ID),
+		Status:      sqlite.Where[Q, string](cols.Status),
+		Size:        sqlite.Where[Q, int32](cols.Size),
+		Error:       sqlite.WhereNull[Q, string](cols.Error),
+		CreatedAt:   sqlite.Where[Q, time.Time](cols.CreatedAt),
+		FinishedAt:  sqlite.WhereNull[Q, time.Time](cols.FinishedAt),
+		HeartbeatAt: sqlite.Where[Q, time.Time](cols.HeartbeatAt),
+		ExpiresAt:   sqlite.Where[Q, time.Time](cols.ExpiresAt),
+		UserID:      sqlite.Where[Q, int32](cols.UserID),
+	}
+}
+
+func (o *AccountExport) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("accountExport cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.AccountExports = AccountExportSlice{o}
+		}
+		return nil
+	case "ExportAccountExportPages":
+		rels, ok := retrieved.(AccountExportPageSlice)
+		if !ok {
+			return fmt.Errorf("accountExport cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ExportAccountExportPages = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.ExportAccountExport = o
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("accountExport has no relationship %q", name)
+	}
+}
+
+type accountExportPreloader struct {
+	User func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildAccountExportPreloader() accountExportPreloader {
+	return accountExportPreloader{
+		User: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*User, UserSlice](sqlite.PreloadRel{
+				Name: "User",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        AccountExports,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type accountExportThenLoader[Q orm.Loadable] struct {
+	User                     func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ExportAccountExportPages func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildAccountExportThenLoader[Q orm.Loadable]() accountExportThenLoader[Q] {
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ExportAccountExportPagesLoadInterface interface {
+		LoadExportAccountExportPages(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return accountExportThenLoader[Q]{
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+		ExportAccountExportPages: thenLoadBuilder[Q](
+			"ExportAccountExportPages",
+			func(ctx context.Context, exec bob.Executor, retrieved ExportAccountExportPagesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadExportAccountExportPages(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadUser loads the accountExport's User into the .R struct
+func (o *AccountExport) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.AccountExports = AccountExportSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the accountExport's User into the .R struct
+func (os AccountExportSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if o.UserID != rel.ID {
+				continue
+			}
+
+			rel.R.AccountExports = append(rel.R.AccountExports, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadExportAccountExportPages loads the accountExport's ExportAccountExportPages into the .R struct
+func (o *AccountExport) LoadExportAccountExportPages(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ExportAccountExportPages = nil
+
+	related, err := o.ExportAccountExportPages(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.ExportAccountExport = o
+	}
+
+	o.R.ExportAccountExportPages = related
+	return nil
+}
+
+// LoadExportAccountExportPages loads the accountExport's ExportAccountExportPages into the .R struct
+func (os AccountExportSlice) LoadExportAccountExportPages(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	accountExportPages, err := os.ExportAccountExportPages(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.ExportAccountExportPages = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range accountExportPages {
+
+			if o.ID != rel.ExportID {
+				continue
+			}
+
+			rel.R.ExportAccountExport = o
+
+			o.R.ExportAccountExportPages = append(o.R.ExportAccountExportPages, rel)
+		}
+	}
+
+	return nil
+}
+
+type accountExportJoins[Q dialect.Joinable] struct {
+	typ                      string
+	User                     modAs[Q, userColumns]
+	ExportAccountExportPages modAs[Q, accountExportPageColumns]
+}
+
+func (j accountExportJoins[Q]) aliasedAs(alias string) accountExportJoins[Q] {
+	return buildAccountExportJoins[Q](buildAccountExportColumns(alias), j.typ)
+}
+
+func buildAccountExportJoins[Q dialect.Joinable](cols accountExportColumns, typ string) accountExportJoins[Q] {
+	return accountExportJoins[Q]{
+		typ: typ,
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+		ExportAccountExportPages: modAs[Q, accountExportPageColumns]{
+			c: AccountExportPages.Columns,
+			f: func(to accountExportPageColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, AccountExportPages.Name().As(to.Alias())).On(
+						to.ExportID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
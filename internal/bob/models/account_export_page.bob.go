@@ -0,0 +1,635 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// AccountExportPage is an object representing the database table.
+type AccountExportPage struct {
+	ExportID string `db:"export_id,pk" `
+	Page     int32  `db:"page,pk" `
+	Data     []byte `db:"data" `
+
+	R accountExportPageR `db:"-" `
+}
+
+// AccountExportPageSlice is an alias for a slice of pointers to AccountExportPage.
+// This should almost always be used instead of []*AccountExportPage.
+type AccountExportPageSlice []*AccountExportPage
+
+// AccountExportPages contains methods to work with the account_export_page table
+var AccountExportPages = sqlite.NewTablex[*AccountExportPage, AccountExportPageSlice, *AccountExportPageSetter]("", "account_export_page", buildAccountExportPageColumns("account_export_page"))
+
+// AccountExportPagesQuery is a query on the account_export_page table
+type AccountExportPagesQuery = *sqlite.ViewQuery[*AccountExportPage, AccountExportPageSlice]
+
+// accountExportPageR is where relationships are stored.
+type accountExportPageR struct {
+	ExportAccountExport *AccountExport // fk_account_export_page_0
+}
+
+func buildAccountExportPageColumns(alias string) accountExportPageColumns {
+	return accountExportPageColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"export_id", "page", "data",
+		).WithParent("account_export_page"),
+		tableAlias: alias,
+		ExportID:   sqlite.Quote(alias, "export_id"),
+		Page:       sqlite.Quote(alias, "page"),
+		Data:       sqlite.Quote(alias, "data"),
+	}
+}
+
+type accountExportPageColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ExportID   sqlite.Expression
+	Page       sqlite.Expression
+	Data       sqlite.Expression
+}
+
+func (c accountExportPageColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (accountExportPageColumns) AliasedAs(alias string) accountExportPageColumns {
+	return buildAccountExportPageColumns(alias)
+}
+
+// AccountExportPageSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type AccountExportPageSetter struct {
+	ExportID omit.Val[string] `db:"export_id,pk" `
+	Page     omit.Val[int32]  `db:"page,pk" `
+	Data     omit.Val[[]byte] `db:"data" `
+}
+
+func (s AccountExportPageSetter) SetColumns() []string {
+	vals := make([]string, 0, 3)
+	if s.ExportID.IsValue() {
+		vals = append(vals, "export_id")
+	}
+	if s.Page.IsValue() {
+		vals = append(vals, "page")
+	}
+	if s.Data.IsValue() {
+		vals = append(vals, "data")
+	}
+	return vals
+}
+
+func (s AccountExportPageSetter) Overwrite(t *AccountExportPage) {
+	if s.ExportID.IsValue() {
+		t.ExportID = s.ExportID.MustGet()
+	}
+	if s.Page.IsValue() {
+		t.Page = s.Page.MustGet()
+	}
+	if s.Data.IsValue() {
+		t.Data = s.Data.MustGet()
+	}
+}
+
+func (s *AccountExportPageSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return AccountExportPages.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"export_id", "page"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 3)
+		if s.ExportID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ExportID.MustGet()))
+		}
+
+		if s.Page.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Page.MustGet()))
+		}
+
+		if s.Data.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Data.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil), sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s AccountExportPageSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s AccountExportPageSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 3)
+
+	if s.ExportID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "export_id")...),
+			sqlite.Arg(s.ExportID),
+		}})
+	}
+
+	if s.Page.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "page")...),
+			sqlite.Arg(s.Page),
+		}})
+	}
+
+	if s.Data.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "data")...),
+			sqlite.Arg(s.Data),
+		}})
+	}
+
+	return exprs
+}
+
+// FindAccountExportPage retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindAccountExportPage(ctx context.Context, exec bob.Executor, ExportIDPK string, PagePK int32, cols ...string) (*AccountExportPage, error) {
+	if len(cols) == 0 {
+		return AccountExportPages.Query(
+			sm.Where(AccountExportPages.Columns.ExportID.EQ(sqlite.Arg(ExportIDPK))),
+			sm.Where(AccountExportPages.Columns.Page.EQ(sqlite.Arg(PagePK))),
+		).One(ctx, exec)
+	}
+
+	return AccountExportPages.Query(
+		sm.Where(AccountExportPages.Columns.ExportID.EQ(sqlite.Arg(ExportIDPK))),
+		sm.Where(AccountExportPages.Columns.Page.EQ(sqlite.Arg(PagePK))),
+		sm.Columns(AccountExportPages.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// AccountExportPageExists checks the presence of a single record by primary key
+func AccountExportPageExists(ctx context.Context, exec bob.Executor, ExportIDPK string, PagePK int32) (bool, error) {
+	return AccountExportPages.Query(
+		sm.Where(AccountExportPages.Columns.ExportID.EQ(sqlite.Arg(ExportIDPK))),
+		sm.Where(AccountExportPages.Columns.Page.EQ(sqlite.Arg(PagePK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after AccountExportPage is retrieved from the database
+func (o *AccountExportPage) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = AccountExportPages.AfterSelectHooks.RunHooks(ctx, exec, AccountExportPageSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = AccountExportPages.AfterInsertHooks.RunHooks(ctx, exec, AccountExportPageSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = AccountExportPages.AfterUpdateHooks.RunHooks(ctx, exec, AccountExportPageSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = AccountExportPages.AfterDeleteHooks.RunHooks(ctx, exec, AccountExportPageSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the AccountExportPage
+func (o *AccountExportPage) primaryKeyVals() bob.Expression {
+	return sqlite.ArgGroup(
+		o.ExportID,
+		o.Page,
+	)
+}
+
+func (o *AccountExportPage) pkEQ() dialect.Expression {
+	return sqlite.Group(sqlite.Quote("account_export_page", "export_id"), sqlite.Quote("account_export_page", "page")).EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the AccountExportPage
+func (o *AccountExportPage) Update(ctx context.Context, exec bob.Executor, s *AccountExportPageSetter) error {
+	v, err := AccountExportPages.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single AccountExportPage record with an executor
+func (o *AccountExportPage) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := AccountExportPages.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the AccountExportPage using the executor
+func (o *AccountExportPage) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := AccountExportPages.Query(
+		sm.Where(AccountExportPages.Columns.ExportID.EQ(sqlite.Arg(o.ExportID))),
+		sm.Where(AccountExportPages.Columns.Page.EQ(sqlite.Arg(o.Page))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after AccountExportPageSlice is retrieved from the database
+func (o AccountExportPageSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = AccountExportPages.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = AccountExportPages.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = AccountExportPages.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = AccountExportPages.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o AccountExportPageSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Group(sqlite.Quote("account_export_page", "export_id"), sqlite.Quote("account_export_page", "page")).In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o AccountExportPageSlice) copyMatchingRows(from ...*AccountExportPage) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ExportID != old.ExportID {
+				continue
+			}
+			if new.Page != old.Page {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o AccountExportPageSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return AccountExportPages.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *AccountExportPage:
+				o.copyMatchingRows(retrieved)
+			case []*AccountExportPage:
+				o.copyMatchingRows(retrieved...)
+			case AccountExportPageSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a AccountExportPage or a slice of AccountExportPage
+				// then run the AfterUpdateHooks on the slice
+				_, err = AccountExportPages.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o AccountExportPageSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return AccountExportPages.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *AccountExportPage:
+				o.copyMatchingRows(retrieved)
+			case []*AccountExportPage:
+				o.copyMatchingRows(retrieved...)
+			case AccountExportPageSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a AccountExportPage or a slice of AccountExportPage
+				// then run the AfterDeleteHooks on the slice
+				_, err = AccountExportPages.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o AccountExportPageSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals AccountExportPageSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := AccountExportPages.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o AccountExportPageSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := AccountExportPages.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o AccountExportPageSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := AccountExportPages.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// ExportAccountExport starts a query for related objects on account_export
+func (o *AccountExportPage) ExportAccountExport(mods ...bob.Mod[*dialect.SelectQuery]) AccountExportsQuery {
+	return AccountExports.Query(append(mods,
+		sm.Where(AccountExports.Columns.ID.EQ(sqlite.Arg(o.ExportID))),
+	)...)
+}
+
+func (os AccountExportPageSlice) ExportAccountExport(mods ...bob.Mod[*dialect.SelectQuery]) AccountExportsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ExportID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return AccountExports.Query(append(mods,
+		sm.Where(sqlite.Group(AccountExports.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachAccountExportPageExportAccountExport0(ctx context.Context, exec bob.Executor, count int, accountExportPage0 *AccountExportPage, accountExport1 *AccountExport) (*AccountExportPage, error) {
+	setter := &AccountExportPageSetter{
+		ExportID: omit.From(accountExport1.ID),
+	}
+
+	err := accountExportPage0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachAccountExportPageExportAccountExport0: %w", err)
+	}
+
+	return accountExportPage0, nil
+}
+
+func (accountExportPage0 *AccountExportPage) InsertExportAccountExport(ctx context.Context, exec bob.Executor, related *AccountExportSetter) error {
+	accountExport1, err := AccountExports.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachAccountExportPageExportAccountExport0(ctx, exec, 1, accountExportPage0, accountExport1)
+	if err != nil {
+		return err
+	}
+
+	accountExportPage0.R.ExportAccountExport = accountExport1
+
+	accountExport1.R.ExportAccountExportPages = append(accountExport1.R.ExportAccountExportPages, accountExportPage0)
+
+	return nil
+}
+
+func (accountExportPage0 *AccountExportPage) AttachExportAccountExport(ctx context.Context, exec bob.Executor, accountExport1 *AccountExport) error {
+	var err error
+
+	_, err = attachAccountExportPageExportAccountExport0(ctx, exec, 1, accountExportPage0, accountExport1)
+	if err != nil {
+		return err
+	}
+
+	accountExportPage0.R.ExportAccountExport = accountExport1
+
+	accountExport1.R.ExportAccountExportPages = append(accountExport1.R.ExportAccountExportPages, accountExportPage0)
+
+	return nil
+}
+
+type accountExportPageWhere[Q sqlite.Filterable] struct {
+	ExportID sqlite.WhereMod[Q, string]
+	Page     sqlite.WhereMod[Q, int32]
+	Data     sqlite.WhereMod[Q, []byte]
+}
+
+func (accountExportPageWhere[Q]) AliasedAs(alias string) accountExportPageWhere[Q] {
+	return buildAccountExportPageWhere[Q](buildAccountExportPageColumns(alias))
+}
+
+func buildAccountExportPageWhere[Q sqlite.Filterable](cols accountExportPageColumns) accountExportPageWhere[Q] {
+	return accountExportPageWhere[Q]{
+		ExportID: sqlite.Where[Q, string](cols.ExportID),
+		Page:     sqlite.Where[Q, int32](cols.Page),
+		Data:     sqlite.Where[Q, []byte](cols.Data),
+	}
+}
+
+func (o *AccountExportPage) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "ExportAccountExport":
+		rel, ok := retrieved.(*AccountExport)
+		if !ok {
+			return fmt.Errorf("accountExportPage cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ExportAccountExport = rel
+
+		if rel != nil {
+			rel.R.ExportAccountExportPages = AccountExportPageSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("accountExportPage has no relationship %q", name)
+	}
+}
+
+type accountExportPagePreloader struct {
+	ExportAccountExport func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildAccountExportPagePreloader() accountExportPagePreloader {
+	return accountExportPagePreloader{
+		ExportAccountExport: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*AccountExport, AccountExportSlice](sqlite.PreloadRel{
+				Name: "ExportAccountExport",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        AccountExportPages,
+						To:          AccountExports,
+						FromColumns: []string{"export_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, AccountExports.Columns.Names(), opts...)
+		},
+	}
+}
+
+type accountExportPageThenLoader[Q orm.Loadable] struct {
+	ExportAccountExport func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildAccountExportPageThenLoader[Q orm.Loadable]() accountExportPageThenLoader[Q] {
+	type ExportAccountExportLoadInterface interface {
+		LoadExportAccountExport(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return accountExportPageThenLoader[Q]{
+		ExportAccountExport: thenLoadBuilder[Q](
+			"ExportAccountExport",
+			func(ctx context.Context, exec bob.Executor, retrieved ExportAccountExportLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadExportAccountExport(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadExportAccountExport loads the accountExportPage's ExportAccountExport into the .R struct
+func (o *AccountExportPage) LoadExportAccountExport(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ExportAccountExport = nil
+
+	related, err := o.ExportAccountExport(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.ExportAccountExportPages = AccountExportPageSlice{o}
+
+	o.R.ExportAccountExport = related
+	return nil
+}
+
+// LoadExportAccountExport loads the accountExportPage's ExportAccountExport into the .R struct
+func (os AccountExportPageSlice) LoadExportAccountExport(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	accountExports, err := os.ExportAccountExport(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range accountExports {
+
+			if o.ExportID != rel.ID {
+				continue
+			}
+
+			rel.R.ExportAccountExportPages = append(rel.R.ExportAccountExportPages, o)
+
+			o.R.ExportAccountExport = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type accountExportPageJoins[Q dialect.Joinable] struct {
+	typ                 string
+	ExportAccountExport modAs[Q, accountExportColumns]
+}
+
+func (j accountExportPageJoins[Q]) aliasedAs(alias string) accountExportPageJoins[Q] {
+	return buildAccountExportPageJoins[Q](buildAccountExportPageColumns(alias), j.typ)
+}
+
+func buildAccountExportPageJoins[Q dialect.Joinable](cols accountExportPageColumns, typ string) accountExportPageJoins[Q] {
+	return accountExportPageJoins[Q]{
+		typ: typ,
+		ExportAccountExport: modAs[Q, accountExportColumns]{
+			c: AccountExports.Columns,
+			f: func(to accountExportColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, AccountExports.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.ExportID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
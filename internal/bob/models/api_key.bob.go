@@ -0,0 +1,766 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// APIKey is an object representing the database table.
+type APIKey struct {
+	ID        int32               `db:"id,pk" `
+	Name      string              `db:"name" `
+	Prefix    string              `db:"prefix" `
+	Hash      []byte              `db:"hash" `
+	Scopes    string              `db:"scopes" `
+	CreatedAt time.Time           `db:"created_at" `
+	ExpiresAt null.Val[time.Time] `db:"expires_at" `
+	LastUsed  null.Val[time.Time] `db:"last_used" `
+	UserID    int32               `db:"user_id" `
+
+	R apiKeyR `db:"-" `
+}
+
+// APIKeySlice is an alias for a slice of pointers to APIKey.
+// This should almost always be used instead of []*APIKey.
+type APIKeySlice []*APIKey
+
+// APIKeys contains methods to work with the api_key table
+var APIKeys = sqlite.NewTablex[*APIKey, APIKeySlice, *APIKeySetter]("", "api_key", buildAPIKeyColumns("api_key"))
+
+// APIKeysQuery is a query on the api_key table
+type APIKeysQuery = *sqlite.ViewQuery[*APIKey, APIKeySlice]
+
+// apiKeyR is where relationships are stored.
+type apiKeyR struct {
+	User *User // fk_api_key_0
+}
+
+func buildAPIKeyColumns(alias string) apiKeyColumns {
+	return apiKeyColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "name", "prefix", "hash", "scopes", "created_at", "expires_at", "last_used", "user_id",
+		).WithParent("api_key"),
+		tableAlias: alias,
+		ID:         sqlite.Quote(alias, "id"),
+		Name:       sqlite.Quote(alias, "name"),
+		Prefix:     sqlite.Quote(alias, "prefix"),
+		Hash:       sqlite.Quote(alias, "hash"),
+		Scopes:     sqlite.Quote(alias, "scopes"),
+		CreatedAt:  sqlite.Quote(alias, "created_at"),
+		ExpiresAt:  sqlite.Quote(alias, "expires_at"),
+		LastUsed:   sqlite.Quote(alias, "last_used"),
+		UserID:     sqlite.Quote(alias, "user_id"),
+	}
+}
+
+type apiKeyColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         sqlite.Expression
+	Name       sqlite.Expression
+	Prefix     sqlite.Expression
+	Hash       sqlite.Expression
+	Scopes     sqlite.Expression
+	CreatedAt  sqlite.Expression
+	ExpiresAt  sqlite.Expression
+	LastUsed   sqlite.Expression
+	UserID     sqlite.Expression
+}
+
+func (c apiKeyColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (apiKeyColumns) AliasedAs(alias string) apiKeyColumns {
+	return buildAPIKeyColumns(alias)
+}
+
+// APIKeySetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type APIKeySetter struct {
+	ID        omit.Val[int32]         `db:"id,pk" `
+	Name      omit.Val[string]        `db:"name" `
+	Prefix    omit.Val[string]        `db:"prefix" `
+	Hash      omit.Val[[]byte]        `db:"hash" `
+	Scopes    omit.Val[string]        `db:"scopes" `
+	CreatedAt omit.Val[time.Time]     `db:"created_at" `
+	ExpiresAt omitnull.Val[time.Time] `db:"expires_at" `
+	LastUsed  omitnull.Val[time.Time] `db:"last_used" `
+	UserID    omit.Val[int32]         `db:"user_id" `
+}
+
+func (s APIKeySetter) SetColumns() []string {
+	vals := make([]string, 0, 9)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.Name.IsValue() {
+		vals = append(vals, "name")
+	}
+	if s.Prefix.IsValue() {
+		vals = append(vals, "prefix")
+	}
+	if s.Hash.IsValue() {
+		vals = append(vals, "hash")
+	}
+	if s.Scopes.IsValue() {
+		vals = append(vals, "scopes")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	if !s.ExpiresAt.IsUnset() {
+		vals = append(vals, "expires_at")
+	}
+	if !s.LastUsed.IsUnset() {
+		vals = append(vals, "last_used")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	return vals
+}
+
+func (s APIKeySetter) Overwrite(t *APIKey) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.Name.IsValue() {
+		t.Name = s.Name.MustGet()
+	}
+	if s.Prefix.IsValue() {
+		t.Prefix = s.Prefix.MustGet()
+	}
+	if s.Hash.IsValue() {
+		t.Hash = s.Hash.MustGet()
+	}
+	if s.Scopes.IsValue() {
+		t.Scopes = s.Scopes.MustGet()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+	if !s.ExpiresAt.IsUnset() {
+		t.ExpiresAt = s.ExpiresAt.MustGetNull()
+	}
+	if !s.LastUsed.IsUnset() {
+		t.LastUsed = s.LastUsed.MustGetNull()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+}
+
+func (s *APIKeySetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return APIKeys.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 9)
+		if s.ID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
+		}
+
+		if s.Name.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Name.MustGet()))
+		}
+
+		if s.Prefix.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Prefix.MustGet()))
+		}
+
+		if s.Hash.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Hash.MustGet()))
+		}
+
+		if s.Scopes.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Scopes.MustGet()))
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.CreatedAt.MustGet()))
+		}
+
+		if !s.ExpiresAt.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.ExpiresAt.MustGetNull()))
+		}
+
+		if !s.LastUsed.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.LastUsed.MustGetNull()))
+		}
+
+		if s.UserID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UserID.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s APIKeySetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s APIKeySetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 9)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "id")...),
+			sqlite.Arg(s.ID),
+		}})
+	}
+
+	if s.Name.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "name")...),
+			sqlite.Arg(s.Name),
+		}})
+	}
+
+	if s.Prefix.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "prefix")...),
+			sqlite.Arg(s.Prefix),
+		}})
+	}
+
+	if s.Hash.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "hash")...),
+			sqlite.Arg(s.Hash),
+		}})
+	}
+
+	if s.Scopes.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "scopes")...),
+			sqlite.Arg(s.Scopes),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "created_at")...),
+			sqlite.Arg(s.CreatedAt),
+		}})
+	}
+
+	if !s.ExpiresAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "expires_at")...),
+			sqlite.Arg(s.ExpiresAt),
+		}})
+	}
+
+	if !s.LastUsed.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "last_used")...),
+			sqlite.Arg(s.LastUsed),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "user_id")...),
+			sqlite.Arg(s.UserID),
+		}})
+	}
+
+	return exprs
+}
+
+// FindAPIKey retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindAPIKey(ctx context.Context, exec bob.Executor, IDPK int32, cols ...string) (*APIKey, error) {
+	if len(cols) == 0 {
+		return APIKeys.Query(
+			sm.Where(APIKeys.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return APIKeys.Query(
+		sm.Where(APIKeys.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		sm.Columns(APIKeys.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// APIKeyExists checks the presence of a single record by primary key
+func APIKeyExists(ctx context.Context, exec bob.Executor, IDPK int32) (bool, error) {
+	return APIKeys.Query(
+		sm.Where(APIKeys.Columns.ID.EQ(sqlite.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after APIKey is retrieved from the database
+func (o *APIKey) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = APIKeys.AfterSelectHooks.RunHooks(ctx, exec, APIKeySlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = APIKeys.AfterInsertHooks.RunHooks(ctx, exec, APIKeySlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = APIKeys.AfterUpdateHooks.RunHooks(ctx, exec, APIKeySlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = APIKeys.AfterDeleteHooks.RunHooks(ctx, exec, APIKeySlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the APIKey
+func (o *APIKey) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.ID)
+}
+
+func (o *APIKey) pkEQ() dialect.Expression {
+	return sqlite.Quote("api_key", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the APIKey
+func (o *APIKey) Update(ctx context.Context, exec bob.Executor, s *APIKeySetter) error {
+	v, err := APIKeys.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single APIKey record with an executor
+func (o *APIKey) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := APIKeys.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the APIKey using the executor
+func (o *APIKey) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := APIKeys.Query(
+		sm.Where(APIKeys.Columns.ID.EQ(sqlite.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after APIKeySlice is retrieved from the database
+func (o APIKeySlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = APIKeys.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = APIKeys.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = APIKeys.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = APIKeys.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o APIKeySlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("api_key", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o APIKeySlice) copyMatchingRows(from ...*APIKey) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o APIKeySlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return APIKeys.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *APIKey:
+				o.copyMatchingRows(retrieved)
+			case []*APIKey:
+				o.copyMatchingRows(retrieved...)
+			case APIKeySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a APIKey or a slice of APIKey
+				// then run the AfterUpdateHooks on the slice
+				_, err = APIKeys.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o APIKeySlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return APIKeys.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *APIKey:
+				o.copyMatchingRows(retrieved)
+			case []*APIKey:
+				o.copyMatchingRows(retrieved...)
+			case APIKeySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a APIKey or a slice of APIKey
+				// then run the AfterDeleteHooks on the slice
+				_, err = APIKeys.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o APIKeySlice) UpdateAll(ctx context.Context, exec bob.Executor, vals APIKeySetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := APIKeys.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o APIKeySlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := APIKeys.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o APIKeySlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := APIKeys.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// User starts a query for related objects on user
+func (o *APIKey) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(sqlite.Arg(o.UserID))),
+	)...)
+}
+
+func (os APIKeySlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.UserID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Users.Query(append(mods,
+		sm.Where(sqlite.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachAPIKeyUser0(ctx context.Context, exec bob.Executor, count int, apiKey0 *APIKey, user1 *User) (*APIKey, error) {
+	setter := &APIKeySetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := apiKey0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachAPIKeyUser0: %w", err)
+	}
+
+	return apiKey0, nil
+}
+
+func (apiKey0 *APIKey) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachAPIKeyUser0(ctx, exec, 1, apiKey0, user1)
+	if err != nil {
+		return err
+	}
+
+	apiKey0.R.User = user1
+
+	user1.R.APIKeys = append(user1.R.APIKeys, apiKey0)
+
+	return nil
+}
+
+func (apiKey0 *APIKey) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachAPIKeyUser0(ctx, exec, 1, apiKey0, user1)
+	if err != nil {
+		return err
+	}
+
+	apiKey0.R.User = user1
+
+	user1.R.APIKeys = append(user1.R.APIKeys, apiKey0)
+
+	return nil
+}
+
+type apiKeyWhere[Q sqlite.Filterable] struct {
+	ID        sqlite.WhereMod[Q, int32]
+	Name      sqlite.WhereMod[Q, string]
+	Prefix    sqlite.WhereMod[Q, string]
+	Hash      sqlite.WhereMod[Q, []byte]
+	Scopes    sqlite.WhereMod[Q, string]
+	CreatedAt sqlite.WhereMod[Q, time.Time]
+	ExpiresAt sqlite.WhereNullMod[Q, time.Time]
+	LastUsed  sqlite.WhereNullMod[Q, time.Time]
+	UserID    sqlite.WhereMod[Q, int32]
+}
+
+func (apiKeyWhere[Q]) AliasedAs(alias string) apiKeyWhere[Q] {
+	return buildAPIKeyWhere[Q](buildAPIKeyColumns(alias))
+}
+
+func buildAPIKeyWhere[Q sqlite.Filterable](cols apiKeyColumns) apiKeyWhere[Q] {
+	return apiKeyWhere[Q]{
+		ID:        sqlite.Where[Q, int32](cols.ID),
+		Name:      sqlite.Where[Q, string](cols.Name),
+		Prefix:    sqlite.Where[Q, string](cols.Prefix),
+		Hash:      sqlite.Where[Q, []byte](cols.Hash),
+		Scopes:    sqlite.Where[Q, string](cols.Scopes),
+		CreatedAt: sqlite.Where[Q, time.Time](cols.CreatedAt),
+		ExpiresAt: sqlite.WhereNull[Q, time.Time](cols.ExpiresAt),
+		LastUsed:  sqlite.WhereNull[Q, time.Time](cols.LastUsed),
+		UserID:    sqlite.Where[Q, int32](cols.UserID),
+	}
+}
+
+func (o *APIKey) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("apiKey cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.APIKeys = APIKeySlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("apiKey has no relationship %q", name)
+	}
+}
+
+type apiKeyPreloader struct {
+	User func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildAPIKeyPreloader() apiKeyPreloader {
+	return apiKeyPreloader{
+		User: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*User, UserSlice](sqlite.PreloadRel{
+				Name: "User",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        APIKeys,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type apiKeyThenLoader[Q orm.Loadable] struct {
+	User func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildAPIKeyThenLoader[Q orm.Loadable]() apiKeyThenLoader[Q] {
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return apiKeyThenLoader[Q]{
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadUser loads the apiKey's User into the .R struct
+func (o *APIKey) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.APIKeys = APIKeySlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the apiKey's User into the .R struct
+func (os APIKeySlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if o.UserID != rel.ID {
+				continue
+			}
+
+			rel.R.APIKeys = append(rel.R.APIKeys, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type apiKeyJoins[Q dialect.Joinable] struct {
+	typ  string
+	User modAs[Q, userColumns]
+}
+
+func (j apiKeyJoins[Q]) aliasedAs(alias string) apiKeyJoins[Q] {
+	return buildAPIKeyJoins[Q](buildAPIKeyColumns(alias), j.typ)
+}
+
+func buildAPIKeyJoins[Q dialect.Joinable](cols apiKeyColumns, typ string) apiKeyJoins[Q] {
+	return apiKeyJoins[Q]{
+		typ: typ,
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
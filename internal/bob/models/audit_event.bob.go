@@ -0,0 +1,568 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+)
+
+// AuditEvent is an object representing the database table.
+type AuditEvent struct {
+	ID        int32           `db:"id,pk" `
+	ActorID   null.Val[int32] `db:"actor_id" `
+	UserID    null.Val[int32] `db:"user_id" `
+	Action    string          `db:"action" `
+	Target    string          `db:"target" `
+	IP        string          `db:"ip" `
+	UserAgent string          `db:"user_agent" `
+	Outcome   string          `db:"outcome" `
+	Metadata  string          `db:"metadata" `
+	CreatedAt time.Time       `db:"created_at" `
+}
+
+// AuditEventSlice is an alias for a slice of pointers to AuditEvent.
+// This should almost always be used instead of []*AuditEvent.
+type AuditEventSlice []*AuditEvent
+
+// AuditEvents contains methods to work with the audit_event table
+var AuditEvents = sqlite.NewTablex[*AuditEvent, AuditEventSlice, *AuditEventSetter]("", "audit_event", buildAuditEventColumns("audit_event"))
+
+// AuditEventsQuery is a query on the audit_event table
+type AuditEventsQuery = *sqlite.ViewQuery[*AuditEvent, AuditEventSlice]
+
+func buildAuditEventColumns(alias string) auditEventColumns {
+	return auditEventColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "actor_id", "user_id", "action", "target", "ip", "user_agent", "outcome", "metadata", "created_at",
+		).WithParent("audit_event"),
+		tableAlias: alias,
+		ID:         sqlite.Quote(alias, "id"),
+		ActorID:    sqlite.Quote(alias, "actor_id"),
+		UserID:     sqlite.Quote(alias, "user_id"),
+		Action:     sqlite.Quote(alias, "action"),
+		Target:     sqlite.Quote(alias, "target"),
+		IP:         sqlite.Quote(alias, "ip"),
+		UserAgent:  sqlite.Quote(alias, "user_agent"),
+		Outcome:    sqlite.Quote(alias, "outcome"),
+		Metadata:   sqlite.Quote(alias, "metadata"),
+		CreatedAt:  sqlite.Quote(alias, "created_at"),
+	}
+}
+
+type auditEventColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         sqlite.Expression
+	ActorID    sqlite.Expression
+	UserID     sqlite.Expression
+	Action     sqlite.Expression
+	Target     sqlite.Expression
+	IP         sqlite.Expression
+	UserAgent  sqlite.Expression
+	Outcome    sqlite.Expression
+	Metadata   sqlite.Expression
+	CreatedAt  sqlite.Expression
+}
+
+func (c auditEventColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (auditEventColumns) AliasedAs(alias string) auditEventColumns {
+	return buildAuditEventColumns(alias)
+}
+
+// AuditEventSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type AuditEventSetter struct {
+	ID        omit.Val[int32]     `db:"id,pk" `
+	ActorID   omitnull.Val[int32] `db:"actor_id" `
+	UserID    omitnull.Val[int32] `db:"user_id" `
+	Action    omit.Val[string]    `db:"action" `
+	Target    omit.Val[string]    `db:"target" `
+	IP        omit.Val[string]    `db:"ip" `
+	UserAgent omit.Val[string]    `db:"user_agent" `
+	Outcome   omit.Val[string]    `db:"outcome" `
+	Metadata  omit.Val[string]    `db:"metadata" `
+	CreatedAt omit.Val[time.Time] `db:"created_at" `
+}
+
+func (s AuditEventSetter) SetColumns() []string {
+	vals := make([]string, 0, 10)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if !s.ActorID.IsUnset() {
+		vals = append(vals, "actor_id")
+	}
+	if !s.UserID.IsUnset() {
+		vals = append(vals, "user_id")
+	}
+	if s.Action.IsValue() {
+		vals = append(vals, "action")
+	}
+	if s.Target.IsValue() {
+		vals = append(vals, "target")
+	}
+	if s.IP.IsValue() {
+		vals = append(vals, "ip")
+	}
+	if s.UserAgent.IsValue() {
+		vals = append(vals, "user_agent")
+	}
+	if s.Outcome.IsValue() {
+		vals = append(vals, "outcome")
+	}
+	if s.Metadata.IsValue() {
+		vals = append(vals, "metadata")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s AuditEventSetter) Overwrite(t *AuditEvent) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if !s.ActorID.IsUnset() {
+		t.ActorID = s.ActorID.MustGetNull()
+	}
+	if !s.UserID.IsUnset() {
+		t.UserID = s.UserID.MustGetNull()
+	}
+	if s.Action.IsValue() {
+		t.Action = s.Action.MustGet()
+	}
+	if s.Target.IsValue() {
+		t.Target = s.Target.MustGet()
+	}
+	if s.IP.IsValue() {
+		t.IP = s.IP.MustGet()
+	}
+	if s.UserAgent.IsValue() {
+		t.UserAgent = s.UserAgent.MustGet()
+	}
+	if s.Outcome.IsValue() {
+		t.Outcome = s.Outcome.MustGet()
+	}
+	if s.Metadata.IsValue() {
+		t.Metadata = s.Metadata.MustGet()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+}
+
+func (s *AuditEventSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return AuditEvents.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 10)
+		if s.ID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
+		}
+
+		if !s.ActorID.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.ActorID.MustGetNull()))
+		}
+
+		if !s.UserID.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.UserID.MustGetNull()))
+		}
+
+		if s.Action.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Action.MustGet()))
+		}
+
+		if s.Target.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Target.MustGet()))
+		}
+
+		if s.IP.IsValue() {
+			vals = append(vals, sqlite.Arg(s.IP.MustGet()))
+		}
+
+		if s.UserAgent.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UserAgent.MustGet()))
+		}
+
+		if s.Outcome.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Outcome.MustGet()))
+		}
+
+		if s.Metadata.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Metadata.MustGet()))
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.CreatedAt.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s AuditEventSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s AuditEventSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 10)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "id")...),
+			sqlite.Arg(s.ID),
+		}})
+	}
+
+	if !s.ActorID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "actor_id")...),
+			sqlite.Arg(s.ActorID),
+		}})
+	}
+
+	if !s.UserID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "user_id")...),
+			sqlite.Arg(s.UserID),
+		}})
+	}
+
+	if s.Action.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "action")...),
+			sqlite.Arg(s.Action),
+		}})
+	}
+
+	if s.Target.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "target")...),
+			sqlite.Arg(s.Target),
+		}})
+	}
+
+	if s.IP.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "ip")...),
+			sqlite.Arg(s.IP),
+		}})
+	}
+
+	if s.UserAgent.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "user_agent")...),
+			sqlite.Arg(s.UserAgent),
+		}})
+	}
+
+	if s.Outcome.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "outcome")...),
+			sqlite.Arg(s.Outcome),
+		}})
+	}
+
+	if s.Metadata.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "metadata")...),
+			sqlite.Arg(s.Metadata),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "created_at")...),
+			sqlite.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindAuditEvent retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindAuditEvent(ctx context.Context, exec bob.Executor, IDPK int32, cols ...string) (*AuditEvent, error) {
+	if len(cols) == 0 {
+		return AuditEvents.Query(
+			sm.Where(AuditEvents.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return AuditEvents.Query(
+		sm.Where(AuditEvents.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		sm.Columns(AuditEvents.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// AuditEventExists checks the presence of a single record by primary key
+func AuditEventExists(ctx context.Context, exec bob.Executor, IDPK int32) (bool, error) {
+	return AuditEvents.Query(
+		sm.Where(AuditEvents.Columns.ID.EQ(sqlite.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after AuditEvent is retrieved from the database
+func (o *AuditEvent) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = AuditEvents.AfterSelectHooks.RunHooks(ctx, exec, AuditEventSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = AuditEvents.AfterInsertHooks.RunHooks(ctx, exec, AuditEventSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = AuditEvents.AfterUpdateHooks.RunHooks(ctx, exec, AuditEventSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = AuditEvents.AfterDeleteHooks.RunHooks(ctx, exec, AuditEventSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the AuditEvent
+func (o *AuditEvent) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.ID)
+}
+
+func (o *AuditEvent) pkEQ() dialect.Expression {
+	return sqlite.Quote("audit_event", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the AuditEvent
+func (o *AuditEvent) Update(ctx context.Context, exec bob.Executor, s *AuditEventSetter) error {
+	v, err := AuditEvents.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single AuditEvent record with an executor
+func (o *AuditEvent) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := AuditEvents.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the AuditEvent using the executor
+func (o *AuditEvent) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := AuditEvents.Query(
+		sm.Where(AuditEvents.Columns.ID.EQ(sqlite.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after AuditEventSlice is retrieved from the database
+func (o AuditEventSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = AuditEvents.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = AuditEvents.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = AuditEvents.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = AuditEvents.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o AuditEventSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("audit_event", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o AuditEventSlice) copyMatchingRows(from ...*AuditEvent) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o AuditEventSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return AuditEvents.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *AuditEvent:
+				o.copyMatchingRows(retrieved)
+			case []*AuditEvent:
+				o.copyMatchingRows(retrieved...)
+			case AuditEventSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a AuditEvent or a slice of AuditEvent
+				// then run the AfterUpdateHooks on the slice
+				_, err = AuditEvents.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o AuditEventSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return AuditEvents.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *AuditEvent:
+				o.copyMatchingRows(retrieved)
+			case []*AuditEvent:
+				o.copyMatchingRows(retrieved...)
+			case AuditEventSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a AuditEvent or a slice of AuditEvent
+				// then run the AfterDeleteHooks on the slice
+				_, err = AuditEvents.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o AuditEventSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals AuditEventSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := AuditEvents.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o AuditEventSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := AuditEvents.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o AuditEventSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := AuditEvents.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type auditEventWhere[Q sqlite.Filterable] struct {
+	ID        sqlite.WhereMod[Q, int32]
+	ActorID   sqlite.WhereNullMod[Q, int32]
+	UserID    sqlite.WhereNullMod[Q, int32]
+	Action    sqlite.WhereMod[Q, string]
+	Target    sqlite.WhereMod[Q, string]
+	IP        sqlite.WhereMod[Q, string]
+	UserAgent sqlite.WhereMod[Q, string]
+	Outcome   sqlite.WhereMod[Q, string]
+	Metadata  sqlite.WhereMod[Q, string]
+	CreatedAt sqlite.WhereMod[Q, time.Time]
+}
+
+func (auditEventWhere[Q]) AliasedAs(alias string) auditEventWhere[Q] {
+	return buildAuditEventWhere[Q](buildAuditEventColumns(alias))
+}
+
+func buildAuditEventWhere[Q sqlite.Filterable](cols auditEventColumns) auditEventWhere[Q] {
+	return auditEventWhere[Q]{
+		ID:        sqlite.Where[Q, int32](cols.ID),
+		ActorID:   sqlite.WhereNull[Q, int32](cols.ActorID),
+		UserID:    sqlite.WhereNull[Q, int32](cols.UserID),
+		Action:    sqlite.Where[Q, string](cols.Action),
+		Target:    sqlite.Where[Q, string](cols.Target),
+		IP:        sqlite.Where[Q, string](cols.IP),
+		UserAgent: sqlite.Where[Q, string](cols.UserAgent),
+		Outcome:   sqlite.Where[Q, string](cols.Outcome),
+		Metadata:  sqlite.Where[Q, string](cols.Metadata),
+		CreatedAt: sqlite.Where[Q, time.Time](cols.CreatedAt),
+	}
+}
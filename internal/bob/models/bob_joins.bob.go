@@ -31,10 +31,21 @@ func (j joinSet[Q]) AliasedAs(alias string) joinSet[Q] {
 }
 
 type joins[Q dialect.Joinable] struct {
-	Credentials joinSet[credentialJoins[Q]]
-	Files       joinSet[fileJoins[Q]]
-	Items       joinSet[itemJoins[Q]]
-	Users       joinSet[userJoins[Q]]
+	AccountExports     joinSet[accountExportJoins[Q]]
+	AccountExportPages joinSet[accountExportPageJoins[Q]]
+	APIKeys            joinSet[apiKeyJoins[Q]]
+	Credentials        joinSet[credentialJoins[Q]]
+	EmailTokens        joinSet[emailTokenJoins[Q]]
+	Files              joinSet[fileJoins[Q]]
+	Identities         joinSet[identityJoins[Q]]
+	Items              joinSet[itemJoins[Q]]
+	LoginChallenges    joinSet[loginChallengeJoins[Q]]
+	OauthClients       joinSet[oauthClientJoins[Q]]
+	OauthCodes         joinSet[oauthCodeJoins[Q]]
+	OauthConsents      joinSet[oauthConsentJoins[Q]]
+	RecoveryCodes      joinSet[recoveryCodeJoins[Q]]
+	Sessions           joinSet[sessionJoins[Q]]
+	Users              joinSet[userJoins[Q]]
 }
 
 func buildJoinSet[Q interface{ aliasedAs(string) Q }, C any, F func(C, string) Q](c C, f F) joinSet[Q] {
@@ -47,10 +58,21 @@ func buildJoinSet[Q interface{ aliasedAs(string) Q }, C any, F func(C, string) Q
 
 func getJoins[Q dialect.Joinable]() joins[Q] {
 	return joins[Q]{
-		Credentials: buildJoinSet[credentialJoins[Q]](Credentials.Columns, buildCredentialJoins),
-		Files:       buildJoinSet[fileJoins[Q]](Files.Columns, buildFileJoins),
-		Items:       buildJoinSet[itemJoins[Q]](Items.Columns, buildItemJoins),
-		Users:       buildJoinSet[userJoins[Q]](Users.Columns, buildUserJoins),
+		AccountExports:     buildJoinSet[accountExportJoins[Q]](AccountExports.Columns, buildAccountExportJoins),
+		AccountExportPages: buildJoinSet[accountExportPageJoins[Q]](AccountExportPages.Columns, buildAccountExportPageJoins),
+		APIKeys:            buildJoinSet[apiKeyJoins[Q]](APIKeys.Columns, buildAPIKeyJoins),
+		Credentials:        buildJoinSet[credentialJoins[Q]](Credentials.Columns, buildCredentialJoins),
+		EmailTokens:        buildJoinSet[emailTokenJoins[Q]](EmailTokens.Columns, buildEmailTokenJoins),
+		Files:              buildJoinSet[fileJoins[Q]](Files.Columns, buildFileJoins),
+		Identities:         buildJoinSet[identityJoins[Q]](Identities.Columns, buildIdentityJoins),
+		Items:              buildJoinSet[itemJoins[Q]](Items.Columns, buildItemJoins),
+		LoginChallenges:    buildJoinSet[loginChallengeJoins[Q]](LoginChallenges.Columns, buildLoginChallengeJoins),
+		OauthClients:       buildJoinSet[oauthClientJoins[Q]](OauthClients.Columns, buildOauthClientJoins),
+		OauthCodes:         buildJoinSet[oauthCodeJoins[Q]](OauthCodes.Columns, buildOauthCodeJoins),
+		OauthConsents:      buildJoinSet[oauthConsentJoins[Q]](OauthConsents.Columns, buildOauthConsentJoins),
+		RecoveryCodes:      buildJoinSet[recoveryCodeJoins[Q]](RecoveryCodes.Columns, buildRecoveryCodeJoins),
+		Sessions:           buildJoinSet[sessionJoins[Q]](Sessions.Columns, buildSessionJoins),
+		Users:              buildJoinSet[userJoins[Q]](Users.Columns, buildUserJoins),
 	}
 }
 
@@ -17,18 +17,40 @@ import (
 var Preload = getPreloaders()
 
 type preloaders struct {
-	Credential credentialPreloader
-	File       filePreloader
-	Item       itemPreloader
-	User       userPreloader
+	AccountExport     accountExportPreloader
+	AccountExportPage accountExportPagePreloader
+	APIKey            apiKeyPreloader
+	Credential        credentialPreloader
+	EmailToken        emailTokenPreloader
+	File              filePreloader
+	Identity          identityPreloader
+	Item              itemPreloader
+	LoginChallenge    loginChallengePreloader
+	OauthClient       oauthClientPreloader
+	OauthCode         oauthCodePreloader
+	OauthConsent      oauthConsentPreloader
+	RecoveryCode      recoveryCodePreloader
+	Session           sessionPreloader
+	User              userPreloader
 }
 
 func getPreloaders() preloaders {
 	return preloaders{
-		Credential: buildCredentialPreloader(),
-		File:       buildFilePreloader(),
-		Item:       buildItemPreloader(),
-		User:       buildUserPreloader(),
+		AccountExport:     buildAccountExportPreloader(),
+		AccountExportPage: buildAccountExportPagePreloader(),
+		APIKey:            buildAPIKeyPreloader(),
+		Credential:        buildCredentialPreloader(),
+		EmailToken:        buildEmailTokenPreloader(),
+		File:              buildFilePreloader(),
+		Identity:          buildIdentityPreloader(),
+		Item:              buildItemPreloader(),
+		LoginChallenge:    buildLoginChallengePreloader(),
+		OauthClient:       buildOauthClientPreloader(),
+		OauthCode:         buildOauthCodePreloader(),
+		OauthConsent:      buildOauthConsentPreloader(),
+		RecoveryCode:      buildRecoveryCodePreloader(),
+		Session:           buildSessionPreloader(),
+		User:              buildUserPreloader(),
 	}
 }
 
@@ -39,18 +61,40 @@ var (
 )
 
 type thenLoaders[Q orm.Loadable] struct {
-	Credential credentialThenLoader[Q]
-	File       fileThenLoader[Q]
-	Item       itemThenLoader[Q]
-	User       userThenLoader[Q]
+	AccountExport     accountExportThenLoader[Q]
+	AccountExportPage accountExportPageThenLoader[Q]
+	APIKey            apiKeyThenLoader[Q]
+	Credential        credentialThenLoader[Q]
+	EmailToken        emailTokenThenLoader[Q]
+	File              fileThenLoader[Q]
+	Identity          identityThenLoader[Q]
+	Item              itemThenLoader[Q]
+	LoginChallenge    loginChallengeThenLoader[Q]
+	OauthClient       oauthClientThenLoader[Q]
+	OauthCode         oauthCodeThenLoader[Q]
+	OauthConsent      oauthConsentThenLoader[Q]
+	RecoveryCode      recoveryCodeThenLoader[Q]
+	Session           sessionThenLoader[Q]
+	User              userThenLoader[Q]
 }
 
 func getThenLoaders[Q orm.Loadable]() thenLoaders[Q] {
 	return thenLoaders[Q]{
-		Credential: buildCredentialThenLoader[Q](),
-		File:       buildFileThenLoader[Q](),
-		Item:       buildItemThenLoader[Q](),
-		User:       buildUserThenLoader[Q](),
+		AccountExport:     buildAccountExportThenLoader[Q](),
+		AccountExportPage: buildAccountExportPageThenLoader[Q](),
+		APIKey:            buildAPIKeyThenLoader[Q](),
+		Credential:        buildCredentialThenLoader[Q](),
+		EmailToken:        buildEmailTokenThenLoader[Q](),
+		File:              buildFileThenLoader[Q](),
+		Identity:          buildIdentityThenLoader[Q](),
+		Item:              buildItemThenLoader[Q](),
+		LoginChallenge:    buildLoginChallengeThenLoader[Q](),
+		OauthClient:       buildOauthClientThenLoader[Q](),
+		OauthCode:         buildOauthCodeThenLoader[Q](),
+		OauthConsent:      buildOauthConsentThenLoader[Q](),
+		RecoveryCode:      buildRecoveryCodeThenLoader[Q](),
+		Session:           buildSessionThenLoader[Q](),
+		User:              buildUserThenLoader[Q](),
 	}
 }
 
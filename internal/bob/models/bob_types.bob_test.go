@@ -8,17 +8,68 @@ import "github.com/stephenafamo/bob"
 // Set the testDB to enable tests that use the database
 var testDB bob.Transactor
 
+// Make sure the type AccountExport runs hooks after queries
+var _ bob.HookableType = &AccountExport{}
+
+// Make sure the type AccountExportPage runs hooks after queries
+var _ bob.HookableType = &AccountExportPage{}
+
+// Make sure the type APIKey runs hooks after queries
+var _ bob.HookableType = &APIKey{}
+
+// Make sure the type AuditEvent runs hooks after queries
+var _ bob.HookableType = &AuditEvent{}
+
+// Make sure the type Ceremony runs hooks after queries
+var _ bob.HookableType = &Ceremony{}
+
 // Make sure the type Credential runs hooks after queries
 var _ bob.HookableType = &Credential{}
 
+// Make sure the type EmailToken runs hooks after queries
+var _ bob.HookableType = &EmailToken{}
+
 // Make sure the type File runs hooks after queries
 var _ bob.HookableType = &File{}
 
+// Make sure the type Identity runs hooks after queries
+var _ bob.HookableType = &Identity{}
+
 // Make sure the type Item runs hooks after queries
 var _ bob.HookableType = &Item{}
 
+// Make sure the type ItemSearch runs hooks after queries
+var _ bob.HookableType = &ItemSearch{}
+
+// Make sure the type LoginAttempt runs hooks after queries
+var _ bob.HookableType = &LoginAttempt{}
+
+// Make sure the type LoginChallenge runs hooks after queries
+var _ bob.HookableType = &LoginChallenge{}
+
+// Make sure the type OauthClient runs hooks after queries
+var _ bob.HookableType = &OauthClient{}
+
+// Make sure the type OauthCode runs hooks after queries
+var _ bob.HookableType = &OauthCode{}
+
+// Make sure the type OauthConsent runs hooks after queries
+var _ bob.HookableType = &OauthConsent{}
+
+// Make sure the type RateLimit runs hooks after queries
+var _ bob.HookableType = &RateLimit{}
+
+// Make sure the type RecoveryCode runs hooks after queries
+var _ bob.HookableType = &RecoveryCode{}
+
 // Make sure the type SchemaMigration runs hooks after queries
 var _ bob.HookableType = &SchemaMigration{}
 
+// Make sure the type Session runs hooks after queries
+var _ bob.HookableType = &Session{}
+
+// Make sure the type SigningKey runs hooks after queries
+var _ bob.HookableType = &SigningKey{}
+
 // Make sure the type User runs hooks after queries
 var _ bob.HookableType = &User{}
@@ -17,23 +17,74 @@ var (
 )
 
 func Where[Q sqlite.Filterable]() struct {
-	Credentials      credentialWhere[Q]
-	Files            fileWhere[Q]
-	Items            itemWhere[Q]
-	SchemaMigrations schemaMigrationWhere[Q]
-	Users            userWhere[Q]
+	AccountExports     accountExportWhere[Q]
+	AccountExportPages accountExportPageWhere[Q]
+	APIKeys            apiKeyWhere[Q]
+	AuditEvents        auditEventWhere[Q]
+	Ceremonies         ceremonyWhere[Q]
+	Credentials        credentialWhere[Q]
+	EmailTokens        emailTokenWhere[Q]
+	Files              fileWhere[Q]
+	Identities         identityWhere[Q]
+	Items              itemWhere[Q]
+	ItemSearches       itemSearchWhere[Q]
+	LoginAttempts      loginAttemptWhere[Q]
+	LoginChallenges    loginChallengeWhere[Q]
+	OauthClients       oauthClientWhere[Q]
+	OauthCodes         oauthCodeWhere[Q]
+	OauthConsents      oauthConsentWhere[Q]
+	RateLimits         rateLimitWhere[Q]
+	RecoveryCodes      recoveryCodeWhere[Q]
+	SchemaMigrations   schemaMigrationWhere[Q]
+	Sessions           sessionWhere[Q]
+	SigningKeys        signingKeyWhere[Q]
+	Users              userWhere[Q]
 } {
 	return struct {
-		Credentials      credentialWhere[Q]
-		Files            fileWhere[Q]
-		Items            itemWhere[Q]
-		SchemaMigrations schemaMigrationWhere[Q]
-		Users            userWhere[Q]
+		AccountExports     accountExportWhere[Q]
+		AccountExportPages accountExportPageWhere[Q]
+		APIKeys            apiKeyWhere[Q]
+		AuditEvents        auditEventWhere[Q]
+		Ceremonies         ceremonyWhere[Q]
+		Credentials        credentialWhere[Q]
+		EmailTokens        emailTokenWhere[Q]
+		Files              fileWhere[Q]
+		Identities         identityWhere[Q]
+		Items              itemWhere[Q]
+		ItemSearches       itemSearchWhere[Q]
+		LoginAttempts      loginAttemptWhere[Q]
+		LoginChallenges    loginChallengeWhere[Q]
+		OauthClients       oauthClientWhere[Q]
+		OauthCodes         oauthCodeWhere[Q]
+		OauthConsents      oauthConsentWhere[Q]
+		RateLimits         rateLimitWhere[Q]
+		RecoveryCodes      recoveryCodeWhere[Q]
+		SchemaMigrations   schemaMigrationWhere[Q]
+		Sessions           sessionWhere[Q]
+		SigningKeys        signingKeyWhere[Q]
+		Users              userWhere[Q]
 	}{
-		Credentials:      buildCredentialWhere[Q](Credentials.Columns),
-		Files:            buildFileWhere[Q](Files.Columns),
-		Items:            buildItemWhere[Q](Items.Columns),
-		SchemaMigrations: buildSchemaMigrationWhere[Q](SchemaMigrations.Columns),
-		Users:            buildUserWhere[Q](Users.Columns),
+		AccountExports:     buildAccountExportWhere[Q](AccountExports.Columns),
+		AccountExportPages: buildAccountExportPageWhere[Q](AccountExportPages.Columns),
+		APIKeys:            buildAPIKeyWhere[Q](APIKeys.Columns),
+		AuditEvents:        buildAuditEventWhere[Q](AuditEvents.Columns),
+		Ceremonies:         buildCeremonyWhere[Q](Ceremonies.Columns),
+		Credentials:        buildCredentialWhere[Q](Credentials.Columns),
+		EmailTokens:        buildEmailTokenWhere[Q](EmailTokens.Columns),
+		Files:              buildFileWhere[Q](Files.Columns),
+		Identities:         buildIdentityWhere[Q](Identities.Columns),
+		Items:              buildItemWhere[Q](Items.Columns),
+		ItemSearches:       buildItemSearchWhere[Q](ItemSearches.Columns),
+		LoginAttempts:      buildLoginAttemptWhere[Q](LoginAttempts.Columns),
+		LoginChallenges:    buildLoginChallengeWhere[Q](LoginChallenges.Columns),
+		OauthClients:       buildOauthClientWhere[Q](OauthClients.Columns),
+		OauthCodes:         buildOauthCodeWhere[Q](OauthCodes.Columns),
+		OauthConsents:      buildOauthConsentWhere[Q](OauthConsents.Columns),
+		RateLimits:         buildRateLimitWhere[Q](RateLimits.Columns),
+		RecoveryCodes:      buildRecoveryCodeWhere[Q](RecoveryCodes.Columns),
+		SchemaMigrations:   buildSchemaMigrationWhere[Q](SchemaMigrations.Columns),
+		Sessions:           buildSessionWhere[Q](Sessions.Columns),
+		SigningKeys:        buildSigningKeyWhere[Q](SigningKeys.Columns),
+		Users:              buildUserWhere[Q](Users.Columns),
 	}
 }
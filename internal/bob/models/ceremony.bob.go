@@ -0,0 +1,405 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+)
+
+// Ceremony is an object representing the database table.
+type Ceremony struct {
+	ID        string    `db:"id,pk" `
+	Data      []byte    `db:"data" `
+	ExpiresAt time.Time `db:"expires_at" `
+}
+
+// CeremonySlice is an alias for a slice of pointers to Ceremony.
+// This should almost always be used instead of []*Ceremony.
+type CeremonySlice []*Ceremony
+
+// Ceremonies contains methods to work with the ceremony table
+var Ceremonies = sqlite.NewTablex[*Ceremony, CeremonySlice, *CeremonySetter]("", "ceremony", buildCeremonyColumns("ceremony"))
+
+// CeremoniesQuery is a query on the ceremony table
+type CeremoniesQuery = *sqlite.ViewQuery[*Ceremony, CeremonySlice]
+
+func buildCeremonyColumns(alias string) ceremonyColumns {
+	return ceremonyColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "data", "expires_at",
+		).WithParent("ceremony"),
+		tableAlias: alias,
+		ID:         sqlite.Quote(alias, "id"),
+		Data:       sqlite.Quote(alias, "data"),
+		ExpiresAt:  sqlite.Quote(alias, "expires_at"),
+	}
+}
+
+type ceremonyColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         sqlite.Expression
+	Data       sqlite.Expression
+	ExpiresAt  sqlite.Expression
+}
+
+func (c ceremonyColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (ceremonyColumns) AliasedAs(alias string) ceremonyColumns {
+	return buildCeremonyColumns(alias)
+}
+
+// CeremonySetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type CeremonySetter struct {
+	ID        omit.Val[string]    `db:"id,pk" `
+	Data      omit.Val[[]byte]    `db:"data" `
+	ExpiresAt omit.Val[time.Time] `db:"expires_at" `
+}
+
+func (s CeremonySetter) SetColumns() []string {
+	vals := make([]string, 0, 3)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.Data.IsValue() {
+		vals = append(vals, "data")
+	}
+	if s.ExpiresAt.IsValue() {
+		vals = append(vals, "expires_at")
+	}
+	return vals
+}
+
+func (s CeremonySetter) Overwrite(t *Ceremony) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.Data.IsValue() {
+		t.Data = s.Data.MustGet()
+	}
+	if s.ExpiresAt.IsValue() {
+		t.ExpiresAt = s.ExpiresAt.MustGet()
+	}
+}
+
+func (s *CeremonySetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Ceremonies.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 3)
+		if s.ID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
+		}
+
+		if s.Data.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Data.MustGet()))
+		}
+
+		if s.ExpiresAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ExpiresAt.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s CeremonySetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s CeremonySetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 3)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "id")...),
+			sqlite.Arg(s.ID),
+		}})
+	}
+
+	if s.Data.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "data")...),
+			sqlite.Arg(s.Data),
+		}})
+	}
+
+	if s.ExpiresAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "expires_at")...),
+			sqlite.Arg(s.ExpiresAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindCeremony retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindCeremony(ctx context.Context, exec bob.Executor, IDPK string, cols ...string) (*Ceremony, error) {
+	if len(cols) == 0 {
+		return Ceremonies.Query(
+			sm.Where(Ceremonies.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return Ceremonies.Query(
+		sm.Where(Ceremonies.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		sm.Columns(Ceremonies.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// CeremonyExists checks the presence of a single record by primary key
+func CeremonyExists(ctx context.Context, exec bob.Executor, IDPK string) (bool, error) {
+	return Ceremonies.Query(
+		sm.Where(Ceremonies.Columns.ID.EQ(sqlite.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Ceremony is retrieved from the database
+func (o *Ceremony) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Ceremonies.AfterSelectHooks.RunHooks(ctx, exec, CeremonySlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Ceremonies.AfterInsertHooks.RunHooks(ctx, exec, CeremonySlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Ceremonies.AfterUpdateHooks.RunHooks(ctx, exec, CeremonySlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Ceremonies.AfterDeleteHooks.RunHooks(ctx, exec, CeremonySlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Ceremony
+func (o *Ceremony) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.ID)
+}
+
+func (o *Ceremony) pkEQ() dialect.Expression {
+	return sqlite.Quote("ceremony", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Ceremony
+func (o *Ceremony) Update(ctx context.Context, exec bob.Executor, s *CeremonySetter) error {
+	v, err := Ceremonies.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Ceremony record with an executor
+func (o *Ceremony) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Ceremonies.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Ceremony using the executor
+func (o *Ceremony) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Ceremonies.Query(
+		sm.Where(Ceremonies.Columns.ID.EQ(sqlite.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after CeremonySlice is retrieved from the database
+func (o CeremonySlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Ceremonies.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Ceremonies.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Ceremonies.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Ceremonies.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o CeremonySlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("ceremony", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o CeremonySlice) copyMatchingRows(from ...*Ceremony) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o CeremonySlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Ceremonies.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Ceremony:
+				o.copyMatchingRows(retrieved)
+			case []*Ceremony:
+				o.copyMatchingRows(retrieved...)
+			case CeremonySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Ceremony or a slice of Ceremony
+				// then run the AfterUpdateHooks on the slice
+				_, err = Ceremonies.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o CeremonySlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Ceremonies.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Ceremony:
+				o.copyMatchingRows(retrieved)
+			case []*Ceremony:
+				o.copyMatchingRows(retrieved...)
+			case CeremonySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Ceremony or a slice of Ceremony
+				// then run the AfterDeleteHooks on the slice
+				_, err = Ceremonies.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o CeremonySlice) UpdateAll(ctx context.Context, exec bob.Executor, vals CeremonySetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Ceremonies.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o CeremonySlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Ceremonies.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o CeremonySlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Ceremonies.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type ceremonyWhere[Q sqlite.Filterable] struct {
+	ID        sqlite.WhereMod[Q, string]
+	Data      sqlite.WhereMod[Q, []byte]
+	ExpiresAt sqlite.WhereMod[Q, time.Time]
+}
+
+func (ceremonyWhere[Q]) AliasedAs(alias string) ceremonyWhere[Q] {
+	return buildCeremonyWhere[Q](buildCeremonyColumns(alias))
+}
+
+func buildCeremonyWhere[Q sqlite.Filterable](cols ceremonyColumns) ceremonyWhere[Q] {
+	return ceremonyWhere[Q]{
+		ID:        sqlite.Where[Q, string](cols.ID),
+		Data:      sqlite.Where[Q, []byte](cols.Data),
+		ExpiresAt: sqlite.Where[Q, time.Time](cols.ExpiresAt),
+	}
+}
@@ -37,6 +37,8 @@ type Credential struct {
 	CreatedAt             time.Time        `db:"created_at" `
 	LastUsed              time.Time        `db:"last_used" `
 	UserID                int32            `db:"user_id" `
+	Name                  string           `db:"name" `
+	CloneWarning          bool             `db:"clone_warning" `
 
 	R credentialR `db:"-" `
 }
@@ -59,7 +61,7 @@ type credentialR struct {
 func buildCredentialColumns(alias string) credentialColumns {
 	return credentialColumns{
 		ColumnsExpr: expr.NewColumnsExpr(
-			"cred_id", "cred_public_key", "sign_count", "transports", "user_verified", "backup_eligible", "backup_state", "attestation_object", "attestation_client_data", "created_at", "last_used", "user_id",
+			"cred_id", "cred_public_key", "sign_count", "transports", "user_verified", "backup_eligible", "backup_state", "attestation_object", "attestation_client_data", "created_at", "last_used", "user_id", "name", "clone_warning",
 		).WithParent("credential"),
 		tableAlias:            alias,
 		CredID:                sqlite.Quote(alias, "cred_id"),
@@ -74,6 +76,8 @@ func buildCredentialColumns(alias string) credentialColumns {
 		CreatedAt:             sqlite.Quote(alias, "created_at"),
 		LastUsed:              sqlite.Quote(alias, "last_used"),
 		UserID:                sqlite.Quote(alias, "user_id"),
+		Name:                  sqlite.Quote(alias, "name"),
+		CloneWarning:          sqlite.Quote(alias, "clone_warning"),
 	}
 }
 
@@ -92,6 +96,8 @@ type credentialColumns struct {
 	CreatedAt             sqlite.Expression
 	LastUsed              sqlite.Expression
 	UserID                sqlite.Expression
+	Name                  sqlite.Expression
+	CloneWarning          sqlite.Expression
 }
 
 func (c credentialColumns) Alias() string {
@@ -118,10 +124,12 @@ type CredentialSetter struct {
 	CreatedAt             omit.Val[time.Time]  `db:"created_at" `
 	LastUsed              omit.Val[time.Time]  `db:"last_used" `
 	UserID                omit.Val[int32]      `db:"user_id" `
+	Name                  omit.Val[string]     `db:"name" `
+	CloneWarning          omit.Val[bool]       `db:"clone_warning" `
 }
 
 func (s CredentialSetter) SetColumns() []string {
-	vals := make([]string, 0, 12)
+	vals := make([]string, 0, 14)
 	if s.CredID.IsValue() {
 		vals = append(vals, "cred_id")
 	}
@@ -158,6 +166,12 @@ func (s CredentialSetter) SetColumns() []string {
 	if s.UserID.IsValue() {
 		vals = append(vals, "user_id")
 	}
+	if s.Name.IsValue() {
+		vals = append(vals, "name")
+	}
+	if s.CloneWarning.IsValue() {
+		vals = append(vals, "clone_warning")
+	}
 	return vals
 }
 
@@ -198,6 +212,12 @@ func (s CredentialSetter) Overwrite(t *Credential) {
 	if s.UserID.IsValue() {
 		t.UserID = s.UserID.MustGet()
 	}
+	if s.Name.IsValue() {
+		t.Name = s.Name.MustGet()
+	}
+	if s.CloneWarning.IsValue() {
+		t.CloneWarning = s.CloneWarning.MustGet()
+	}
 }
 
 func (s *CredentialSetter) Apply(q *dialect.InsertQuery) {
@@ -214,7 +234,7 @@ func (s *CredentialSetter) Apply(q *dialect.InsertQuery) {
 	}
 
 	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
-		vals := make([]bob.Expression, 0, 12)
+		vals := make([]bob.Expression, 0, 14)
 		if s.CredID.IsValue() {
 			vals = append(vals, sqlite.Arg(s.CredID.MustGet()))
 		}
@@ -263,6 +283,14 @@ func (s *CredentialSetter) Apply(q *dialect.InsertQuery) {
 			vals = append(vals, sqlite.Arg(s.UserID.MustGet()))
 		}
 
+		if s.Name.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Name.MustGet()))
+		}
+
+		if s.CloneWarning.IsValue() {
+			vals = append(vals, sqlite.Arg(s.CloneWarning.MustGet()))
+		}
+
 		if len(vals) == 0 {
 			vals = append(vals, sqlite.Arg(nil))
 		}
@@ -276,7 +304,7 @@ func (s CredentialSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s CredentialSetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 12)
+	exprs := make([]bob.Expression, 0, 14)
 
 	if s.CredID.IsValue() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -362,6 +390,20 @@ func (s CredentialSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if s.Name.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "name")...),
+			sqlite.Arg(s.Name),
+		}})
+	}
+
+	if s.CloneWarning.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "clone_warning")...),
+			sqlite.Arg(s.CloneWarning),
+		}})
+	}
+
 	return exprs
 }
 
@@ -666,6 +708,8 @@ type credentialWhere[Q sqlite.Filterable] struct {
 	CreatedAt             sqlite.WhereMod[Q, time.Time]
 	LastUsed              sqlite.WhereMod[Q, time.Time]
 	UserID                sqlite.WhereMod[Q, int32]
+	Name                  sqlite.WhereMod[Q, string]
+	CloneWarning          sqlite.WhereMod[Q, bool]
 }
 
 func (credentialWhere[Q]) AliasedAs(alias string) credentialWhere[Q] {
@@ -686,6 +730,8 @@ func buildCredentialWhere[Q sqlite.Filterable](cols credentialColumns) credentia
 		CreatedAt:             sqlite.Where[Q, time.Time](cols.CreatedAt),
 		LastUsed:              sqlite.Where[Q, time.Time](cols.LastUsed),
 		UserID:                sqlite.Where[Q, int32](cols.UserID),
+		Name:                  sqlite.Where[Q, string](cols.Name),
+		CloneWarning:          sqlite.Where[Q, bool](cols.CloneWarning),
 	}
 }
 
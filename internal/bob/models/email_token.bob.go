@@ -0,0 +1,743 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// EmailToken is an object representing the database table.
+type EmailToken struct {
+	ID        int32               `db:"id,pk" `
+	Hash      []byte              `db:"hash" `
+	Purpose   string              `db:"purpose" `
+	Email     string              `db:"email" `
+	CreatedAt time.Time           `db:"created_at" `
+	ExpiresAt time.Time           `db:"expires_at" `
+	UsedAt    null.Val[time.Time] `db:"used_at" `
+	UserID    int32               `db:"user_id" `
+
+	R emailTokenR `db:"-" `
+}
+
+// EmailTokenSlice is an alias for a slice of pointers to EmailToken.
+// This should almost always be used instead of []*EmailToken.
+type EmailTokenSlice []*EmailToken
+
+// EmailTokens contains methods to work with the email_token table
+var EmailTokens = sqlite.NewTablex[*EmailToken, EmailTokenSlice, *EmailTokenSetter]("", "email_token", buildEmailTokenColumns("email_token"))
+
+// EmailTokensQuery is a query on the email_token table
+type EmailTokensQuery = *sqlite.ViewQuery[*EmailToken, EmailTokenSlice]
+
+// emailTokenR is where relationships are stored.
+type emailTokenR struct {
+	User *User // fk_email_token_0
+}
+
+func buildEmailTokenColumns(alias string) emailTokenColumns {
+	return emailTokenColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "hash", "purpose", "email", "created_at", "expires_at", "used_at", "user_id",
+		).WithParent("email_token"),
+		tableAlias: alias,
+		ID:         sqlite.Quote(alias, "id"),
+		Hash:       sqlite.Quote(alias, "hash"),
+		Purpose:    sqlite.Quote(alias, "purpose"),
+		Email:      sqlite.Quote(alias, "email"),
+		CreatedAt:  sqlite.Quote(alias, "created_at"),
+		ExpiresAt:  sqlite.Quote(alias, "expires_at"),
+		UsedAt:     sqlite.Quote(alias, "used_at"),
+		UserID:     sqlite.Quote(alias, "user_id"),
+	}
+}
+
+type emailTokenColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         sqlite.Expression
+	Hash       sqlite.Expression
+	Purpose    sqlite.Expression
+	Email      sqlite.Expression
+	CreatedAt  sqlite.Expression
+	ExpiresAt  sqlite.Expression
+	UsedAt     sqlite.Expression
+	UserID     sqlite.Expression
+}
+
+func (c emailTokenColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (emailTokenColumns) AliasedAs(alias string) emailTokenColumns {
+	return buildEmailTokenColumns(alias)
+}
+
+// EmailTokenSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type EmailTokenSetter struct {
+	ID        omit.Val[int32]         `db:"id,pk" `
+	Hash      omit.Val[[]byte]        `db:"hash" `
+	Purpose   omit.Val[string]        `db:"purpose" `
+	Email     omit.Val[string]        `db:"email" `
+	CreatedAt omit.Val[time.Time]     `db:"created_at" `
+	ExpiresAt omit.Val[time.Time]     `db:"expires_at" `
+	UsedAt    omitnull.Val[time.Time] `db:"used_at" `
+	UserID    omit.Val[int32]         `db:"user_id" `
+}
+
+func (s EmailTokenSetter) SetColumns() []string {
+	vals := make([]string, 0, 8)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.Hash.IsValue() {
+		vals = append(vals, "hash")
+	}
+	if s.Purpose.IsValue() {
+		vals = append(vals, "purpose")
+	}
+	if s.Email.IsValue() {
+		vals = append(vals, "email")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	if s.ExpiresAt.IsValue() {
+		vals = append(vals, "expires_at")
+	}
+	if !s.UsedAt.IsUnset() {
+		vals = append(vals, "used_at")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	return vals
+}
+
+func (s EmailTokenSetter) Overwrite(t *EmailToken) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.Hash.IsValue() {
+		t.Hash = s.Hash.MustGet()
+	}
+	if s.Purpose.IsValue() {
+		t.Purpose = s.Purpose.MustGet()
+	}
+	if s.Email.IsValue() {
+		t.Email = s.Email.MustGet()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+	if s.ExpiresAt.IsValue() {
+		t.ExpiresAt = s.ExpiresAt.MustGet()
+	}
+	if !s.UsedAt.IsUnset() {
+		t.UsedAt = s.UsedAt.MustGetNull()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+}
+
+func (s *EmailTokenSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return EmailTokens.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 8)
+		if s.ID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
+		}
+
+		if s.Hash.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Hash.MustGet()))
+		}
+
+		if s.Purpose.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Purpose.MustGet()))
+		}
+
+		if s.Email.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Email.MustGet()))
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.CreatedAt.MustGet()))
+		}
+
+		if s.ExpiresAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ExpiresAt.MustGet()))
+		}
+
+		if !s.UsedAt.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.UsedAt.MustGetNull()))
+		}
+
+		if s.UserID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UserID.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s EmailTokenSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s EmailTokenSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 8)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "id")...),
+			sqlite.Arg(s.ID),
+		}})
+	}
+
+	if s.Hash.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "hash")...),
+			sqlite.Arg(s.Hash),
+		}})
+	}
+
+	if s.Purpose.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "purpose")...),
+			sqlite.Arg(s.Purpose),
+		}})
+	}
+
+	if s.Email.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "email")...),
+			sqlite.Arg(s.Email),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "created_at")...),
+			sqlite.Arg(s.CreatedAt),
+		}})
+	}
+
+	if s.ExpiresAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "expires_at")...),
+			sqlite.Arg(s.ExpiresAt),
+		}})
+	}
+
+	if !s.UsedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "used_at")...),
+			sqlite.Arg(s.UsedAt),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "user_id")...),
+			sqlite.Arg(s.UserID),
+		}})
+	}
+
+	return exprs
+}
+
+// FindEmailToken retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindEmailToken(ctx context.Context, exec bob.Executor, IDPK int32, cols ...string) (*EmailToken, error) {
+	if len(cols) == 0 {
+		return EmailTokens.Query(
+			sm.Where(EmailTokens.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return EmailTokens.Query(
+		sm.Where(EmailTokens.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		sm.Columns(EmailTokens.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// EmailTokenExists checks the presence of a single record by primary key
+func EmailTokenExists(ctx context.Context, exec bob.Executor, IDPK int32) (bool, error) {
+	return EmailTokens.Query(
+		sm.Where(EmailTokens.Columns.ID.EQ(sqlite.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after EmailToken is retrieved from the database
+func (o *EmailToken) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = EmailTokens.AfterSelectHooks.RunHooks(ctx, exec, EmailTokenSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = EmailTokens.AfterInsertHooks.RunHooks(ctx, exec, EmailTokenSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = EmailTokens.AfterUpdateHooks.RunHooks(ctx, exec, EmailTokenSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = EmailTokens.AfterDeleteHooks.RunHooks(ctx, exec, EmailTokenSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the EmailToken
+func (o *EmailToken) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.ID)
+}
+
+func (o *EmailToken) pkEQ() dialect.Expression {
+	return sqlite.Quote("email_token", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the EmailToken
+func (o *EmailToken) Update(ctx context.Context, exec bob.Executor, s *EmailTokenSetter) error {
+	v, err := EmailTokens.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single EmailToken record with an executor
+func (o *EmailToken) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := EmailTokens.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the EmailToken using the executor
+func (o *EmailToken) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := EmailTokens.Query(
+		sm.Where(EmailTokens.Columns.ID.EQ(sqlite.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after EmailTokenSlice is retrieved from the database
+func (o EmailTokenSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = EmailTokens.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = EmailTokens.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = EmailTokens.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = EmailTokens.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o EmailTokenSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("email_token", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o EmailTokenSlice) copyMatchingRows(from ...*EmailToken) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o EmailTokenSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return EmailTokens.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *EmailToken:
+				o.copyMatchingRows(retrieved)
+			case []*EmailToken:
+				o.copyMatchingRows(retrieved...)
+			case EmailTokenSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a EmailToken or a slice of EmailToken
+				// then run the AfterUpdateHooks on the slice
+				_, err = EmailTokens.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o EmailTokenSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return EmailTokens.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *EmailToken:
+				o.copyMatchingRows(retrieved)
+			case []*EmailToken:
+				o.copyMatchingRows(retrieved...)
+			case EmailTokenSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a EmailToken or a slice of EmailToken
+				// then run the AfterDeleteHooks on the slice
+				_, err = EmailTokens.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o EmailTokenSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals EmailTokenSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := EmailTokens.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o EmailTokenSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := EmailTokens.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o EmailTokenSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := EmailTokens.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// User starts a query for related objects on user
+func (o *EmailToken) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(sqlite.Arg(o.UserID))),
+	)...)
+}
+
+func (os EmailTokenSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.UserID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Users.Query(append(mods,
+		sm.Where(sqlite.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachEmailTokenUser0(ctx context.Context, exec bob.Executor, count int, emailToken0 *EmailToken, user1 *User) (*EmailToken, error) {
+	setter := &EmailTokenSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := emailToken0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachEmailTokenUser0: %w", err)
+	}
+
+	return emailToken0, nil
+}
+
+func (emailToken0 *EmailToken) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachEmailTokenUser0(ctx, exec, 1, emailToken0, user1)
+	if err != nil {
+		return err
+	}
+
+	emailToken0.R.User = user1
+
+	user1.R.EmailTokens = append(user1.R.EmailTokens, emailToken0)
+
+	return nil
+}
+
+func (emailToken0 *EmailToken) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachEmailTokenUser0(ctx, exec, 1, emailToken0, user1)
+	if err != nil {
+		return err
+	}
+
+	emailToken0.R.User = user1
+
+	user1.R.EmailTokens = append(user1.R.EmailTokens, emailToken0)
+
+	return nil
+}
+
+type emailTokenWhere[Q sqlite.Filterable] struct {
+	ID        sqlite.WhereMod[Q, int32]
+	Hash      sqlite.WhereMod[Q, []byte]
+	Purpose   sqlite.WhereMod[Q, string]
+	Email     sqlite.WhereMod[Q, string]
+	CreatedAt sqlite.WhereMod[Q, time.Time]
+	ExpiresAt sqlite.WhereMod[Q, time.Time]
+	UsedAt    sqlite.WhereNullMod[Q, time.Time]
+	UserID    sqlite.WhereMod[Q, int32]
+}
+
+func (emailTokenWhere[Q]) AliasedAs(alias string) emailTokenWhere[Q] {
+	return buildEmailTokenWhere[Q](buildEmailTokenColumns(alias))
+}
+
+func buildEmailTokenWhere[Q sqlite.Filterable](cols emailTokenColumns) emailTokenWhere[Q] {
+	return emailTokenWhere[Q]{
+		ID:        sqlite.Where[Q, int32](cols.ID),
+		Hash:      sqlite.Where[Q, []byte](cols.Hash),
+		Purpose:   sqlite.Where[Q, string](cols.Purpose),
+		Email:     sqlite.Where[Q, string](cols.Email),
+		CreatedAt: sqlite.Where[Q, time.Time](cols.CreatedAt),
+		ExpiresAt: sqlite.Where[Q, time.Time](cols.ExpiresAt),
+		UsedAt:    sqlite.WhereNull[Q, time.Time](cols.UsedAt),
+		UserID:    sqlite.Where[Q, int32](cols.UserID),
+	}
+}
+
+func (o *EmailToken) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("emailToken cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.EmailTokens = EmailTokenSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("emailToken has no relationship %q", name)
+	}
+}
+
+type emailTokenPreloader struct {
+	User func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildEmailTokenPreloader() emailTokenPreloader {
+	return emailTokenPreloader{
+		User: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*User, UserSlice](sqlite.PreloadRel{
+				Name: "User",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        EmailTokens,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type emailTokenThenLoader[Q orm.Loadable] struct {
+	User func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildEmailTokenThenLoader[Q orm.Loadable]() emailTokenThenLoader[Q] {
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return emailTokenThenLoader[Q]{
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadUser loads the emailToken's User into the .R struct
+func (o *EmailToken) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.EmailTokens = EmailTokenSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the emailToken's User into the .R struct
+func (os EmailTokenSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if o.UserID != rel.ID {
+				continue
+			}
+
+			rel.R.EmailTokens = append(rel.R.EmailTokens, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type emailTokenJoins[Q dialect.Joinable] struct {
+	typ  string
+	User modAs[Q, userColumns]
+}
+
+func (j emailTokenJoins[Q]) aliasedAs(alias string) emailTokenJoins[Q] {
+	return buildEmailTokenJoins[Q](buildEmailTokenColumns(alias), j.typ)
+}
+
+func buildEmailTokenJoins[Q dialect.Joinable](cols emailTokenColumns, typ string) emailTokenJoins[Q] {
+	return emailTokenJoins[Q]{
+		typ: typ,
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
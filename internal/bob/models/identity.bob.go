@@ -0,0 +1,720 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// Identity is an object representing the database table.
+type Identity struct {
+	ID        int32            `db:"id,pk" `
+	Provider  string           `db:"provider" `
+	Subject   string           `db:"subject" `
+	Email     null.Val[string] `db:"email" `
+	CreatedAt time.Time        `db:"created_at" `
+	LastUsed  time.Time        `db:"last_used" `
+	UserID    int32            `db:"user_id" `
+
+	R identityR `db:"-" `
+}
+
+// IdentitySlice is an alias for a slice of pointers to Identity.
+// This should almost always be used instead of []*Identity.
+type IdentitySlice []*Identity
+
+// Identities contains methods to work with the identity table
+var Identities = sqlite.NewTablex[*Identity, IdentitySlice, *IdentitySetter]("", "identity", buildIdentityColumns("identity"))
+
+// IdentitiesQuery is a query on the identity table
+type IdentitiesQuery = *sqlite.ViewQuery[*Identity, IdentitySlice]
+
+// identityR is where relationships are stored.
+type identityR struct {
+	User *User // fk_identity_0
+}
+
+func buildIdentityColumns(alias string) identityColumns {
+	return identityColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "provider", "subject", "email", "created_at", "last_used", "user_id",
+		).WithParent("identity"),
+		tableAlias: alias,
+		ID:         sqlite.Quote(alias, "id"),
+		Provider:   sqlite.Quote(alias, "provider"),
+		Subject:    sqlite.Quote(alias, "subject"),
+		Email:      sqlite.Quote(alias, "email"),
+		CreatedAt:  sqlite.Quote(alias, "created_at"),
+		LastUsed:   sqlite.Quote(alias, "last_used"),
+		UserID:     sqlite.Quote(alias, "user_id"),
+	}
+}
+
+type identityColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         sqlite.Expression
+	Provider   sqlite.Expression
+	Subject    sqlite.Expression
+	Email      sqlite.Expression
+	CreatedAt  sqlite.Expression
+	LastUsed   sqlite.Expression
+	UserID     sqlite.Expression
+}
+
+func (c identityColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (identityColumns) AliasedAs(alias string) identityColumns {
+	return buildIdentityColumns(alias)
+}
+
+// IdentitySetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type IdentitySetter struct {
+	ID        omit.Val[int32]      `db:"id,pk" `
+	Provider  omit.Val[string]     `db:"provider" `
+	Subject   omit.Val[string]     `db:"subject" `
+	Email     omitnull.Val[string] `db:"email" `
+	CreatedAt omit.Val[time.Time]  `db:"created_at" `
+	LastUsed  omit.Val[time.Time]  `db:"last_used" `
+	UserID    omit.Val[int32]      `db:"user_id" `
+}
+
+func (s IdentitySetter) SetColumns() []string {
+	vals := make([]string, 0, 7)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.Provider.IsValue() {
+		vals = append(vals, "provider")
+	}
+	if s.Subject.IsValue() {
+		vals = append(vals, "subject")
+	}
+	if !s.Email.IsUnset() {
+		vals = append(vals, "email")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	if s.LastUsed.IsValue() {
+		vals = append(vals, "last_used")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	return vals
+}
+
+func (s IdentitySetter) Overwrite(t *Identity) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.Provider.IsValue() {
+		t.Provider = s.Provider.MustGet()
+	}
+	if s.Subject.IsValue() {
+		t.Subject = s.Subject.MustGet()
+	}
+	if !s.Email.IsUnset() {
+		t.Email = s.Email.MustGetNull()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+	if s.LastUsed.IsValue() {
+		t.LastUsed = s.LastUsed.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+}
+
+func (s *IdentitySetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Identities.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 7)
+		if s.ID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
+		}
+
+		if s.Provider.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Provider.MustGet()))
+		}
+
+		if s.Subject.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Subject.MustGet()))
+		}
+
+		if !s.Email.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.Email.MustGetNull()))
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.CreatedAt.MustGet()))
+		}
+
+		if s.LastUsed.IsValue() {
+			vals = append(vals, sqlite.Arg(s.LastUsed.MustGet()))
+		}
+
+		if s.UserID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UserID.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s IdentitySetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s IdentitySetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 7)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "id")...),
+			sqlite.Arg(s.ID),
+		}})
+	}
+
+	if s.Provider.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "provider")...),
+			sqlite.Arg(s.Provider),
+		}})
+	}
+
+	if s.Subject.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "subject")...),
+			sqlite.Arg(s.Subject),
+		}})
+	}
+
+	if !s.Email.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "email")...),
+			sqlite.Arg(s.Email),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "created_at")...),
+			sqlite.Arg(s.CreatedAt),
+		}})
+	}
+
+	if s.LastUsed.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "last_used")...),
+			sqlite.Arg(s.LastUsed),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "user_id")...),
+			sqlite.Arg(s.UserID),
+		}})
+	}
+
+	return exprs
+}
+
+// FindIdentity retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindIdentity(ctx context.Context, exec bob.Executor, IDPK int32, cols ...string) (*Identity, error) {
+	if len(cols) == 0 {
+		return Identities.Query(
+			sm.Where(Identities.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return Identities.Query(
+		sm.Where(Identities.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		sm.Columns(Identities.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// IdentityExists checks the presence of a single record by primary key
+func IdentityExists(ctx context.Context, exec bob.Executor, IDPK int32) (bool, error) {
+	return Identities.Query(
+		sm.Where(Identities.Columns.ID.EQ(sqlite.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Identity is retrieved from the database
+func (o *Identity) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Identities.AfterSelectHooks.RunHooks(ctx, exec, IdentitySlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Identities.AfterInsertHooks.RunHooks(ctx, exec, IdentitySlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Identities.AfterUpdateHooks.RunHooks(ctx, exec, IdentitySlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Identities.AfterDeleteHooks.RunHooks(ctx, exec, IdentitySlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Identity
+func (o *Identity) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.ID)
+}
+
+func (o *Identity) pkEQ() dialect.Expression {
+	return sqlite.Quote("identity", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Identity
+func (o *Identity) Update(ctx context.Context, exec bob.Executor, s *IdentitySetter) error {
+	v, err := Identities.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Identity record with an executor
+func (o *Identity) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Identities.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Identity using the executor
+func (o *Identity) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Identities.Query(
+		sm.Where(Identities.Columns.ID.EQ(sqlite.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after IdentitySlice is retrieved from the database
+func (o IdentitySlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Identities.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Identities.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Identities.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Identities.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o IdentitySlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("identity", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o IdentitySlice) copyMatchingRows(from ...*Identity) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o IdentitySlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Identities.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Identity:
+				o.copyMatchingRows(retrieved)
+			case []*Identity:
+				o.copyMatchingRows(retrieved...)
+			case IdentitySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Identity or a slice of Identity
+				// then run the AfterUpdateHooks on the slice
+				_, err = Identities.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o IdentitySlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Identities.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Identity:
+				o.copyMatchingRows(retrieved)
+			case []*Identity:
+				o.copyMatchingRows(retrieved...)
+			case IdentitySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Identity or a slice of Identity
+				// then run the AfterDeleteHooks on the slice
+				_, err = Identities.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o IdentitySlice) UpdateAll(ctx context.Context, exec bob.Executor, vals IdentitySetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Identities.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o IdentitySlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Identities.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o IdentitySlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Identities.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// User starts a query for related objects on user
+func (o *Identity) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(sqlite.Arg(o.UserID))),
+	)...)
+}
+
+func (os IdentitySlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.UserID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Users.Query(append(mods,
+		sm.Where(sqlite.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachIdentityUser0(ctx context.Context, exec bob.Executor, count int, identity0 *Identity, user1 *User) (*Identity, error) {
+	setter := &IdentitySetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := identity0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachIdentityUser0: %w", err)
+	}
+
+	return identity0, nil
+}
+
+func (identity0 *Identity) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachIdentityUser0(ctx, exec, 1, identity0, user1)
+	if err != nil {
+		return err
+	}
+
+	identity0.R.User = user1
+
+	user1.R.Identities = append(user1.R.Identities, identity0)
+
+	return nil
+}
+
+func (identity0 *Identity) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachIdentityUser0(ctx, exec, 1, identity0, user1)
+	if err != nil {
+		return err
+	}
+
+	identity0.R.User = user1
+
+	user1.R.Identities = append(user1.R.Identities, identity0)
+
+	return nil
+}
+
+type identityWhere[Q sqlite.Filterable] struct {
+	ID        sqlite.WhereMod[Q, int32]
+	Provider  sqlite.WhereMod[Q, string]
+	Subject   sqlite.WhereMod[Q, string]
+	Email     sqlite.WhereNullMod[Q, string]
+	CreatedAt sqlite.WhereMod[Q, time.Time]
+	LastUsed  sqlite.WhereMod[Q, time.Time]
+	UserID    sqlite.WhereMod[Q, int32]
+}
+
+func (identityWhere[Q]) AliasedAs(alias string) identityWhere[Q] {
+	return buildIdentityWhere[Q](buildIdentityColumns(alias))
+}
+
+func buildIdentityWhere[Q sqlite.Filterable](cols identityColumns) identityWhere[Q] {
+	return identityWhere[Q]{
+		ID:        sqlite.Where[Q, int32](cols.ID),
+		Provider:  sqlite.Where[Q, string](cols.Provider),
+		Subject:   sqlite.Where[Q, string](cols.Subject),
+		Email:     sqlite.WhereNull[Q, string](cols.Email),
+		CreatedAt: sqlite.Where[Q, time.Time](cols.CreatedAt),
+		LastUsed:  sqlite.Where[Q, time.Time](cols.LastUsed),
+		UserID:    sqlite.Where[Q, int32](cols.UserID),
+	}
+}
+
+func (o *Identity) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("identity cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.Identities = IdentitySlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("identity has no relationship %q", name)
+	}
+}
+
+type identityPreloader struct {
+	User func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildIdentityPreloader() identityPreloader {
+	return identityPreloader{
+		User: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*User, UserSlice](sqlite.PreloadRel{
+				Name: "User",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        Identities,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type identityThenLoader[Q orm.Loadable] struct {
+	User func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildIdentityThenLoader[Q orm.Loadable]() identityThenLoader[Q] {
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return identityThenLoader[Q]{
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadUser loads the identity's User into the .R struct
+func (o *Identity) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Identities = IdentitySlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the identity's User into the .R struct
+func (os IdentitySlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if o.UserID != rel.ID {
+				continue
+			}
+
+			rel.R.Identities = append(rel.R.Identities, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type identityJoins[Q dialect.Joinable] struct {
+	typ  string
+	User modAs[Q, userColumns]
+}
+
+func (j identityJoins[Q]) aliasedAs(alias string) identityJoins[Q] {
+	return buildIdentityJoins[Q](buildIdentityColumns(alias), j.typ)
+}
+
+func buildIdentityJoins[Q dialect.Joinable](cols identityColumns, typ string) identityJoins[Q] {
+	return identityJoins[Q]{
+		typ: typ,
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,105 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+
+	"github.com/aarondl/opt/null"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/expr"
+)
+
+// ItemSearch is an object representing the database table.
+type ItemSearch struct {
+	Name        null.Val[string] `db:"name" `
+	Description null.Val[string] `db:"description" `
+	ItemSearch  null.Val[string] `db:"item_search" `
+	Rank        null.Val[string] `db:"rank" `
+}
+
+// ItemSearchSlice is an alias for a slice of pointers to ItemSearch.
+// This should almost always be used instead of []*ItemSearch.
+type ItemSearchSlice []*ItemSearch
+
+// ItemSearches contains methods to work with the item_search view
+var ItemSearches = sqlite.NewViewx[*ItemSearch, ItemSearchSlice]("", "item_search", buildItemSearchColumns("item_search"))
+
+// ItemSearchesQuery is a query on the item_search view
+type ItemSearchesQuery = *sqlite.ViewQuery[*ItemSearch, ItemSearchSlice]
+
+func buildItemSearchColumns(alias string) itemSearchColumns {
+	return itemSearchColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"name", "description", "item_search", "rank",
+		).WithParent("item_search"),
+		tableAlias:  alias,
+		Name:        sqlite.Quote(alias, "name"),
+		Description: sqlite.Quote(alias, "description"),
+		ItemSearch:  sqlite.Quote(alias, "item_search"),
+		Rank:        sqlite.Quote(alias, "rank"),
+	}
+}
+
+type itemSearchColumns struct {
+	expr.ColumnsExpr
+	tableAlias  string
+	Name        sqlite.Expression
+	Description sqlite.Expression
+	ItemSearch  sqlite.Expression
+	Rank        sqlite.Expression
+}
+
+func (c itemSearchColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (itemSearchColumns) AliasedAs(alias string) itemSearchColumns {
+	return buildItemSearchColumns(alias)
+}
+
+// AfterQueryHook is called after ItemSearch is retrieved from the database
+func (o *ItemSearch) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = ItemSearches.AfterSelectHooks.RunHooks(ctx, exec, ItemSearchSlice{o})
+	}
+
+	return err
+}
+
+// AfterQueryHook is called after ItemSearchSlice is retrieved from the database
+func (o ItemSearchSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = ItemSearches.AfterSelectHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+type itemSearchWhere[Q sqlite.Filterable] struct {
+	Name        sqlite.WhereNullMod[Q, string]
+	Description sqlite.WhereNullMod[Q, string]
+	ItemSearch  sqlite.WhereNullMod[Q, string]
+	Rank        sqlite.WhereNullMod[Q, string]
+}
+
+func (itemSearchWhere[Q]) AliasedAs(alias string) itemSearchWhere[Q] {
+	return buildItemSearchWhere[Q](buildItemSearchColumns(alias))
+}
+
+func buildItemSearchWhere[Q sqlite.Filterable](cols itemSearchColumns) itemSearchWhere[Q] {
+	return itemSearchWhere[Q]{
+		Name:        sqlite.WhereNull[Q, string](cols.Name),
+		Description: sqlite.WhereNull[Q, string](cols.Description),
+		ItemSearch:  sqlite.WhereNull[Q, string](cols.ItemSearch),
+		Rank:        sqlite.WhereNull[Q, string](cols.Rank),
+	}
+}
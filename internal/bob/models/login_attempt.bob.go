@@ -0,0 +1,497 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+)
+
+// LoginAttempt is an object representing the database table.
+type LoginAttempt struct {
+	ID        int32     `db:"id,pk" `
+	Username  string    `db:"username" `
+	IP        string    `db:"ip" `
+	UserAgent string    `db:"user_agent" `
+	Factor    string    `db:"factor" `
+	Success   bool      `db:"success" `
+	CreatedAt time.Time `db:"created_at" `
+}
+
+// LoginAttemptSlice is an alias for a slice of pointers to LoginAttempt.
+// This should almost always be used instead of []*LoginAttempt.
+type LoginAttemptSlice []*LoginAttempt
+
+// LoginAttempts contains methods to work with the login_attempt table
+var LoginAttempts = sqlite.NewTablex[*LoginAttempt, LoginAttemptSlice, *LoginAttemptSetter]("", "login_attempt", buildLoginAttemptColumns("login_attempt"))
+
+// LoginAttemptsQuery is a query on the login_attempt table
+type LoginAttemptsQuery = *sqlite.ViewQuery[*LoginAttempt, LoginAttemptSlice]
+
+func buildLoginAttemptColumns(alias string) loginAttemptColumns {
+	return loginAttemptColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "username", "ip", "user_agent", "factor", "success", "created_at",
+		).WithParent("login_attempt"),
+		tableAlias: alias,
+		ID:         sqlite.Quote(alias, "id"),
+		Username:   sqlite.Quote(alias, "username"),
+		IP:         sqlite.Quote(alias, "ip"),
+		UserAgent:  sqlite.Quote(alias, "user_agent"),
+		Factor:     sqlite.Quote(alias, "factor"),
+		Success:    sqlite.Quote(alias, "success"),
+		CreatedAt:  sqlite.Quote(alias, "created_at"),
+	}
+}
+
+type loginAttemptColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         sqlite.Expression
+	Username   sqlite.Expression
+	IP         sqlite.Expression
+	UserAgent  sqlite.Expression
+	Factor     sqlite.Expression
+	Success    sqlite.Expression
+	CreatedAt  sqlite.Expression
+}
+
+func (c loginAttemptColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (loginAttemptColumns) AliasedAs(alias string) loginAttemptColumns {
+	return buildLoginAttemptColumns(alias)
+}
+
+// LoginAttemptSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type LoginAttemptSetter struct {
+	ID        omit.Val[int32]     `db:"id,pk" `
+	Username  omit.Val[string]    `db:"username" `
+	IP        omit.Val[string]    `db:"ip" `
+	UserAgent omit.Val[string]    `db:"user_agent" `
+	Factor    omit.Val[string]    `db:"factor" `
+	Success   omit.Val[bool]      `db:"success" `
+	CreatedAt omit.Val[time.Time] `db:"created_at" `
+}
+
+func (s LoginAttemptSetter) SetColumns() []string {
+	vals := make([]string, 0, 7)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.Username.IsValue() {
+		vals = append(vals, "username")
+	}
+	if s.IP.IsValue() {
+		vals = append(vals, "ip")
+	}
+	if s.UserAgent.IsValue() {
+		vals = append(vals, "user_agent")
+	}
+	if s.Factor.IsValue() {
+		vals = append(vals, "factor")
+	}
+	if s.Success.IsValue() {
+		vals = append(vals, "success")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s LoginAttemptSetter) Overwrite(t *LoginAttempt) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.Username.IsValue() {
+		t.Username = s.Username.MustGet()
+	}
+	if s.IP.IsValue() {
+		t.IP = s.IP.MustGet()
+	}
+	if s.UserAgent.IsValue() {
+		t.UserAgent = s.UserAgent.MustGet()
+	}
+	if s.Factor.IsValue() {
+		t.Factor = s.Factor.MustGet()
+	}
+	if s.Success.IsValue() {
+		t.Success = s.Success.MustGet()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+}
+
+func (s *LoginAttemptSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return LoginAttempts.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 7)
+		if s.ID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
+		}
+
+		if s.Username.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Username.MustGet()))
+		}
+
+		if s.IP.IsValue() {
+			vals = append(vals, sqlite.Arg(s.IP.MustGet()))
+		}
+
+		if s.UserAgent.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UserAgent.MustGet()))
+		}
+
+		if s.Factor.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Factor.MustGet()))
+		}
+
+		if s.Success.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Success.MustGet()))
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.CreatedAt.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s LoginAttemptSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s LoginAttemptSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 7)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "id")...),
+			sqlite.Arg(s.ID),
+		}})
+	}
+
+	if s.Username.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "username")...),
+			sqlite.Arg(s.Username),
+		}})
+	}
+
+	if s.IP.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "ip")...),
+			sqlite.Arg(s.IP),
+		}})
+	}
+
+	if s.UserAgent.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "user_agent")...),
+			sqlite.Arg(s.UserAgent),
+		}})
+	}
+
+	if s.Factor.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "factor")...),
+			sqlite.Arg(s.Factor),
+		}})
+	}
+
+	if s.Success.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "success")...),
+			sqlite.Arg(s.Success),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "created_at")...),
+			sqlite.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindLoginAttempt retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindLoginAttempt(ctx context.Context, exec bob.Executor, IDPK int32, cols ...string) (*LoginAttempt, error) {
+	if len(cols) == 0 {
+		return LoginAttempts.Query(
+			sm.Where(LoginAttempts.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return LoginAttempts.Query(
+		sm.Where(LoginAttempts.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		sm.Columns(LoginAttempts.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// LoginAttemptExists checks the presence of a single record by primary key
+func LoginAttemptExists(ctx context.Context, exec bob.Executor, IDPK int32) (bool, error) {
+	return LoginAttempts.Query(
+		sm.Where(LoginAttempts.Columns.ID.EQ(sqlite.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after LoginAttempt is retrieved from the database
+func (o *LoginAttempt) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = LoginAttempts.AfterSelectHooks.RunHooks(ctx, exec, LoginAttemptSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = LoginAttempts.AfterInsertHooks.RunHooks(ctx, exec, LoginAttemptSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = LoginAttempts.AfterUpdateHooks.RunHooks(ctx, exec, LoginAttemptSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = LoginAttempts.AfterDeleteHooks.RunHooks(ctx, exec, LoginAttemptSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the LoginAttempt
+func (o *LoginAttempt) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.ID)
+}
+
+func (o *LoginAttempt) pkEQ() dialect.Expression {
+	return sqlite.Quote("login_attempt", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the LoginAttempt
+func (o *LoginAttempt) Update(ctx context.Context, exec bob.Executor, s *LoginAttemptSetter) error {
+	v, err := LoginAttempts.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single LoginAttempt record with an executor
+func (o *LoginAttempt) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := LoginAttempts.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the LoginAttempt using the executor
+func (o *LoginAttempt) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := LoginAttempts.Query(
+		sm.Where(LoginAttempts.Columns.ID.EQ(sqlite.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after LoginAttemptSlice is retrieved from the database
+func (o LoginAttemptSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = LoginAttempts.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = LoginAttempts.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = LoginAttempts.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = LoginAttempts.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o LoginAttemptSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("login_attempt", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o LoginAttemptSlice) copyMatchingRows(from ...*LoginAttempt) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o LoginAttemptSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return LoginAttempts.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *LoginAttempt:
+				o.copyMatchingRows(retrieved)
+			case []*LoginAttempt:
+				o.copyMatchingRows(retrieved...)
+			case LoginAttemptSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a LoginAttempt or a slice of LoginAttempt
+				// then run the AfterUpdateHooks on the slice
+				_, err = LoginAttempts.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o LoginAttemptSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return LoginAttempts.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *LoginAttempt:
+				o.copyMatchingRows(retrieved)
+			case []*LoginAttempt:
+				o.copyMatchingRows(retrieved...)
+			case LoginAttemptSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a LoginAttempt or a slice of LoginAttempt
+				// then run the AfterDeleteHooks on the slice
+				_, err = LoginAttempts.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o LoginAttemptSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals LoginAttemptSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := LoginAttempts.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o LoginAttemptSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := LoginAttempts.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o LoginAttemptSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := LoginAttempts.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type loginAttemptWhere[Q sqlite.Filterable] struct {
+	ID        sqlite.WhereMod[Q, int32]
+	Username  sqlite.WhereMod[Q, string]
+	IP        sqlite.WhereMod[Q, string]
+	UserAgent sqlite.WhereMod[Q, string]
+	Factor    sqlite.WhereMod[Q, string]
+	Success   sqlite.WhereMod[Q, bool]
+	CreatedAt sqlite.WhereMod[Q, time.Time]
+}
+
+func (loginAttemptWhere[Q]) AliasedAs(alias string) loginAttemptWhere[Q] {
+	return buildLoginAttemptWhere[Q](buildLoginAttemptColumns(alias))
+}
+
+func buildLoginAttemptWhere[Q sqlite.Filterable](cols loginAttemptColumns) loginAttemptWhere[Q] {
+	return loginAttemptWhere[Q]{
+		ID:        sqlite.Where[Q, int32](cols.ID),
+		Username:  sqlite.Where[Q, string](cols.Username),
+		IP:        sqlite.Where[Q, string](cols.IP),
+		UserAgent: sqlite.Where[Q, string](cols.UserAgent),
+		Factor:    sqlite.Where[Q, string](cols.Factor),
+		Success:   sqlite.Where[Q, bool](cols.Success),
+		CreatedAt: sqlite.Where[Q, time.Time](cols.CreatedAt),
+	}
+}
@@ -0,0 +1,672 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// LoginChallenge is an object representing the database table.
+type LoginChallenge struct {
+	ID        string    `db:"id,pk" `
+	Failures  int32     `db:"failures" `
+	CreatedAt time.Time `db:"created_at" `
+	ExpiresAt time.Time `db:"expires_at" `
+	UserID    int32     `db:"user_id" `
+
+	R loginChallengeR `db:"-" `
+}
+
+// LoginChallengeSlice is an alias for a slice of pointers to LoginChallenge.
+// This should almost always be used instead of []*LoginChallenge.
+type LoginChallengeSlice []*LoginChallenge
+
+// LoginChallenges contains methods to work with the login_challenge table
+var LoginChallenges = sqlite.NewTablex[*LoginChallenge, LoginChallengeSlice, *LoginChallengeSetter]("", "login_challenge", buildLoginChallengeColumns("login_challenge"))
+
+// LoginChallengesQuery is a query on the login_challenge table
+type LoginChallengesQuery = *sqlite.ViewQuery[*LoginChallenge, LoginChallengeSlice]
+
+// loginChallengeR is where relationships are stored.
+type loginChallengeR struct {
+	User *User // fk_login_challenge_0
+}
+
+func buildLoginChallengeColumns(alias string) loginChallengeColumns {
+	return loginChallengeColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "failures", "created_at", "expires_at", "user_id",
+		).WithParent("login_challenge"),
+		tableAlias: alias,
+		ID:         sqlite.Quote(alias, "id"),
+		Failures:   sqlite.Quote(alias, "failures"),
+		CreatedAt:  sqlite.Quote(alias, "created_at"),
+		ExpiresAt:  sqlite.Quote(alias, "expires_at"),
+		UserID:     sqlite.Quote(alias, "user_id"),
+	}
+}
+
+type loginChallengeColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         sqlite.Expression
+	Failures   sqlite.Expression
+	CreatedAt  sqlite.Expression
+	ExpiresAt  sqlite.Expression
+	UserID     sqlite.Expression
+}
+
+func (c loginChallengeColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (loginChallengeColumns) AliasedAs(alias string) loginChallengeColumns {
+	return buildLoginChallengeColumns(alias)
+}
+
+// LoginChallengeSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type LoginChallengeSetter struct {
+	ID        omit.Val[string]    `db:"id,pk" `
+	Failures  omit.Val[int32]     `db:"failures" `
+	CreatedAt omit.Val[time.Time] `db:"created_at" `
+	ExpiresAt omit.Val[time.Time] `db:"expires_at" `
+	UserID    omit.Val[int32]     `db:"user_id" `
+}
+
+func (s LoginChallengeSetter) SetColumns() []string {
+	vals := make([]string, 0, 5)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.Failures.IsValue() {
+		vals = append(vals, "failures")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	if s.ExpiresAt.IsValue() {
+		vals = append(vals, "expires_at")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	return vals
+}
+
+func (s LoginChallengeSetter) Overwrite(t *LoginChallenge) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.Failures.IsValue() {
+		t.Failures = s.Failures.MustGet()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+	if s.ExpiresAt.IsValue() {
+		t.ExpiresAt = s.ExpiresAt.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+}
+
+func (s *LoginChallengeSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return LoginChallenges.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 5)
+		if s.ID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
+		}
+
+		if s.Failures.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Failures.MustGet()))
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.CreatedAt.MustGet()))
+		}
+
+		if s.ExpiresAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ExpiresAt.MustGet()))
+		}
+
+		if s.UserID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UserID.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s LoginChallengeSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s LoginChallengeSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 5)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "id")...),
+			sqlite.Arg(s.ID),
+		}})
+	}
+
+	if s.Failures.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "failures")...),
+			sqlite.Arg(s.Failures),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "created_at")...),
+			sqlite.Arg(s.CreatedAt),
+		}})
+	}
+
+	if s.ExpiresAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "expires_at")...),
+			sqlite.Arg(s.ExpiresAt),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "user_id")...),
+			sqlite.Arg(s.UserID),
+		}})
+	}
+
+	return exprs
+}
+
+// FindLoginChallenge retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindLoginChallenge(ctx context.Context, exec bob.Executor, IDPK string, cols ...string) (*LoginChallenge, error) {
+	if len(cols) == 0 {
+		return LoginChallenges.Query(
+			sm.Where(LoginChallenges.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return LoginChallenges.Query(
+		sm.Where(LoginChallenges.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		sm.Columns(LoginChallenges.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// LoginChallengeExists checks the presence of a single record by primary key
+func LoginChallengeExists(ctx context.Context, exec bob.Executor, IDPK string) (bool, error) {
+	return LoginChallenges.Query(
+		sm.Where(LoginChallenges.Columns.ID.EQ(sqlite.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after LoginChallenge is retrieved from the database
+func (o *LoginChallenge) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = LoginChallenges.AfterSelectHooks.RunHooks(ctx, exec, LoginChallengeSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = LoginChallenges.AfterInsertHooks.RunHooks(ctx, exec, LoginChallengeSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = LoginChallenges.AfterUpdateHooks.RunHooks(ctx, exec, LoginChallengeSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = LoginChallenges.AfterDeleteHooks.RunHooks(ctx, exec, LoginChallengeSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the LoginChallenge
+func (o *LoginChallenge) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.ID)
+}
+
+func (o *LoginChallenge) pkEQ() dialect.Expression {
+	return sqlite.Quote("login_challenge", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the LoginChallenge
+func (o *LoginChallenge) Update(ctx context.Context, exec bob.Executor, s *LoginChallengeSetter) error {
+	v, err := LoginChallenges.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single LoginChallenge record with an executor
+func (o *LoginChallenge) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := LoginChallenges.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the LoginChallenge using the executor
+func (o *LoginChallenge) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := LoginChallenges.Query(
+		sm.Where(LoginChallenges.Columns.ID.EQ(sqlite.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after LoginChallengeSlice is retrieved from the database
+func (o LoginChallengeSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = LoginChallenges.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = LoginChallenges.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = LoginChallenges.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = LoginChallenges.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o LoginChallengeSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("login_challenge", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o LoginChallengeSlice) copyMatchingRows(from ...*LoginChallenge) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o LoginChallengeSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return LoginChallenges.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *LoginChallenge:
+				o.copyMatchingRows(retrieved)
+			case []*LoginChallenge:
+				o.copyMatchingRows(retrieved...)
+			case LoginChallengeSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a LoginChallenge or a slice of LoginChallenge
+				// then run the AfterUpdateHooks on the slice
+				_, err = LoginChallenges.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o LoginChallengeSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return LoginChallenges.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *LoginChallenge:
+				o.copyMatchingRows(retrieved)
+			case []*LoginChallenge:
+				o.copyMatchingRows(retrieved...)
+			case LoginChallengeSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a LoginChallenge or a slice of LoginChallenge
+				// then run the AfterDeleteHooks on the slice
+				_, err = LoginChallenges.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o LoginChallengeSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals LoginChallengeSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := LoginChallenges.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o LoginChallengeSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := LoginChallenges.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o LoginChallengeSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := LoginChallenges.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// User starts a query for related objects on user
+func (o *LoginChallenge) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(sqlite.Arg(o.UserID))),
+	)...)
+}
+
+func (os LoginChallengeSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.UserID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Users.Query(append(mods,
+		sm.Where(sqlite.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachLoginChallengeUser0(ctx context.Context, exec bob.Executor, count int, loginChallenge0 *LoginChallenge, user1 *User) (*LoginChallenge, error) {
+	setter := &LoginChallengeSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := loginChallenge0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachLoginChallengeUser0: %w", err)
+	}
+
+	return loginChallenge0, nil
+}
+
+func (loginChallenge0 *LoginChallenge) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachLoginChallengeUser0(ctx, exec, 1, loginChallenge0, user1)
+	if err != nil {
+		return err
+	}
+
+	loginChallenge0.R.User = user1
+
+	user1.R.LoginChallenges = append(user1.R.LoginChallenges, loginChallenge0)
+
+	return nil
+}
+
+func (loginChallenge0 *LoginChallenge) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachLoginChallengeUser0(ctx, exec, 1, loginChallenge0, user1)
+	if err != nil {
+		return err
+	}
+
+	loginChallenge0.R.User = user1
+
+	user1.R.LoginChallenges = append(user1.R.LoginChallenges, loginChallenge0)
+
+	return nil
+}
+
+type loginChallengeWhere[Q sqlite.Filterable] struct {
+	ID        sqlite.WhereMod[Q, string]
+	Failures  sqlite.WhereMod[Q, int32]
+	CreatedAt sqlite.WhereMod[Q, time.Time]
+	ExpiresAt sqlite.WhereMod[Q, time.Time]
+	UserID    sqlite.WhereMod[Q, int32]
+}
+
+func (loginChallengeWhere[Q]) AliasedAs(alias string) loginChallengeWhere[Q] {
+	return buildLoginChallengeWhere[Q](buildLoginChallengeColumns(alias))
+}
+
+func buildLoginChallengeWhere[Q sqlite.Filterable](cols loginChallengeColumns) loginChallengeWhere[Q] {
+	return loginChallengeWhere[Q]{
+		ID:        sqlite.Where[Q, string](cols.ID),
+		Failures:  sqlite.Where[Q, int32](cols.Failures),
+		CreatedAt: sqlite.Where[Q, time.Time](cols.CreatedAt),
+		ExpiresAt: sqlite.Where[Q, time.Time](cols.ExpiresAt),
+		UserID:    sqlite.Where[Q, int32](cols.UserID),
+	}
+}
+
+func (o *LoginChallenge) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("loginChallenge cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.LoginChallenges = LoginChallengeSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("loginChallenge has no relationship %q", name)
+	}
+}
+
+type loginChallengePreloader struct {
+	User func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildLoginChallengePreloader() loginChallengePreloader {
+	return loginChallengePreloader{
+		User: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*User, UserSlice](sqlite.PreloadRel{
+				Name: "User",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        LoginChallenges,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type loginChallengeThenLoader[Q orm.Loadable] struct {
+	User func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildLoginChallengeThenLoader[Q orm.Loadable]() loginChallengeThenLoader[Q] {
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return loginChallengeThenLoader[Q]{
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadUser loads the loginChallenge's User into the .R struct
+func (o *LoginChallenge) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.LoginChallenges = LoginChallengeSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the loginChallenge's User into the .R struct
+func (os LoginChallengeSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if o.UserID != rel.ID {
+				continue
+			}
+
+			rel.R.LoginChallenges = append(rel.R.LoginChallenges, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type loginChallengeJoins[Q dialect.Joinable] struct {
+	typ  string
+	User modAs[Q, userColumns]
+}
+
+func (j loginChallengeJoins[Q]) aliasedAs(alias string) loginChallengeJoins[Q] {
+	return buildLoginChallengeJoins[Q](buildLoginChallengeColumns(alias), j.typ)
+}
+
+func buildLoginChallengeJoins[Q dialect.Joinable](cols loginChallengeColumns, typ string) loginChallengeJoins[Q] {
+	return loginChallengeJoins[Q]{
+		typ: typ,
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,1093 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// OauthClient is an object representing the database table.
+type OauthClient struct {
+	ID           string           `db:"id,pk" `
+	Name         string           `db:"name" `
+	SecretHash   null.Val[[]byte] `db:"secret_hash" `
+	RedirectUris string           `db:"redirect_uris" `
+	Scopes       string           `db:"scopes" `
+	CreatedAt    time.Time        `db:"created_at" `
+
+	R oauthClientR `db:"-" `
+}
+
+// OauthClientSlice is an alias for a slice of pointers to OauthClient.
+// This should almost always be used instead of []*OauthClient.
+type OauthClientSlice []*OauthClient
+
+// OauthClients contains methods to work with the oauth_client table
+var OauthClients = sqlite.NewTablex[*OauthClient, OauthClientSlice, *OauthClientSetter]("", "oauth_client", buildOauthClientColumns("oauth_client"))
+
+// OauthClientsQuery is a query on the oauth_client table
+type OauthClientsQuery = *sqlite.ViewQuery[*OauthClient, OauthClientSlice]
+
+// oauthClientR is where relationships are stored.
+type oauthClientR struct {
+	ClientOauthCodes    OauthCodeSlice    // fk_oauth_code_1
+	ClientOauthConsents OauthConsentSlice // fk_oauth_consent_1
+	ClientSessions      SessionSlice      // fk_session_1
+}
+
+func buildOauthClientColumns(alias string) oauthClientColumns {
+	return oauthClientColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "name", "secret_hash", "redirect_uris", "scopes", "created_at",
+		).WithParent("oauth_client"),
+		tableAlias:   alias,
+		ID:           sqlite.Quote(alias, "id"),
+		Name:         sqlite.Quote(alias, "name"),
+		SecretHash:   sqlite.Quote(alias, "secret_hash"),
+		RedirectUris: sqlite.Quote(alias, "redirect_uris"),
+		Scopes:       sqlite.Quote(alias, "scopes"),
+		CreatedAt:    sqlite.Quote(alias, "created_at"),
+	}
+}
+
+type oauthClientColumns struct {
+	expr.ColumnsExpr
+	tableAlias   string
+	ID           sqlite.Expression
+	Name         sqlite.Expression
+	SecretHash   sqlite.Expression
+	RedirectUris sqlite.Expression
+	Scopes       sqlite.Expression
+	CreatedAt    sqlite.Expression
+}
+
+func (c oauthClientColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (oauthClientColumns) AliasedAs(alias string) oauthClientColumns {
+	return buildOauthClientColumns(alias)
+}
+
+// OauthClientSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type OauthClientSetter struct {
+	ID           omit.Val[string]     `db:"id,pk" `
+	Name         omit.Val[string]     `db:"name" `
+	SecretHash   omitnull.Val[[]byte] `db:"secret_hash" `
+	RedirectUris omit.Val[string]     `db:"redirect_uris" `
+	Scopes       omit.Val[string]     `db:"scopes" `
+	CreatedAt    omit.Val[time.Time]  `db:"created_at" `
+}
+
+func (s OauthClientSetter) SetColumns() []string {
+	vals := make([]string, 0, 6)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.Name.IsValue() {
+		vals = append(vals, "name")
+	}
+	if !s.SecretHash.IsUnset() {
+		vals = append(vals, "secret_hash")
+	}
+	if s.RedirectUris.IsValue() {
+		vals = append(vals, "redirect_uris")
+	}
+	if s.Scopes.IsValue() {
+		vals = append(vals, "scopes")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s OauthClientSetter) Overwrite(t *OauthClient) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.Name.IsValue() {
+		t.Name = s.Name.MustGet()
+	}
+	if !s.SecretHash.IsUnset() {
+		t.SecretHash = s.SecretHash.MustGetNull()
+	}
+	if s.RedirectUris.IsValue() {
+		t.RedirectUris = s.RedirectUris.MustGet()
+	}
+	if s.Scopes.IsValue() {
+		t.Scopes = s.Scopes.MustGet()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+}
+
+func (s *OauthClientSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return OauthClients.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 6)
+		if s.ID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
+		}
+
+		if s.Name.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Name.MustGet()))
+		}
+
+		if !s.SecretHash.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.SecretHash.MustGetNull()))
+		}
+
+		if s.RedirectUris.IsValue() {
+			vals = append(vals, sqlite.Arg(s.RedirectUris.MustGet()))
+		}
+
+		if s.Scopes.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Scopes.MustGet()))
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.CreatedAt.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s OauthClientSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s OauthClientSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 6)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "id")...),
+			sqlite.Arg(s.ID),
+		}})
+	}
+
+	if s.Name.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "name")...),
+			sqlite.Arg(s.Name),
+		}})
+	}
+
+	if !s.SecretHash.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "secret_hash")...),
+			sqlite.Arg(s.SecretHash),
+		}})
+	}
+
+	if s.RedirectUris.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "redirect_uris")...),
+			sqlite.Arg(s.RedirectUris),
+		}})
+	}
+
+	if s.Scopes.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "scopes")...),
+			sqlite.Arg(s.Scopes),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "created_at")...),
+			sqlite.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindOauthClient retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindOauthClient(ctx context.Context, exec bob.Executor, IDPK string, cols ...string) (*OauthClient, error) {
+	if len(cols) == 0 {
+		return OauthClients.Query(
+			sm.Where(OauthClients.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return OauthClients.Query(
+		sm.Where(OauthClients.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		sm.Columns(OauthClients.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// OauthClientExists checks the presence of a single record by primary key
+func OauthClientExists(ctx context.Context, exec bob.Executor, IDPK string) (bool, error) {
+	return OauthClients.Query(
+		sm.Where(OauthClients.Columns.ID.EQ(sqlite.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after OauthClient is retrieved from the database
+func (o *OauthClient) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = OauthClients.AfterSelectHooks.RunHooks(ctx, exec, OauthClientSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = OauthClients.AfterInsertHooks.RunHooks(ctx, exec, OauthClientSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = OauthClients.AfterUpdateHooks.RunHooks(ctx, exec, OauthClientSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = OauthClients.AfterDeleteHooks.RunHooks(ctx, exec, OauthClientSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the OauthClient
+func (o *OauthClient) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.ID)
+}
+
+func (o *OauthClient) pkEQ() dialect.Expression {
+	return sqlite.Quote("oauth_client", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the OauthClient
+func (o *OauthClient) Update(ctx context.Context, exec bob.Executor, s *OauthClientSetter) error {
+	v, err := OauthClients.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single OauthClient record with an executor
+func (o *OauthClient) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := OauthClients.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the OauthClient using the executor
+func (o *OauthClient) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := OauthClients.Query(
+		sm.Where(OauthClients.Columns.ID.EQ(sqlite.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after OauthClientSlice is retrieved from the database
+func (o OauthClientSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = OauthClients.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = OauthClients.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = OauthClients.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = OauthClients.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o OauthClientSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("oauth_client", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o OauthClientSlice) copyMatchingRows(from ...*OauthClient) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o OauthClientSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return OauthClients.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *OauthClient:
+				o.copyMatchingRows(retrieved)
+			case []*OauthClient:
+				o.copyMatchingRows(retrieved...)
+			case OauthClientSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a OauthClient or a slice of OauthClient
+				// then run the AfterUpdateHooks on the slice
+				_, err = OauthClients.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o OauthClientSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return OauthClients.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *OauthClient:
+				o.copyMatchingRows(retrieved)
+			case []*OauthClient:
+				o.copyMatchingRows(retrieved...)
+			case OauthClientSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a OauthClient or a slice of OauthClient
+				// then run the AfterDeleteHooks on the slice
+				_, err = OauthClients.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o OauthClientSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals OauthClientSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := OauthClients.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o OauthClientSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := OauthClients.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o OauthClientSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := OauthClients.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// ClientOauthCodes starts a query for related objects on oauth_code
+func (o *OauthClient) ClientOauthCodes(mods ...bob.Mod[*dialect.SelectQuery]) OauthCodesQuery {
+	return OauthCodes.Query(append(mods,
+		sm.Where(OauthCodes.Columns.ClientID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os OauthClientSlice) ClientOauthCodes(mods ...bob.Mod[*dialect.SelectQuery]) OauthCodesQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return OauthCodes.Query(append(mods,
+		sm.Where(sqlite.Group(OauthCodes.Columns.ClientID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// ClientOauthConsents starts a query for related objects on oauth_consent
+func (o *OauthClient) ClientOauthConsents(mods ...bob.Mod[*dialect.SelectQuery]) OauthConsentsQuery {
+	return OauthConsents.Query(append(mods,
+		sm.Where(OauthConsents.Columns.ClientID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os OauthClientSlice) ClientOauthConsents(mods ...bob.Mod[*dialect.SelectQuery]) OauthConsentsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return OauthConsents.Query(append(mods,
+		sm.Where(sqlite.Group(OauthConsents.Columns.ClientID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// ClientSessions starts a query for related objects on session
+func (o *OauthClient) ClientSessions(mods ...bob.Mod[*dialect.SelectQuery]) SessionsQuery {
+	return Sessions.Query(append(mods,
+		sm.Where(Sessions.Columns.ClientID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os OauthClientSlice) ClientSessions(mods ...bob.Mod[*dialect.SelectQuery]) SessionsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Sessions.Query(append(mods,
+		sm.Where(sqlite.Group(Sessions.Columns.ClientID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func insertOauthClientClientOauthCodes0(ctx context.Context, exec bob.Executor, oauthCodes1 []*OauthCodeSetter, oauthClient0 *OauthClient) (OauthCodeSlice, error) {
+	for i := range oauthCodes1 {
+		oauthCodes1[i].ClientID = omit.From(oauthClient0.ID)
+	}
+
+	ret, err := OauthCodes.Insert(bob.ToMods(oauthCodes1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertOauthClientClientOauthCodes0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachOauthClientClientOauthCodes0(ctx context.Context, exec bob.Executor, count int, oauthCodes1 OauthCodeSlice, oauthClient0 *OauthClient) (OauthCodeSlice, error) {
+	setter := &OauthCodeSetter{
+		ClientID: omit.From(oauthClient0.ID),
+	}
+
+	err := oauthCodes1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOauthClientClientOauthCodes0: %w", err)
+	}
+
+	return oauthCodes1, nil
+}
+
+func (oauthClient0 *OauthClient) InsertClientOauthCodes(ctx context.Context, exec bob.Executor, related ...*OauthCodeSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	oauthCodes1, err := insertOauthClientClientOauthCodes0(ctx, exec, related, oauthClient0)
+	if err != nil {
+		return err
+	}
+
+	oauthClient0.R.ClientOauthCodes = append(oauthClient0.R.ClientOauthCodes, oauthCodes1...)
+
+	for _, rel := range oauthCodes1 {
+		rel.R.ClientOauthClient = oauthClient0
+	}
+	return nil
+}
+
+func (oauthClient0 *OauthClient) AttachClientOauthCodes(ctx context.Context, exec bob.Executor, related ...*OauthCode) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	oauthCodes1 := OauthCodeSlice(related)
+
+	_, err = attachOauthClientClientOauthCodes0(ctx, exec, len(related), oauthCodes1, oauthClient0)
+	if err != nil {
+		return err
+	}
+
+	oauthClient0.R.ClientOauthCodes = append(oauthClient0.R.ClientOauthCodes, oauthCodes1...)
+
+	for _, rel := range related {
+		rel.R.ClientOauthClient = oauthClient0
+	}
+
+	return nil
+}
+
+func insertOauthClientClientOauthConsents0(ctx context.Context, exec bob.Executor, oauthConsents1 []*OauthConsentSetter, oauthClient0 *OauthClient) (OauthConsentSlice, error) {
+	for i := range oauthConsents1 {
+		oauthConsents1[i].ClientID = omit.From(oauthClient0.ID)
+	}
+
+	ret, err := OauthConsents.Insert(bob.ToMods(oauthConsents1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertOauthClientClientOauthConsents0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachOauthClientClientOauthConsents0(ctx context.Context, exec bob.Executor, count int, oauthConsents1 OauthConsentSlice, oauthClient0 *OauthClient) (OauthConsentSlice, error) {
+	setter := &OauthConsentSetter{
+		ClientID: omit.From(oauthClient0.ID),
+	}
+
+	err := oauthConsents1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOauthClientClientOauthConsents0: %w", err)
+	}
+
+	return oauthConsents1, nil
+}
+
+func (oauthClient0 *OauthClient) InsertClientOauthConsents(ctx context.Context, exec bob.Executor, related ...*OauthConsentSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	oauthConsents1, err := insertOauthClientClientOauthConsents0(ctx, exec, related, oauthClient0)
+	if err != nil {
+		return err
+	}
+
+	oauthClient0.R.ClientOauthConsents = append(oauthClient0.R.ClientOauthConsents, oauthConsents1...)
+
+	for _, rel := range oauthConsents1 {
+		rel.R.ClientOauthClient = oauthClient0
+	}
+	return nil
+}
+
+func (oauthClient0 *OauthClient) AttachClientOauthConsents(ctx context.Context, exec bob.Executor, related ...*OauthConsent) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	oauthConsents1 := OauthConsentSlice(related)
+
+	_, err = attachOauthClientClientOauthConsents0(ctx, exec, len(related), oauthConsents1, oauthClient0)
+	if err != nil {
+		return err
+	}
+
+	oauthClient0.R.ClientOauthConsents = append(oauthClient0.R.ClientOauthConsents, oauthConsents1...)
+
+	for _, rel := range related {
+		rel.R.ClientOauthClient = oauthClient0
+	}
+
+	return nil
+}
+
+func insertOauthClientClientSessions0(ctx context.Context, exec bob.Executor, sessions1 []*SessionSetter, oauthClient0 *OauthClient) (SessionSlice, error) {
+	for i := range sessions1 {
+		sessions1[i].ClientID = omitnull.From(oauthClient0.ID)
+	}
+
+	ret, err := Sessions.Insert(bob.ToMods(sessions1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertOauthClientClientSessions0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachOauthClientClientSessions0(ctx context.Context, exec bob.Executor, count int, sessions1 SessionSlice, oauthClient0 *OauthClient) (SessionSlice, error) {
+	setter := &SessionSetter{
+		ClientID: omitnull.From(oauthClient0.ID),
+	}
+
+	err := sessions1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOauthClientClientSessions0: %w", err)
+	}
+
+	return sessions1, nil
+}
+
+func (oauthClient0 *OauthClient) InsertClientSessions(ctx context.Context, exec bob.Executor, related ...*SessionSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	sessions1, err := insertOauthClientClientSessions0(ctx, exec, related, oauthClient0)
+	if err != nil {
+		return err
+	}
+
+	oauthClient0.R.ClientSessions = append(oauthClient0.R.ClientSessions, sessions1...)
+
+	for _, rel := range sessions1 {
+		rel.R.ClientOauthClient = oauthClient0
+	}
+	return nil
+}
+
+func (oauthClient0 *OauthClient) AttachClientSessions(ctx context.Context, exec bob.Executor, related ...*Session) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	sessions1 := SessionSlice(related)
+
+	_, err = attachOauthClientClientSessions0(ctx, exec, len(related), sessions1, oauthClient0)
+	if err != nil {
+		return err
+	}
+
+	oauthClient0.R.ClientSessions = append(oauthClient0.R.ClientSessions, sessions1...)
+
+	for _, rel := range related {
+		rel.R.ClientOauthClient = oauthClient0
+	}
+
+	return nil
+}
+
+type oauthClientWhere[Q sqlite.Filterable] struct {
+	ID           sqlite.WhereMod[Q, string]
+	Name         sqlite.WhereMod[Q, string]
+	SecretHash   sqlite.WhereNullMod[Q, []byte]
+	RedirectUris sqlite.WhereMod[Q, string]
+	Scopes       sqlite.WhereMod[Q, string]
+	CreatedAt    sqlite.WhereMod[Q, time.Time]
+}
+
+func (oauthClientWhere[Q]) AliasedAs(alias string) oauthClientWhere[Q] {
+	return buildOauthClientWhere[Q](buildOauthClientColumns(alias))
+}
+
+func buildOauthClientWhere[Q sqlite.Filterable](cols oauthClientColumns) oauthClientWhere[Q] {
+	return oauthClientWhere[Q]{
+		ID:           sqlite.Where[Q, string](cols.ID),
+		Name:         sqlite.Where[Q, string](cols.Name),
+		SecretHash:   sqlite.WhereNull[Q, []byte](cols.SecretHash),
+		RedirectUris: sqlite.Where[Q, string](cols.RedirectUris),
+		Scopes:       sqlite.Where[Q, string](cols.Scopes),
+		CreatedAt:    sqlite.Where[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *OauthClient) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "ClientOauthCodes":
+		rels, ok := retrieved.(OauthCodeSlice)
+		if !ok {
+			return fmt.Errorf("oauthClient cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ClientOauthCodes = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.ClientOauthClient = o
+			}
+		}
+		return nil
+	case "ClientOauthConsents":
+		rels, ok := retrieved.(OauthConsentSlice)
+		if !ok {
+			return fmt.Errorf("oauthClient cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ClientOauthConsents = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.ClientOauthClient = o
+			}
+		}
+		return nil
+	case "ClientSessions":
+		rels, ok := retrieved.(SessionSlice)
+		if !ok {
+			return fmt.Errorf("oauthClient cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ClientSessions = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.ClientOauthClient = o
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("oauthClient has no relationship %q", name)
+	}
+}
+
+type oauthClientPreloader struct{}
+
+func buildOauthClientPreloader() oauthClientPreloader {
+	return oauthClientPreloader{}
+}
+
+type oauthClientThenLoader[Q orm.Loadable] struct {
+	ClientOauthCodes    func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ClientOauthConsents func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ClientSessions      func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildOauthClientThenLoader[Q orm.Loadable]() oauthClientThenLoader[Q] {
+	type ClientOauthCodesLoadInterface interface {
+		LoadClientOauthCodes(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ClientOauthConsentsLoadInterface interface {
+		LoadClientOauthConsents(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ClientSessionsLoadInterface interface {
+		LoadClientSessions(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return oauthClientThenLoader[Q]{
+		ClientOauthCodes: thenLoadBuilder[Q](
+			"ClientOauthCodes",
+			func(ctx context.Context, exec bob.Executor, retrieved ClientOauthCodesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadClientOauthCodes(ctx, exec, mods...)
+			},
+		),
+		ClientOauthConsents: thenLoadBuilder[Q](
+			"ClientOauthConsents",
+			func(ctx context.Context, exec bob.Executor, retrieved ClientOauthConsentsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadClientOauthConsents(ctx, exec, mods...)
+			},
+		),
+		ClientSessions: thenLoadBuilder[Q](
+			"ClientSessions",
+			func(ctx context.Context, exec bob.Executor, retrieved ClientSessionsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadClientSessions(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadClientOauthCodes loads the oauthClient's ClientOauthCodes into the .R struct
+func (o *OauthClient) LoadClientOauthCodes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ClientOauthCodes = nil
+
+	related, err := o.ClientOauthCodes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.ClientOauthClient = o
+	}
+
+	o.R.ClientOauthCodes = related
+	return nil
+}
+
+// LoadClientOauthCodes loads the oauthClient's ClientOauthCodes into the .R struct
+func (os OauthClientSlice) LoadClientOauthCodes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	oauthCodes, err := os.ClientOauthCodes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.ClientOauthCodes = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range oauthCodes {
+
+			if o.ID != rel.ClientID {
+				continue
+			}
+
+			rel.R.ClientOauthClient = o
+
+			o.R.ClientOauthCodes = append(o.R.ClientOauthCodes, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadClientOauthConsents loads the oauthClient's ClientOauthConsents into the .R struct
+func (o *OauthClient) LoadClientOauthConsents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ClientOauthConsents = nil
+
+	related, err := o.ClientOauthConsents(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.ClientOauthClient = o
+	}
+
+	o.R.ClientOauthConsents = related
+	return nil
+}
+
+// LoadClientOauthConsents loads the oauthClient's ClientOauthConsents into the .R struct
+func (os OauthClientSlice) LoadClientOauthConsents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	oauthConsents, err := os.ClientOauthConsents(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.ClientOauthConsents = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range oauthConsents {
+
+			if o.ID != rel.ClientID {
+				continue
+			}
+
+			rel.R.ClientOauthClient = o
+
+			o.R.ClientOauthConsents = append(o.R.ClientOauthConsents, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadClientSessions loads the oauthClient's ClientSessions into the .R struct
+func (o *OauthClient) LoadClientSessions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ClientSessions = nil
+
+	related, err := o.ClientSessions(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.ClientOauthClient = o
+	}
+
+	o.R.ClientSessions = related
+	return nil
+}
+
+// LoadClientSessions loads the oauthClient's ClientSessions into the .R struct
+func (os OauthClientSlice) LoadClientSessions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	sessions, err := os.ClientSessions(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.ClientSessions = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range sessions {
+
+			if !rel.ClientID.IsValue() {
+				continue
+			}
+			if o.ID != rel.ClientID.MustGet() {
+				continue
+			}
+
+			rel.R.ClientOauthClient = o
+
+			o.R.ClientSessions = append(o.R.ClientSessions, rel)
+		}
+	}
+
+	return nil
+}
+
+type oauthClientJoins[Q dialect.Joinable] struct {
+	typ                 string
+	ClientOauthCodes    modAs[Q, oauthCodeColumns]
+	ClientOauthConsents modAs[Q, oauthConsentColumns]
+	ClientSessions      modAs[Q, sessionColumns]
+}
+
+func (j oauthClientJoins[Q]) aliasedAs(alias string) oauthClientJoins[Q] {
+	return buildOauthClientJoins[Q](buildOauthClientColumns(alias), j.typ)
+}
+
+func buildOauthClientJoins[Q dialect.Joinable](cols oauthClientColumns, typ string) oauthClientJoins[Q] {
+	return oauthClientJoins[Q]{
+		typ: typ,
+		ClientOauthCodes: modAs[Q, oauthCodeColumns]{
+			c: OauthCodes.Columns,
+			f: func(to oauthCodeColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, OauthCodes.Name().As(to.Alias())).On(
+						to.ClientID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		ClientOauthConsents: modAs[Q, oauthConsentColumns]{
+			c: OauthConsents.Columns,
+			f: func(to oauthConsentColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, OauthConsents.Name().As(to.Alias())).On(
+						to.ClientID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		ClientSessions: modAs[Q, sessionColumns]{
+			c: Sessions.Columns,
+			f: func(to sessionColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Sessions.Name().As(to.Alias())).On(
+						to.ClientID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
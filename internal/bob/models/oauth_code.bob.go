@@ -0,0 +1,911 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// OauthCode is an object representing the database table.
+type OauthCode struct {
+	Hash          []byte    `db:"hash,pk" `
+	RedirectURI   string    `db:"redirect_uri" `
+	Scopes        string    `db:"scopes" `
+	Nonce         string    `db:"nonce" `
+	CodeChallenge string    `db:"code_challenge" `
+	ExpiresAt     time.Time `db:"expires_at" `
+	ClientID      string    `db:"client_id" `
+	UserID        int32     `db:"user_id" `
+
+	R oauthCodeR `db:"-" `
+}
+
+// OauthCodeSlice is an alias for a slice of pointers to OauthCode.
+// This should almost always be used instead of []*OauthCode.
+type OauthCodeSlice []*OauthCode
+
+// OauthCodes contains methods to work with the oauth_code table
+var OauthCodes = sqlite.NewTablex[*OauthCode, OauthCodeSlice, *OauthCodeSetter]("", "oauth_code", buildOauthCodeColumns("oauth_code"))
+
+// OauthCodesQuery is a query on the oauth_code table
+type OauthCodesQuery = *sqlite.ViewQuery[*OauthCode, OauthCodeSlice]
+
+// oauthCodeR is where relationships are stored.
+type oauthCodeR struct {
+	User              *User        // fk_oauth_code_0
+	ClientOauthClient *OauthClient // fk_oauth_code_1
+}
+
+func buildOauthCodeColumns(alias string) oauthCodeColumns {
+	return oauthCodeColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"hash", "redirect_uri", "scopes", "nonce", "code_challenge", "expires_at", "client_id", "user_id",
+		).WithParent("oauth_code"),
+		tableAlias:    alias,
+		Hash:          sqlite.Quote(alias, "hash"),
+		RedirectURI:   sqlite.Quote(alias, "redirect_uri"),
+		Scopes:        sqlite.Quote(alias, "scopes"),
+		Nonce:         sqlite.Quote(alias, "nonce"),
+		CodeChallenge: sqlite.Quote(alias, "code_challenge"),
+		ExpiresAt:     sqlite.Quote(alias, "expires_at"),
+		ClientID:      sqlite.Quote(alias, "client_id"),
+		UserID:        sqlite.Quote(alias, "user_id"),
+	}
+}
+
+type oauthCodeColumns struct {
+	expr.ColumnsExpr
+	tableAlias    string
+	Hash          sqlite.Expression
+	RedirectURI   sqlite.Expression
+	Scopes        sqlite.Expression
+	Nonce         sqlite.Expression
+	CodeChallenge sqlite.Expression
+	ExpiresAt     sqlite.Expression
+	ClientID      sqlite.Expression
+	UserID        sqlite.Expression
+}
+
+func (c oauthCodeColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (oauthCodeColumns) AliasedAs(alias string) oauthCodeColumns {
+	return buildOauthCodeColumns(alias)
+}
+
+// OauthCodeSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type OauthCodeSetter struct {
+	Hash          omit.Val[[]byte]    `db:"hash,pk" `
+	RedirectURI   omit.Val[string]    `db:"redirect_uri" `
+	Scopes        omit.Val[string]    `db:"scopes" `
+	Nonce         omit.Val[string]    `db:"nonce" `
+	CodeChallenge omit.Val[string]    `db:"code_challenge" `
+	ExpiresAt     omit.Val[time.Time] `db:"expires_at" `
+	ClientID      omit.Val[string]    `db:"client_id" `
+	UserID        omit.Val[int32]     `db:"user_id" `
+}
+
+func (s OauthCodeSetter) SetColumns() []string {
+	vals := make([]string, 0, 8)
+	if s.Hash.IsValue() {
+		vals = append(vals, "hash")
+	}
+	if s.RedirectURI.IsValue() {
+		vals = append(vals, "redirect_uri")
+	}
+	if s.Scopes.IsValue() {
+		vals = append(vals, "scopes")
+	}
+	if s.Nonce.IsValue() {
+		vals = append(vals, "nonce")
+	}
+	if s.CodeChallenge.IsValue() {
+		vals = append(vals, "code_challenge")
+	}
+	if s.ExpiresAt.IsValue() {
+		vals = append(vals, "expires_at")
+	}
+	if s.ClientID.IsValue() {
+		vals = append(vals, "client_id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	return vals
+}
+
+func (s OauthCodeSetter) Overwrite(t *OauthCode) {
+	if s.Hash.IsValue() {
+		t.Hash = s.Hash.MustGet()
+	}
+	if s.RedirectURI.IsValue() {
+		t.RedirectURI = s.RedirectURI.MustGet()
+	}
+	if s.Scopes.IsValue() {
+		t.Scopes = s.Scopes.MustGet()
+	}
+	if s.Nonce.IsValue() {
+		t.Nonce = s.Nonce.MustGet()
+	}
+	if s.CodeChallenge.IsValue() {
+		t.CodeChallenge = s.CodeChallenge.MustGet()
+	}
+	if s.ExpiresAt.IsValue() {
+		t.ExpiresAt = s.ExpiresAt.MustGet()
+	}
+	if s.ClientID.IsValue() {
+		t.ClientID = s.ClientID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+}
+
+func (s *OauthCodeSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return OauthCodes.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"hash"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 8)
+		if s.Hash.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Hash.MustGet()))
+		}
+
+		if s.RedirectURI.IsValue() {
+			vals = append(vals, sqlite.Arg(s.RedirectURI.MustGet()))
+		}
+
+		if s.Scopes.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Scopes.MustGet()))
+		}
+
+		if s.Nonce.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Nonce.MustGet()))
+		}
+
+		if s.CodeChallenge.IsValue() {
+			vals = append(vals, sqlite.Arg(s.CodeChallenge.MustGet()))
+		}
+
+		if s.ExpiresAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ExpiresAt.MustGet()))
+		}
+
+		if s.ClientID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ClientID.MustGet()))
+		}
+
+		if s.UserID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UserID.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s OauthCodeSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s OauthCodeSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 8)
+
+	if s.Hash.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "hash")...),
+			sqlite.Arg(s.Hash),
+		}})
+	}
+
+	if s.RedirectURI.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "redirect_uri")...),
+			sqlite.Arg(s.RedirectURI),
+		}})
+	}
+
+	if s.Scopes.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "scopes")...),
+			sqlite.Arg(s.Scopes),
+		}})
+	}
+
+	if s.Nonce.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "nonce")...),
+			sqlite.Arg(s.Nonce),
+		}})
+	}
+
+	if s.CodeChallenge.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "code_challenge")...),
+			sqlite.Arg(s.CodeChallenge),
+		}})
+	}
+
+	if s.ExpiresAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "expires_at")...),
+			sqlite.Arg(s.ExpiresAt),
+		}})
+	}
+
+	if s.ClientID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "client_id")...),
+			sqlite.Arg(s.ClientID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "user_id")...),
+			sqlite.Arg(s.UserID),
+		}})
+	}
+
+	return exprs
+}
+
+// FindOauthCode retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindOauthCode(ctx context.Context, exec bob.Executor, HashPK []byte, cols ...string) (*OauthCode, error) {
+	if len(cols) == 0 {
+		return OauthCodes.Query(
+			sm.Where(OauthCodes.Columns.Hash.EQ(sqlite.Arg(HashPK))),
+		).One(ctx, exec)
+	}
+
+	return OauthCodes.Query(
+		sm.Where(OauthCodes.Columns.Hash.EQ(sqlite.Arg(HashPK))),
+		sm.Columns(OauthCodes.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// OauthCodeExists checks the presence of a single record by primary key
+func OauthCodeExists(ctx context.Context, exec bob.Executor, HashPK []byte) (bool, error) {
+	return OauthCodes.Query(
+		sm.Where(OauthCodes.Columns.Hash.EQ(sqlite.Arg(HashPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after OauthCode is retrieved from the database
+func (o *OauthCode) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = OauthCodes.AfterSelectHooks.RunHooks(ctx, exec, OauthCodeSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = OauthCodes.AfterInsertHooks.RunHooks(ctx, exec, OauthCodeSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = OauthCodes.AfterUpdateHooks.RunHooks(ctx, exec, OauthCodeSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = OauthCodes.AfterDeleteHooks.RunHooks(ctx, exec, OauthCodeSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the OauthCode
+func (o *OauthCode) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.Hash)
+}
+
+func (o *OauthCode) pkEQ() dialect.Expression {
+	return sqlite.Quote("oauth_code", "hash").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the OauthCode
+func (o *OauthCode) Update(ctx context.Context, exec bob.Executor, s *OauthCodeSetter) error {
+	v, err := OauthCodes.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single OauthCode record with an executor
+func (o *OauthCode) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := OauthCodes.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the OauthCode using the executor
+func (o *OauthCode) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := OauthCodes.Query(
+		sm.Where(OauthCodes.Columns.Hash.EQ(sqlite.Arg(o.Hash))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after OauthCodeSlice is retrieved from the database
+func (o OauthCodeSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = OauthCodes.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = OauthCodes.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = OauthCodes.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = OauthCodes.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o OauthCodeSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("oauth_code", "hash").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o OauthCodeSlice) copyMatchingRows(from ...*OauthCode) {
+	for i, old := range o {
+		for _, new := range from {
+			if bytes.Equal(new.Hash, old.Hash) {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o OauthCodeSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return OauthCodes.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *OauthCode:
+				o.copyMatchingRows(retrieved)
+			case []*OauthCode:
+				o.copyMatchingRows(retrieved...)
+			case OauthCodeSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a OauthCode or a slice of OauthCode
+				// then run the AfterUpdateHooks on the slice
+				_, err = OauthCodes.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o OauthCodeSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return OauthCodes.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *OauthCode:
+				o.copyMatchingRows(retrieved)
+			case []*OauthCode:
+				o.copyMatchingRows(retrieved...)
+			case OauthCodeSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a OauthCode or a slice of OauthCode
+				// then run the AfterDeleteHooks on the slice
+				_, err = OauthCodes.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o OauthCodeSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals OauthCodeSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := OauthCodes.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o OauthCodeSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := OauthCodes.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o OauthCodeSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := OauthCodes.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// User starts a query for related objects on user
+func (o *OauthCode) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(sqlite.Arg(o.UserID))),
+	)...)
+}
+
+func (os OauthCodeSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.UserID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Users.Query(append(mods,
+		sm.Where(sqlite.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// ClientOauthClient starts a query for related objects on oauth_client
+func (o *OauthCode) ClientOauthClient(mods ...bob.Mod[*dialect.SelectQuery]) OauthClientsQuery {
+	return OauthClients.Query(append(mods,
+		sm.Where(OauthClients.Columns.ID.EQ(sqlite.Arg(o.ClientID))),
+	)...)
+}
+
+func (os OauthCodeSlice) ClientOauthClient(mods ...bob.Mod[*dialect.SelectQuery]) OauthClientsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ClientID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return OauthClients.Query(append(mods,
+		sm.Where(sqlite.Group(OauthClients.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachOauthCodeUser0(ctx context.Context, exec bob.Executor, count int, oauthCode0 *OauthCode, user1 *User) (*OauthCode, error) {
+	setter := &OauthCodeSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := oauthCode0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOauthCodeUser0: %w", err)
+	}
+
+	return oauthCode0, nil
+}
+
+func (oauthCode0 *OauthCode) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachOauthCodeUser0(ctx, exec, 1, oauthCode0, user1)
+	if err != nil {
+		return err
+	}
+
+	oauthCode0.R.User = user1
+
+	user1.R.OauthCodes = append(user1.R.OauthCodes, oauthCode0)
+
+	return nil
+}
+
+func (oauthCode0 *OauthCode) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachOauthCodeUser0(ctx, exec, 1, oauthCode0, user1)
+	if err != nil {
+		return err
+	}
+
+	oauthCode0.R.User = user1
+
+	user1.R.OauthCodes = append(user1.R.OauthCodes, oauthCode0)
+
+	return nil
+}
+
+func attachOauthCodeClientOauthClient0(ctx context.Context, exec bob.Executor, count int, oauthCode0 *OauthCode, oauthClient1 *OauthClient) (*OauthCode, error) {
+	setter := &OauthCodeSetter{
+		ClientID: omit.From(oauthClient1.ID),
+	}
+
+	err := oauthCode0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOauthCodeClientOauthClient0: %w", err)
+	}
+
+	return oauthCode0, nil
+}
+
+func (oauthCode0 *OauthCode) InsertClientOauthClient(ctx context.Context, exec bob.Executor, related *OauthClientSetter) error {
+	oauthClient1, err := OauthClients.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachOauthCodeClientOauthClient0(ctx, exec, 1, oauthCode0, oauthClient1)
+	if err != nil {
+		return err
+	}
+
+	oauthCode0.R.ClientOauthClient = oauthClient1
+
+	oauthClient1.R.ClientOauthCodes = append(oauthClient1.R.ClientOauthCodes, oauthCode0)
+
+	return nil
+}
+
+func (oauthCode0 *OauthCode) AttachClientOauthClient(ctx context.Context, exec bob.Executor, oauthClient1 *OauthClient) error {
+	var err error
+
+	_, err = attachOauthCodeClientOauthClient0(ctx, exec, 1, oauthCode0, oauthClient1)
+	if err != nil {
+		return err
+	}
+
+	oauthCode0.R.ClientOauthClient = oauthClient1
+
+	oauthClient1.R.ClientOauthCodes = append(oauthClient1.R.ClientOauthCodes, oauthCode0)
+
+	return nil
+}
+
+type oauthCodeWhere[Q sqlite.Filterable] struct {
+	Hash          sqlite.WhereMod[Q, []byte]
+	RedirectURI   sqlite.WhereMod[Q, string]
+	Scopes        sqlite.WhereMod[Q, string]
+	Nonce         sqlite.WhereMod[Q, string]
+	CodeChallenge sqlite.WhereMod[Q, string]
+	ExpiresAt     sqlite.WhereMod[Q, time.Time]
+	ClientID      sqlite.WhereMod[Q, string]
+	UserID        sqlite.WhereMod[Q, int32]
+}
+
+func (oauthCodeWhere[Q]) AliasedAs(alias string) oauthCodeWhere[Q] {
+	return buildOauthCodeWhere[Q](buildOauthCodeColumns(alias))
+}
+
+func buildOauthCodeWhere[Q sqlite.Filterable](cols oauthCodeColumns) oauthCodeWhere[Q] {
+	return oauthCodeWhere[Q]{
+		Hash:          sqlite.Where[Q, []byte](cols.Hash),
+		RedirectURI:   sqlite.Where[Q, string](cols.RedirectURI),
+		Scopes:        sqlite.Where[Q, string](cols.Scopes),
+		Nonce:         sqlite.Where[Q, string](cols.Nonce),
+		CodeChallenge: sqlite.Where[Q, string](cols.CodeChallenge),
+		ExpiresAt:     sqlite.Where[Q, time.Time](cols.ExpiresAt),
+		ClientID:      sqlite.Where[Q, string](cols.ClientID),
+		UserID:        sqlite.Where[Q, int32](cols.UserID),
+	}
+}
+
+func (o *OauthCode) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("oauthCode cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.OauthCodes = OauthCodeSlice{o}
+		}
+		return nil
+	case "ClientOauthClient":
+		rel, ok := retrieved.(*OauthClient)
+		if !ok {
+			return fmt.Errorf("oauthCode cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ClientOauthClient = rel
+
+		if rel != nil {
+			rel.R.ClientOauthCodes = OauthCodeSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("oauthCode has no relationship %q", name)
+	}
+}
+
+type oauthCodePreloader struct {
+	User              func(...sqlite.PreloadOption) sqlite.Preloader
+	ClientOauthClient func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildOauthCodePreloader() oauthCodePreloader {
+	return oauthCodePreloader{
+		User: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*User, UserSlice](sqlite.PreloadRel{
+				Name: "User",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        OauthCodes,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+		ClientOauthClient: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*OauthClient, OauthClientSlice](sqlite.PreloadRel{
+				Name: "ClientOauthClient",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        OauthCodes,
+						To:          OauthClients,
+						FromColumns: []string{"client_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, OauthClients.Columns.Names(), opts...)
+		},
+	}
+}
+
+type oauthCodeThenLoader[Q orm.Loadable] struct {
+	User              func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ClientOauthClient func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildOauthCodeThenLoader[Q orm.Loadable]() oauthCodeThenLoader[Q] {
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ClientOauthClientLoadInterface interface {
+		LoadClientOauthClient(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return oauthCodeThenLoader[Q]{
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+		ClientOauthClient: thenLoadBuilder[Q](
+			"ClientOauthClient",
+			func(ctx context.Context, exec bob.Executor, retrieved ClientOauthClientLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadClientOauthClient(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadUser loads the oauthCode's User into the .R struct
+func (o *OauthCode) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.OauthCodes = OauthCodeSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the oauthCode's User into the .R struct
+func (os OauthCodeSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if o.UserID != rel.ID {
+				continue
+			}
+
+			rel.R.OauthCodes = append(rel.R.OauthCodes, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadClientOauthClient loads the oauthCode's ClientOauthClient into the .R struct
+func (o *OauthCode) LoadClientOauthClient(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ClientOauthClient = nil
+
+	related, err := o.ClientOauthClient(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.ClientOauthCodes = OauthCodeSlice{o}
+
+	o.R.ClientOauthClient = related
+	return nil
+}
+
+// LoadClientOauthClient loads the oauthCode's ClientOauthClient into the .R struct
+func (os OauthCodeSlice) LoadClientOauthClient(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	oauthClients, err := os.ClientOauthClient(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range oauthClients {
+
+			if o.ClientID != rel.ID {
+				continue
+			}
+
+			rel.R.ClientOauthCodes = append(rel.R.ClientOauthCodes, o)
+
+			o.R.ClientOauthClient = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type oauthCodeJoins[Q dialect.Joinable] struct {
+	typ               string
+	User              modAs[Q, userColumns]
+	ClientOauthClient modAs[Q, oauthClientColumns]
+}
+
+func (j oauthCodeJoins[Q]) aliasedAs(alias string) oauthCodeJoins[Q] {
+	return buildOauthCodeJoins[Q](buildOauthCodeColumns(alias), j.typ)
+}
+
+func buildOauthCodeJoins[Q dialect.Joinable](cols oauthCodeColumns, typ string) oauthCodeJoins[Q] {
+	return oauthCodeJoins[Q]{
+		typ: typ,
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+		ClientOauthClient: modAs[Q, oauthClientColumns]{
+			c: OauthClients.Columns,
+			f: func(to oauthClientColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, OauthClients.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.ClientID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
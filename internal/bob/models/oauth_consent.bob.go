@@ -0,0 +1,828 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// OauthConsent is an object representing the database table.
+type OauthConsent struct {
+	Scopes    string    `db:"scopes" `
+	CreatedAt time.Time `db:"created_at" `
+	ClientID  string    `db:"client_id,pk" `
+	UserID    int32     `db:"user_id,pk" `
+
+	R oauthConsentR `db:"-" `
+}
+
+// OauthConsentSlice is an alias for a slice of pointers to OauthConsent.
+// This should almost always be used instead of []*OauthConsent.
+type OauthConsentSlice []*OauthConsent
+
+// OauthConsents contains methods to work with the oauth_consent table
+var OauthConsents = sqlite.NewTablex[*OauthConsent, OauthConsentSlice, *OauthConsentSetter]("", "oauth_consent", buildOauthConsentColumns("oauth_consent"))
+
+// OauthConsentsQuery is a query on the oauth_consent table
+type OauthConsentsQuery = *sqlite.ViewQuery[*OauthConsent, OauthConsentSlice]
+
+// oauthConsentR is where relationships are stored.
+type oauthConsentR struct {
+	User              *User        // fk_oauth_consent_0
+	ClientOauthClient *OauthClient // fk_oauth_consent_1
+}
+
+func buildOauthConsentColumns(alias string) oauthConsentColumns {
+	return oauthConsentColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"scopes", "created_at", "client_id", "user_id",
+		).WithParent("oauth_consent"),
+		tableAlias: alias,
+		Scopes:     sqlite.Quote(alias, "scopes"),
+		CreatedAt:  sqlite.Quote(alias, "created_at"),
+		ClientID:   sqlite.Quote(alias, "client_id"),
+		UserID:     sqlite.Quote(alias, "user_id"),
+	}
+}
+
+type oauthConsentColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	Scopes     sqlite.Expression
+	CreatedAt  sqlite.Expression
+	ClientID   sqlite.Expression
+	UserID     sqlite.Expression
+}
+
+func (c oauthConsentColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (oauthConsentColumns) AliasedAs(alias string) oauthConsentColumns {
+	return buildOauthConsentColumns(alias)
+}
+
+// OauthConsentSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type OauthConsentSetter struct {
+	Scopes    omit.Val[string]    `db:"scopes" `
+	CreatedAt omit.Val[time.Time] `db:"created_at" `
+	ClientID  omit.Val[string]    `db:"client_id,pk" `
+	UserID    omit.Val[int32]     `db:"user_id,pk" `
+}
+
+func (s OauthConsentSetter) SetColumns() []string {
+	vals := make([]string, 0, 4)
+	if s.Scopes.IsValue() {
+		vals = append(vals, "scopes")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	if s.ClientID.IsValue() {
+		vals = append(vals, "client_id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	return vals
+}
+
+func (s OauthConsentSetter) Overwrite(t *OauthConsent) {
+	if s.Scopes.IsValue() {
+		t.Scopes = s.Scopes.MustGet()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+	if s.ClientID.IsValue() {
+		t.ClientID = s.ClientID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+}
+
+func (s *OauthConsentSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return OauthConsents.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"client_id", "user_id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 4)
+		if s.Scopes.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Scopes.MustGet()))
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.CreatedAt.MustGet()))
+		}
+
+		if s.ClientID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ClientID.MustGet()))
+		}
+
+		if s.UserID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UserID.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil), sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s OauthConsentSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s OauthConsentSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 4)
+
+	if s.Scopes.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "scopes")...),
+			sqlite.Arg(s.Scopes),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "created_at")...),
+			sqlite.Arg(s.CreatedAt),
+		}})
+	}
+
+	if s.ClientID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "client_id")...),
+			sqlite.Arg(s.ClientID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "user_id")...),
+			sqlite.Arg(s.UserID),
+		}})
+	}
+
+	return exprs
+}
+
+// FindOauthConsent retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindOauthConsent(ctx context.Context, exec bob.Executor, ClientIDPK string, UserIDPK int32, cols ...string) (*OauthConsent, error) {
+	if len(cols) == 0 {
+		return OauthConsents.Query(
+			sm.Where(OauthConsents.Columns.ClientID.EQ(sqlite.Arg(ClientIDPK))),
+			sm.Where(OauthConsents.Columns.UserID.EQ(sqlite.Arg(UserIDPK))),
+		).One(ctx, exec)
+	}
+
+	return OauthConsents.Query(
+		sm.Where(OauthConsents.Columns.ClientID.EQ(sqlite.Arg(ClientIDPK))),
+		sm.Where(OauthConsents.Columns.UserID.EQ(sqlite.Arg(UserIDPK))),
+		sm.Columns(OauthConsents.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// OauthConsentExists checks the presence of a single record by primary key
+func OauthConsentExists(ctx context.Context, exec bob.Executor, ClientIDPK string, UserIDPK int32) (bool, error) {
+	return OauthConsents.Query(
+		sm.Where(OauthConsents.Columns.ClientID.EQ(sqlite.Arg(ClientIDPK))),
+		sm.Where(OauthConsents.Columns.UserID.EQ(sqlite.Arg(UserIDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after OauthConsent is retrieved from the database
+func (o *OauthConsent) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = OauthConsents.AfterSelectHooks.RunHooks(ctx, exec, OauthConsentSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = OauthConsents.AfterInsertHooks.RunHooks(ctx, exec, OauthConsentSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = OauthConsents.AfterUpdateHooks.RunHooks(ctx, exec, OauthConsentSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = OauthConsents.AfterDeleteHooks.RunHooks(ctx, exec, OauthConsentSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the OauthConsent
+func (o *OauthConsent) primaryKeyVals() bob.Expression {
+	return sqlite.ArgGroup(
+		o.ClientID,
+		o.UserID,
+	)
+}
+
+func (o *OauthConsent) pkEQ() dialect.Expression {
+	return sqlite.Group(sqlite.Quote("oauth_consent", "client_id"), sqlite.Quote("oauth_consent", "user_id")).EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the OauthConsent
+func (o *OauthConsent) Update(ctx context.Context, exec bob.Executor, s *OauthConsentSetter) error {
+	v, err := OauthConsents.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single OauthConsent record with an executor
+func (o *OauthConsent) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := OauthConsents.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the OauthConsent using the executor
+func (o *OauthConsent) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := OauthConsents.Query(
+		sm.Where(OauthConsents.Columns.ClientID.EQ(sqlite.Arg(o.ClientID))),
+		sm.Where(OauthConsents.Columns.UserID.EQ(sqlite.Arg(o.UserID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after OauthConsentSlice is retrieved from the database
+func (o OauthConsentSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = OauthConsents.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = OauthConsents.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = OauthConsents.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = OauthConsents.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o OauthConsentSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Group(sqlite.Quote("oauth_consent", "client_id"), sqlite.Quote("oauth_consent", "user_id")).In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o OauthConsentSlice) copyMatchingRows(from ...*OauthConsent) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ClientID != old.ClientID {
+				continue
+			}
+			if new.UserID != old.UserID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o OauthConsentSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return OauthConsents.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *OauthConsent:
+				o.copyMatchingRows(retrieved)
+			case []*OauthConsent:
+				o.copyMatchingRows(retrieved...)
+			case OauthConsentSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a OauthConsent or a slice of OauthConsent
+				// then run the AfterUpdateHooks on the slice
+				_, err = OauthConsents.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o OauthConsentSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return OauthConsents.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *OauthConsent:
+				o.copyMatchingRows(retrieved)
+			case []*OauthConsent:
+				o.copyMatchingRows(retrieved...)
+			case OauthConsentSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a OauthConsent or a slice of OauthConsent
+				// then run the AfterDeleteHooks on the slice
+				_, err = OauthConsents.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o OauthConsentSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals OauthConsentSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := OauthConsents.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o OauthConsentSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := OauthConsents.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o OauthConsentSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := OauthConsents.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// User starts a query for related objects on user
+func (o *OauthConsent) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(sqlite.Arg(o.UserID))),
+	)...)
+}
+
+func (os OauthConsentSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.UserID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Users.Query(append(mods,
+		sm.Where(sqlite.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// ClientOauthClient starts a query for related objects on oauth_client
+func (o *OauthConsent) ClientOauthClient(mods ...bob.Mod[*dialect.SelectQuery]) OauthClientsQuery {
+	return OauthClients.Query(append(mods,
+		sm.Where(OauthClients.Columns.ID.EQ(sqlite.Arg(o.ClientID))),
+	)...)
+}
+
+func (os OauthConsentSlice) ClientOauthClient(mods ...bob.Mod[*dialect.SelectQuery]) OauthClientsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ClientID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return OauthClients.Query(append(mods,
+		sm.Where(sqlite.Group(OauthClients.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachOauthConsentUser0(ctx context.Context, exec bob.Executor, count int, oauthConsent0 *OauthConsent, user1 *User) (*OauthConsent, error) {
+	setter := &OauthConsentSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := oauthConsent0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOauthConsentUser0: %w", err)
+	}
+
+	return oauthConsent0, nil
+}
+
+func (oauthConsent0 *OauthConsent) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachOauthConsentUser0(ctx, exec, 1, oauthConsent0, user1)
+	if err != nil {
+		return err
+	}
+
+	oauthConsent0.R.User = user1
+
+	user1.R.OauthConsents = append(user1.R.OauthConsents, oauthConsent0)
+
+	return nil
+}
+
+func (oauthConsent0 *OauthConsent) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachOauthConsentUser0(ctx, exec, 1, oauthConsent0, user1)
+	if err != nil {
+		return err
+	}
+
+	oauthConsent0.R.User = user1
+
+	user1.R.OauthConsents = append(user1.R.OauthConsents, oauthConsent0)
+
+	return nil
+}
+
+func attachOauthConsentClientOauthClient0(ctx context.Context, exec bob.Executor, count int, oauthConsent0 *OauthConsent, oauthClient1 *OauthClient) (*OauthConsent, error) {
+	setter := &OauthConsentSetter{
+		ClientID: omit.From(oauthClient1.ID),
+	}
+
+	err := oauthConsent0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOauthConsentClientOauthClient0: %w", err)
+	}
+
+	return oauthConsent0, nil
+}
+
+func (oauthConsent0 *OauthConsent) InsertClientOauthClient(ctx context.Context, exec bob.Executor, related *OauthClientSetter) error {
+	oauthClient1, err := OauthClients.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachOauthConsentClientOauthClient0(ctx, exec, 1, oauthConsent0, oauthClient1)
+	if err != nil {
+		return err
+	}
+
+	oauthConsent0.R.ClientOauthClient = oauthClient1
+
+	oauthClient1.R.ClientOauthConsents = append(oauthClient1.R.ClientOauthConsents, oauthConsent0)
+
+	return nil
+}
+
+func (oauthConsent0 *OauthConsent) AttachClientOauthClient(ctx context.Context, exec bob.Executor, oauthClient1 *OauthClient) error {
+	var err error
+
+	_, err = attachOauthConsentClientOauthClient0(ctx, exec, 1, oauthConsent0, oauthClient1)
+	if err != nil {
+		return err
+	}
+
+	oauthConsent0.R.ClientOauthClient = oauthClient1
+
+	oauthClient1.R.ClientOauthConsents = append(oauthClient1.R.ClientOauthConsents, oauthConsent0)
+
+	return nil
+}
+
+type oauthConsentWhere[Q sqlite.Filterable] struct {
+	Scopes    sqlite.WhereMod[Q, string]
+	CreatedAt sqlite.WhereMod[Q, time.Time]
+	ClientID  sqlite.WhereMod[Q, string]
+	UserID    sqlite.WhereMod[Q, int32]
+}
+
+func (oauthConsentWhere[Q]) AliasedAs(alias string) oauthConsentWhere[Q] {
+	return buildOauthConsentWhere[Q](buildOauthConsentColumns(alias))
+}
+
+func buildOauthConsentWhere[Q sqlite.Filterable](cols oauthConsentColumns) oauthConsentWhere[Q] {
+	return oauthConsentWhere[Q]{
+		Scopes:    sqlite.Where[Q, string](cols.Scopes),
+		CreatedAt: sqlite.Where[Q, time.Time](cols.CreatedAt),
+		ClientID:  sqlite.Where[Q, string](cols.ClientID),
+		UserID:    sqlite.Where[Q, int32](cols.UserID),
+	}
+}
+
+func (o *OauthConsent) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("oauthConsent cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.OauthConsents = OauthConsentSlice{o}
+		}
+		return nil
+	case "ClientOauthClient":
+		rel, ok := retrieved.(*OauthClient)
+		if !ok {
+			return fmt.Errorf("oauthConsent cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ClientOauthClient = rel
+
+		if rel != nil {
+			rel.R.ClientOauthConsents = OauthConsentSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("oauthConsent has no relationship %q", name)
+	}
+}
+
+type oauthConsentPreloader struct {
+	User              func(...sqlite.PreloadOption) sqlite.Preloader
+	ClientOauthClient func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildOauthConsentPreloader() oauthConsentPreloader {
+	return oauthConsentPreloader{
+		User: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*User, UserSlice](sqlite.PreloadRel{
+				Name: "User",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        OauthConsents,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+		ClientOauthClient: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*OauthClient, OauthClientSlice](sqlite.PreloadRel{
+				Name: "ClientOauthClient",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        OauthConsents,
+						To:          OauthClients,
+						FromColumns: []string{"client_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, OauthClients.Columns.Names(), opts...)
+		},
+	}
+}
+
+type oauthConsentThenLoader[Q orm.Loadable] struct {
+	User              func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ClientOauthClient func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildOauthConsentThenLoader[Q orm.Loadable]() oauthConsentThenLoader[Q] {
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ClientOauthClientLoadInterface interface {
+		LoadClientOauthClient(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return oauthConsentThenLoader[Q]{
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+		ClientOauthClient: thenLoadBuilder[Q](
+			"ClientOauthClient",
+			func(ctx context.Context, exec bob.Executor, retrieved ClientOauthClientLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadClientOauthClient(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadUser loads the oauthConsent's User into the .R struct
+func (o *OauthConsent) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.OauthConsents = OauthConsentSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the oauthConsent's User into the .R struct
+func (os OauthConsentSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if o.UserID != rel.ID {
+				continue
+			}
+
+			rel.R.OauthConsents = append(rel.R.OauthConsents, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadClientOauthClient loads the oauthConsent's ClientOauthClient into the .R struct
+func (o *OauthConsent) LoadClientOauthClient(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ClientOauthClient = nil
+
+	related, err := o.ClientOauthClient(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.ClientOauthConsents = OauthConsentSlice{o}
+
+	o.R.ClientOauthClient = related
+	return nil
+}
+
+// LoadClientOauthClient loads the oauthConsent's ClientOauthClient into the .R struct
+func (os OauthConsentSlice) LoadClientOauthClient(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	oauthClients, err := os.ClientOauthClient(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range oauthClients {
+
+			if o.ClientID != rel.ID {
+				continue
+			}
+
+			rel.R.ClientOauthConsents = append(rel.R.ClientOauthConsents, o)
+
+			o.R.ClientOauthClient = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type oauthConsentJoins[Q dialect.Joinable] struct {
+	typ               string
+	User              modAs[Q, userColumns]
+	ClientOauthClient modAs[Q, oauthClientColumns]
+}
+
+func (j oauthConsentJoins[Q]) aliasedAs(alias string) oauthConsentJoins[Q] {
+	return buildOauthConsentJoins[Q](buildOauthConsentColumns(alias), j.typ)
+}
+
+func buildOauthConsentJoins[Q dialect.Joinable](cols oauthConsentColumns, typ string) oauthConsentJoins[Q] {
+	return oauthConsentJoins[Q]{
+		typ: typ,
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+		ClientOauthClient: modAs[Q, oauthClientColumns]{
+			c: OauthClients.Columns,
+			f: func(to oauthClientColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, OauthClients.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.ClientID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,405 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+)
+
+// RateLimit is an object representing the database table.
+type RateLimit struct {
+	Key       string    `db:"key,pk" `
+	Tokens    float32   `db:"tokens" `
+	UpdatedAt time.Time `db:"updated_at" `
+}
+
+// RateLimitSlice is an alias for a slice of pointers to RateLimit.
+// This should almost always be used instead of []*RateLimit.
+type RateLimitSlice []*RateLimit
+
+// RateLimits contains methods to work with the rate_limit table
+var RateLimits = sqlite.NewTablex[*RateLimit, RateLimitSlice, *RateLimitSetter]("", "rate_limit", buildRateLimitColumns("rate_limit"))
+
+// RateLimitsQuery is a query on the rate_limit table
+type RateLimitsQuery = *sqlite.ViewQuery[*RateLimit, RateLimitSlice]
+
+func buildRateLimitColumns(alias string) rateLimitColumns {
+	return rateLimitColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"key", "tokens", "updated_at",
+		).WithParent("rate_limit"),
+		tableAlias: alias,
+		Key:        sqlite.Quote(alias, "key"),
+		Tokens:     sqlite.Quote(alias, "tokens"),
+		UpdatedAt:  sqlite.Quote(alias, "updated_at"),
+	}
+}
+
+type rateLimitColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	Key        sqlite.Expression
+	Tokens     sqlite.Expression
+	UpdatedAt  sqlite.Expression
+}
+
+func (c rateLimitColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (rateLimitColumns) AliasedAs(alias string) rateLimitColumns {
+	return buildRateLimitColumns(alias)
+}
+
+// RateLimitSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type RateLimitSetter struct {
+	Key       omit.Val[string]    `db:"key,pk" `
+	Tokens    omit.Val[float32]   `db:"tokens" `
+	UpdatedAt omit.Val[time.Time] `db:"updated_at" `
+}
+
+func (s RateLimitSetter) SetColumns() []string {
+	vals := make([]string, 0, 3)
+	if s.Key.IsValue() {
+		vals = append(vals, "key")
+	}
+	if s.Tokens.IsValue() {
+		vals = append(vals, "tokens")
+	}
+	if s.UpdatedAt.IsValue() {
+		vals = append(vals, "updated_at")
+	}
+	return vals
+}
+
+func (s RateLimitSetter) Overwrite(t *RateLimit) {
+	if s.Key.IsValue() {
+		t.Key = s.Key.MustGet()
+	}
+	if s.Tokens.IsValue() {
+		t.Tokens = s.Tokens.MustGet()
+	}
+	if s.UpdatedAt.IsValue() {
+		t.UpdatedAt = s.UpdatedAt.MustGet()
+	}
+}
+
+func (s *RateLimitSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return RateLimits.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"key"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 3)
+		if s.Key.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Key.MustGet()))
+		}
+
+		if s.Tokens.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Tokens.MustGet()))
+		}
+
+		if s.UpdatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UpdatedAt.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s RateLimitSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s RateLimitSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 3)
+
+	if s.Key.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "key")...),
+			sqlite.Arg(s.Key),
+		}})
+	}
+
+	if s.Tokens.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "tokens")...),
+			sqlite.Arg(s.Tokens),
+		}})
+	}
+
+	if s.UpdatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "updated_at")...),
+			sqlite.Arg(s.UpdatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindRateLimit retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindRateLimit(ctx context.Context, exec bob.Executor, KeyPK string, cols ...string) (*RateLimit, error) {
+	if len(cols) == 0 {
+		return RateLimits.Query(
+			sm.Where(RateLimits.Columns.Key.EQ(sqlite.Arg(KeyPK))),
+		).One(ctx, exec)
+	}
+
+	return RateLimits.Query(
+		sm.Where(RateLimits.Columns.Key.EQ(sqlite.Arg(KeyPK))),
+		sm.Columns(RateLimits.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// RateLimitExists checks the presence of a single record by primary key
+func RateLimitExists(ctx context.Context, exec bob.Executor, KeyPK string) (bool, error) {
+	return RateLimits.Query(
+		sm.Where(RateLimits.Columns.Key.EQ(sqlite.Arg(KeyPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after RateLimit is retrieved from the database
+func (o *RateLimit) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = RateLimits.AfterSelectHooks.RunHooks(ctx, exec, RateLimitSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = RateLimits.AfterInsertHooks.RunHooks(ctx, exec, RateLimitSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = RateLimits.AfterUpdateHooks.RunHooks(ctx, exec, RateLimitSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = RateLimits.AfterDeleteHooks.RunHooks(ctx, exec, RateLimitSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the RateLimit
+func (o *RateLimit) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.Key)
+}
+
+func (o *RateLimit) pkEQ() dialect.Expression {
+	return sqlite.Quote("rate_limit", "key").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the RateLimit
+func (o *RateLimit) Update(ctx context.Context, exec bob.Executor, s *RateLimitSetter) error {
+	v, err := RateLimits.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single RateLimit record with an executor
+func (o *RateLimit) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := RateLimits.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the RateLimit using the executor
+func (o *RateLimit) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := RateLimits.Query(
+		sm.Where(RateLimits.Columns.Key.EQ(sqlite.Arg(o.Key))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after RateLimitSlice is retrieved from the database
+func (o RateLimitSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = RateLimits.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = RateLimits.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = RateLimits.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = RateLimits.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o RateLimitSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("rate_limit", "key").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o RateLimitSlice) copyMatchingRows(from ...*RateLimit) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.Key != old.Key {
+				continue
+			}
+
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o RateLimitSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return RateLimits.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *RateLimit:
+				o.copyMatchingRows(retrieved)
+			case []*RateLimit:
+				o.copyMatchingRows(retrieved...)
+			case RateLimitSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a RateLimit or a slice of RateLimit
+				// then run the AfterUpdateHooks on the slice
+				_, err = RateLimits.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o RateLimitSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return RateLimits.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *RateLimit:
+				o.copyMatchingRows(retrieved)
+			case []*RateLimit:
+				o.copyMatchingRows(retrieved...)
+			case RateLimitSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a RateLimit or a slice of RateLimit
+				// then run the AfterDeleteHooks on the slice
+				_, err = RateLimits.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o RateLimitSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals RateLimitSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := RateLimits.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o RateLimitSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := RateLimits.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o RateLimitSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := RateLimits.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type rateLimitWhere[Q sqlite.Filterable] struct {
+	Key       sqlite.WhereMod[Q, string]
+	Tokens    sqlite.WhereMod[Q, float32]
+	UpdatedAt sqlite.WhereMod[Q, time.Time]
+}
+
+func (rateLimitWhere[Q]) AliasedAs(alias string) rateLimitWhere[Q] {
+	return buildRateLimitWhere[Q](buildRateLimitColumns(alias))
+}
+
+func buildRateLimitWhere[Q sqlite.Filterable](cols rateLimitColumns) rateLimitWhere[Q] {
+	return rateLimitWhere[Q]{
+		Key:       sqlite.Where[Q, string](cols.Key),
+		Tokens:    sqlite.Where[Q, float32](cols.Tokens),
+		UpdatedAt: sqlite.Where[Q, time.Time](cols.UpdatedAt),
+	}
+}
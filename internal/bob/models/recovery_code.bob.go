@@ -0,0 +1,651 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// RecoveryCode is an object representing the database table.
+type RecoveryCode struct {
+	ID     int32               `db:"id,pk" `
+	Hash   []byte              `db:"hash" `
+	UsedAt null.Val[time.Time] `db:"used_at" `
+	UserID int32               `db:"user_id" `
+
+	R recoveryCodeR `db:"-" `
+}
+
+// RecoveryCodeSlice is an alias for a slice of pointers to RecoveryCode.
+// This should almost always be used instead of []*RecoveryCode.
+type RecoveryCodeSlice []*RecoveryCode
+
+// RecoveryCodes contains methods to work with the recovery_code table
+var RecoveryCodes = sqlite.NewTablex[*RecoveryCode, RecoveryCodeSlice, *RecoveryCodeSetter]("", "recovery_code", buildRecoveryCodeColumns("recovery_code"))
+
+// RecoveryCodesQuery is a query on the recovery_code table
+type RecoveryCodesQuery = *sqlite.ViewQuery[*RecoveryCode, RecoveryCodeSlice]
+
+// recoveryCodeR is where relationships are stored.
+type recoveryCodeR struct {
+	User *User // fk_recovery_code_0
+}
+
+func buildRecoveryCodeColumns(alias string) recoveryCodeColumns {
+	return recoveryCodeColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "hash", "used_at", "user_id",
+		).WithParent("recovery_code"),
+		tableAlias: alias,
+		ID:         sqlite.Quote(alias, "id"),
+		Hash:       sqlite.Quote(alias, "hash"),
+		UsedAt:     sqlite.Quote(alias, "used_at"),
+		UserID:     sqlite.Quote(alias, "user_id"),
+	}
+}
+
+type recoveryCodeColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         sqlite.Expression
+	Hash       sqlite.Expression
+	UsedAt     sqlite.Expression
+	UserID     sqlite.Expression
+}
+
+func (c recoveryCodeColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (recoveryCodeColumns) AliasedAs(alias string) recoveryCodeColumns {
+	return buildRecoveryCodeColumns(alias)
+}
+
+// RecoveryCodeSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type RecoveryCodeSetter struct {
+	ID     omit.Val[int32]         `db:"id,pk" `
+	Hash   omit.Val[[]byte]        `db:"hash" `
+	UsedAt omitnull.Val[time.Time] `db:"used_at" `
+	UserID omit.Val[int32]         `db:"user_id" `
+}
+
+func (s RecoveryCodeSetter) SetColumns() []string {
+	vals := make([]string, 0, 4)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.Hash.IsValue() {
+		vals = append(vals, "hash")
+	}
+	if !s.UsedAt.IsUnset() {
+		vals = append(vals, "used_at")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	return vals
+}
+
+func (s RecoveryCodeSetter) Overwrite(t *RecoveryCode) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.Hash.IsValue() {
+		t.Hash = s.Hash.MustGet()
+	}
+	if !s.UsedAt.IsUnset() {
+		t.UsedAt = s.UsedAt.MustGetNull()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+}
+
+func (s *RecoveryCodeSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return RecoveryCodes.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 4)
+		if s.ID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
+		}
+
+		if s.Hash.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Hash.MustGet()))
+		}
+
+		if !s.UsedAt.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.UsedAt.MustGetNull()))
+		}
+
+		if s.UserID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UserID.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s RecoveryCodeSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s RecoveryCodeSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 4)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "id")...),
+			sqlite.Arg(s.ID),
+		}})
+	}
+
+	if s.Hash.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "hash")...),
+			sqlite.Arg(s.Hash),
+		}})
+	}
+
+	if !s.UsedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "used_at")...),
+			sqlite.Arg(s.UsedAt),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "user_id")...),
+			sqlite.Arg(s.UserID),
+		}})
+	}
+
+	return exprs
+}
+
+// FindRecoveryCode retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindRecoveryCode(ctx context.Context, exec bob.Executor, IDPK int32, cols ...string) (*RecoveryCode, error) {
+	if len(cols) == 0 {
+		return RecoveryCodes.Query(
+			sm.Where(RecoveryCodes.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return RecoveryCodes.Query(
+		sm.Where(RecoveryCodes.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		sm.Columns(RecoveryCodes.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// RecoveryCodeExists checks the presence of a single record by primary key
+func RecoveryCodeExists(ctx context.Context, exec bob.Executor, IDPK int32) (bool, error) {
+	return RecoveryCodes.Query(
+		sm.Where(RecoveryCodes.Columns.ID.EQ(sqlite.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after RecoveryCode is retrieved from the database
+func (o *RecoveryCode) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = RecoveryCodes.AfterSelectHooks.RunHooks(ctx, exec, RecoveryCodeSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = RecoveryCodes.AfterInsertHooks.RunHooks(ctx, exec, RecoveryCodeSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = RecoveryCodes.AfterUpdateHooks.RunHooks(ctx, exec, RecoveryCodeSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = RecoveryCodes.AfterDeleteHooks.RunHooks(ctx, exec, RecoveryCodeSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the RecoveryCode
+func (o *RecoveryCode) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.ID)
+}
+
+func (o *RecoveryCode) pkEQ() dialect.Expression {
+	return sqlite.Quote("recovery_code", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the RecoveryCode
+func (o *RecoveryCode) Update(ctx context.Context, exec bob.Executor, s *RecoveryCodeSetter) error {
+	v, err := RecoveryCodes.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single RecoveryCode record with an executor
+func (o *RecoveryCode) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := RecoveryCodes.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the RecoveryCode using the executor
+func (o *RecoveryCode) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := RecoveryCodes.Query(
+		sm.Where(RecoveryCodes.Columns.ID.EQ(sqlite.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after RecoveryCodeSlice is retrieved from the database
+func (o RecoveryCodeSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = RecoveryCodes.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = RecoveryCodes.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = RecoveryCodes.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = RecoveryCodes.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o RecoveryCodeSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("recovery_code", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o RecoveryCodeSlice) copyMatchingRows(from ...*RecoveryCode) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o RecoveryCodeSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return RecoveryCodes.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *RecoveryCode:
+				o.copyMatchingRows(retrieved)
+			case []*RecoveryCode:
+				o.copyMatchingRows(retrieved...)
+			case RecoveryCodeSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a RecoveryCode or a slice of RecoveryCode
+				// then run the AfterUpdateHooks on the slice
+				_, err = RecoveryCodes.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o RecoveryCodeSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return RecoveryCodes.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *RecoveryCode:
+				o.copyMatchingRows(retrieved)
+			case []*RecoveryCode:
+				o.copyMatchingRows(retrieved...)
+			case RecoveryCodeSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a RecoveryCode or a slice of RecoveryCode
+				// then run the AfterDeleteHooks on the slice
+				_, err = RecoveryCodes.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o RecoveryCodeSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals RecoveryCodeSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := RecoveryCodes.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o RecoveryCodeSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := RecoveryCodes.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o RecoveryCodeSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := RecoveryCodes.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// User starts a query for related objects on user
+func (o *RecoveryCode) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(sqlite.Arg(o.UserID))),
+	)...)
+}
+
+func (os RecoveryCodeSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.UserID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Users.Query(append(mods,
+		sm.Where(sqlite.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachRecoveryCodeUser0(ctx context.Context, exec bob.Executor, count int, recoveryCode0 *RecoveryCode, user1 *User) (*RecoveryCode, error) {
+	setter := &RecoveryCodeSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := recoveryCode0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachRecoveryCodeUser0: %w", err)
+	}
+
+	return recoveryCode0, nil
+}
+
+func (recoveryCode0 *RecoveryCode) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachRecoveryCodeUser0(ctx, exec, 1, recoveryCode0, user1)
+	if err != nil {
+		return err
+	}
+
+	recoveryCode0.R.User = user1
+
+	user1.R.RecoveryCodes = append(user1.R.RecoveryCodes, recoveryCode0)
+
+	return nil
+}
+
+func (recoveryCode0 *RecoveryCode) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachRecoveryCodeUser0(ctx, exec, 1, recoveryCode0, user1)
+	if err != nil {
+		return err
+	}
+
+	recoveryCode0.R.User = user1
+
+	user1.R.RecoveryCodes = append(user1.R.RecoveryCodes, recoveryCode0)
+
+	return nil
+}
+
+type recoveryCodeWhere[Q sqlite.Filterable] struct {
+	ID     sqlite.WhereMod[Q, int32]
+	Hash   sqlite.WhereMod[Q, []byte]
+	UsedAt sqlite.WhereNullMod[Q, time.Time]
+	UserID sqlite.WhereMod[Q, int32]
+}
+
+func (recoveryCodeWhere[Q]) AliasedAs(alias string) recoveryCodeWhere[Q] {
+	return buildRecoveryCodeWhere[Q](buildRecoveryCodeColumns(alias))
+}
+
+func buildRecoveryCodeWhere[Q sqlite.Filterable](cols recoveryCodeColumns) recoveryCodeWhere[Q] {
+	return recoveryCodeWhere[Q]{
+		ID:     sqlite.Where[Q, int32](cols.ID),
+		Hash:   sqlite.Where[Q, []byte](cols.Hash),
+		UsedAt: sqlite.WhereNull[Q, time.Time](cols.UsedAt),
+		UserID: sqlite.Where[Q, int32](cols.UserID),
+	}
+}
+
+func (o *RecoveryCode) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("recoveryCode cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.RecoveryCodes = RecoveryCodeSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("recoveryCode has no relationship %q", name)
+	}
+}
+
+type recoveryCodePreloader struct {
+	User func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildRecoveryCodePreloader() recoveryCodePreloader {
+	return recoveryCodePreloader{
+		User: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*User, UserSlice](sqlite.PreloadRel{
+				Name: "User",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        RecoveryCodes,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type recoveryCodeThenLoader[Q orm.Loadable] struct {
+	User func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildRecoveryCodeThenLoader[Q orm.Loadable]() recoveryCodeThenLoader[Q] {
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return recoveryCodeThenLoader[Q]{
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadUser loads the recoveryCode's User into the .R struct
+func (o *RecoveryCode) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.RecoveryCodes = RecoveryCodeSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the recoveryCode's User into the .R struct
+func (os RecoveryCodeSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if o.UserID != rel.ID {
+				continue
+			}
+
+			rel.R.RecoveryCodes = append(rel.R.RecoveryCodes, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type recoveryCodeJoins[Q dialect.Joinable] struct {
+	typ  string
+	User modAs[Q, userColumns]
+}
+
+func (j recoveryCodeJoins[Q]) aliasedAs(alias string) recoveryCodeJoins[Q] {
+	return buildRecoveryCodeJoins[Q](buildRecoveryCodeColumns(alias), j.typ)
+}
+
+func buildRecoveryCodeJoins[Q dialect.Joinable](cols recoveryCodeColumns, typ string) recoveryCodeJoins[Q] {
+	return recoveryCodeJoins[Q]{
+		typ: typ,
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,1030 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// Session is an object representing the database table.
+type Session struct {
+	ID                  string              `db:"id,pk" `
+	RefreshHash         []byte              `db:"refresh_hash" `
+	PreviousRefreshHash null.Val[[]byte]    `db:"previous_refresh_hash" `
+	CreatedAt           time.Time           `db:"created_at" `
+	LastSeen            time.Time           `db:"last_seen" `
+	RotatedAt           time.Time           `db:"rotated_at" `
+	ExpiresAt           time.Time           `db:"expires_at" `
+	RevokedAt           null.Val[time.Time] `db:"revoked_at" `
+	UserAgent           string              `db:"user_agent" `
+	IP                  string              `db:"ip" `
+	UserID              int32               `db:"user_id" `
+	ClientID            null.Val[string]    `db:"client_id" `
+	Scopes              null.Val[string]    `db:"scopes" `
+
+	R sessionR `db:"-" `
+}
+
+// SessionSlice is an alias for a slice of pointers to Session.
+// This should almost always be used instead of []*Session.
+type SessionSlice []*Session
+
+// Sessions contains methods to work with the session table
+var Sessions = sqlite.NewTablex[*Session, SessionSlice, *SessionSetter]("", "session", buildSessionColumns("session"))
+
+// SessionsQuery is a query on the session table
+type SessionsQuery = *sqlite.ViewQuery[*Session, SessionSlice]
+
+// sessionR is where relationships are stored.
+type sessionR struct {
+	User              *User        // fk_session_0
+	ClientOauthClient *OauthClient // fk_session_1
+}
+
+func buildSessionColumns(alias string) sessionColumns {
+	return sessionColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "refresh_hash", "previous_refresh_hash", "created_at", "last_seen", "rotated_at", "expires_at", "revoked_at", "user_agent", "ip", "user_id", "client_id", "scopes",
+		).WithParent("session"),
+		tableAlias:          alias,
+		ID:                  sqlite.Quote(alias, "id"),
+		RefreshHash:         sqlite.Quote(alias, "refresh_hash"),
+		PreviousRefreshHash: sqlite.Quote(alias, "previous_refresh_hash"),
+		CreatedAt:           sqlite.Quote(alias, "created_at"),
+		LastSeen:            sqlite.Quote(alias, "last_seen"),
+		RotatedAt:           sqlite.Quote(alias, "rotated_at"),
+		ExpiresAt:           sqlite.Quote(alias, "expires_at"),
+		RevokedAt:           sqlite.Quote(alias, "revoked_at"),
+		UserAgent:           sqlite.Quote(alias, "user_agent"),
+		IP:                  sqlite.Quote(alias, "ip"),
+		UserID:              sqlite.Quote(alias, "user_id"),
+		ClientID:            sqlite.Quote(alias, "client_id"),
+		Scopes:              sqlite.Quote(alias, "scopes"),
+	}
+}
+
+type sessionColumns struct {
+	expr.ColumnsExpr
+	tableAlias          string
+	ID                  sqlite.Expression
+	RefreshHash         sqlite.Expression
+	PreviousRefreshHash sqlite.Expression
+	CreatedAt           sqlite.Expression
+	LastSeen            sqlite.Expression
+	RotatedAt           sqlite.Expression
+	ExpiresAt           sqlite.Expression
+	RevokedAt           sqlite.Expression
+	UserAgent           sqlite.Expression
+	IP                  sqlite.Expression
+	UserID              sqlite.Expression
+	ClientID            sqlite.Expression
+	Scopes              sqlite.Expression
+}
+
+func (c sessionColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (sessionColumns) AliasedAs(alias string) sessionColumns {
+	return buildSessionColumns(alias)
+}
+
+// SessionSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type SessionSetter struct {
+	ID                  omit.Val[string]        `db:"id,pk" `
+	RefreshHash         omit.Val[[]byte]        `db:"refresh_hash" `
+	PreviousRefreshHash omitnull.Val[[]byte]    `db:"previous_refresh_hash" `
+	CreatedAt           omit.Val[time.Time]     `db:"created_at" `
+	LastSeen            omit.Val[time.Time]     `db:"last_seen" `
+	RotatedAt           omit.Val[time.Time]     `db:"rotated_at" `
+	ExpiresAt           omit.Val[time.Time]     `db:"expires_at" `
+	RevokedAt           omitnull.Val[time.Time] `db:"revoked_at" `
+	UserAgent           omit.Val[string]        `db:"user_agent" `
+	IP                  omit.Val[string]        `db:"ip" `
+	UserID              omit.Val[int32]         `db:"user_id" `
+	ClientID            omitnull.Val[string]    `db:"client_id" `
+	Scopes              omitnull.Val[string]    `db:"scopes" `
+}
+
+func (s SessionSetter) SetColumns() []string {
+	vals := make([]string, 0, 13)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.RefreshHash.IsValue() {
+		vals = append(vals, "refresh_hash")
+	}
+	if !s.PreviousRefreshHash.IsUnset() {
+		vals = append(vals, "previous_refresh_hash")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	if s.LastSeen.IsValue() {
+		vals = append(vals, "last_seen")
+	}
+	if s.RotatedAt.IsValue() {
+		vals = append(vals, "rotated_at")
+	}
+	if s.ExpiresAt.IsValue() {
+		vals = append(vals, "expires_at")
+	}
+	if !s.RevokedAt.IsUnset() {
+		vals = append(vals, "revoked_at")
+	}
+	if s.UserAgent.IsValue() {
+		vals = append(vals, "user_agent")
+	}
+	if s.IP.IsValue() {
+		vals = append(vals, "ip")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if !s.ClientID.IsUnset() {
+		vals = append(vals, "client_id")
+	}
+	if !s.Scopes.IsUnset() {
+		vals = append(vals, "scopes")
+	}
+	return vals
+}
+
+func (s SessionSetter) Overwrite(t *Session) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.RefreshHash.IsValue() {
+		t.RefreshHash = s.RefreshHash.MustGet()
+	}
+	if !s.PreviousRefreshHash.IsUnset() {
+		t.PreviousRefreshHash = s.PreviousRefreshHash.MustGetNull()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+	if s.LastSeen.IsValue() {
+		t.LastSeen = s.LastSeen.MustGet()
+	}
+	if s.RotatedAt.IsValue() {
+		t.RotatedAt = s.RotatedAt.MustGet()
+	}
+	if s.ExpiresAt.IsValue() {
+		t.ExpiresAt = s.ExpiresAt.MustGet()
+	}
+	if !s.RevokedAt.IsUnset() {
+		t.RevokedAt = s.RevokedAt.MustGetNull()
+	}
+	if s.UserAgent.IsValue() {
+		t.UserAgent = s.UserAgent.MustGet()
+	}
+	if s.IP.IsValue() {
+		t.IP = s.IP.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if !s.ClientID.IsUnset() {
+		t.ClientID = s.ClientID.MustGetNull()
+	}
+	if !s.Scopes.IsUnset() {
+		t.Scopes = s.Scopes.MustGetNull()
+	}
+}
+
+func (s *SessionSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Sessions.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 13)
+		if s.ID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
+		}
+
+		if s.RefreshHash.IsValue() {
+			vals = append(vals, sqlite.Arg(s.RefreshHash.MustGet()))
+		}
+
+		if !s.PreviousRefreshHash.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.PreviousRefreshHash.MustGetNull()))
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.CreatedAt.MustGet()))
+		}
+
+		if s.LastSeen.IsValue() {
+			vals = append(vals, sqlite.Arg(s.LastSeen.MustGet()))
+		}
+
+		if s.RotatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.RotatedAt.MustGet()))
+		}
+
+		if s.ExpiresAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ExpiresAt.MustGet()))
+		}
+
+		if !s.RevokedAt.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.RevokedAt.MustGetNull()))
+		}
+
+		if s.UserAgent.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UserAgent.MustGet()))
+		}
+
+		if s.IP.IsValue() {
+			vals = append(vals, sqlite.Arg(s.IP.MustGet()))
+		}
+
+		if s.UserID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UserID.MustGet()))
+		}
+
+		if !s.ClientID.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.ClientID.MustGetNull()))
+		}
+
+		if !s.Scopes.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.Scopes.MustGetNull()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s SessionSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s SessionSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 13)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "id")...),
+			sqlite.Arg(s.ID),
+		}})
+	}
+
+	if s.RefreshHash.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "refresh_hash")...),
+			sqlite.Arg(s.RefreshHash),
+		}})
+	}
+
+	if !s.PreviousRefreshHash.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "previous_refresh_hash")...),
+			sqlite.Arg(s.PreviousRefreshHash),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "created_at")...),
+			sqlite.Arg(s.CreatedAt),
+		}})
+	}
+
+	if s.LastSeen.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "last_seen")...),
+			sqlite.Arg(s.LastSeen),
+		}})
+	}
+
+	if s.RotatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "rotated_at")...),
+			sqlite.Arg(s.RotatedAt),
+		}})
+	}
+
+	if s.ExpiresAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "expires_at")...),
+			sqlite.Arg(s.ExpiresAt),
+		}})
+	}
+
+	if !s.RevokedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "revoked_at")...),
+			sqlite.Arg(s.RevokedAt),
+		}})
+	}
+
+	if s.UserAgent.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "user_agent")...),
+			sqlite.Arg(s.UserAgent),
+		}})
+	}
+
+	if s.IP.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "ip")...),
+			sqlite.Arg(s.IP),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "user_id")...),
+			sqlite.Arg(s.UserID),
+		}})
+	}
+
+	if !s.ClientID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "client_id")...),
+			sqlite.Arg(s.ClientID),
+		}})
+	}
+
+	if !s.Scopes.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "scopes")...),
+			sqlite.Arg(s.Scopes),
+		}})
+	}
+
+	return exprs
+}
+
+// FindSession retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindSession(ctx context.Context, exec bob.Executor, IDPK string, cols ...string) (*Session, error) {
+	if len(cols) == 0 {
+		return Sessions.Query(
+			sm.Where(Sessions.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return Sessions.Query(
+		sm.Where(Sessions.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		sm.Columns(Sessions.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// SessionExists checks the presence of a single record by primary key
+func SessionExists(ctx context.Context, exec bob.Executor, IDPK string) (bool, error) {
+	return Sessions.Query(
+		sm.Where(Sessions.Columns.ID.EQ(sqlite.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Session is retrieved from the database
+func (o *Session) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Sessions.AfterSelectHooks.RunHooks(ctx, exec, SessionSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Sessions.AfterInsertHooks.RunHooks(ctx, exec, SessionSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Sessions.AfterUpdateHooks.RunHooks(ctx, exec, SessionSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Sessions.AfterDeleteHooks.RunHooks(ctx, exec, SessionSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Session
+func (o *Session) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.ID)
+}
+
+func (o *Session) pkEQ() dialect.Expression {
+	return sqlite.Quote("session", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Session
+func (o *Session) Update(ctx context.Context, exec bob.Executor, s *SessionSetter) error {
+	v, err := Sessions.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Session record with an executor
+func (o *Session) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Sessions.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Session using the executor
+func (o *Session) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Sessions.Query(
+		sm.Where(Sessions.Columns.ID.EQ(sqlite.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after SessionSlice is retrieved from the database
+func (o SessionSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Sessions.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Sessions.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Sessions.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Sessions.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o SessionSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("session", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o SessionSlice) copyMatchingRows(from ...*Session) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o SessionSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Sessions.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Session:
+				o.copyMatchingRows(retrieved)
+			case []*Session:
+				o.copyMatchingRows(retrieved...)
+			case SessionSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Session or a slice of Session
+				// then run the AfterUpdateHooks on the slice
+				_, err = Sessions.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o SessionSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Sessions.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Session:
+				o.copyMatchingRows(retrieved)
+			case []*Session:
+				o.copyMatchingRows(retrieved...)
+			case SessionSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Session or a slice of Session
+				// then run the AfterDeleteHooks on the slice
+				_, err = Sessions.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o SessionSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals SessionSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Sessions.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o SessionSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Sessions.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o SessionSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Sessions.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// User starts a query for related objects on user
+func (o *Session) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(sqlite.Arg(o.UserID))),
+	)...)
+}
+
+func (os SessionSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.UserID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Users.Query(append(mods,
+		sm.Where(sqlite.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// ClientOauthClient starts a query for related objects on oauth_client
+func (o *Session) ClientOauthClient(mods ...bob.Mod[*dialect.SelectQuery]) OauthClientsQuery {
+	return OauthClients.Query(append(mods,
+		sm.Where(OauthClients.Columns.ID.EQ(sqlite.Arg(o.ClientID))),
+	)...)
+}
+
+func (os SessionSlice) ClientOauthClient(mods ...bob.Mod[*dialect.SelectQuery]) OauthClientsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ClientID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return OauthClients.Query(append(mods,
+		sm.Where(sqlite.Group(OauthClients.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachSessionUser0(ctx context.Context, exec bob.Executor, count int, session0 *Session, user1 *User) (*Session, error) {
+	setter := &SessionSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := session0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachSessionUser0: %w", err)
+	}
+
+	return session0, nil
+}
+
+func (session0 *Session) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachSessionUser0(ctx, exec, 1, session0, user1)
+	if err != nil {
+		return err
+	}
+
+	session0.R.User = user1
+
+	user1.R.Sessions = append(user1.R.Sessions, session0)
+
+	return nil
+}
+
+func (session0 *Session) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachSessionUser0(ctx, exec, 1, session0, user1)
+	if err != nil {
+		return err
+	}
+
+	session0.R.User = user1
+
+	user1.R.Sessions = append(user1.R.Sessions, session0)
+
+	return nil
+}
+
+func attachSessionClientOauthClient0(ctx context.Context, exec bob.Executor, count int, session0 *Session, oauthClient1 *OauthClient) (*Session, error) {
+	setter := &SessionSetter{
+		ClientID: omitnull.From(oauthClient1.ID),
+	}
+
+	err := session0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachSessionClientOauthClient0: %w", err)
+	}
+
+	return session0, nil
+}
+
+func (session0 *Session) InsertClientOauthClient(ctx context.Context, exec bob.Executor, related *OauthClientSetter) error {
+	oauthClient1, err := OauthClients.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachSessionClientOauthClient0(ctx, exec, 1, session0, oauthClient1)
+	if err != nil {
+		return err
+	}
+
+	session0.R.ClientOauthClient = oauthClient1
+
+	oauthClient1.R.ClientSessions = append(oauthClient1.R.ClientSessions, session0)
+
+	return nil
+}
+
+func (session0 *Session) AttachClientOauthClient(ctx context.Context, exec bob.Executor, oauthClient1 *OauthClient) error {
+	var err error
+
+	_, err = attachSessionClientOauthClient0(ctx, exec, 1, session0, oauthClient1)
+	if err != nil {
+		return err
+	}
+
+	session0.R.ClientOauthClient = oauthClient1
+
+	oauthClient1.R.ClientSessions = append(oauthClient1.R.ClientSessions, session0)
+
+	return nil
+}
+
+type sessionWhere[Q sqlite.Filterable] struct {
+	ID                  sqlite.WhereMod[Q, string]
+	RefreshHash         sqlite.WhereMod[Q, []byte]
+	PreviousRefreshHash sqlite.WhereNullMod[Q, []byte]
+	CreatedAt           sqlite.WhereMod[Q, time.Time]
+	LastSeen            sqlite.WhereMod[Q, time.Time]
+	RotatedAt           sqlite.WhereMod[Q, time.Time]
+	ExpiresAt           sqlite.WhereMod[Q, time.Time]
+	RevokedAt           sqlite.WhereNullMod[Q, time.Time]
+	UserAgent           sqlite.WhereMod[Q, string]
+	IP                  sqlite.WhereMod[Q, string]
+	UserID              sqlite.WhereMod[Q, int32]
+	ClientID            sqlite.WhereNullMod[Q, string]
+	Scopes              sqlite.WhereNullMod[Q, string]
+}
+
+func (sessionWhere[Q]) AliasedAs(alias string) sessionWhere[Q] {
+	return buildSessionWhere[Q](buildSessionColumns(alias))
+}
+
+func buildSessionWhere[Q sqlite.Filterable](cols sessionColumns) sessionWhere[Q] {
+	return sessionWhere[Q]{
+		ID:                  sqlite.Where[Q, string](cols.ID),
+		RefreshHash:         sqlite.Where[Q, []byte](cols.RefreshHash),
+		PreviousRefreshHash: sqlite.WhereNull[Q, []byte](cols.PreviousRefreshHash),
+		CreatedAt:           sqlite.Where[Q, time.Time](cols.CreatedAt),
+		LastSeen:            sqlite.Where[Q, time.Time](cols.LastSeen),
+		RotatedAt:           sqlite.Where[Q, time.Time](cols.RotatedAt),
+		ExpiresAt:           sqlite.Where[Q, time.Time](cols.ExpiresAt),
+		RevokedAt:           sqlite.WhereNull[Q, time.Time](cols.RevokedAt),
+		UserAgent:           sqlite.Where[Q, string](cols.UserAgent),
+		IP:                  sqlite.Where[Q, string](cols.IP),
+		UserID:              sqlite.Where[Q, int32](cols.UserID),
+		ClientID:            sqlite.WhereNull[Q, string](cols.ClientID),
+		Scopes:              sqlite.WhereNull[Q, string](cols.Scopes),
+	}
+}
+
+func (o *Session) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("session cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.Sessions = SessionSlice{o}
+		}
+		return nil
+	case "ClientOauthClient":
+		rel, ok := retrieved.(*OauthClient)
+		if !ok {
+			return fmt.Errorf("session cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ClientOauthClient = rel
+
+		if rel != nil {
+			rel.R.ClientSessions = SessionSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("session has no relationship %q", name)
+	}
+}
+
+type sessionPreloader struct {
+	User              func(...sqlite.PreloadOption) sqlite.Preloader
+	ClientOauthClient func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildSessionPreloader() sessionPreloader {
+	return sessionPreloader{
+		User: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*User, UserSlice](sqlite.PreloadRel{
+				Name: "User",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        Sessions,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+		ClientOauthClient: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*OauthClient, OauthClientSlice](sqlite.PreloadRel{
+				Name: "ClientOauthClient",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        Sessions,
+						To:          OauthClients,
+						FromColumns: []string{"client_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, OauthClients.Columns.Names(), opts...)
+		},
+	}
+}
+
+type sessionThenLoader[Q orm.Loadable] struct {
+	User              func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ClientOauthClient func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildSessionThenLoader[Q orm.Loadable]() sessionThenLoader[Q] {
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ClientOauthClientLoadInterface interface {
+		LoadClientOauthClient(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return sessionThenLoader[Q]{
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+		ClientOauthClient: thenLoadBuilder[Q](
+			"ClientOauthClient",
+			func(ctx context.Context, exec bob.Executor, retrieved ClientOauthClientLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadClientOauthClient(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadUser loads the session's User into the .R struct
+func (o *Session) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Sessions = SessionSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the session's User into the .R struct
+func (os SessionSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if o.UserID != rel.ID {
+				continue
+			}
+
+			rel.R.Sessions = append(rel.R.Sessions, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadClientOauthClient loads the session's ClientOauthClient into the .R struct
+func (o *Session) LoadClientOauthClient(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ClientOauthClient = nil
+
+	related, err := o.ClientOauthClient(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.ClientSessions = SessionSlice{o}
+
+	o.R.ClientOauthClient = related
+	return nil
+}
+
+// LoadClientOauthClient loads the session's ClientOauthClient into the .R struct
+func (os SessionSlice) LoadClientOauthClient(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	oauthClients, err := os.ClientOauthClient(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range oauthClients {
+			if !o.ClientID.IsValue() {
+				continue
+			}
+
+			if o.ClientID.MustGet() != rel.ID {
+				continue
+			}
+
+			rel.R.ClientSessions = append(rel.R.ClientSessions, o)
+
+			o.R.ClientOauthClient = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type sessionJoins[Q dialect.Joinable] struct {
+	typ               string
+	User              modAs[Q, userColumns]
+	ClientOauthClient modAs[Q, oauthClientColumns]
+}
+
+func (j sessionJoins[Q]) aliasedAs(alias string) sessionJoins[Q] {
+	return buildSessionJoins[Q](buildSessionColumns(alias), j.typ)
+}
+
+func buildSessionJoins[Q dialect.Joinable](cols sessionColumns, typ string) sessionJoins[Q] {
+	return sessionJoins[Q]{
+		typ: typ,
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+		ClientOauthClient: modAs[Q, oauthClientColumns]{
+			c: OauthClients.Columns,
+			f: func(to oauthClientColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, OauthClients.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.ClientID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,428 @@
+// Code generated by BobGen sql (devel). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+)
+
+// SigningKey is an object representing the database table.
+type SigningKey struct {
+	ID         string    `db:"id,pk" `
+	Algorithm  string    `db:"algorithm" `
+	PrivateKey []byte    `db:"private_key" `
+	CreatedAt  time.Time `db:"created_at" `
+}
+
+// SigningKeySlice is an alias for a slice of pointers to SigningKey.
+// This should almost always be used instead of []*SigningKey.
+type SigningKeySlice []*SigningKey
+
+// SigningKeys contains methods to work with the signing_key table
+var SigningKeys = sqlite.NewTablex[*SigningKey, SigningKeySlice, *SigningKeySetter]("", "signing_key", buildSigningKeyColumns("signing_key"))
+
+// SigningKeysQuery is a query on the signing_key table
+type SigningKeysQuery = *sqlite.ViewQuery[*SigningKey, SigningKeySlice]
+
+func buildSigningKeyColumns(alias string) signingKeyColumns {
+	return signingKeyColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "algorithm", "private_key", "created_at",
+		).WithParent("signing_key"),
+		tableAlias: alias,
+		ID:         sqlite.Quote(alias, "id"),
+		Algorithm:  sqlite.Quote(alias, "algorithm"),
+		PrivateKey: sqlite.Quote(alias, "private_key"),
+		CreatedAt:  sqlite.Quote(alias, "created_at"),
+	}
+}
+
+type signingKeyColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         sqlite.Expression
+	Algorithm  sqlite.Expression
+	PrivateKey sqlite.Expression
+	CreatedAt  sqlite.Expression
+}
+
+func (c signingKeyColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (signingKeyColumns) AliasedAs(alias string) signingKeyColumns {
+	return buildSigningKeyColumns(alias)
+}
+
+// SigningKeySetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type SigningKeySetter struct {
+	ID         omit.Val[string]    `db:"id,pk" `
+	Algorithm  omit.Val[string]    `db:"algorithm" `
+	PrivateKey omit.Val[[]byte]    `db:"private_key" `
+	CreatedAt  omit.Val[time.Time] `db:"created_at" `
+}
+
+func (s SigningKeySetter) SetColumns() []string {
+	vals := make([]string, 0, 4)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.Algorithm.IsValue() {
+		vals = append(vals, "algorithm")
+	}
+	if s.PrivateKey.IsValue() {
+		vals = append(vals, "private_key")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s SigningKeySetter) Overwrite(t *SigningKey) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.Algorithm.IsValue() {
+		t.Algorithm = s.Algorithm.MustGet()
+	}
+	if s.PrivateKey.IsValue() {
+		t.PrivateKey = s.PrivateKey.MustGet()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+}
+
+func (s *SigningKeySetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return SigningKeys.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 4)
+		if s.ID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
+		}
+
+		if s.Algorithm.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Algorithm.MustGet()))
+		}
+
+		if s.PrivateKey.IsValue() {
+			vals = append(vals, sqlite.Arg(s.PrivateKey.MustGet()))
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.CreatedAt.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s SigningKeySetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s SigningKeySetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 4)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "id")...),
+			sqlite.Arg(s.ID),
+		}})
+	}
+
+	if s.Algorithm.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "algorithm")...),
+			sqlite.Arg(s.Algorithm),
+		}})
+	}
+
+	if s.PrivateKey.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "private_key")...),
+			sqlite.Arg(s.PrivateKey),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "created_at")...),
+			sqlite.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindSigningKey retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindSigningKey(ctx context.Context, exec bob.Executor, IDPK string, cols ...string) (*SigningKey, error) {
+	if len(cols) == 0 {
+		return SigningKeys.Query(
+			sm.Where(SigningKeys.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return SigningKeys.Query(
+		sm.Where(SigningKeys.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		sm.Columns(SigningKeys.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// SigningKeyExists checks the presence of a single record by primary key
+func SigningKeyExists(ctx context.Context, exec bob.Executor, IDPK string) (bool, error) {
+	return SigningKeys.Query(
+		sm.Where(SigningKeys.Columns.ID.EQ(sqlite.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after SigningKey is retrieved from the database
+func (o *SigningKey) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = SigningKeys.AfterSelectHooks.RunHooks(ctx, exec, SigningKeySlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = SigningKeys.AfterInsertHooks.RunHooks(ctx, exec, SigningKeySlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = SigningKeys.AfterUpdateHooks.RunHooks(ctx, exec, SigningKeySlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = SigningKeys.AfterDeleteHooks.RunHooks(ctx, exec, SigningKeySlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the SigningKey
+func (o *SigningKey) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.ID)
+}
+
+func (o *SigningKey) pkEQ() dialect.Expression {
+	return sqlite.Quote("signing_key", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the SigningKey
+func (o *SigningKey) Update(ctx context.Context, exec bob.Executor, s *SigningKeySetter) error {
+	v, err := SigningKeys.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single SigningKey record with an executor
+func (o *SigningKey) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := SigningKeys.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the SigningKey using the executor
+func (o *SigningKey) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := SigningKeys.Query(
+		sm.Where(SigningKeys.Columns.ID.EQ(sqlite.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after SigningKeySlice is retrieved from the database
+func (o SigningKeySlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = SigningKeys.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = SigningKeys.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = SigningKeys.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = SigningKeys.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o SigningKeySlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("signing_key", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o SigningKeySlice) copyMatchingRows(from ...*SigningKey) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o SigningKeySlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return SigningKeys.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *SigningKey:
+				o.copyMatchingRows(retrieved)
+			case []*SigningKey:
+				o.copyMatchingRows(retrieved...)
+			case SigningKeySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a SigningKey or a slice of SigningKey
+				// then run the AfterUpdateHooks on the slice
+				_, err = SigningKeys.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o SigningKeySlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return SigningKeys.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *SigningKey:
+				o.copyMatchingRows(retrieved)
+			case []*SigningKey:
+				o.copyMatchingRows(retrieved...)
+			case SigningKeySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a SigningKey or a slice of SigningKey
+				// then run the AfterDeleteHooks on the slice
+				_, err = SigningKeys.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o SigningKeySlice) UpdateAll(ctx context.Context, exec bob.Executor, vals SigningKeySetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := SigningKeys.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o SigningKeySlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := SigningKeys.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o SigningKeySlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := SigningKeys.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type signingKeyWhere[Q sqlite.Filterable] struct {
+	ID         sqlite.WhereMod[Q, string]
+	Algorithm  sqlite.WhereMod[Q, string]
+	PrivateKey sqlite.WhereMod[Q, []byte]
+	CreatedAt  sqlite.WhereMod[Q, time.Time]
+}
+
+func (signingKeyWhere[Q]) AliasedAs(alias string) signingKeyWhere[Q] {
+	return buildSigningKeyWhere[Q](buildSigningKeyColumns(alias))
+}
+
+func buildSigningKeyWhere[Q sqlite.Filterable](cols signingKeyColumns) signingKeyWhere[Q] {
+	return signingKeyWhere[Q]{
+		ID:         sqlite.Where[Q, string](cols.ID),
+		Algorithm:  sqlite.Where[Q, string](cols.Algorithm),
+		PrivateKey: sqlite.Where[Q, []byte](cols.PrivateKey),
+		CreatedAt:  sqlite.Where[Q, time.Time](cols.CreatedAt),
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/aarondl/opt/null"
 	"github.com/aarondl/opt/omit"
@@ -24,11 +25,20 @@ import (
 
 // User is an object representing the database table.
 type User struct {
-	ID               int32           `db:"id,pk" `
-	Username         string          `db:"username" `
-	Password         string          `db:"password" `
-	ProfilePictureID null.Val[int32] `db:"profile_picture_id" `
-	WebauthnID       string          `db:"webauthn_id" `
+	ID                    int32               `db:"id,pk" `
+	Username              string              `db:"username" `
+	Password              string              `db:"password" `
+	ProfilePictureID      null.Val[int32]     `db:"profile_picture_id" `
+	WebauthnID            string              `db:"webauthn_id" `
+	TokenVersion          int32               `db:"token_version" `
+	Role                  string              `db:"role" `
+	DisabledAt            null.Val[time.Time] `db:"disabled_at" `
+	PasswordResetRequired bool                `db:"password_reset_required" `
+	TotpSecret            null.Val[string]    `db:"totp_secret" `
+	TotpEnabled           bool                `db:"totp_enabled" `
+	TotpLastStep          int32               `db:"totp_last_step" `
+	Email                 null.Val[string]    `db:"email" `
+	EmailVerifiedAt       null.Val[time.Time] `db:"email_verified_at" `
 
 	R userR `db:"-" `
 }
@@ -45,34 +55,61 @@ type UsersQuery = *sqlite.ViewQuery[*User, UserSlice]
 
 // userR is where relationships are stored.
 type userR struct {
-	Credentials        CredentialSlice // fk_credential_0
-	Files              FileSlice       // fk_file_0
-	Items              ItemSlice       // fk_item_0
-	ProfilePictureFile *File           // fk_user_0
+	AccountExports     AccountExportSlice  // fk_account_export_0
+	APIKeys            APIKeySlice         // fk_api_key_0
+	Credentials        CredentialSlice     // fk_credential_0
+	EmailTokens        EmailTokenSlice     // fk_email_token_0
+	Files              FileSlice           // fk_file_0
+	Identities         IdentitySlice       // fk_identity_0
+	Items              ItemSlice           // fk_item_0
+	LoginChallenges    LoginChallengeSlice // fk_login_challenge_0
+	OauthCodes         OauthCodeSlice      // fk_oauth_code_0
+	OauthConsents      OauthConsentSlice   // fk_oauth_consent_0
+	RecoveryCodes      RecoveryCodeSlice   // fk_recovery_code_0
+	Sessions           SessionSlice        // fk_session_0
+	ProfilePictureFile *File               // fk_user_0
 }
 
 func buildUserColumns(alias string) userColumns {
 	return userColumns{
 		ColumnsExpr: expr.NewColumnsExpr(
-			"id", "username", "password", "profile_picture_id", "webauthn_id",
+			"id", "username", "password", "profile_picture_id", "webauthn_id", "token_version", "role", "disabled_at", "password_reset_required", "totp_secret", "totp_enabled", "totp_last_step", "email", "email_verified_at",
 		).WithParent("user"),
-		tableAlias:       alias,
-		ID:               sqlite.Quote(alias, "id"),
-		Username:         sqlite.Quote(alias, "username"),
-		Password:         sqlite.Quote(alias, "password"),
-		ProfilePictureID: sqlite.Quote(alias, "profile_picture_id"),
-		WebauthnID:       sqlite.Quote(alias, "webauthn_id"),
+		tableAlias:            alias,
+		ID:                    sqlite.Quote(alias, "id"),
+		Username:              sqlite.Quote(alias, "username"),
+		Password:              sqlite.Quote(alias, "password"),
+		ProfilePictureID:      sqlite.Quote(alias, "profile_picture_id"),
+		WebauthnID:            sqlite.Quote(alias, "webauthn_id"),
+		TokenVersion:          sqlite.Quote(alias, "token_version"),
+		Role:                  sqlite.Quote(alias, "role"),
+		DisabledAt:            sqlite.Quote(alias, "disabled_at"),
+		PasswordResetRequired: sqlite.Quote(alias, "password_reset_required"),
+		TotpSecret:            sqlite.Quote(alias, "totp_secret"),
+		TotpEnabled:           sqlite.Quote(alias, "totp_enabled"),
+		TotpLastStep:          sqlite.Quote(alias, "totp_last_step"),
+		Email:                 sqlite.Quote(alias, "email"),
+		EmailVerifiedAt:       sqlite.Quote(alias, "email_verified_at"),
 	}
 }
 
 type userColumns struct {
 	expr.ColumnsExpr
-	tableAlias       string
-	ID               sqlite.Expression
-	Username         sqlite.Expression
-	Password         sqlite.Expression
-	ProfilePictureID sqlite.Expression
-	WebauthnID       sqlite.Expression
+	tableAlias            string
+	ID                    sqlite.Expression
+	Username              sqlite.Expression
+	Password              sqlite.Expression
+	ProfilePictureID      sqlite.Expression
+	WebauthnID            sqlite.Expression
+	TokenVersion          sqlite.Expression
+	Role                  sqlite.Expression
+	DisabledAt            sqlite.Expression
+	PasswordResetRequired sqlite.Expression
+	TotpSecret            sqlite.Expression
+	TotpEnabled           sqlite.Expression
+	TotpLastStep          sqlite.Expression
+	Email                 sqlite.Expression
+	EmailVerifiedAt       sqlite.Expression
 }
 
 func (c userColumns) Alias() string {
@@ -87,15 +124,24 @@ func (userColumns) AliasedAs(alias string) userColumns {
 // All values are optional, and do not have to be set
 // Generated columns are not included
 type UserSetter struct {
-	ID               omit.Val[int32]     `db:"id,pk" `
-	Username         omit.Val[string]    `db:"username" `
-	Password         omit.Val[string]    `db:"password" `
-	ProfilePictureID omitnull.Val[int32] `db:"profile_picture_id" `
-	WebauthnID       omit.Val[string]    `db:"webauthn_id" `
+	ID                    omit.Val[int32]         `db:"id,pk" `
+	Username              omit.Val[string]        `db:"username" `
+	Password              omit.Val[string]        `db:"password" `
+	ProfilePictureID      omitnull.Val[int32]     `db:"profile_picture_id" `
+	WebauthnID            omit.Val[string]        `db:"webauthn_id" `
+	TokenVersion          omit.Val[int32]         `db:"token_version" `
+	Role                  omit.Val[string]        `db:"role" `
+	DisabledAt            omitnull.Val[time.Time] `db:"disabled_at" `
+	PasswordResetRequired omit.Val[bool]          `db:"password_reset_required" `
+	TotpSecret            omitnull.Val[string]    `db:"totp_secret" `
+	TotpEnabled           omit.Val[bool]          `db:"totp_enabled" `
+	TotpLastStep          omit.Val[int32]         `db:"totp_last_step" `
+	Email                 omitnull.Val[string]    `db:"email" `
+	EmailVerifiedAt       omitnull.Val[time.Time] `db:"email_verified_at" `
 }
 
 func (s UserSetter) SetColumns() []string {
-	vals := make([]string, 0, 5)
+	vals := make([]string, 0, 14)
 	if s.ID.IsValue() {
 		vals = append(vals, "id")
 	}
@@ -111,6 +157,33 @@ func (s UserSetter) SetColumns() []string {
 	if s.WebauthnID.IsValue() {
 		vals = append(vals, "webauthn_id")
 	}
+	if s.TokenVersion.IsValue() {
+		vals = append(vals, "token_version")
+	}
+	if s.Role.IsValue() {
+		vals = append(vals, "role")
+	}
+	if !s.DisabledAt.IsUnset() {
+		vals = append(vals, "disabled_at")
+	}
+	if s.PasswordResetRequired.IsValue() {
+		vals = append(vals, "password_reset_required")
+	}
+	if !s.TotpSecret.IsUnset() {
+		vals = append(vals, "totp_secret")
+	}
+	if s.TotpEnabled.IsValue() {
+		vals = append(vals, "totp_enabled")
+	}
+	if s.TotpLastStep.IsValue() {
+		vals = append(vals, "totp_last_step")
+	}
+	if !s.Email.IsUnset() {
+		vals = append(vals, "email")
+	}
+	if !s.EmailVerifiedAt.IsUnset() {
+		vals = append(vals, "email_verified_at")
+	}
 	return vals
 }
 
@@ -130,6 +203,33 @@ func (s UserSetter) Overwrite(t *User) {
 	if s.WebauthnID.IsValue() {
 		t.WebauthnID = s.WebauthnID.MustGet()
 	}
+	if s.TokenVersion.IsValue() {
+		t.TokenVersion = s.TokenVersion.MustGet()
+	}
+	if s.Role.IsValue() {
+		t.Role = s.Role.MustGet()
+	}
+	if !s.DisabledAt.IsUnset() {
+		t.DisabledAt = s.DisabledAt.MustGetNull()
+	}
+	if s.PasswordResetRequired.IsValue() {
+		t.PasswordResetRequired = s.PasswordResetRequired.MustGet()
+	}
+	if !s.TotpSecret.IsUnset() {
+		t.TotpSecret = s.TotpSecret.MustGetNull()
+	}
+	if s.TotpEnabled.IsValue() {
+		t.TotpEnabled = s.TotpEnabled.MustGet()
+	}
+	if s.TotpLastStep.IsValue() {
+		t.TotpLastStep = s.TotpLastStep.MustGet()
+	}
+	if !s.Email.IsUnset() {
+		t.Email = s.Email.MustGetNull()
+	}
+	if !s.EmailVerifiedAt.IsUnset() {
+		t.EmailVerifiedAt = s.EmailVerifiedAt.MustGetNull()
+	}
 }
 
 func (s *UserSetter) Apply(q *dialect.InsertQuery) {
@@ -146,7 +246,7 @@ func (s *UserSetter) Apply(q *dialect.InsertQuery) {
 	}
 
 	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
-		vals := make([]bob.Expression, 0, 5)
+		vals := make([]bob.Expression, 0, 14)
 		if s.ID.IsValue() {
 			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
 		}
@@ -167,6 +267,42 @@ func (s *UserSetter) Apply(q *dialect.InsertQuery) {
 			vals = append(vals, sqlite.Arg(s.WebauthnID.MustGet()))
 		}
 
+		if s.TokenVersion.IsValue() {
+			vals = append(vals, sqlite.Arg(s.TokenVersion.MustGet()))
+		}
+
+		if s.Role.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Role.MustGet()))
+		}
+
+		if !s.DisabledAt.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.DisabledAt.MustGetNull()))
+		}
+
+		if s.PasswordResetRequired.IsValue() {
+			vals = append(vals, sqlite.Arg(s.PasswordResetRequired.MustGet()))
+		}
+
+		if !s.TotpSecret.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.TotpSecret.MustGetNull()))
+		}
+
+		if s.TotpEnabled.IsValue() {
+			vals = append(vals, sqlite.Arg(s.TotpEnabled.MustGet()))
+		}
+
+		if s.TotpLastStep.IsValue() {
+			vals = append(vals, sqlite.Arg(s.TotpLastStep.MustGet()))
+		}
+
+		if !s.Email.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.Email.MustGetNull()))
+		}
+
+		if !s.EmailVerifiedAt.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.EmailVerifiedAt.MustGetNull()))
+		}
+
 		if len(vals) == 0 {
 			vals = append(vals, sqlite.Arg(nil))
 		}
@@ -180,7 +316,7 @@ func (s UserSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s UserSetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 5)
+	exprs := make([]bob.Expression, 0, 14)
 
 	if s.ID.IsValue() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -217,6 +353,69 @@ func (s UserSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if s.TokenVersion.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "token_version")...),
+			sqlite.Arg(s.TokenVersion),
+		}})
+	}
+
+	if s.Role.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "role")...),
+			sqlite.Arg(s.Role),
+		}})
+	}
+
+	if !s.DisabledAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "disabled_at")...),
+			sqlite.Arg(s.DisabledAt),
+		}})
+	}
+
+	if s.PasswordResetRequired.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "password_reset_required")...),
+			sqlite.Arg(s.PasswordResetRequired),
+		}})
+	}
+
+	if !s.TotpSecret.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "totp_secret")...),
+			sqlite.Arg(s.TotpSecret),
+		}})
+	}
+
+	if s.TotpEnabled.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "totp_enabled")...),
+			sqlite.Arg(s.TotpEnabled),
+		}})
+	}
+
+	if s.TotpLastStep.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "totp_last_step")...),
+			sqlite.Arg(s.TotpLastStep),
+		}})
+	}
+
+	if !s.Email.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "email")...),
+			sqlite.Arg(s.Email),
+		}})
+	}
+
+	if !s.EmailVerifiedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "email_verified_at")...),
+			sqlite.Arg(s.EmailVerifiedAt),
+		}})
+	}
+
 	return exprs
 }
 
@@ -443,6 +642,44 @@ func (o UserSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
 	return nil
 }
 
+// AccountExports starts a query for related objects on account_export
+func (o *User) AccountExports(mods ...bob.Mod[*dialect.SelectQuery]) AccountExportsQuery {
+	return AccountExports.Query(append(mods,
+		sm.Where(AccountExports.Columns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) AccountExports(mods ...bob.Mod[*dialect.SelectQuery]) AccountExportsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return AccountExports.Query(append(mods,
+		sm.Where(sqlite.Group(AccountExports.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// APIKeys starts a query for related objects on api_key
+func (o *User) APIKeys(mods ...bob.Mod[*dialect.SelectQuery]) APIKeysQuery {
+	return APIKeys.Query(append(mods,
+		sm.Where(APIKeys.Columns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) APIKeys(mods ...bob.Mod[*dialect.SelectQuery]) APIKeysQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return APIKeys.Query(append(mods,
+		sm.Where(sqlite.Group(APIKeys.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
 // Credentials starts a query for related objects on credential
 func (o *User) Credentials(mods ...bob.Mod[*dialect.SelectQuery]) CredentialsQuery {
 	return Credentials.Query(append(mods,
@@ -462,6 +699,25 @@ func (os UserSlice) Credentials(mods ...bob.Mod[*dialect.SelectQuery]) Credentia
 	)...)
 }
 
+// EmailTokens starts a query for related objects on email_token
+func (o *User) EmailTokens(mods ...bob.Mod[*dialect.SelectQuery]) EmailTokensQuery {
+	return EmailTokens.Query(append(mods,
+		sm.Where(EmailTokens.Columns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) EmailTokens(mods ...bob.Mod[*dialect.SelectQuery]) EmailTokensQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return EmailTokens.Query(append(mods,
+		sm.Where(sqlite.Group(EmailTokens.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
 // Files starts a query for related objects on file
 func (o *User) Files(mods ...bob.Mod[*dialect.SelectQuery]) FilesQuery {
 	return Files.Query(append(mods,
@@ -481,6 +737,25 @@ func (os UserSlice) Files(mods ...bob.Mod[*dialect.SelectQuery]) FilesQuery {
 	)...)
 }
 
+// Identities starts a query for related objects on identity
+func (o *User) Identities(mods ...bob.Mod[*dialect.SelectQuery]) IdentitiesQuery {
+	return Identities.Query(append(mods,
+		sm.Where(Identities.Columns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) Identities(mods ...bob.Mod[*dialect.SelectQuery]) IdentitiesQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Identities.Query(append(mods,
+		sm.Where(sqlite.Group(Identities.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
 // Items starts a query for related objects on item
 func (o *User) Items(mods ...bob.Mod[*dialect.SelectQuery]) ItemsQuery {
 	return Items.Query(append(mods,
@@ -500,6 +775,101 @@ func (os UserSlice) Items(mods ...bob.Mod[*dialect.SelectQuery]) ItemsQuery {
 	)...)
 }
 
+// LoginChallenges starts a query for related objects on login_challenge
+func (o *User) LoginChallenges(mods ...bob.Mod[*dialect.SelectQuery]) LoginChallengesQuery {
+	return LoginChallenges.Query(append(mods,
+		sm.Where(LoginChallenges.Columns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) LoginChallenges(mods ...bob.Mod[*dialect.SelectQuery]) LoginChallengesQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return LoginChallenges.Query(append(mods,
+		sm.Where(sqlite.Group(LoginChallenges.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// OauthCodes starts a query for related objects on oauth_code
+func (o *User) OauthCodes(mods ...bob.Mod[*dialect.SelectQuery]) OauthCodesQuery {
+	return OauthCodes.Query(append(mods,
+		sm.Where(OauthCodes.Columns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) OauthCodes(mods ...bob.Mod[*dialect.SelectQuery]) OauthCodesQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return OauthCodes.Query(append(mods,
+		sm.Where(sqlite.Group(OauthCodes.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// OauthConsents starts a query for related objects on oauth_consent
+func (o *User) OauthConsents(mods ...bob.Mod[*dialect.SelectQuery]) OauthConsentsQuery {
+	return OauthConsents.Query(append(mods,
+		sm.Where(OauthConsents.Columns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) OauthConsents(mods ...bob.Mod[*dialect.SelectQuery]) OauthConsentsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return OauthConsents.Query(append(mods,
+		sm.Where(sqlite.Group(OauthConsents.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// RecoveryCodes starts a query for related objects on recovery_code
+func (o *User) RecoveryCodes(mods ...bob.Mod[*dialect.SelectQuery]) RecoveryCodesQuery {
+	return RecoveryCodes.Query(append(mods,
+		sm.Where(RecoveryCodes.Columns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) RecoveryCodes(mods ...bob.Mod[*dialect.SelectQuery]) RecoveryCodesQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return RecoveryCodes.Query(append(mods,
+		sm.Where(sqlite.Group(RecoveryCodes.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Sessions starts a query for related objects on session
+func (o *User) Sessions(mods ...bob.Mod[*dialect.SelectQuery]) SessionsQuery {
+	return Sessions.Query(append(mods,
+		sm.Where(Sessions.Columns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) Sessions(mods ...bob.Mod[*dialect.SelectQuery]) SessionsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Sessions.Query(append(mods,
+		sm.Where(sqlite.Group(Sessions.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
 // ProfilePictureFile starts a query for related objects on file
 func (o *User) ProfilePictureFile(mods ...bob.Mod[*dialect.SelectQuery]) FilesQuery {
 	return Files.Query(append(mods,
@@ -519,66 +889,66 @@ func (os UserSlice) ProfilePictureFile(mods ...bob.Mod[*dialect.SelectQuery]) Fi
 	)...)
 }
 
-func insertUserCredentials0(ctx context.Context, exec bob.Executor, credentials1 []*CredentialSetter, user0 *User) (CredentialSlice, error) {
-	for i := range credentials1 {
-		credentials1[i].UserID = omit.From(user0.ID)
+func insertUserAccountExports0(ctx context.Context, exec bob.Executor, accountExports1 []*AccountExportSetter, user0 *User) (AccountExportSlice, error) {
+	for i := range accountExports1 {
+		accountExports1[i].UserID = omit.From(user0.ID)
 	}
 
-	ret, err := Credentials.Insert(bob.ToMods(credentials1...)).All(ctx, exec)
+	ret, err := AccountExports.Insert(bob.ToMods(accountExports1...)).All(ctx, exec)
 	if err != nil {
-		return ret, fmt.Errorf("insertUserCredentials0: %w", err)
+		return ret, fmt.Errorf("insertUserAccountExports0: %w", err)
 	}
 
 	return ret, nil
 }
 
-func attachUserCredentials0(ctx context.Context, exec bob.Executor, count int, credentials1 CredentialSlice, user0 *User) (CredentialSlice, error) {
-	setter := &CredentialSetter{
+func attachUserAccountExports0(ctx context.Context, exec bob.Executor, count int, accountExports1 AccountExportSlice, user0 *User) (AccountExportSlice, error) {
+	setter := &AccountExportSetter{
 		UserID: omit.From(user0.ID),
 	}
 
-	err := credentials1.UpdateAll(ctx, exec, *setter)
+	err := accountExports1.UpdateAll(ctx, exec, *setter)
 	if err != nil {
-		return nil, fmt.Errorf("attachUserCredentials0: %w", err)
+		return nil, fmt.Errorf("attachUserAccountExports0: %w", err)
 	}
 
-	return credentials1, nil
+	return accountExports1, nil
 }
 
-func (user0 *User) InsertCredentials(ctx context.Context, exec bob.Executor, related ...*CredentialSetter) error {
+func (user0 *User) InsertAccountExports(ctx context.Context, exec bob.Executor, related ...*AccountExportSetter) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 
-	credentials1, err := insertUserCredentials0(ctx, exec, related, user0)
+	accountExports1, err := insertUserAccountExports0(ctx, exec, related, user0)
 	if err != nil {
 		return err
 	}
 
-	user0.R.Credentials = append(user0.R.Credentials, credentials1...)
+	user0.R.AccountExports = append(user0.R.AccountExports, accountExports1...)
 
-	for _, rel := range credentials1 {
+	for _, rel := range accountExports1 {
 		rel.R.User = user0
 	}
 	return nil
 }
 
-func (user0 *User) AttachCredentials(ctx context.Context, exec bob.Executor, related ...*Credential) error {
+func (user0 *User) AttachAccountExports(ctx context.Context, exec bob.Executor, related ...*AccountExport) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
-	credentials1 := CredentialSlice(related)
+	accountExports1 := AccountExportSlice(related)
 
-	_, err = attachUserCredentials0(ctx, exec, len(related), credentials1, user0)
+	_, err = attachUserAccountExports0(ctx, exec, len(related), accountExports1, user0)
 	if err != nil {
 		return err
 	}
 
-	user0.R.Credentials = append(user0.R.Credentials, credentials1...)
+	user0.R.AccountExports = append(user0.R.AccountExports, accountExports1...)
 
 	for _, rel := range related {
 		rel.R.User = user0
@@ -587,66 +957,66 @@ func (user0 *User) AttachCredentials(ctx context.Context, exec bob.Executor, rel
 	return nil
 }
 
-func insertUserFiles0(ctx context.Context, exec bob.Executor, files1 []*FileSetter, user0 *User) (FileSlice, error) {
-	for i := range files1 {
-		files1[i].UserID = omit.From(user0.ID)
+func insertUserAPIKeys0(ctx context.Context, exec bob.Executor, apiKeys1 []*APIKeySetter, user0 *User) (APIKeySlice, error) {
+	for i := range apiKeys1 {
+		apiKeys1[i].UserID = omit.From(user0.ID)
 	}
 
-	ret, err := Files.Insert(bob.ToMods(files1...)).All(ctx, exec)
+	ret, err := APIKeys.Insert(bob.ToMods(apiKeys1...)).All(ctx, exec)
 	if err != nil {
-		return ret, fmt.Errorf("insertUserFiles0: %w", err)
+		return ret, fmt.Errorf("insertUserAPIKeys0: %w", err)
 	}
 
 	return ret, nil
 }
 
-func attachUserFiles0(ctx context.Context, exec bob.Executor, count int, files1 FileSlice, user0 *User) (FileSlice, error) {
-	setter := &FileSetter{
+func attachUserAPIKeys0(ctx context.Context, exec bob.Executor, count int, apiKeys1 APIKeySlice, user0 *User) (APIKeySlice, error) {
+	setter := &APIKeySetter{
 		UserID: omit.From(user0.ID),
 	}
 
-	err := files1.UpdateAll(ctx, exec, *setter)
+	err := apiKeys1.UpdateAll(ctx, exec, *setter)
 	if err != nil {
-		return nil, fmt.Errorf("attachUserFiles0: %w", err)
+		return nil, fmt.Errorf("attachUserAPIKeys0: %w", err)
 	}
 
-	return files1, nil
+	return apiKeys1, nil
 }
 
-func (user0 *User) InsertFiles(ctx context.Context, exec bob.Executor, related ...*FileSetter) error {
+func (user0 *User) InsertAPIKeys(ctx context.Context, exec bob.Executor, related ...*APIKeySetter) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 
-	files1, err := insertUserFiles0(ctx, exec, related, user0)
+	apiKeys1, err := insertUserAPIKeys0(ctx, exec, related, user0)
 	if err != nil {
 		return err
 	}
 
-	user0.R.Files = append(user0.R.Files, files1...)
+	user0.R.APIKeys = append(user0.R.APIKeys, apiKeys1...)
 
-	for _, rel := range files1 {
+	for _, rel := range apiKeys1 {
 		rel.R.User = user0
 	}
 	return nil
 }
 
-func (user0 *User) AttachFiles(ctx context.Context, exec bob.Executor, related ...*File) error {
+func (user0 *User) AttachAPIKeys(ctx context.Context, exec bob.Executor, related ...*APIKey) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
-	files1 := FileSlice(related)
+	apiKeys1 := APIKeySlice(related)
 
-	_, err = attachUserFiles0(ctx, exec, len(related), files1, user0)
+	_, err = attachUserAPIKeys0(ctx, exec, len(related), apiKeys1, user0)
 	if err != nil {
 		return err
 	}
 
-	user0.R.Files = append(user0.R.Files, files1...)
+	user0.R.APIKeys = append(user0.R.APIKeys, apiKeys1...)
 
 	for _, rel := range related {
 		rel.R.User = user0
@@ -655,66 +1025,66 @@ func (user0 *User) AttachFiles(ctx context.Context, exec bob.Executor, related .
 	return nil
 }
 
-func insertUserItems0(ctx context.Context, exec bob.Executor, items1 []*ItemSetter, user0 *User) (ItemSlice, error) {
-	for i := range items1 {
-		items1[i].UserID = omit.From(user0.ID)
+func insertUserCredentials0(ctx context.Context, exec bob.Executor, credentials1 []*CredentialSetter, user0 *User) (CredentialSlice, error) {
+	for i := range credentials1 {
+		credentials1[i].UserID = omit.From(user0.ID)
 	}
 
-	ret, err := Items.Insert(bob.ToMods(items1...)).All(ctx, exec)
+	ret, err := Credentials.Insert(bob.ToMods(credentials1...)).All(ctx, exec)
 	if err != nil {
-		return ret, fmt.Errorf("insertUserItems0: %w", err)
+		return ret, fmt.Errorf("insertUserCredentials0: %w", err)
 	}
 
 	return ret, nil
 }
 
-func attachUserItems0(ctx context.Context, exec bob.Executor, count int, items1 ItemSlice, user0 *User) (ItemSlice, error) {
-	setter := &ItemSetter{
+func attachUserCredentials0(ctx context.Context, exec bob.Executor, count int, credentials1 CredentialSlice, user0 *User) (CredentialSlice, error) {
+	setter := &CredentialSetter{
 		UserID: omit.From(user0.ID),
 	}
 
-	err := items1.UpdateAll(ctx, exec, *setter)
+	err := credentials1.UpdateAll(ctx, exec, *setter)
 	if err != nil {
-		return nil, fmt.Errorf("attachUserItems0: %w", err)
+		return nil, fmt.Errorf("attachUserCredentials0: %w", err)
 	}
 
-	return items1, nil
+	return credentials1, nil
 }
 
-func (user0 *User) InsertItems(ctx context.Context, exec bob.Executor, related ...*ItemSetter) error {
+func (user0 *User) InsertCredentials(ctx context.Context, exec bob.Executor, related ...*CredentialSetter) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 
-	items1, err := insertUserItems0(ctx, exec, related, user0)
+	credentials1, err := insertUserCredentials0(ctx, exec, related, user0)
 	if err != nil {
 		return err
 	}
 
-	user0.R.Items = append(user0.R.Items, items1...)
+	user0.R.Credentials = append(user0.R.Credentials, credentials1...)
 
-	for _, rel := range items1 {
+	for _, rel := range credentials1 {
 		rel.R.User = user0
 	}
 	return nil
 }
 
-func (user0 *User) AttachItems(ctx context.Context, exec bob.Executor, related ...*Item) error {
+func (user0 *User) AttachCredentials(ctx context.Context, exec bob.Executor, related ...*Credential) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
-	items1 := ItemSlice(related)
+	credentials1 := CredentialSlice(related)
 
-	_, err = attachUserItems0(ctx, exec, len(related), items1, user0)
+	_, err = attachUserCredentials0(ctx, exec, len(related), credentials1, user0)
 	if err != nil {
 		return err
 	}
 
-	user0.R.Items = append(user0.R.Items, items1...)
+	user0.R.Credentials = append(user0.R.Credentials, credentials1...)
 
 	for _, rel := range related {
 		rel.R.User = user0
@@ -723,220 +1093,1615 @@ func (user0 *User) AttachItems(ctx context.Context, exec bob.Executor, related .
 	return nil
 }
 
-func attachUserProfilePictureFile0(ctx context.Context, exec bob.Executor, count int, user0 *User, file1 *File) (*User, error) {
-	setter := &UserSetter{
-		ProfilePictureID: omitnull.From(file1.ID),
+func insertUserEmailTokens0(ctx context.Context, exec bob.Executor, emailTokens1 []*EmailTokenSetter, user0 *User) (EmailTokenSlice, error) {
+	for i := range emailTokens1 {
+		emailTokens1[i].UserID = omit.From(user0.ID)
 	}
 
-	err := user0.Update(ctx, exec, setter)
+	ret, err := EmailTokens.Insert(bob.ToMods(emailTokens1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserEmailTokens0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserEmailTokens0(ctx context.Context, exec bob.Executor, count int, emailTokens1 EmailTokenSlice, user0 *User) (EmailTokenSlice, error) {
+	setter := &EmailTokenSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := emailTokens1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserEmailTokens0: %w", err)
+	}
+
+	return emailTokens1, nil
+}
+
+func (user0 *User) InsertEmailTokens(ctx context.Context, exec bob.Executor, related ...*EmailTokenSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	emailTokens1, err := insertUserEmailTokens0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.EmailTokens = append(user0.R.EmailTokens, emailTokens1...)
+
+	for _, rel := range emailTokens1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachEmailTokens(ctx context.Context, exec bob.Executor, related ...*EmailToken) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	emailTokens1 := EmailTokenSlice(related)
+
+	_, err = attachUserEmailTokens0(ctx, exec, len(related), emailTokens1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.EmailTokens = append(user0.R.EmailTokens, emailTokens1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserFiles0(ctx context.Context, exec bob.Executor, files1 []*FileSetter, user0 *User) (FileSlice, error) {
+	for i := range files1 {
+		files1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := Files.Insert(bob.ToMods(files1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserFiles0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserFiles0(ctx context.Context, exec bob.Executor, count int, files1 FileSlice, user0 *User) (FileSlice, error) {
+	setter := &FileSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := files1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserFiles0: %w", err)
+	}
+
+	return files1, nil
+}
+
+func (user0 *User) InsertFiles(ctx context.Context, exec bob.Executor, related ...*FileSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	files1, err := insertUserFiles0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Files = append(user0.R.Files, files1...)
+
+	for _, rel := range files1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachFiles(ctx context.Context, exec bob.Executor, related ...*File) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	files1 := FileSlice(related)
+
+	_, err = attachUserFiles0(ctx, exec, len(related), files1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Files = append(user0.R.Files, files1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserIdentities0(ctx context.Context, exec bob.Executor, identities1 []*IdentitySetter, user0 *User) (IdentitySlice, error) {
+	for i := range identities1 {
+		identities1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := Identities.Insert(bob.ToMods(identities1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserIdentities0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserIdentities0(ctx context.Context, exec bob.Executor, count int, identities1 IdentitySlice, user0 *User) (IdentitySlice, error) {
+	setter := &IdentitySetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := identities1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserIdentities0: %w", err)
+	}
+
+	return identities1, nil
+}
+
+func (user0 *User) InsertIdentities(ctx context.Context, exec bob.Executor, related ...*IdentitySetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	identities1, err := insertUserIdentities0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Identities = append(user0.R.Identities, identities1...)
+
+	for _, rel := range identities1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachIdentities(ctx context.Context, exec bob.Executor, related ...*Identity) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	identities1 := IdentitySlice(related)
+
+	_, err = attachUserIdentities0(ctx, exec, len(related), identities1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Identities = append(user0.R.Identities, identities1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserItems0(ctx context.Context, exec bob.Executor, items1 []*ItemSetter, user0 *User) (ItemSlice, error) {
+	for i := range items1 {
+		items1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := Items.Insert(bob.ToMods(items1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserItems0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserItems0(ctx context.Context, exec bob.Executor, count int, items1 ItemSlice, user0 *User) (ItemSlice, error) {
+	setter := &ItemSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := items1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserItems0: %w", err)
+	}
+
+	return items1, nil
+}
+
+func (user0 *User) InsertItems(ctx context.Context, exec bob.Executor, related ...*ItemSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	items1, err := insertUserItems0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Items = append(user0.R.Items, items1...)
+
+	for _, rel := range items1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachItems(ctx context.Context, exec bob.Executor, related ...*Item) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	items1 := ItemSlice(related)
+
+	_, err = attachUserItems0(ctx, exec, len(related), items1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Items = append(user0.R.Items, items1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserLoginChallenges0(ctx context.Context, exec bob.Executor, loginChallenges1 []*LoginChallengeSetter, user0 *User) (LoginChallengeSlice, error) {
+	for i := range loginChallenges1 {
+		loginChallenges1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := LoginChallenges.Insert(bob.ToMods(loginChallenges1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserLoginChallenges0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserLoginChallenges0(ctx context.Context, exec bob.Executor, count int, loginChallenges1 LoginChallengeSlice, user0 *User) (LoginChallengeSlice, error) {
+	setter := &LoginChallengeSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := loginChallenges1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserLoginChallenges0: %w", err)
+	}
+
+	return loginChallenges1, nil
+}
+
+func (user0 *User) InsertLoginChallenges(ctx context.Context, exec bob.Executor, related ...*LoginChallengeSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	loginChallenges1, err := insertUserLoginChallenges0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.LoginChallenges = append(user0.R.LoginChallenges, loginChallenges1...)
+
+	for _, rel := range loginChallenges1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachLoginChallenges(ctx context.Context, exec bob.Executor, related ...*LoginChallenge) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	loginChallenges1 := LoginChallengeSlice(related)
+
+	_, err = attachUserLoginChallenges0(ctx, exec, len(related), loginChallenges1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.LoginChallenges = append(user0.R.LoginChallenges, loginChallenges1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserOauthCodes0(ctx context.Context, exec bob.Executor, oauthCodes1 []*OauthCodeSetter, user0 *User) (OauthCodeSlice, error) {
+	for i := range oauthCodes1 {
+		oauthCodes1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := OauthCodes.Insert(bob.ToMods(oauthCodes1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserOauthCodes0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserOauthCodes0(ctx context.Context, exec bob.Executor, count int, oauthCodes1 OauthCodeSlice, user0 *User) (OauthCodeSlice, error) {
+	setter := &OauthCodeSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := oauthCodes1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserOauthCodes0: %w", err)
+	}
+
+	return oauthCodes1, nil
+}
+
+func (user0 *User) InsertOauthCodes(ctx context.Context, exec bob.Executor, related ...*OauthCodeSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	oauthCodes1, err := insertUserOauthCodes0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.OauthCodes = append(user0.R.OauthCodes, oauthCodes1...)
+
+	for _, rel := range oauthCodes1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachOauthCodes(ctx context.Context, exec bob.Executor, related ...*OauthCode) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	oauthCodes1 := OauthCodeSlice(related)
+
+	_, err = attachUserOauthCodes0(ctx, exec, len(related), oauthCodes1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.OauthCodes = append(user0.R.OauthCodes, oauthCodes1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserOauthConsents0(ctx context.Context, exec bob.Executor, oauthConsents1 []*OauthConsentSetter, user0 *User) (OauthConsentSlice, error) {
+	for i := range oauthConsents1 {
+		oauthConsents1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := OauthConsents.Insert(bob.ToMods(oauthConsents1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserOauthConsents0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserOauthConsents0(ctx context.Context, exec bob.Executor, count int, oauthConsents1 OauthConsentSlice, user0 *User) (OauthConsentSlice, error) {
+	setter := &OauthConsentSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := oauthConsents1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserOauthConsents0: %w", err)
+	}
+
+	return oauthConsents1, nil
+}
+
+func (user0 *User) InsertOauthConsents(ctx context.Context, exec bob.Executor, related ...*OauthConsentSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	oauthConsents1, err := insertUserOauthConsents0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.OauthConsents = append(user0.R.OauthConsents, oauthConsents1...)
+
+	for _, rel := range oauthConsents1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachOauthConsents(ctx context.Context, exec bob.Executor, related ...*OauthConsent) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	oauthConsents1 := OauthConsentSlice(related)
+
+	_, err = attachUserOauthConsents0(ctx, exec, len(related), oauthConsents1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.OauthConsents = append(user0.R.OauthConsents, oauthConsents1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserRecoveryCodes0(ctx context.Context, exec bob.Executor, recoveryCodes1 []*RecoveryCodeSetter, user0 *User) (RecoveryCodeSlice, error) {
+	for i := range recoveryCodes1 {
+		recoveryCodes1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := RecoveryCodes.Insert(bob.ToMods(recoveryCodes1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserRecoveryCodes0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserRecoveryCodes0(ctx context.Context, exec bob.Executor, count int, recoveryCodes1 RecoveryCodeSlice, user0 *User) (RecoveryCodeSlice, error) {
+	setter := &RecoveryCodeSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := recoveryCodes1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserRecoveryCodes0: %w", err)
+	}
+
+	return recoveryCodes1, nil
+}
+
+func (user0 *User) InsertRecoveryCodes(ctx context.Context, exec bob.Executor, related ...*RecoveryCodeSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	recoveryCodes1, err := insertUserRecoveryCodes0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.RecoveryCodes = append(user0.R.RecoveryCodes, recoveryCodes1...)
+
+	for _, rel := range recoveryCodes1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachRecoveryCodes(ctx context.Context, exec bob.Executor, related ...*RecoveryCode) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	recoveryCodes1 := RecoveryCodeSlice(related)
+
+	_, err = attachUserRecoveryCodes0(ctx, exec, len(related), recoveryCodes1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.RecoveryCodes = append(user0.R.RecoveryCodes, recoveryCodes1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserSessions0(ctx context.Context, exec bob.Executor, sessions1 []*SessionSetter, user0 *User) (SessionSlice, error) {
+	for i := range sessions1 {
+		sessions1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := Sessions.Insert(bob.ToMods(sessions1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserSessions0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserSessions0(ctx context.Context, exec bob.Executor, count int, sessions1 SessionSlice, user0 *User) (SessionSlice, error) {
+	setter := &SessionSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := sessions1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserSessions0: %w", err)
+	}
+
+	return sessions1, nil
+}
+
+func (user0 *User) InsertSessions(ctx context.Context, exec bob.Executor, related ...*SessionSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	sessions1, err := insertUserSessions0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Sessions = append(user0.R.Sessions, sessions1...)
+
+	for _, rel := range sessions1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachSessions(ctx context.Context, exec bob.Executor, related ...*Session) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	sessions1 := SessionSlice(related)
+
+	_, err = attachUserSessions0(ctx, exec, len(related), sessions1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Sessions = append(user0.R.Sessions, sessions1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func attachUserProfilePictureFile0(ctx context.Context, exec bob.Executor, count int, user0 *User, file1 *File) (*User, error) {
+	setter := &UserSetter{
+		ProfilePictureID: omitnull.From(file1.ID),
+	}
+
+	err := user0.Update(ctx, exec, setter)
 	if err != nil {
 		return nil, fmt.Errorf("attachUserProfilePictureFile0: %w", err)
 	}
 
-	return user0, nil
+	return user0, nil
+}
+
+func (user0 *User) InsertProfilePictureFile(ctx context.Context, exec bob.Executor, related *FileSetter) error {
+	file1, err := Files.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachUserProfilePictureFile0(ctx, exec, 1, user0, file1)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ProfilePictureFile = file1
+
+	file1.R.ProfilePictureUsers = append(file1.R.ProfilePictureUsers, user0)
+
+	return nil
+}
+
+func (user0 *User) AttachProfilePictureFile(ctx context.Context, exec bob.Executor, file1 *File) error {
+	var err error
+
+	_, err = attachUserProfilePictureFile0(ctx, exec, 1, user0, file1)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ProfilePictureFile = file1
+
+	file1.R.ProfilePictureUsers = append(file1.R.ProfilePictureUsers, user0)
+
+	return nil
+}
+
+type userWhere[Q sqlite.Filterable] struct {
+	ID                    sqlite.WhereMod[Q, int32]
+	Username              sqlite.WhereMod[Q, string]
+	Password              sqlite.WhereMod[Q, string]
+	ProfilePictureID      sqlite.WhereNullMod[Q, int32]
+	WebauthnID            sqlite.WhereMod[Q, string]
+	TokenVersion          sqlite.WhereMod[Q, int32]
+	Role                  sqlite.WhereMod[Q, string]
+	DisabledAt            sqlite.WhereNullMod[Q, time.Time]
+	PasswordResetRequired sqlite.WhereMod[Q, bool]
+	TotpSecret            sqlite.WhereNullMod[Q, string]
+	TotpEnabled           sqlite.WhereMod[Q, bool]
+	TotpLastStep          sqlite.WhereMod[Q, int32]
+	Email                 sqlite.WhereNullMod[Q, string]
+	EmailVerifiedAt       sqlite.WhereNullMod[Q, time.Time]
+}
+
+func (userWhere[Q]) AliasedAs(alias string) userWhere[Q] {
+	return buildUserWhere[Q](buildUserColumns(alias))
+}
+
+func buildUserWhere[Q sqlite.Filterable](cols userColumns) userWhere[Q] {
+	return userWhere[Q]{
+		ID:                    sqlite.Where[Q, int32](cols.ID),
+		Username:              sqlite.Where[Q, string](cols.Username),
+		Password:              sqlite.Where[Q, string](cols.Password),
+		ProfilePictureID:      sqlite.WhereNull[Q, int32](cols.ProfilePictureID),
+		WebauthnID:            sqlite.Where[Q, string](cols.WebauthnID),
+		TokenVersion:          sqlite.Where[Q, int32](cols.TokenVersion),
+		Role:                  sqlite.Where[Q, string](cols.Role),
+		DisabledAt:            sqlite.WhereNull[Q, time.Time](cols.DisabledAt),
+		PasswordResetRequired: sqlite.Where[Q, bool](cols.PasswordResetRequired),
+		TotpSecret:            sqlite.WhereNull[Q, string](cols.TotpSecret),
+		TotpEnabled:           sqlite.Where[Q, bool](cols.TotpEnabled),
+		TotpLastStep:          sqlite.Where[Q, int32](cols.TotpLastStep),
+		Email:                 sqlite.WhereNull[Q, string](cols.Email),
+		EmailVerifiedAt:       sqlite.WhereNull[Q, time.Time](cols.EmailVerifiedAt),
+	}
+}
+
+func (o *User) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "AccountExports":
+		rels, ok := retrieved.(AccountExportSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.AccountExports = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "APIKeys":
+		rels, ok := retrieved.(APIKeySlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.APIKeys = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "Credentials":
+		rels, ok := retrieved.(CredentialSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Credentials = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "EmailTokens":
+		rels, ok := retrieved.(EmailTokenSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.EmailTokens = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "Files":
+		rels, ok := retrieved.(FileSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Files = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "Identities":
+		rels, ok := retrieved.(IdentitySlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Identities = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "Items":
+		rels, ok := retrieved.(ItemSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Items = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "LoginChallenges":
+		rels, ok := retrieved.(LoginChallengeSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.LoginChallenges = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "OauthCodes":
+		rels, ok := retrieved.(OauthCodeSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.OauthCodes = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "OauthConsents":
+		rels, ok := retrieved.(OauthConsentSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.OauthConsents = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "RecoveryCodes":
+		rels, ok := retrieved.(RecoveryCodeSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.RecoveryCodes = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "Sessions":
+		rels, ok := retrieved.(SessionSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Sessions = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "ProfilePictureFile":
+		rel, ok := retrieved.(*File)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ProfilePictureFile = rel
+
+		if rel != nil {
+			rel.R.ProfilePictureUsers = UserSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("user has no relationship %q", name)
+	}
+}
+
+type userPreloader struct {
+	ProfilePictureFile func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildUserPreloader() userPreloader {
+	return userPreloader{
+		ProfilePictureFile: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*File, FileSlice](sqlite.PreloadRel{
+				Name: "ProfilePictureFile",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        Users,
+						To:          Files,
+						FromColumns: []string{"profile_picture_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Files.Columns.Names(), opts...)
+		},
+	}
+}
+
+type userThenLoader[Q orm.Loadable] struct {
+	AccountExports     func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	APIKeys            func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Credentials        func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	EmailTokens        func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Files              func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Identities         func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Items              func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	LoginChallenges    func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	OauthCodes         func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	OauthConsents      func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	RecoveryCodes      func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Sessions           func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ProfilePictureFile func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildUserThenLoader[Q orm.Loadable]() userThenLoader[Q] {
+	type AccountExportsLoadInterface interface {
+		LoadAccountExports(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type APIKeysLoadInterface interface {
+		LoadAPIKeys(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type CredentialsLoadInterface interface {
+		LoadCredentials(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type EmailTokensLoadInterface interface {
+		LoadEmailTokens(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type FilesLoadInterface interface {
+		LoadFiles(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type IdentitiesLoadInterface interface {
+		LoadIdentities(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ItemsLoadInterface interface {
+		LoadItems(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type LoginChallengesLoadInterface interface {
+		LoadLoginChallenges(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type OauthCodesLoadInterface interface {
+		LoadOauthCodes(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type OauthConsentsLoadInterface interface {
+		LoadOauthConsents(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type RecoveryCodesLoadInterface interface {
+		LoadRecoveryCodes(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type SessionsLoadInterface interface {
+		LoadSessions(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ProfilePictureFileLoadInterface interface {
+		LoadProfilePictureFile(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return userThenLoader[Q]{
+		AccountExports: thenLoadBuilder[Q](
+			"AccountExports",
+			func(ctx context.Context, exec bob.Executor, retrieved AccountExportsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadAccountExports(ctx, exec, mods...)
+			},
+		),
+		APIKeys: thenLoadBuilder[Q](
+			"APIKeys",
+			func(ctx context.Context, exec bob.Executor, retrieved APIKeysLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadAPIKeys(ctx, exec, mods...)
+			},
+		),
+		Credentials: thenLoadBuilder[Q](
+			"Credentials",
+			func(ctx context.Context, exec bob.Executor, retrieved CredentialsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadCredentials(ctx, exec, mods...)
+			},
+		),
+		EmailTokens: thenLoadBuilder[Q](
+			"EmailTokens",
+			func(ctx context.Context, exec bob.Executor, retrieved EmailTokensLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadEmailTokens(ctx, exec, mods...)
+			},
+		),
+		Files: thenLoadBuilder[Q](
+			"Files",
+			func(ctx context.Context, exec bob.Executor, retrieved FilesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadFiles(ctx, exec, mods...)
+			},
+		),
+		Identities: thenLoadBuilder[Q](
+			"Identities",
+			func(ctx context.Context, exec bob.Executor, retrieved IdentitiesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadIdentities(ctx, exec, mods...)
+			},
+		),
+		Items: thenLoadBuilder[Q](
+			"Items",
+			func(ctx context.Context, exec bob.Executor, retrieved ItemsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadItems(ctx, exec, mods...)
+			},
+		),
+		LoginChallenges: thenLoadBuilder[Q](
+			"LoginChallenges",
+			func(ctx context.Context, exec bob.Executor, retrieved LoginChallengesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadLoginChallenges(ctx, exec, mods...)
+			},
+		),
+		OauthCodes: thenLoadBuilder[Q](
+			"OauthCodes",
+			func(ctx context.Context, exec bob.Executor, retrieved OauthCodesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadOauthCodes(ctx, exec, mods...)
+			},
+		),
+		OauthConsents: thenLoadBuilder[Q](
+			"OauthConsents",
+			func(ctx context.Context, exec bob.Executor, retrieved OauthConsentsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadOauthConsents(ctx, exec, mods...)
+			},
+		),
+		RecoveryCodes: thenLoadBuilder[Q](
+			"RecoveryCodes",
+			func(ctx context.Context, exec bob.Executor, retrieved RecoveryCodesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadRecoveryCodes(ctx, exec, mods...)
+			},
+		),
+		Sessions: thenLoadBuilder[Q](
+			"Sessions",
+			func(ctx context.Context, exec bob.Executor, retrieved SessionsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadSessions(ctx, exec, mods...)
+			},
+		),
+		ProfilePictureFile: thenLoadBuilder[Q](
+			"ProfilePictureFile",
+			func(ctx context.Context, exec bob.Executor, retrieved ProfilePictureFileLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadProfilePictureFile(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadAccountExports loads the user's AccountExports into the .R struct
+func (o *User) LoadAccountExports(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.AccountExports = nil
+
+	related, err := o.AccountExports(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.AccountExports = related
+	return nil
+}
+
+// LoadAccountExports loads the user's AccountExports into the .R struct
+func (os UserSlice) LoadAccountExports(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	accountExports, err := os.AccountExports(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.AccountExports = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range accountExports {
+
+			if o.ID != rel.UserID {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.AccountExports = append(o.R.AccountExports, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadAPIKeys loads the user's APIKeys into the .R struct
+func (o *User) LoadAPIKeys(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.APIKeys = nil
+
+	related, err := o.APIKeys(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.APIKeys = related
+	return nil
+}
+
+// LoadAPIKeys loads the user's APIKeys into the .R struct
+func (os UserSlice) LoadAPIKeys(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	apiKeys, err := os.APIKeys(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.APIKeys = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range apiKeys {
+
+			if o.ID != rel.UserID {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.APIKeys = append(o.R.APIKeys, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadCredentials loads the user's Credentials into the .R struct
+func (o *User) LoadCredentials(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Credentials = nil
+
+	related, err := o.Credentials(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.Credentials = related
+	return nil
+}
+
+// LoadCredentials loads the user's Credentials into the .R struct
+func (os UserSlice) LoadCredentials(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	credentials, err := os.Credentials(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Credentials = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range credentials {
+
+			if o.ID != rel.UserID {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.Credentials = append(o.R.Credentials, rel)
+		}
+	}
+
+	return nil
 }
 
-func (user0 *User) InsertProfilePictureFile(ctx context.Context, exec bob.Executor, related *FileSetter) error {
-	file1, err := Files.Insert(related).One(ctx, exec)
+// LoadEmailTokens loads the user's EmailTokens into the .R struct
+func (o *User) LoadEmailTokens(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.EmailTokens = nil
+
+	related, err := o.EmailTokens(mods...).All(ctx, exec)
 	if err != nil {
-		return fmt.Errorf("inserting related objects: %w", err)
+		return err
 	}
 
-	_, err = attachUserProfilePictureFile0(ctx, exec, 1, user0, file1)
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.EmailTokens = related
+	return nil
+}
+
+// LoadEmailTokens loads the user's EmailTokens into the .R struct
+func (os UserSlice) LoadEmailTokens(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	emailTokens, err := os.EmailTokens(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
 
-	user0.R.ProfilePictureFile = file1
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
 
-	file1.R.ProfilePictureUsers = append(file1.R.ProfilePictureUsers, user0)
+		o.R.EmailTokens = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range emailTokens {
+
+			if o.ID != rel.UserID {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.EmailTokens = append(o.R.EmailTokens, rel)
+		}
+	}
 
 	return nil
 }
 
-func (user0 *User) AttachProfilePictureFile(ctx context.Context, exec bob.Executor, file1 *File) error {
-	var err error
+// LoadFiles loads the user's Files into the .R struct
+func (o *User) LoadFiles(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
 
-	_, err = attachUserProfilePictureFile0(ctx, exec, 1, user0, file1)
+	// Reset the relationship
+	o.R.Files = nil
+
+	related, err := o.Files(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
 
-	user0.R.ProfilePictureFile = file1
-
-	file1.R.ProfilePictureUsers = append(file1.R.ProfilePictureUsers, user0)
+	for _, rel := range related {
+		rel.R.User = o
+	}
 
+	o.R.Files = related
 	return nil
 }
 
-type userWhere[Q sqlite.Filterable] struct {
-	ID               sqlite.WhereMod[Q, int32]
-	Username         sqlite.WhereMod[Q, string]
-	Password         sqlite.WhereMod[Q, string]
-	ProfilePictureID sqlite.WhereNullMod[Q, int32]
-	WebauthnID       sqlite.WhereMod[Q, string]
+// LoadFiles loads the user's Files into the .R struct
+func (os UserSlice) LoadFiles(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	files, err := os.Files(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Files = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range files {
+
+			if o.ID != rel.UserID {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.Files = append(o.R.Files, rel)
+		}
+	}
+
+	return nil
 }
 
-func (userWhere[Q]) AliasedAs(alias string) userWhere[Q] {
-	return buildUserWhere[Q](buildUserColumns(alias))
+// LoadIdentities loads the user's Identities into the .R struct
+func (o *User) LoadIdentities(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Identities = nil
+
+	related, err := o.Identities(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.Identities = related
+	return nil
 }
 
-func buildUserWhere[Q sqlite.Filterable](cols userColumns) userWhere[Q] {
-	return userWhere[Q]{
-		ID:               sqlite.Where[Q, int32](cols.ID),
-		Username:         sqlite.Where[Q, string](cols.Username),
-		Password:         sqlite.Where[Q, string](cols.Password),
-		ProfilePictureID: sqlite.WhereNull[Q, int32](cols.ProfilePictureID),
-		WebauthnID:       sqlite.Where[Q, string](cols.WebauthnID),
+// LoadIdentities loads the user's Identities into the .R struct
+func (os UserSlice) LoadIdentities(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	identities, err := os.Identities(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Identities = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range identities {
+
+			if o.ID != rel.UserID {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.Identities = append(o.R.Identities, rel)
+		}
 	}
+
+	return nil
 }
 
-func (o *User) Preload(name string, retrieved any) error {
+// LoadItems loads the user's Items into the .R struct
+func (o *User) LoadItems(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
 		return nil
 	}
 
-	switch name {
-	case "Credentials":
-		rels, ok := retrieved.(CredentialSlice)
-		if !ok {
-			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+	// Reset the relationship
+	o.R.Items = nil
+
+	related, err := o.Items(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.Items = related
+	return nil
+}
+
+// LoadItems loads the user's Items into the .R struct
+func (os UserSlice) LoadItems(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	items, err := os.Items(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
 		}
 
-		o.R.Credentials = rels
+		o.R.Items = nil
+	}
 
-		for _, rel := range rels {
-			if rel != nil {
-				rel.R.User = o
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range items {
+
+			if o.ID != rel.UserID {
+				continue
 			}
+
+			rel.R.User = o
+
+			o.R.Items = append(o.R.Items, rel)
 		}
+	}
+
+	return nil
+}
+
+// LoadLoginChallenges loads the user's LoginChallenges into the .R struct
+func (o *User) LoadLoginChallenges(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
 		return nil
-	case "Files":
-		rels, ok := retrieved.(FileSlice)
-		if !ok {
-			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+	}
+
+	// Reset the relationship
+	o.R.LoginChallenges = nil
+
+	related, err := o.LoginChallenges(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.LoginChallenges = related
+	return nil
+}
+
+// LoadLoginChallenges loads the user's LoginChallenges into the .R struct
+func (os UserSlice) LoadLoginChallenges(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	loginChallenges, err := os.LoginChallenges(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.LoginChallenges = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
 		}
 
-		o.R.Files = rels
+		for _, rel := range loginChallenges {
 
-		for _, rel := range rels {
-			if rel != nil {
-				rel.R.User = o
+			if o.ID != rel.UserID {
+				continue
 			}
-		}
-		return nil
-	case "Items":
-		rels, ok := retrieved.(ItemSlice)
-		if !ok {
-			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
-		}
 
-		o.R.Items = rels
+			rel.R.User = o
 
-		for _, rel := range rels {
-			if rel != nil {
-				rel.R.User = o
-			}
-		}
-		return nil
-	case "ProfilePictureFile":
-		rel, ok := retrieved.(*File)
-		if !ok {
-			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+			o.R.LoginChallenges = append(o.R.LoginChallenges, rel)
 		}
+	}
 
-		o.R.ProfilePictureFile = rel
+	return nil
+}
 
-		if rel != nil {
-			rel.R.ProfilePictureUsers = UserSlice{o}
-		}
+// LoadOauthCodes loads the user's OauthCodes into the .R struct
+func (o *User) LoadOauthCodes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
 		return nil
-	default:
-		return fmt.Errorf("user has no relationship %q", name)
 	}
-}
 
-type userPreloader struct {
-	ProfilePictureFile func(...sqlite.PreloadOption) sqlite.Preloader
-}
+	// Reset the relationship
+	o.R.OauthCodes = nil
 
-func buildUserPreloader() userPreloader {
-	return userPreloader{
-		ProfilePictureFile: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
-			return sqlite.Preload[*File, FileSlice](sqlite.PreloadRel{
-				Name: "ProfilePictureFile",
-				Sides: []sqlite.PreloadSide{
-					{
-						From:        Users,
-						To:          Files,
-						FromColumns: []string{"profile_picture_id"},
-						ToColumns:   []string{"id"},
-					},
-				},
-			}, Files.Columns.Names(), opts...)
-		},
+	related, err := o.OauthCodes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
 	}
-}
 
-type userThenLoader[Q orm.Loadable] struct {
-	Credentials        func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
-	Files              func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
-	Items              func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
-	ProfilePictureFile func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.OauthCodes = related
+	return nil
 }
 
-func buildUserThenLoader[Q orm.Loadable]() userThenLoader[Q] {
-	type CredentialsLoadInterface interface {
-		LoadCredentials(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
-	}
-	type FilesLoadInterface interface {
-		LoadFiles(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+// LoadOauthCodes loads the user's OauthCodes into the .R struct
+func (os UserSlice) LoadOauthCodes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
 	}
-	type ItemsLoadInterface interface {
-		LoadItems(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+
+	oauthCodes, err := os.OauthCodes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
 	}
-	type ProfilePictureFileLoadInterface interface {
-		LoadProfilePictureFile(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.OauthCodes = nil
 	}
 
-	return userThenLoader[Q]{
-		Credentials: thenLoadBuilder[Q](
-			"Credentials",
-			func(ctx context.Context, exec bob.Executor, retrieved CredentialsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
-				return retrieved.LoadCredentials(ctx, exec, mods...)
-			},
-		),
-		Files: thenLoadBuilder[Q](
-			"Files",
-			func(ctx context.Context, exec bob.Executor, retrieved FilesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
-				return retrieved.LoadFiles(ctx, exec, mods...)
-			},
-		),
-		Items: thenLoadBuilder[Q](
-			"Items",
-			func(ctx context.Context, exec bob.Executor, retrieved ItemsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
-				return retrieved.LoadItems(ctx, exec, mods...)
-			},
-		),
-		ProfilePictureFile: thenLoadBuilder[Q](
-			"ProfilePictureFile",
-			func(ctx context.Context, exec bob.Executor, retrieved ProfilePictureFileLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
-				return retrieved.LoadProfilePictureFile(ctx, exec, mods...)
-			},
-		),
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range oauthCodes {
+
+			if o.ID != rel.UserID {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.OauthCodes = append(o.R.OauthCodes, rel)
+		}
 	}
+
+	return nil
 }
 
-// LoadCredentials loads the user's Credentials into the .R struct
-func (o *User) LoadCredentials(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadOauthConsents loads the user's OauthConsents into the .R struct
+func (o *User) LoadOauthConsents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
 		return nil
 	}
 
 	// Reset the relationship
-	o.R.Credentials = nil
+	o.R.OauthConsents = nil
 
-	related, err := o.Credentials(mods...).All(ctx, exec)
+	related, err := o.OauthConsents(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
@@ -945,17 +2710,17 @@ func (o *User) LoadCredentials(ctx context.Context, exec bob.Executor, mods ...b
 		rel.R.User = o
 	}
 
-	o.R.Credentials = related
+	o.R.OauthConsents = related
 	return nil
 }
 
-// LoadCredentials loads the user's Credentials into the .R struct
-func (os UserSlice) LoadCredentials(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadOauthConsents loads the user's OauthConsents into the .R struct
+func (os UserSlice) LoadOauthConsents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if len(os) == 0 {
 		return nil
 	}
 
-	credentials, err := os.Credentials(mods...).All(ctx, exec)
+	oauthConsents, err := os.OauthConsents(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
@@ -965,7 +2730,7 @@ func (os UserSlice) LoadCredentials(ctx context.Context, exec bob.Executor, mods
 			continue
 		}
 
-		o.R.Credentials = nil
+		o.R.OauthConsents = nil
 	}
 
 	for _, o := range os {
@@ -973,7 +2738,7 @@ func (os UserSlice) LoadCredentials(ctx context.Context, exec bob.Executor, mods
 			continue
 		}
 
-		for _, rel := range credentials {
+		for _, rel := range oauthConsents {
 
 			if o.ID != rel.UserID {
 				continue
@@ -981,23 +2746,23 @@ func (os UserSlice) LoadCredentials(ctx context.Context, exec bob.Executor, mods
 
 			rel.R.User = o
 
-			o.R.Credentials = append(o.R.Credentials, rel)
+			o.R.OauthConsents = append(o.R.OauthConsents, rel)
 		}
 	}
 
 	return nil
 }
 
-// LoadFiles loads the user's Files into the .R struct
-func (o *User) LoadFiles(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadRecoveryCodes loads the user's RecoveryCodes into the .R struct
+func (o *User) LoadRecoveryCodes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
 		return nil
 	}
 
 	// Reset the relationship
-	o.R.Files = nil
+	o.R.RecoveryCodes = nil
 
-	related, err := o.Files(mods...).All(ctx, exec)
+	related, err := o.RecoveryCodes(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
@@ -1006,17 +2771,17 @@ func (o *User) LoadFiles(ctx context.Context, exec bob.Executor, mods ...bob.Mod
 		rel.R.User = o
 	}
 
-	o.R.Files = related
+	o.R.RecoveryCodes = related
 	return nil
 }
 
-// LoadFiles loads the user's Files into the .R struct
-func (os UserSlice) LoadFiles(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadRecoveryCodes loads the user's RecoveryCodes into the .R struct
+func (os UserSlice) LoadRecoveryCodes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if len(os) == 0 {
 		return nil
 	}
 
-	files, err := os.Files(mods...).All(ctx, exec)
+	recoveryCodes, err := os.RecoveryCodes(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
@@ -1026,7 +2791,7 @@ func (os UserSlice) LoadFiles(ctx context.Context, exec bob.Executor, mods ...bo
 			continue
 		}
 
-		o.R.Files = nil
+		o.R.RecoveryCodes = nil
 	}
 
 	for _, o := range os {
@@ -1034,7 +2799,7 @@ func (os UserSlice) LoadFiles(ctx context.Context, exec bob.Executor, mods ...bo
 			continue
 		}
 
-		for _, rel := range files {
+		for _, rel := range recoveryCodes {
 
 			if o.ID != rel.UserID {
 				continue
@@ -1042,23 +2807,23 @@ func (os UserSlice) LoadFiles(ctx context.Context, exec bob.Executor, mods ...bo
 
 			rel.R.User = o
 
-			o.R.Files = append(o.R.Files, rel)
+			o.R.RecoveryCodes = append(o.R.RecoveryCodes, rel)
 		}
 	}
 
 	return nil
 }
 
-// LoadItems loads the user's Items into the .R struct
-func (o *User) LoadItems(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadSessions loads the user's Sessions into the .R struct
+func (o *User) LoadSessions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
 		return nil
 	}
 
 	// Reset the relationship
-	o.R.Items = nil
+	o.R.Sessions = nil
 
-	related, err := o.Items(mods...).All(ctx, exec)
+	related, err := o.Sessions(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
@@ -1067,17 +2832,17 @@ func (o *User) LoadItems(ctx context.Context, exec bob.Executor, mods ...bob.Mod
 		rel.R.User = o
 	}
 
-	o.R.Items = related
+	o.R.Sessions = related
 	return nil
 }
 
-// LoadItems loads the user's Items into the .R struct
-func (os UserSlice) LoadItems(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadSessions loads the user's Sessions into the .R struct
+func (os UserSlice) LoadSessions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if len(os) == 0 {
 		return nil
 	}
 
-	items, err := os.Items(mods...).All(ctx, exec)
+	sessions, err := os.Sessions(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
@@ -1087,7 +2852,7 @@ func (os UserSlice) LoadItems(ctx context.Context, exec bob.Executor, mods ...bo
 			continue
 		}
 
-		o.R.Items = nil
+		o.R.Sessions = nil
 	}
 
 	for _, o := range os {
@@ -1095,7 +2860,7 @@ func (os UserSlice) LoadItems(ctx context.Context, exec bob.Executor, mods ...bo
 			continue
 		}
 
-		for _, rel := range items {
+		for _, rel := range sessions {
 
 			if o.ID != rel.UserID {
 				continue
@@ -1103,7 +2868,7 @@ func (os UserSlice) LoadItems(ctx context.Context, exec bob.Executor, mods ...bo
 
 			rel.R.User = o
 
-			o.R.Items = append(o.R.Items, rel)
+			o.R.Sessions = append(o.R.Sessions, rel)
 		}
 	}
 
@@ -1167,9 +2932,18 @@ func (os UserSlice) LoadProfilePictureFile(ctx context.Context, exec bob.Executo
 
 type userJoins[Q dialect.Joinable] struct {
 	typ                string
+	AccountExports     modAs[Q, accountExportColumns]
+	APIKeys            modAs[Q, apiKeyColumns]
 	Credentials        modAs[Q, credentialColumns]
+	EmailTokens        modAs[Q, emailTokenColumns]
 	Files              modAs[Q, fileColumns]
+	Identities         modAs[Q, identityColumns]
 	Items              modAs[Q, itemColumns]
+	LoginChallenges    modAs[Q, loginChallengeColumns]
+	OauthCodes         modAs[Q, oauthCodeColumns]
+	OauthConsents      modAs[Q, oauthConsentColumns]
+	RecoveryCodes      modAs[Q, recoveryCodeColumns]
+	Sessions           modAs[Q, sessionColumns]
 	ProfilePictureFile modAs[Q, fileColumns]
 }
 
@@ -1180,6 +2954,34 @@ func (j userJoins[Q]) aliasedAs(alias string) userJoins[Q] {
 func buildUserJoins[Q dialect.Joinable](cols userColumns, typ string) userJoins[Q] {
 	return userJoins[Q]{
 		typ: typ,
+		AccountExports: modAs[Q, accountExportColumns]{
+			c: AccountExports.Columns,
+			f: func(to accountExportColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, AccountExports.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		APIKeys: modAs[Q, apiKeyColumns]{
+			c: APIKeys.Columns,
+			f: func(to apiKeyColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, APIKeys.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
 		Credentials: modAs[Q, credentialColumns]{
 			c: Credentials.Columns,
 			f: func(to credentialColumns) bob.Mod[Q] {
@@ -1194,6 +2996,20 @@ func buildUserJoins[Q dialect.Joinable](cols userColumns, typ string) userJoins[
 				return mods
 			},
 		},
+		EmailTokens: modAs[Q, emailTokenColumns]{
+			c: EmailTokens.Columns,
+			f: func(to emailTokenColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, EmailTokens.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
 		Files: modAs[Q, fileColumns]{
 			c: Files.Columns,
 			f: func(to fileColumns) bob.Mod[Q] {
@@ -1208,6 +3024,20 @@ func buildUserJoins[Q dialect.Joinable](cols userColumns, typ string) userJoins[
 				return mods
 			},
 		},
+		Identities: modAs[Q, identityColumns]{
+			c: Identities.Columns,
+			f: func(to identityColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Identities.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
 		Items: modAs[Q, itemColumns]{
 			c: Items.Columns,
 			f: func(to itemColumns) bob.Mod[Q] {
@@ -1222,6 +3052,76 @@ func buildUserJoins[Q dialect.Joinable](cols userColumns, typ string) userJoins[
 				return mods
 			},
 		},
+		LoginChallenges: modAs[Q, loginChallengeColumns]{
+			c: LoginChallenges.Columns,
+			f: func(to loginChallengeColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, LoginChallenges.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		OauthCodes: modAs[Q, oauthCodeColumns]{
+			c: OauthCodes.Columns,
+			f: func(to oauthCodeColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, OauthCodes.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		OauthConsents: modAs[Q, oauthConsentColumns]{
+			c: OauthConsents.Columns,
+			f: func(to oauthConsentColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, OauthConsents.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		RecoveryCodes: modAs[Q, recoveryCodeColumns]{
+			c: RecoveryCodes.Columns,
+			f: func(to recoveryCodeColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, RecoveryCodes.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Sessions: modAs[Q, sessionColumns]{
+			c: Sessions.Columns,
+			f: func(to sessionColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Sessions.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
 		ProfilePictureFile: modAs[Q, fileColumns]{
 			c: Files.Columns,
 			f: func(to fileColumns) bob.Mod[Q] {
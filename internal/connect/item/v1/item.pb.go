@@ -23,6 +23,113 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ItemFormat int32
+
+const (
+	ItemFormat_ITEM_FORMAT_UNSPECIFIED ItemFormat = 0
+	ItemFormat_ITEM_FORMAT_CSV         ItemFormat = 1
+	ItemFormat_ITEM_FORMAT_JSON        ItemFormat = 2
+	ItemFormat_ITEM_FORMAT_NDJSON      ItemFormat = 3
+)
+
+// Enum value maps for ItemFormat.
+var (
+	ItemFormat_name = map[int32]string{
+		0: "ITEM_FORMAT_UNSPECIFIED",
+		1: "ITEM_FORMAT_CSV",
+		2: "ITEM_FORMAT_JSON",
+		3: "ITEM_FORMAT_NDJSON",
+	}
+	ItemFormat_value = map[string]int32{
+		"ITEM_FORMAT_UNSPECIFIED": 0,
+		"ITEM_FORMAT_CSV":         1,
+		"ITEM_FORMAT_JSON":        2,
+		"ITEM_FORMAT_NDJSON":      3,
+	}
+)
+
+func (x ItemFormat) Enum() *ItemFormat {
+	p := new(ItemFormat)
+	*p = x
+	return p
+}
+
+func (x ItemFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ItemFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_item_v1_item_proto_enumTypes[0].Descriptor()
+}
+
+func (ItemFormat) Type() protoreflect.EnumType {
+	return &file_item_v1_item_proto_enumTypes[0]
+}
+
+func (x ItemFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ItemFormat.Descriptor instead.
+func (ItemFormat) EnumDescriptor() ([]byte, []int) {
+	return file_item_v1_item_proto_rawDescGZIP(), []int{0}
+}
+
+type ItemSortField int32
+
+const (
+	ItemSortField_ITEM_SORT_FIELD_UNSPECIFIED ItemSortField = 0
+	ItemSortField_ITEM_SORT_FIELD_NAME        ItemSortField = 1
+	ItemSortField_ITEM_SORT_FIELD_ADDED       ItemSortField = 2
+	ItemSortField_ITEM_SORT_FIELD_PRICE       ItemSortField = 3
+	ItemSortField_ITEM_SORT_FIELD_QUANTITY    ItemSortField = 4
+)
+
+// Enum value maps for ItemSortField.
+var (
+	ItemSortField_name = map[int32]string{
+		0: "ITEM_SORT_FIELD_UNSPECIFIED",
+		1: "ITEM_SORT_FIELD_NAME",
+		2: "ITEM_SORT_FIELD_ADDED",
+		3: "ITEM_SORT_FIELD_PRICE",
+		4: "ITEM_SORT_FIELD_QUANTITY",
+	}
+	ItemSortField_value = map[string]int32{
+		"ITEM_SORT_FIELD_UNSPECIFIED": 0,
+		"ITEM_SORT_FIELD_NAME":        1,
+		"ITEM_SORT_FIELD_ADDED":       2,
+		"ITEM_SORT_FIELD_PRICE":       3,
+		"ITEM_SORT_FIELD_QUANTITY":    4,
+	}
+)
+
+func (x ItemSortField) Enum() *ItemSortField {
+	p := new(ItemSortField)
+	*p = x
+	return p
+}
+
+func (x ItemSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ItemSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_item_v1_item_proto_enumTypes[1].Descriptor()
+}
+
+func (ItemSortField) Type() protoreflect.EnumType {
+	return &file_item_v1_item_proto_enumTypes[1]
+}
+
+func (x ItemSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ItemSortField.Descriptor instead.
+func (ItemSortField) EnumDescriptor() ([]byte, []int) {
+	return file_item_v1_item_proto_rawDescGZIP(), []int{1}
+}
+
 type Item struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -195,20 +302,80 @@ func (x *GetItemResponse) GetItem() *Item {
 	return nil
 }
 
-type GetItemsRequest struct {
+type ItemSort struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3,oneof" json:"start,omitempty"`
-	End           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3,oneof" json:"end,omitempty"`
-	Filter        *string                `protobuf:"bytes,3,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
-	Limit         *int32                 `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	Offset        *int32                 `protobuf:"varint,5,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	Field         ItemSortField          `protobuf:"varint,1,opt,name=field,proto3,enum=item.v1.ItemSortField" json:"field,omitempty"`
+	Descending    bool                   `protobuf:"varint,2,opt,name=descending,proto3" json:"descending,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemSort) Reset() {
+	*x = ItemSort{}
+	mi := &file_item_v1_item_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemSort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemSort) ProtoMessage() {}
+
+func (x *ItemSort) ProtoReflect() protoreflect.Message {
+	mi := &file_item_v1_item_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemSort.ProtoReflect.Descriptor instead.
+func (*ItemSort) Descriptor() ([]byte, []int) {
+	return file_item_v1_item_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ItemSort) GetField() ItemSortField {
+	if x != nil {
+		return x.Field
+	}
+	return ItemSortField_ITEM_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ItemSort) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+type GetItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3,oneof" json:"start,omitempty"`
+	End   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3,oneof" json:"end,omitempty"`
+	// AIP-160 filter over id, name, description, price, quantity and added,
+	// like price < 20 AND quantity >= 1 AND name:"widget*"
+	Filter *string `protobuf:"bytes,3,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	Limit  *int32  `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset *int32  `protobuf:"varint,5,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	// Sorted by each field in order, ties are broken by id
+	Sort []*ItemSort `protobuf:"bytes,6,rep,name=sort,proto3" json:"sort,omitempty"`
+	// Continues from the next_page_token of a previous response with the same sort, cannot be used with offset
+	PageToken *string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+	// Defaults to counting on the first page only
+	IncludeCount  *bool `protobuf:"varint,8,opt,name=include_count,json=includeCount,proto3,oneof" json:"include_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetItemsRequest) Reset() {
 	*x = GetItemsRequest{}
-	mi := &file_item_v1_item_proto_msgTypes[3]
+	mi := &file_item_v1_item_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -220,7 +387,7 @@ func (x *GetItemsRequest) String() string {
 func (*GetItemsRequest) ProtoMessage() {}
 
 func (x *GetItemsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_item_v1_item_proto_msgTypes[3]
+	mi := &file_item_v1_item_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -233,7 +400,7 @@ func (x *GetItemsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetItemsRequest.ProtoReflect.Descriptor instead.
 func (*GetItemsRequest) Descriptor() ([]byte, []int) {
-	return file_item_v1_item_proto_rawDescGZIP(), []int{3}
+	return file_item_v1_item_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetItemsRequest) GetStart() *timestamppb.Timestamp {
@@ -271,17 +438,40 @@ func (x *GetItemsRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *GetItemsRequest) GetSort() []*ItemSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *GetItemsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *GetItemsRequest) GetIncludeCount() bool {
+	if x != nil && x.IncludeCount != nil {
+		return *x.IncludeCount
+	}
+	return false
+}
+
 type GetItemsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Item                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items []*Item                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Count *int64                 `protobuf:"varint,2,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	// Empty on the last page
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetItemsResponse) Reset() {
 	*x = GetItemsResponse{}
-	mi := &file_item_v1_item_proto_msgTypes[4]
+	mi := &file_item_v1_item_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -293,7 +483,7 @@ func (x *GetItemsResponse) String() string {
 func (*GetItemsResponse) ProtoMessage() {}
 
 func (x *GetItemsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_item_v1_item_proto_msgTypes[4]
+	mi := &file_item_v1_item_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -306,7 +496,7 @@ func (x *GetItemsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetItemsResponse.ProtoReflect.Descriptor instead.
 func (*GetItemsResponse) Descriptor() ([]byte, []int) {
-	return file_item_v1_item_proto_rawDescGZIP(), []int{4}
+	return file_item_v1_item_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetItemsResponse) GetItems() []*Item {
@@ -317,37 +507,43 @@ func (x *GetItemsResponse) GetItems() []*Item {
 }
 
 func (x *GetItemsResponse) GetCount() int64 {
-	if x != nil {
-		return x.Count
+	if x != nil && x.Count != nil {
+		return *x.Count
 	}
 	return 0
 }
 
-type CreateItemRequest struct {
+func (x *GetItemsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SearchItemsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float32                `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"`
-	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset        *int32                 `protobuf:"varint,3,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateItemRequest) Reset() {
-	*x = CreateItemRequest{}
-	mi := &file_item_v1_item_proto_msgTypes[5]
+func (x *SearchItemsRequest) Reset() {
+	*x = SearchItemsRequest{}
+	mi := &file_item_v1_item_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateItemRequest) String() string {
+func (x *SearchItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateItemRequest) ProtoMessage() {}
+func (*SearchItemsRequest) ProtoMessage() {}
 
-func (x *CreateItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_item_v1_item_proto_msgTypes[5]
+func (x *SearchItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_item_v1_item_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -358,62 +554,55 @@ func (x *CreateItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateItemRequest.ProtoReflect.Descriptor instead.
-func (*CreateItemRequest) Descriptor() ([]byte, []int) {
-	return file_item_v1_item_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *CreateItemRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use SearchItemsRequest.ProtoReflect.Descriptor instead.
+func (*SearchItemsRequest) Descriptor() ([]byte, []int) {
+	return file_item_v1_item_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CreateItemRequest) GetDescription() string {
+func (x *SearchItemsRequest) GetQuery() string {
 	if x != nil {
-		return x.Description
+		return x.Query
 	}
 	return ""
 }
 
-func (x *CreateItemRequest) GetPrice() float32 {
-	if x != nil {
-		return x.Price
+func (x *SearchItemsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
 	}
 	return 0
 }
 
-func (x *CreateItemRequest) GetQuantity() int32 {
-	if x != nil {
-		return x.Quantity
+func (x *SearchItemsRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
 	}
 	return 0
 }
 
-type CreateItemResponse struct {
+type SearchItemsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Added         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=added,proto3" json:"added,omitempty"`
+	Hits          []*ItemSearchHit       `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateItemResponse) Reset() {
-	*x = CreateItemResponse{}
-	mi := &file_item_v1_item_proto_msgTypes[6]
+func (x *SearchItemsResponse) Reset() {
+	*x = SearchItemsResponse{}
+	mi := &file_item_v1_item_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateItemResponse) String() string {
+func (x *SearchItemsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateItemResponse) ProtoMessage() {}
+func (*SearchItemsResponse) ProtoMessage() {}
 
-func (x *CreateItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_item_v1_item_proto_msgTypes[6]
+func (x *SearchItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_item_v1_item_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -424,51 +613,52 @@ func (x *CreateItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateItemResponse.ProtoReflect.Descriptor instead.
-func (*CreateItemResponse) Descriptor() ([]byte, []int) {
-	return file_item_v1_item_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use SearchItemsResponse.ProtoReflect.Descriptor instead.
+func (*SearchItemsResponse) Descriptor() ([]byte, []int) {
+	return file_item_v1_item_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreateItemResponse) GetId() int32 {
+func (x *SearchItemsResponse) GetHits() []*ItemSearchHit {
 	if x != nil {
-		return x.Id
+		return x.Hits
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateItemResponse) GetAdded() *timestamppb.Timestamp {
+func (x *SearchItemsResponse) GetCount() int64 {
 	if x != nil {
-		return x.Added
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-type UpdateItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Price         *float32               `protobuf:"fixed32,4,opt,name=price,proto3,oneof" json:"price,omitempty"`
-	Quantity      *int32                 `protobuf:"varint,5,opt,name=quantity,proto3,oneof" json:"quantity,omitempty"`
+type ItemSearchHit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Item  *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	// How well the item matched, higher is better
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// The name and a snippet of the description, split into matched and unmatched parts
+	Name          []*TextFragment `protobuf:"bytes,3,rep,name=name,proto3" json:"name,omitempty"`
+	Description   []*TextFragment `protobuf:"bytes,4,rep,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateItemRequest) Reset() {
-	*x = UpdateItemRequest{}
-	mi := &file_item_v1_item_proto_msgTypes[7]
+func (x *ItemSearchHit) Reset() {
+	*x = ItemSearchHit{}
+	mi := &file_item_v1_item_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateItemRequest) String() string {
+func (x *ItemSearchHit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateItemRequest) ProtoMessage() {}
+func (*ItemSearchHit) ProtoMessage() {}
 
-func (x *UpdateItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_item_v1_item_proto_msgTypes[7]
+func (x *ItemSearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_item_v1_item_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -479,67 +669,62 @@ func (x *UpdateItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateItemRequest.ProtoReflect.Descriptor instead.
-func (*UpdateItemRequest) Descriptor() ([]byte, []int) {
-	return file_item_v1_item_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ItemSearchHit.ProtoReflect.Descriptor instead.
+func (*ItemSearchHit) Descriptor() ([]byte, []int) {
+	return file_item_v1_item_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateItemRequest) GetId() int32 {
+func (x *ItemSearchHit) GetItem() *Item {
 	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *UpdateItemRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+		return x.Item
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateItemRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *ItemSearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateItemRequest) GetPrice() float32 {
-	if x != nil && x.Price != nil {
-		return *x.Price
+func (x *ItemSearchHit) GetName() []*TextFragment {
+	if x != nil {
+		return x.Name
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateItemRequest) GetQuantity() int32 {
-	if x != nil && x.Quantity != nil {
-		return *x.Quantity
+func (x *ItemSearchHit) GetDescription() []*TextFragment {
+	if x != nil {
+		return x.Description
 	}
-	return 0
+	return nil
 }
 
-type UpdateItemResponse struct {
+type TextFragment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Highlighted   bool                   `protobuf:"varint,2,opt,name=highlighted,proto3" json:"highlighted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateItemResponse) Reset() {
-	*x = UpdateItemResponse{}
-	mi := &file_item_v1_item_proto_msgTypes[8]
+func (x *TextFragment) Reset() {
+	*x = TextFragment{}
+	mi := &file_item_v1_item_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateItemResponse) String() string {
+func (x *TextFragment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateItemResponse) ProtoMessage() {}
+func (*TextFragment) ProtoMessage() {}
 
-func (x *UpdateItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_item_v1_item_proto_msgTypes[8]
+func (x *TextFragment) ProtoReflect() protoreflect.Message {
+	mi := &file_item_v1_item_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,23 +735,956 @@ func (x *UpdateItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateItemResponse.ProtoReflect.Descriptor instead.
-func (*UpdateItemResponse) Descriptor() ([]byte, []int) {
-	return file_item_v1_item_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use TextFragment.ProtoReflect.Descriptor instead.
+func (*TextFragment) Descriptor() ([]byte, []int) {
+	return file_item_v1_item_proto_rawDescGZIP(), []int{9}
 }
 
-type DeleteItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TextFragment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
 }
 
-func (x *DeleteItemRequest) Reset() {
-	*x = DeleteItemRequest{}
-	mi := &file_item_v1_item_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *TextFragment) GetHighlighted() bool {
+	if x != nil {
+		return x.Highlighted
+	}
+	return false
+}
+
+type CreateItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Price         float32                `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateItemRequest) Reset() {
+	*x = CreateItemRequest{}
+	mi := &file_item_v1_item_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateItemRequest) ProtoMessage() {}
+
+func (x *CreateItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_item_v1_item_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateItemRequest.ProtoReflect.Descriptor instead.
+func (*CreateItemRequest) Descriptor() ([]byte, []int) {
+	return file_item_v1_item_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateItemRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateItemRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateItemRequest) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type CreateItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Added         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=added,proto3" json:"added,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateItemResponse) Reset() {
+	*x = CreateItemResponse{}
+	mi := &file_item_v1_item_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateItemResponse) ProtoMessage() {}
+
+func (x *CreateItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_item_v1_item_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateItemResponse.ProtoReflect.Descriptor instead.
+func (*CreateItemResponse) Descriptor() ([]byte, []int) {
+	return file_item_v1_item_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateItemResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateItemResponse) GetAdded() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+type UpdateItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Price         *float32               `protobuf:"fixed32,4,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	Quantity      *int32                 `protobuf:"varint,5,opt,name=quantity,proto3,oneof" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateItemRequest) Reset() {
+	*x = UpdateItemRequest{}
+	mi := &file_item_v1_item_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateItemRequest) ProtoMessage() {}
+
+func (x *UpdateItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_item_v1_item_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateItemRequest) Descriptor() ([]byte, []int) {
+	return file_item_v1_item_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateItemRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateItemRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetPrice() float32 {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return 0
+}
+
+func (x *UpdateItemRequest) GetQuantity() int32 {
+	if x != nil && x.Quantity != nil {
+		return *x.Quantity
+	}
+	return 0
+}
+
+type UpdateItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateItemResponse) Reset() {
+	*x = UpdateItemResponse{}
+	mi := &file_item_v1_item_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateItemResponse) ProtoMessage() {}
+
+func (x *UpdateItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_item_v1_item_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateItemResponse.ProtoReflect.Descriptor instead.
+func (*UpdateItemResponse) Descriptor() ([]byte, []int) {
+	return file_item_v1_item_proto_rawDescGZIP(), []int{13}
+}
+
+type BatchCreateItemsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Requests []*CreateItemRequest   `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	// Create the items that can be, instead of none if any fail
+	AllowPartial  bool `protobuf:"varint,2,opt,name=allow_partial,json=allowPartial,proto3" json:"allow_partial,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateItemsRequest) Reset() {
+	*x = BatchCreateItemsRequest{}
+	mi := &file_item_v1_item_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateItemsRequest) ProtoMessage() {}
+
+func (x *BatchCreateItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_item_v1_item_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateItemsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateItemsRequest) Descriptor() ([]byte, []int) {
+	return file_item_v1_item_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BatchCreateItemsRequest) GetRequests() []*CreateItemRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *BatchCreateItemsRequest) GetAllowPartial() bool {
+	if x != nil {
+		return x.AllowPartial
+	}
+	return false
+}
+
+type BatchCreateItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchItemResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateItemsResponse) Reset() {
+	*x = BatchCreateItemsResponse{}
+	mi := &file_item_v1_item_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateItemsResponse) ProtoMessage() {}
+
+func (x *BatchCreateItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_item_v1_item_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateItemsResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateItemsResponse) Descriptor() ([]byte, []int) {
+	return file_item_v1_item_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BatchCreateItemsResponse) GetResults() []*BatchItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchUpdateItemsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Requests []*UpdateItemRequest   `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	// Update the items that can be, instead of none if any fail
+	AllowPartial  bool `protobuf:"varint,2,opt,name=allow_partial,json=allowPartial,proto3" json:"allow_partial,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateItemsRequest) Reset() {
+	*x = BatchUpdateItemsRequest{}
+	mi := &file_item_v1_item_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateItemsRequest) ProtoMessage() {}
+
+func (x *BatchUpdateItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_item_v1_item_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateItemsRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateItemsRequest) Descriptor() ([]byte, []int) {
+	return file_item_v1_item_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BatchUpdateItemsRequest) GetRequests() []*UpdateItemRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *BatchUpdateItemsRequest) GetAllowPartial() bool {
+	if x != nil {
+		return x.AllowPartial
+	}
+	return false
+}
+
+type BatchUpdateItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchItemResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateItemsResponse) Reset() {
+	*x = BatchUpdateItemsResponse{}
+	mi := &file_item_v1_item_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateItemsResponse) ProtoMessage() {}
+
+func (x *BatchUpdateItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_item_v1_item_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateItemsResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateItemsResponse) Descriptor() ([]byte, []int) {
+	return file_item_v1_item_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BatchUpdateItemsResponse) GetResults() []*BatchItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchDeleteItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []int32                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// Delete the items that can be, instead of none if any fail
+	AllowPartial  bool `protobuf:"varint,2,opt,name=allow_partial,json=allowPartial,proto3" json:"allow_partial,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteItemsRequest) Reset() {
+	*x = BatchDeleteItemsRequest{}
+	mi := &file_item_v1_item_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteItemsRequest) ProtoMessage() {}
+
+func (x *BatchDeleteItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_item_v1_item_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteItemsRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteItemsRequest) Descriptor() ([]byte, []int) {
+	return file_item_v1_item_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BatchDeleteItemsRequest) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchDeleteItemsRequest) GetAllowPartial() bool {
+	if x != nil {
+		return x.AllowPartial
+	}
+	return false
+}
+
+type BatchDeleteItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchItemResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteItemsResponse) Reset() {
+	*x = BatchDeleteItemsResponse{}
+	mi := &file_item_v1_item_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteItemsResponse) ProtoMessage() {}
+
+func (x *BatchDeleteItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_item_v1_item_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteItemsResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeleteItemsResponse) Descriptor() ([]byte, []int) {
+	return file_item_v1_item_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BatchDeleteItemsResponse) GetResults() []*BatchItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// The outcome of one entry of a batch, in the order of the request
+type BatchItemResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The created or updated item, unset for deletes and failures
+	Item *Item `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	// Connect error code like not_found, empty on success
+	ErrorCode     string `protobuf:"bytes,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorMessage  string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchItemResult) Reset() {
+	*x = BatchItemResult{}
+	mi := &file_item_v1_item_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItemResult) ProtoMessage() {}
+
+func (x *BatchItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_item_v1_item_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItemResult.ProtoReflect.Descriptor instead.
+func (*BatchItemResult) Descriptor() ([]byte, []int) {
+	return file_item_v1_item_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BatchItemResult) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BatchItemResult) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *BatchItemResult) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *BatchItemResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type ExportItemsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Format ItemFormat             `protobuf:"varint,1,opt,name=format,proto3,enum=item.v1.ItemFormat" json:"format,omitempty"`
+	// Filters and sorts like GetItems
+	Start         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3,oneof" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3,oneof" json:"end,omitempty"`
+	Filter        *string                `protobuf:"bytes,4,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	Sort          []*ItemSort            `protobuf:"bytes,5,rep,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportItemsRequest) Reset() {
+	*x = ExportItemsRequest{}
+	mi := &file_item_v1_item_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportItemsRequest) ProtoMessage() {}
+
+func (x *ExportItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_item_v1_item_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportItemsRequest.ProtoReflect.Descriptor instead.
+func (*ExportItemsRequest) Descriptor() ([]byte, []int) {
+	return file_item_v1_item_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ExportItemsRequest) GetFormat() ItemFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ItemFormat_ITEM_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportItemsRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ExportItemsRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *ExportItemsRequest) GetFilter() string {
+	if x != nil && x.Filter != nil {
+		return *x.Filter
+	}
+	return ""
+}
+
+func (x *ExportItemsRequest) GetSort() []*ItemSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+// A chunk of the exported file
+type ExportItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportItemsResponse) Reset() {
+	*x = ExportItemsResponse{}
+	mi := &file_item_v1_item_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportItemsResponse) ProtoMessage() {}
+
+func (x *ExportItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_item_v1_item_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportItemsResponse.ProtoReflect.Descriptor instead.
+func (*ExportItemsResponse) Descriptor() ([]byte, []int) {
+	return file_item_v1_item_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExportItemsResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The options are read from the first message, the data of every message is joined together
+type ImportItemsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Format ItemFormat             `protobuf:"varint,1,opt,name=format,proto3,enum=item.v1.ItemFormat" json:"format,omitempty"`
+	// Check the rows without importing them
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Import the valid rows, instead of none if any fail
+	AllowPartial  bool   `protobuf:"varint,3,opt,name=allow_partial,json=allowPartial,proto3" json:"allow_partial,omitempty"`
+	Data          []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportItemsRequest) Reset() {
+	*x = ImportItemsRequest{}
+	mi := &file_item_v1_item_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportItemsRequest) ProtoMessage() {}
+
+func (x *ImportItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_item_v1_item_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportItemsRequest.ProtoReflect.Descriptor instead.
+func (*ImportItemsRequest) Descriptor() ([]byte, []int) {
+	return file_item_v1_item_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ImportItemsRequest) GetFormat() ItemFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ItemFormat_ITEM_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportItemsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportItemsRequest) GetAllowPartial() bool {
+	if x != nil {
+		return x.AllowPartial
+	}
+	return false
+}
+
+func (x *ImportItemsRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ImportItemsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Rows imported, or that would be without dry_run
+	Imported int32 `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	Failed   int32 `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	// The first errors, of at most one per row
+	Errors        []*ImportItemsError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	DryRun        bool                `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportItemsResponse) Reset() {
+	*x = ImportItemsResponse{}
+	mi := &file_item_v1_item_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportItemsResponse) ProtoMessage() {}
+
+func (x *ImportItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_item_v1_item_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportItemsResponse.ProtoReflect.Descriptor instead.
+func (*ImportItemsResponse) Descriptor() ([]byte, []int) {
+	return file_item_v1_item_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ImportItemsResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportItemsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportItemsResponse) GetErrors() []*ImportItemsError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportItemsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportItemsError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Row of the data starting at 1, not counting the CSV header
+	Row int32 `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	// Field that is invalid, empty if the row could not be read
+	Field         string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportItemsError) Reset() {
+	*x = ImportItemsError{}
+	mi := &file_item_v1_item_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportItemsError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportItemsError) ProtoMessage() {}
+
+func (x *ImportItemsError) ProtoReflect() protoreflect.Message {
+	mi := &file_item_v1_item_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportItemsError.ProtoReflect.Descriptor instead.
+func (*ImportItemsError) Descriptor() ([]byte, []int) {
+	return file_item_v1_item_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImportItemsError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportItemsError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ImportItemsError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteItemRequest) Reset() {
+	*x = DeleteItemRequest{}
+	mi := &file_item_v1_item_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *DeleteItemRequest) String() string {
@@ -576,7 +1694,7 @@ func (x *DeleteItemRequest) String() string {
 func (*DeleteItemRequest) ProtoMessage() {}
 
 func (x *DeleteItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_item_v1_item_proto_msgTypes[9]
+	mi := &file_item_v1_item_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -589,7 +1707,7 @@ func (x *DeleteItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteItemRequest.ProtoReflect.Descriptor instead.
 func (*DeleteItemRequest) Descriptor() ([]byte, []int) {
-	return file_item_v1_item_proto_rawDescGZIP(), []int{9}
+	return file_item_v1_item_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *DeleteItemRequest) GetId() int32 {
@@ -607,7 +1725,7 @@ type DeleteItemResponse struct {
 
 func (x *DeleteItemResponse) Reset() {
 	*x = DeleteItemResponse{}
-	mi := &file_item_v1_item_proto_msgTypes[10]
+	mi := &file_item_v1_item_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -619,7 +1737,7 @@ func (x *DeleteItemResponse) String() string {
 func (*DeleteItemResponse) ProtoMessage() {}
 
 func (x *DeleteItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_item_v1_item_proto_msgTypes[10]
+	mi := &file_item_v1_item_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -632,70 +1750,171 @@ func (x *DeleteItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteItemResponse.ProtoReflect.Descriptor instead.
 func (*DeleteItemResponse) Descriptor() ([]byte, []int) {
-	return file_item_v1_item_proto_rawDescGZIP(), []int{10}
+	return file_item_v1_item_proto_rawDescGZIP(), []int{27}
 }
 
 var File_item_v1_item_proto protoreflect.FileDescriptor
 
 const file_item_v1_item_proto_rawDesc = "" +
 	"\n" +
-	"\x12item/v1/item.proto\x12\aitem.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb0\x01\n" +
+	"\x12item/v1/item.proto\x12\aitem.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd4\x01\n" +
 	"\x04Item\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x120\n" +
-	"\x05added\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05added\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x05 \x01(\x02R\x05price\x12\x1a\n" +
-	"\bquantity\x18\x06 \x01(\x05R\bquantity\" \n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x03R\x04name\x120\n" +
+	"\x05added\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05added\x12)\n" +
+	"\vdescription\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x03R\vdescription\x12\x1d\n" +
+	"\x05price\x18\x05 \x01(\x02B\a\xbaH\x04\n" +
+	"\x02@\x01R\x05price\x12#\n" +
+	"\bquantity\x18\x06 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bquantity\" \n" +
 	"\x0eGetItemRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"4\n" +
 	"\x0fGetItemResponse\x12!\n" +
-	"\x04item\x18\x01 \x01(\v2\r.item.v1.ItemR\x04item\"\x82\x02\n" +
+	"\x04item\x18\x01 \x01(\v2\r.item.v1.ItemR\x04item\"d\n" +
+	"\bItemSort\x128\n" +
+	"\x05field\x18\x01 \x01(\x0e2\x16.item.v1.ItemSortFieldB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x05field\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x02 \x01(\bR\n" +
+	"descending\"\xc1\x03\n" +
 	"\x0fGetItemsRequest\x125\n" +
 	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x05start\x88\x01\x01\x121\n" +
-	"\x03end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x03end\x88\x01\x01\x12\x1b\n" +
-	"\x06filter\x18\x03 \x01(\tH\x02R\x06filter\x88\x01\x01\x12\x19\n" +
-	"\x05limit\x18\x04 \x01(\x05H\x03R\x05limit\x88\x01\x01\x12\x1b\n" +
-	"\x06offset\x18\x05 \x01(\x05H\x04R\x06offset\x88\x01\x01B\b\n" +
+	"\x03end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x03end\x88\x01\x01\x12%\n" +
+	"\x06filter\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x02R\x06filter\x88\x01\x01\x12%\n" +
+	"\x05limit\x18\x04 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xe8\a \x00H\x03R\x05limit\x88\x01\x01\x12$\n" +
+	"\x06offset\x18\x05 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x04R\x06offset\x88\x01\x01\x12/\n" +
+	"\x04sort\x18\x06 \x03(\v2\x11.item.v1.ItemSortB\b\xbaH\x05\x92\x01\x02\x10\x04R\x04sort\x12\"\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tH\x05R\tpageToken\x88\x01\x01\x12(\n" +
+	"\rinclude_count\x18\b \x01(\bH\x06R\fincludeCount\x88\x01\x01B\b\n" +
 	"\x06_startB\x06\n" +
 	"\x04_endB\t\n" +
 	"\a_filterB\b\n" +
 	"\x06_limitB\t\n" +
-	"\a_offset\"M\n" +
+	"\a_offsetB\r\n" +
+	"\v_page_tokenB\x10\n" +
+	"\x0e_include_count\"\x84\x01\n" +
 	"\x10GetItemsResponse\x12#\n" +
-	"\x05items\x18\x01 \x03(\v2\r.item.v1.ItemR\x05items\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x03R\x05count\"{\n" +
-	"\x11CreateItemRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x02R\x05price\x12\x1a\n" +
-	"\bquantity\x18\x04 \x01(\x05R\bquantity\"V\n" +
+	"\x05items\x18\x01 \x03(\v2\r.item.v1.ItemR\x05items\x12\x19\n" +
+	"\x05count\x18\x02 \x01(\x03H\x00R\x05count\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\b\n" +
+	"\x06_count\"\x97\x01\n" +
+	"\x12SearchItemsRequest\x12 \n" +
+	"\x05query\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x02R\x05query\x12$\n" +
+	"\x05limit\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00H\x00R\x05limit\x88\x01\x01\x12$\n" +
+	"\x06offset\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x01R\x06offset\x88\x01\x01B\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_offset\"W\n" +
+	"\x13SearchItemsResponse\x12*\n" +
+	"\x04hits\x18\x01 \x03(\v2\x16.item.v1.ItemSearchHitR\x04hits\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\xac\x01\n" +
+	"\rItemSearchHit\x12!\n" +
+	"\x04item\x18\x01 \x01(\v2\r.item.v1.ItemR\x04item\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12)\n" +
+	"\x04name\x18\x03 \x03(\v2\x15.item.v1.TextFragmentR\x04name\x127\n" +
+	"\vdescription\x18\x04 \x03(\v2\x15.item.v1.TextFragmentR\vdescription\"D\n" +
+	"\fTextFragment\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12 \n" +
+	"\vhighlighted\x18\x02 \x01(\bR\vhighlighted\"\x9f\x01\n" +
+	"\x11CreateItemRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x03R\x04name\x12)\n" +
+	"\vdescription\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x03R\vdescription\x12\x1d\n" +
+	"\x05price\x18\x03 \x01(\x02B\a\xbaH\x04\n" +
+	"\x02@\x01R\x05price\x12#\n" +
+	"\bquantity\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bquantity\"V\n" +
 	"\x12CreateItemResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x120\n" +
-	"\x05added\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05added\"\xea\x01\n" +
+	"\x05added\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05added\"\xf3\x01\n" +
 	"\x11UpdateItemRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12 \n" +
 	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x03H\x00R\x04name\x88\x01\x01\x12.\n" +
-	"\vdescription\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x03H\x01R\vdescription\x88\x01\x01\x12\x19\n" +
-	"\x05price\x18\x04 \x01(\x02H\x02R\x05price\x88\x01\x01\x12(\n" +
+	"\vdescription\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x03H\x01R\vdescription\x88\x01\x01\x12\"\n" +
+	"\x05price\x18\x04 \x01(\x02B\a\xbaH\x04\n" +
+	"\x02@\x01H\x02R\x05price\x88\x01\x01\x12(\n" +
 	"\bquantity\x18\x05 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x03R\bquantity\x88\x01\x01B\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\b\n" +
 	"\x06_priceB\v\n" +
 	"\t_quantity\"\x14\n" +
-	"\x12UpdateItemResponse\"#\n" +
+	"\x12UpdateItemResponse\"\x80\x01\n" +
+	"\x17BatchCreateItemsRequest\x12@\n" +
+	"\brequests\x18\x01 \x03(\v2\x1a.item.v1.CreateItemRequestB\b\xbaH\x05\x92\x01\x02\b\x01R\brequests\x12#\n" +
+	"\rallow_partial\x18\x02 \x01(\bR\fallowPartial\"N\n" +
+	"\x18BatchCreateItemsResponse\x122\n" +
+	"\aresults\x18\x01 \x03(\v2\x18.item.v1.BatchItemResultR\aresults\"\x80\x01\n" +
+	"\x17BatchUpdateItemsRequest\x12@\n" +
+	"\brequests\x18\x01 \x03(\v2\x1a.item.v1.UpdateItemRequestB\b\xbaH\x05\x92\x01\x02\b\x01R\brequests\x12#\n" +
+	"\rallow_partial\x18\x02 \x01(\bR\fallowPartial\"N\n" +
+	"\x18BatchUpdateItemsResponse\x122\n" +
+	"\aresults\x18\x01 \x03(\v2\x18.item.v1.BatchItemResultR\aresults\"Z\n" +
+	"\x17BatchDeleteItemsRequest\x12\x1a\n" +
+	"\x03ids\x18\x01 \x03(\x05B\b\xbaH\x05\x92\x01\x02\b\x01R\x03ids\x12#\n" +
+	"\rallow_partial\x18\x02 \x01(\bR\fallowPartial\"N\n" +
+	"\x18BatchDeleteItemsResponse\x122\n" +
+	"\aresults\x18\x01 \x03(\v2\x18.item.v1.BatchItemResultR\aresults\"\x88\x01\n" +
+	"\x0fBatchItemResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12!\n" +
+	"\x04item\x18\x02 \x01(\v2\r.item.v1.ItemR\x04item\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x03 \x01(\tR\terrorCode\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"\xac\x02\n" +
+	"\x12ExportItemsRequest\x127\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x13.item.v1.ItemFormatB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06format\x125\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x05start\x88\x01\x01\x121\n" +
+	"\x03end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x03end\x88\x01\x01\x12%\n" +
+	"\x06filter\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x02R\x06filter\x88\x01\x01\x12/\n" +
+	"\x04sort\x18\x05 \x03(\v2\x11.item.v1.ItemSortB\b\xbaH\x05\x92\x01\x02\x10\x04R\x04sortB\b\n" +
+	"\x06_startB\x06\n" +
+	"\x04_endB\t\n" +
+	"\a_filter\")\n" +
+	"\x13ExportItemsResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\x9d\x01\n" +
+	"\x12ImportItemsRequest\x125\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x13.item.v1.ItemFormatB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06format\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12#\n" +
+	"\rallow_partial\x18\x03 \x01(\bR\fallowPartial\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\"\x95\x01\n" +
+	"\x13ImportItemsResponse\x12\x1a\n" +
+	"\bimported\x18\x01 \x01(\x05R\bimported\x12\x16\n" +
+	"\x06failed\x18\x02 \x01(\x05R\x06failed\x121\n" +
+	"\x06errors\x18\x03 \x03(\v2\x19.item.v1.ImportItemsErrorR\x06errors\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"T\n" +
+	"\x10ImportItemsError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"#\n" +
 	"\x11DeleteItemRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"\x14\n" +
-	"\x12DeleteItemResponse2\xeb\x02\n" +
+	"\x12DeleteItemResponse*l\n" +
+	"\n" +
+	"ItemFormat\x12\x1b\n" +
+	"\x17ITEM_FORMAT_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fITEM_FORMAT_CSV\x10\x01\x12\x14\n" +
+	"\x10ITEM_FORMAT_JSON\x10\x02\x12\x16\n" +
+	"\x12ITEM_FORMAT_NDJSON\x10\x03*\x9e\x01\n" +
+	"\rItemSortField\x12\x1f\n" +
+	"\x1bITEM_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14ITEM_SORT_FIELD_NAME\x10\x01\x12\x19\n" +
+	"\x15ITEM_SORT_FIELD_ADDED\x10\x02\x12\x19\n" +
+	"\x15ITEM_SORT_FIELD_PRICE\x10\x03\x12\x1c\n" +
+	"\x18ITEM_SORT_FIELD_QUANTITY\x10\x042\xe4\x06\n" +
 	"\vItemService\x12>\n" +
 	"\aGetItem\x12\x17.item.v1.GetItemRequest\x1a\x18.item.v1.GetItemResponse\"\x00\x12A\n" +
-	"\bGetItems\x12\x18.item.v1.GetItemsRequest\x1a\x19.item.v1.GetItemsResponse\"\x00\x12G\n" +
+	"\bGetItems\x12\x18.item.v1.GetItemsRequest\x1a\x19.item.v1.GetItemsResponse\"\x00\x12J\n" +
+	"\vSearchItems\x12\x1b.item.v1.SearchItemsRequest\x1a\x1c.item.v1.SearchItemsResponse\"\x00\x12G\n" +
 	"\n" +
 	"CreateItem\x12\x1a.item.v1.CreateItemRequest\x1a\x1b.item.v1.CreateItemResponse\"\x00\x12G\n" +
 	"\n" +
 	"UpdateItem\x12\x1a.item.v1.UpdateItemRequest\x1a\x1b.item.v1.UpdateItemResponse\"\x00\x12G\n" +
 	"\n" +
-	"DeleteItem\x12\x1a.item.v1.DeleteItemRequest\x1a\x1b.item.v1.DeleteItemResponse\"\x00B\x95\x01\n" +
+	"DeleteItem\x12\x1a.item.v1.DeleteItemRequest\x1a\x1b.item.v1.DeleteItemResponse\"\x00\x12Y\n" +
+	"\x10BatchCreateItems\x12 .item.v1.BatchCreateItemsRequest\x1a!.item.v1.BatchCreateItemsResponse\"\x00\x12Y\n" +
+	"\x10BatchUpdateItems\x12 .item.v1.BatchUpdateItemsRequest\x1a!.item.v1.BatchUpdateItemsResponse\"\x00\x12Y\n" +
+	"\x10BatchDeleteItems\x12 .item.v1.BatchDeleteItemsRequest\x1a!.item.v1.BatchDeleteItemsResponse\"\x00\x12L\n" +
+	"\vExportItems\x12\x1b.item.v1.ExportItemsRequest\x1a\x1c.item.v1.ExportItemsResponse\"\x000\x01\x12L\n" +
+	"\vImportItems\x12\x1b.item.v1.ImportItemsRequest\x1a\x1c.item.v1.ImportItemsResponse\"\x00(\x01B\x95\x01\n" +
 	"\vcom.item.v1B\tItemProtoP\x01Z>github.com/spotdemo4/ts-server/internal/connect/item/v1;itemv1\xa2\x02\x03IXX\xaa\x02\aItem.V1\xca\x02\aItem\\V1\xe2\x02\x13Item\\V1\\GPBMetadata\xea\x02\bItem::V1b\x06proto3"
 
 var (
@@ -710,43 +1929,93 @@ func file_item_v1_item_proto_rawDescGZIP() []byte {
 	return file_item_v1_item_proto_rawDescData
 }
 
-var file_item_v1_item_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_item_v1_item_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_item_v1_item_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_item_v1_item_proto_goTypes = []any{
-	(*Item)(nil),                  // 0: item.v1.Item
-	(*GetItemRequest)(nil),        // 1: item.v1.GetItemRequest
-	(*GetItemResponse)(nil),       // 2: item.v1.GetItemResponse
-	(*GetItemsRequest)(nil),       // 3: item.v1.GetItemsRequest
-	(*GetItemsResponse)(nil),      // 4: item.v1.GetItemsResponse
-	(*CreateItemRequest)(nil),     // 5: item.v1.CreateItemRequest
-	(*CreateItemResponse)(nil),    // 6: item.v1.CreateItemResponse
-	(*UpdateItemRequest)(nil),     // 7: item.v1.UpdateItemRequest
-	(*UpdateItemResponse)(nil),    // 8: item.v1.UpdateItemResponse
-	(*DeleteItemRequest)(nil),     // 9: item.v1.DeleteItemRequest
-	(*DeleteItemResponse)(nil),    // 10: item.v1.DeleteItemResponse
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(ItemFormat)(0),                  // 0: item.v1.ItemFormat
+	(ItemSortField)(0),               // 1: item.v1.ItemSortField
+	(*Item)(nil),                     // 2: item.v1.Item
+	(*GetItemRequest)(nil),           // 3: item.v1.GetItemRequest
+	(*GetItemResponse)(nil),          // 4: item.v1.GetItemResponse
+	(*ItemSort)(nil),                 // 5: item.v1.ItemSort
+	(*GetItemsRequest)(nil),          // 6: item.v1.GetItemsRequest
+	(*GetItemsResponse)(nil),         // 7: item.v1.GetItemsResponse
+	(*SearchItemsRequest)(nil),       // 8: item.v1.SearchItemsRequest
+	(*SearchItemsResponse)(nil),      // 9: item.v1.SearchItemsResponse
+	(*ItemSearchHit)(nil),            // 10: item.v1.ItemSearchHit
+	(*TextFragment)(nil),             // 11: item.v1.TextFragment
+	(*CreateItemRequest)(nil),        // 12: item.v1.CreateItemRequest
+	(*CreateItemResponse)(nil),       // 13: item.v1.CreateItemResponse
+	(*UpdateItemRequest)(nil),        // 14: item.v1.UpdateItemRequest
+	(*UpdateItemResponse)(nil),       // 15: item.v1.UpdateItemResponse
+	(*BatchCreateItemsRequest)(nil),  // 16: item.v1.BatchCreateItemsRequest
+	(*BatchCreateItemsResponse)(nil), // 17: item.v1.BatchCreateItemsResponse
+	(*BatchUpdateItemsRequest)(nil),  // 18: item.v1.BatchUpdateItemsRequest
+	(*BatchUpdateItemsResponse)(nil), // 19: item.v1.BatchUpdateItemsResponse
+	(*BatchDeleteItemsRequest)(nil),  // 20: item.v1.BatchDeleteItemsRequest
+	(*BatchDeleteItemsResponse)(nil), // 21: item.v1.BatchDeleteItemsResponse
+	(*BatchItemResult)(nil),          // 22: item.v1.BatchItemResult
+	(*ExportItemsRequest)(nil),       // 23: item.v1.ExportItemsRequest
+	(*ExportItemsResponse)(nil),      // 24: item.v1.ExportItemsResponse
+	(*ImportItemsRequest)(nil),       // 25: item.v1.ImportItemsRequest
+	(*ImportItemsResponse)(nil),      // 26: item.v1.ImportItemsResponse
+	(*ImportItemsError)(nil),         // 27: item.v1.ImportItemsError
+	(*DeleteItemRequest)(nil),        // 28: item.v1.DeleteItemRequest
+	(*DeleteItemResponse)(nil),       // 29: item.v1.DeleteItemResponse
+	(*timestamppb.Timestamp)(nil),    // 30: google.protobuf.Timestamp
 }
 var file_item_v1_item_proto_depIdxs = []int32{
-	11, // 0: item.v1.Item.added:type_name -> google.protobuf.Timestamp
-	0,  // 1: item.v1.GetItemResponse.item:type_name -> item.v1.Item
-	11, // 2: item.v1.GetItemsRequest.start:type_name -> google.protobuf.Timestamp
-	11, // 3: item.v1.GetItemsRequest.end:type_name -> google.protobuf.Timestamp
-	0,  // 4: item.v1.GetItemsResponse.items:type_name -> item.v1.Item
-	11, // 5: item.v1.CreateItemResponse.added:type_name -> google.protobuf.Timestamp
-	1,  // 6: item.v1.ItemService.GetItem:input_type -> item.v1.GetItemRequest
-	3,  // 7: item.v1.ItemService.GetItems:input_type -> item.v1.GetItemsRequest
-	5,  // 8: item.v1.ItemService.CreateItem:input_type -> item.v1.CreateItemRequest
-	7,  // 9: item.v1.ItemService.UpdateItem:input_type -> item.v1.UpdateItemRequest
-	9,  // 10: item.v1.ItemService.DeleteItem:input_type -> item.v1.DeleteItemRequest
-	2,  // 11: item.v1.ItemService.GetItem:output_type -> item.v1.GetItemResponse
-	4,  // 12: item.v1.ItemService.GetItems:output_type -> item.v1.GetItemsResponse
-	6,  // 13: item.v1.ItemService.CreateItem:output_type -> item.v1.CreateItemResponse
-	8,  // 14: item.v1.ItemService.UpdateItem:output_type -> item.v1.UpdateItemResponse
-	10, // 15: item.v1.ItemService.DeleteItem:output_type -> item.v1.DeleteItemResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	30, // 0: item.v1.Item.added:type_name -> google.protobuf.Timestamp
+	2,  // 1: item.v1.GetItemResponse.item:type_name -> item.v1.Item
+	1,  // 2: item.v1.ItemSort.field:type_name -> item.v1.ItemSortField
+	30, // 3: item.v1.GetItemsRequest.start:type_name -> google.protobuf.Timestamp
+	30, // 4: item.v1.GetItemsRequest.end:type_name -> google.protobuf.Timestamp
+	5,  // 5: item.v1.GetItemsRequest.sort:type_name -> item.v1.ItemSort
+	2,  // 6: item.v1.GetItemsResponse.items:type_name -> item.v1.Item
+	10, // 7: item.v1.SearchItemsResponse.hits:type_name -> item.v1.ItemSearchHit
+	2,  // 8: item.v1.ItemSearchHit.item:type_name -> item.v1.Item
+	11, // 9: item.v1.ItemSearchHit.name:type_name -> item.v1.TextFragment
+	11, // 10: item.v1.ItemSearchHit.description:type_name -> item.v1.TextFragment
+	30, // 11: item.v1.CreateItemResponse.added:type_name -> google.protobuf.Timestamp
+	12, // 12: item.v1.BatchCreateItemsRequest.requests:type_name -> item.v1.CreateItemRequest
+	22, // 13: item.v1.BatchCreateItemsResponse.results:type_name -> item.v1.BatchItemResult
+	14, // 14: item.v1.BatchUpdateItemsRequest.requests:type_name -> item.v1.UpdateItemRequest
+	22, // 15: item.v1.BatchUpdateItemsResponse.results:type_name -> item.v1.BatchItemResult
+	22, // 16: item.v1.BatchDeleteItemsResponse.results:type_name -> item.v1.BatchItemResult
+	2,  // 17: item.v1.BatchItemResult.item:type_name -> item.v1.Item
+	0,  // 18: item.v1.ExportItemsRequest.format:type_name -> item.v1.ItemFormat
+	30, // 19: item.v1.ExportItemsRequest.start:type_name -> google.protobuf.Timestamp
+	30, // 20: item.v1.ExportItemsRequest.end:type_name -> google.protobuf.Timestamp
+	5,  // 21: item.v1.ExportItemsRequest.sort:type_name -> item.v1.ItemSort
+	0,  // 22: item.v1.ImportItemsRequest.format:type_name -> item.v1.ItemFormat
+	27, // 23: item.v1.ImportItemsResponse.errors:type_name -> item.v1.ImportItemsError
+	3,  // 24: item.v1.ItemService.GetItem:input_type -> item.v1.GetItemRequest
+	6,  // 25: item.v1.ItemService.GetItems:input_type -> item.v1.GetItemsRequest
+	8,  // 26: item.v1.ItemService.SearchItems:input_type -> item.v1.SearchItemsRequest
+	12, // 27: item.v1.ItemService.CreateItem:input_type -> item.v1.CreateItemRequest
+	14, // 28: item.v1.ItemService.UpdateItem:input_type -> item.v1.UpdateItemRequest
+	28, // 29: item.v1.ItemService.DeleteItem:input_type -> item.v1.DeleteItemRequest
+	16, // 30: item.v1.ItemService.BatchCreateItems:input_type -> item.v1.BatchCreateItemsRequest
+	18, // 31: item.v1.ItemService.BatchUpdateItems:input_type -> item.v1.BatchUpdateItemsRequest
+	20, // 32: item.v1.ItemService.BatchDeleteItems:input_type -> item.v1.BatchDeleteItemsRequest
+	23, // 33: item.v1.ItemService.ExportItems:input_type -> item.v1.ExportItemsRequest
+	25, // 34: item.v1.ItemService.ImportItems:input_type -> item.v1.ImportItemsRequest
+	4,  // 35: item.v1.ItemService.GetItem:output_type -> item.v1.GetItemResponse
+	7,  // 36: item.v1.ItemService.GetItems:output_type -> item.v1.GetItemsResponse
+	9,  // 37: item.v1.ItemService.SearchItems:output_type -> item.v1.SearchItemsResponse
+	13, // 38: item.v1.ItemService.CreateItem:output_type -> item.v1.CreateItemResponse
+	15, // 39: item.v1.ItemService.UpdateItem:output_type -> item.v1.UpdateItemResponse
+	29, // 40: item.v1.ItemService.DeleteItem:output_type -> item.v1.DeleteItemResponse
+	17, // 41: item.v1.ItemService.BatchCreateItems:output_type -> item.v1.BatchCreateItemsResponse
+	19, // 42: item.v1.ItemService.BatchUpdateItems:output_type -> item.v1.BatchUpdateItemsResponse
+	21, // 43: item.v1.ItemService.BatchDeleteItems:output_type -> item.v1.BatchDeleteItemsResponse
+	24, // 44: item.v1.ItemService.ExportItems:output_type -> item.v1.ExportItemsResponse
+	26, // 45: item.v1.ItemService.ImportItems:output_type -> item.v1.ImportItemsResponse
+	35, // [35:46] is the sub-list for method output_type
+	24, // [24:35] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_item_v1_item_proto_init() }
@@ -754,20 +2023,24 @@ func file_item_v1_item_proto_init() {
 	if File_item_v1_item_proto != nil {
 		return
 	}
-	file_item_v1_item_proto_msgTypes[3].OneofWrappers = []any{}
-	file_item_v1_item_proto_msgTypes[7].OneofWrappers = []any{}
+	file_item_v1_item_proto_msgTypes[4].OneofWrappers = []any{}
+	file_item_v1_item_proto_msgTypes[5].OneofWrappers = []any{}
+	file_item_v1_item_proto_msgTypes[6].OneofWrappers = []any{}
+	file_item_v1_item_proto_msgTypes[12].OneofWrappers = []any{}
+	file_item_v1_item_proto_msgTypes[21].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_item_v1_item_proto_rawDesc), len(file_item_v1_item_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      2,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_item_v1_item_proto_goTypes,
 		DependencyIndexes: file_item_v1_item_proto_depIdxs,
+		EnumInfos:         file_item_v1_item_proto_enumTypes,
 		MessageInfos:      file_item_v1_item_proto_msgTypes,
 	}.Build()
 	File_item_v1_item_proto = out.File
@@ -37,21 +37,42 @@ const (
 	ItemServiceGetItemProcedure = "/item.v1.ItemService/GetItem"
 	// ItemServiceGetItemsProcedure is the fully-qualified name of the ItemService's GetItems RPC.
 	ItemServiceGetItemsProcedure = "/item.v1.ItemService/GetItems"
+	// ItemServiceSearchItemsProcedure is the fully-qualified name of the ItemService's SearchItems RPC.
+	ItemServiceSearchItemsProcedure = "/item.v1.ItemService/SearchItems"
 	// ItemServiceCreateItemProcedure is the fully-qualified name of the ItemService's CreateItem RPC.
 	ItemServiceCreateItemProcedure = "/item.v1.ItemService/CreateItem"
 	// ItemServiceUpdateItemProcedure is the fully-qualified name of the ItemService's UpdateItem RPC.
 	ItemServiceUpdateItemProcedure = "/item.v1.ItemService/UpdateItem"
 	// ItemServiceDeleteItemProcedure is the fully-qualified name of the ItemService's DeleteItem RPC.
 	ItemServiceDeleteItemProcedure = "/item.v1.ItemService/DeleteItem"
+	// ItemServiceBatchCreateItemsProcedure is the fully-qualified name of the ItemService's
+	// BatchCreateItems RPC.
+	ItemServiceBatchCreateItemsProcedure = "/item.v1.ItemService/BatchCreateItems"
+	// ItemServiceBatchUpdateItemsProcedure is the fully-qualified name of the ItemService's
+	// BatchUpdateItems RPC.
+	ItemServiceBatchUpdateItemsProcedure = "/item.v1.ItemService/BatchUpdateItems"
+	// ItemServiceBatchDeleteItemsProcedure is the fully-qualified name of the ItemService's
+	// BatchDeleteItems RPC.
+	ItemServiceBatchDeleteItemsProcedure = "/item.v1.ItemService/BatchDeleteItems"
+	// ItemServiceExportItemsProcedure is the fully-qualified name of the ItemService's ExportItems RPC.
+	ItemServiceExportItemsProcedure = "/item.v1.ItemService/ExportItems"
+	// ItemServiceImportItemsProcedure is the fully-qualified name of the ItemService's ImportItems RPC.
+	ItemServiceImportItemsProcedure = "/item.v1.ItemService/ImportItems"
 )
 
 // ItemServiceClient is a client for the item.v1.ItemService service.
 type ItemServiceClient interface {
 	GetItem(context.Context, *connect.Request[v1.GetItemRequest]) (*connect.Response[v1.GetItemResponse], error)
 	GetItems(context.Context, *connect.Request[v1.GetItemsRequest]) (*connect.Response[v1.GetItemsResponse], error)
+	SearchItems(context.Context, *connect.Request[v1.SearchItemsRequest]) (*connect.Response[v1.SearchItemsResponse], error)
 	CreateItem(context.Context, *connect.Request[v1.CreateItemRequest]) (*connect.Response[v1.CreateItemResponse], error)
 	UpdateItem(context.Context, *connect.Request[v1.UpdateItemRequest]) (*connect.Response[v1.UpdateItemResponse], error)
 	DeleteItem(context.Context, *connect.Request[v1.DeleteItemRequest]) (*connect.Response[v1.DeleteItemResponse], error)
+	BatchCreateItems(context.Context, *connect.Request[v1.BatchCreateItemsRequest]) (*connect.Response[v1.BatchCreateItemsResponse], error)
+	BatchUpdateItems(context.Context, *connect.Request[v1.BatchUpdateItemsRequest]) (*connect.Response[v1.BatchUpdateItemsResponse], error)
+	BatchDeleteItems(context.Context, *connect.Request[v1.BatchDeleteItemsRequest]) (*connect.Response[v1.BatchDeleteItemsResponse], error)
+	ExportItems(context.Context, *connect.Request[v1.ExportItemsRequest]) (*connect.ServerStreamForClient[v1.ExportItemsResponse], error)
+	ImportItems(context.Context) *connect.ClientStreamForClient[v1.ImportItemsRequest, v1.ImportItemsResponse]
 }
 
 // NewItemServiceClient constructs a client for the item.v1.ItemService service. By default, it uses
@@ -77,6 +98,12 @@ func NewItemServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(itemServiceMethods.ByName("GetItems")),
 			connect.WithClientOptions(opts...),
 		),
+		searchItems: connect.NewClient[v1.SearchItemsRequest, v1.SearchItemsResponse](
+			httpClient,
+			baseURL+ItemServiceSearchItemsProcedure,
+			connect.WithSchema(itemServiceMethods.ByName("SearchItems")),
+			connect.WithClientOptions(opts...),
+		),
 		createItem: connect.NewClient[v1.CreateItemRequest, v1.CreateItemResponse](
 			httpClient,
 			baseURL+ItemServiceCreateItemProcedure,
@@ -95,16 +122,52 @@ func NewItemServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(itemServiceMethods.ByName("DeleteItem")),
 			connect.WithClientOptions(opts...),
 		),
+		batchCreateItems: connect.NewClient[v1.BatchCreateItemsRequest, v1.BatchCreateItemsResponse](
+			httpClient,
+			baseURL+ItemServiceBatchCreateItemsProcedure,
+			connect.WithSchema(itemServiceMethods.ByName("BatchCreateItems")),
+			connect.WithClientOptions(opts...),
+		),
+		batchUpdateItems: connect.NewClient[v1.BatchUpdateItemsRequest, v1.BatchUpdateItemsResponse](
+			httpClient,
+			baseURL+ItemServiceBatchUpdateItemsProcedure,
+			connect.WithSchema(itemServiceMethods.ByName("BatchUpdateItems")),
+			connect.WithClientOptions(opts...),
+		),
+		batchDeleteItems: connect.NewClient[v1.BatchDeleteItemsRequest, v1.BatchDeleteItemsResponse](
+			httpClient,
+			baseURL+ItemServiceBatchDeleteItemsProcedure,
+			connect.WithSchema(itemServiceMethods.ByName("BatchDeleteItems")),
+			connect.WithClientOptions(opts...),
+		),
+		exportItems: connect.NewClient[v1.ExportItemsRequest, v1.ExportItemsResponse](
+			httpClient,
+			baseURL+ItemServiceExportItemsProcedure,
+			connect.WithSchema(itemServiceMethods.ByName("ExportItems")),
+			connect.WithClientOptions(opts...),
+		),
+		importItems: connect.NewClient[v1.ImportItemsRequest, v1.ImportItemsResponse](
+			httpClient,
+			baseURL+ItemServiceImportItemsProcedure,
+			connect.WithSchema(itemServiceMethods.ByName("ImportItems")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // itemServiceClient implements ItemServiceClient.
 type itemServiceClient struct {
-	getItem    *connect.Client[v1.GetItemRequest, v1.GetItemResponse]
-	getItems   *connect.Client[v1.GetItemsRequest, v1.GetItemsResponse]
-	createItem *connect.Client[v1.CreateItemRequest, v1.CreateItemResponse]
-	updateItem *connect.Client[v1.UpdateItemRequest, v1.UpdateItemResponse]
-	deleteItem *connect.Client[v1.DeleteItemRequest, v1.DeleteItemResponse]
+	getItem          *connect.Client[v1.GetItemRequest, v1.GetItemResponse]
+	getItems         *connect.Client[v1.GetItemsRequest, v1.GetItemsResponse]
+	searchItems      *connect.Client[v1.SearchItemsRequest, v1.SearchItemsResponse]
+	createItem       *connect.Client[v1.CreateItemRequest, v1.CreateItemResponse]
+	updateItem       *connect.Client[v1.UpdateItemRequest, v1.UpdateItemResponse]
+	deleteItem       *connect.Client[v1.DeleteItemRequest, v1.DeleteItemResponse]
+	batchCreateItems *connect.Client[v1.BatchCreateItemsRequest, v1.BatchCreateItemsResponse]
+	batchUpdateItems *connect.Client[v1.BatchUpdateItemsRequest, v1.BatchUpdateItemsResponse]
+	batchDeleteItems *connect.Client[v1.BatchDeleteItemsRequest, v1.BatchDeleteItemsResponse]
+	exportItems      *connect.Client[v1.ExportItemsRequest, v1.ExportItemsResponse]
+	importItems      *connect.Client[v1.ImportItemsRequest, v1.ImportItemsResponse]
 }
 
 // GetItem calls item.v1.ItemService.GetItem.
@@ -117,6 +180,11 @@ func (c *itemServiceClient) GetItems(ctx context.Context, req *connect.Request[v
 	return c.getItems.CallUnary(ctx, req)
 }
 
+// SearchItems calls item.v1.ItemService.SearchItems.
+func (c *itemServiceClient) SearchItems(ctx context.Context, req *connect.Request[v1.SearchItemsRequest]) (*connect.Response[v1.SearchItemsResponse], error) {
+	return c.searchItems.CallUnary(ctx, req)
+}
+
 // CreateItem calls item.v1.ItemService.CreateItem.
 func (c *itemServiceClient) CreateItem(ctx context.Context, req *connect.Request[v1.CreateItemRequest]) (*connect.Response[v1.CreateItemResponse], error) {
 	return c.createItem.CallUnary(ctx, req)
@@ -132,13 +200,44 @@ func (c *itemServiceClient) DeleteItem(ctx context.Context, req *connect.Request
 	return c.deleteItem.CallUnary(ctx, req)
 }
 
+// BatchCreateItems calls item.v1.ItemService.BatchCreateItems.
+func (c *itemServiceClient) BatchCreateItems(ctx context.Context, req *connect.Request[v1.BatchCreateItemsRequest]) (*connect.Response[v1.BatchCreateItemsResponse], error) {
+	return c.batchCreateItems.CallUnary(ctx, req)
+}
+
+// BatchUpdateItems calls item.v1.ItemService.BatchUpdateItems.
+func (c *itemServiceClient) BatchUpdateItems(ctx context.Context, req *connect.Request[v1.BatchUpdateItemsRequest]) (*connect.Response[v1.BatchUpdateItemsResponse], error) {
+	return c.batchUpdateItems.CallUnary(ctx, req)
+}
+
+// BatchDeleteItems calls item.v1.ItemService.BatchDeleteItems.
+func (c *itemServiceClient) BatchDeleteItems(ctx context.Context, req *connect.Request[v1.BatchDeleteItemsRequest]) (*connect.Response[v1.BatchDeleteItemsResponse], error) {
+	return c.batchDeleteItems.CallUnary(ctx, req)
+}
+
+// ExportItems calls item.v1.ItemService.ExportItems.
+func (c *itemServiceClient) ExportItems(ctx context.Context, req *connect.Request[v1.ExportItemsRequest]) (*connect.ServerStreamForClient[v1.ExportItemsResponse], error) {
+	return c.exportItems.CallServerStream(ctx, req)
+}
+
+// ImportItems calls item.v1.ItemService.ImportItems.
+func (c *itemServiceClient) ImportItems(ctx context.Context) *connect.ClientStreamForClient[v1.ImportItemsRequest, v1.ImportItemsResponse] {
+	return c.importItems.CallClientStream(ctx)
+}
+
 // ItemServiceHandler is an implementation of the item.v1.ItemService service.
 type ItemServiceHandler interface {
 	GetItem(context.Context, *connect.Request[v1.GetItemRequest]) (*connect.Response[v1.GetItemResponse], error)
 	GetItems(context.Context, *connect.Request[v1.GetItemsRequest]) (*connect.Response[v1.GetItemsResponse], error)
+	SearchItems(context.Context, *connect.Request[v1.SearchItemsRequest]) (*connect.Response[v1.SearchItemsResponse], error)
 	CreateItem(context.Context, *connect.Request[v1.CreateItemRequest]) (*connect.Response[v1.CreateItemResponse], error)
 	UpdateItem(context.Context, *connect.Request[v1.UpdateItemRequest]) (*connect.Response[v1.UpdateItemResponse], error)
 	DeleteItem(context.Context, *connect.Request[v1.DeleteItemRequest]) (*connect.Response[v1.DeleteItemResponse], error)
+	BatchCreateItems(context.Context, *connect.Request[v1.BatchCreateItemsRequest]) (*connect.Response[v1.BatchCreateItemsResponse], error)
+	BatchUpdateItems(context.Context, *connect.Request[v1.BatchUpdateItemsRequest]) (*connect.Response[v1.BatchUpdateItemsResponse], error)
+	BatchDeleteItems(context.Context, *connect.Request[v1.BatchDeleteItemsRequest]) (*connect.Response[v1.BatchDeleteItemsResponse], error)
+	ExportItems(context.Context, *connect.Request[v1.ExportItemsRequest], *connect.ServerStream[v1.ExportItemsResponse]) error
+	ImportItems(context.Context, *connect.ClientStream[v1.ImportItemsRequest]) (*connect.Response[v1.ImportItemsResponse], error)
 }
 
 // NewItemServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -160,6 +259,12 @@ func NewItemServiceHandler(svc ItemServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(itemServiceMethods.ByName("GetItems")),
 		connect.WithHandlerOptions(opts...),
 	)
+	itemServiceSearchItemsHandler := connect.NewUnaryHandler(
+		ItemServiceSearchItemsProcedure,
+		svc.SearchItems,
+		connect.WithSchema(itemServiceMethods.ByName("SearchItems")),
+		connect.WithHandlerOptions(opts...),
+	)
 	itemServiceCreateItemHandler := connect.NewUnaryHandler(
 		ItemServiceCreateItemProcedure,
 		svc.CreateItem,
@@ -178,18 +283,60 @@ func NewItemServiceHandler(svc ItemServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(itemServiceMethods.ByName("DeleteItem")),
 		connect.WithHandlerOptions(opts...),
 	)
+	itemServiceBatchCreateItemsHandler := connect.NewUnaryHandler(
+		ItemServiceBatchCreateItemsProcedure,
+		svc.BatchCreateItems,
+		connect.WithSchema(itemServiceMethods.ByName("BatchCreateItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	itemServiceBatchUpdateItemsHandler := connect.NewUnaryHandler(
+		ItemServiceBatchUpdateItemsProcedure,
+		svc.BatchUpdateItems,
+		connect.WithSchema(itemServiceMethods.ByName("BatchUpdateItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	itemServiceBatchDeleteItemsHandler := connect.NewUnaryHandler(
+		ItemServiceBatchDeleteItemsProcedure,
+		svc.BatchDeleteItems,
+		connect.WithSchema(itemServiceMethods.ByName("BatchDeleteItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	itemServiceExportItemsHandler := connect.NewServerStreamHandler(
+		ItemServiceExportItemsProcedure,
+		svc.ExportItems,
+		connect.WithSchema(itemServiceMethods.ByName("ExportItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	itemServiceImportItemsHandler := connect.NewClientStreamHandler(
+		ItemServiceImportItemsProcedure,
+		svc.ImportItems,
+		connect.WithSchema(itemServiceMethods.ByName("ImportItems")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/item.v1.ItemService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ItemServiceGetItemProcedure:
 			itemServiceGetItemHandler.ServeHTTP(w, r)
 		case ItemServiceGetItemsProcedure:
 			itemServiceGetItemsHandler.ServeHTTP(w, r)
+		case ItemServiceSearchItemsProcedure:
+			itemServiceSearchItemsHandler.ServeHTTP(w, r)
 		case ItemServiceCreateItemProcedure:
 			itemServiceCreateItemHandler.ServeHTTP(w, r)
 		case ItemServiceUpdateItemProcedure:
 			itemServiceUpdateItemHandler.ServeHTTP(w, r)
 		case ItemServiceDeleteItemProcedure:
 			itemServiceDeleteItemHandler.ServeHTTP(w, r)
+		case ItemServiceBatchCreateItemsProcedure:
+			itemServiceBatchCreateItemsHandler.ServeHTTP(w, r)
+		case ItemServiceBatchUpdateItemsProcedure:
+			itemServiceBatchUpdateItemsHandler.ServeHTTP(w, r)
+		case ItemServiceBatchDeleteItemsProcedure:
+			itemServiceBatchDeleteItemsHandler.ServeHTTP(w, r)
+		case ItemServiceExportItemsProcedure:
+			itemServiceExportItemsHandler.ServeHTTP(w, r)
+		case ItemServiceImportItemsProcedure:
+			itemServiceImportItemsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -207,6 +354,10 @@ func (UnimplementedItemServiceHandler) GetItems(context.Context, *connect.Reques
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("item.v1.ItemService.GetItems is not implemented"))
 }
 
+func (UnimplementedItemServiceHandler) SearchItems(context.Context, *connect.Request[v1.SearchItemsRequest]) (*connect.Response[v1.SearchItemsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("item.v1.ItemService.SearchItems is not implemented"))
+}
+
 func (UnimplementedItemServiceHandler) CreateItem(context.Context, *connect.Request[v1.CreateItemRequest]) (*connect.Response[v1.CreateItemResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("item.v1.ItemService.CreateItem is not implemented"))
 }
@@ -218,3 +369,23 @@ func (UnimplementedItemServiceHandler) UpdateItem(context.Context, *connect.Requ
 func (UnimplementedItemServiceHandler) DeleteItem(context.Context, *connect.Request[v1.DeleteItemRequest]) (*connect.Response[v1.DeleteItemResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("item.v1.ItemService.DeleteItem is not implemented"))
 }
+
+func (UnimplementedItemServiceHandler) BatchCreateItems(context.Context, *connect.Request[v1.BatchCreateItemsRequest]) (*connect.Response[v1.BatchCreateItemsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("item.v1.ItemService.BatchCreateItems is not implemented"))
+}
+
+func (UnimplementedItemServiceHandler) BatchUpdateItems(context.Context, *connect.Request[v1.BatchUpdateItemsRequest]) (*connect.Response[v1.BatchUpdateItemsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("item.v1.ItemService.BatchUpdateItems is not implemented"))
+}
+
+func (UnimplementedItemServiceHandler) BatchDeleteItems(context.Context, *connect.Request[v1.BatchDeleteItemsRequest]) (*connect.Response[v1.BatchDeleteItemsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("item.v1.ItemService.BatchDeleteItems is not implemented"))
+}
+
+func (UnimplementedItemServiceHandler) ExportItems(context.Context, *connect.Request[v1.ExportItemsRequest], *connect.ServerStream[v1.ExportItemsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("item.v1.ItemService.ExportItems is not implemented"))
+}
+
+func (UnimplementedItemServiceHandler) ImportItems(context.Context, *connect.ClientStream[v1.ImportItemsRequest]) (*connect.Response[v1.ImportItemsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("item.v1.ItemService.ImportItems is not implemented"))
+}
@@ -49,16 +49,100 @@ const (
 	// UserServiceFinishPasskeyRegistrationProcedure is the fully-qualified name of the UserService's
 	// FinishPasskeyRegistration RPC.
 	UserServiceFinishPasskeyRegistrationProcedure = "/user.v1.UserService/FinishPasskeyRegistration"
+	// UserServiceListSessionsProcedure is the fully-qualified name of the UserService's ListSessions
+	// RPC.
+	UserServiceListSessionsProcedure = "/user.v1.UserService/ListSessions"
+	// UserServiceRevokeSessionProcedure is the fully-qualified name of the UserService's RevokeSession
+	// RPC.
+	UserServiceRevokeSessionProcedure = "/user.v1.UserService/RevokeSession"
+	// UserServiceRevokeAllOtherSessionsProcedure is the fully-qualified name of the UserService's
+	// RevokeAllOtherSessions RPC.
+	UserServiceRevokeAllOtherSessionsProcedure = "/user.v1.UserService/RevokeAllOtherSessions"
+	// UserServiceCreateAPIKeyProcedure is the fully-qualified name of the UserService's CreateAPIKey
+	// RPC.
+	UserServiceCreateAPIKeyProcedure = "/user.v1.UserService/CreateAPIKey"
+	// UserServiceListAPIKeysProcedure is the fully-qualified name of the UserService's ListAPIKeys RPC.
+	UserServiceListAPIKeysProcedure = "/user.v1.UserService/ListAPIKeys"
+	// UserServiceRevokeAPIKeyProcedure is the fully-qualified name of the UserService's RevokeAPIKey
+	// RPC.
+	UserServiceRevokeAPIKeyProcedure = "/user.v1.UserService/RevokeAPIKey"
+	// UserServiceBeginTOTPEnrollmentProcedure is the fully-qualified name of the UserService's
+	// BeginTOTPEnrollment RPC.
+	UserServiceBeginTOTPEnrollmentProcedure = "/user.v1.UserService/BeginTOTPEnrollment"
+	// UserServiceFinishTOTPEnrollmentProcedure is the fully-qualified name of the UserService's
+	// FinishTOTPEnrollment RPC.
+	UserServiceFinishTOTPEnrollmentProcedure = "/user.v1.UserService/FinishTOTPEnrollment"
+	// UserServiceDisableTOTPProcedure is the fully-qualified name of the UserService's DisableTOTP RPC.
+	UserServiceDisableTOTPProcedure = "/user.v1.UserService/DisableTOTP"
+	// UserServiceRegenerateRecoveryCodesProcedure is the fully-qualified name of the UserService's
+	// RegenerateRecoveryCodes RPC.
+	UserServiceRegenerateRecoveryCodesProcedure = "/user.v1.UserService/RegenerateRecoveryCodes"
+	// UserServiceListPasskeysProcedure is the fully-qualified name of the UserService's ListPasskeys
+	// RPC.
+	UserServiceListPasskeysProcedure = "/user.v1.UserService/ListPasskeys"
+	// UserServiceRenamePasskeyProcedure is the fully-qualified name of the UserService's RenamePasskey
+	// RPC.
+	UserServiceRenamePasskeyProcedure = "/user.v1.UserService/RenamePasskey"
+	// UserServiceDeletePasskeyProcedure is the fully-qualified name of the UserService's DeletePasskey
+	// RPC.
+	UserServiceDeletePasskeyProcedure = "/user.v1.UserService/DeletePasskey"
+	// UserServiceUpdateEmailProcedure is the fully-qualified name of the UserService's UpdateEmail RPC.
+	UserServiceUpdateEmailProcedure = "/user.v1.UserService/UpdateEmail"
+	// UserServiceListIdentitiesProcedure is the fully-qualified name of the UserService's
+	// ListIdentities RPC.
+	UserServiceListIdentitiesProcedure = "/user.v1.UserService/ListIdentities"
+	// UserServiceDeleteIdentityProcedure is the fully-qualified name of the UserService's
+	// DeleteIdentity RPC.
+	UserServiceDeleteIdentityProcedure = "/user.v1.UserService/DeleteIdentity"
+	// UserServiceListAuditEventsProcedure is the fully-qualified name of the UserService's
+	// ListAuditEvents RPC.
+	UserServiceListAuditEventsProcedure = "/user.v1.UserService/ListAuditEvents"
+	// UserServiceUpdateUsernameProcedure is the fully-qualified name of the UserService's
+	// UpdateUsername RPC.
+	UserServiceUpdateUsernameProcedure = "/user.v1.UserService/UpdateUsername"
+	// UserServiceDeleteAccountProcedure is the fully-qualified name of the UserService's DeleteAccount
+	// RPC.
+	UserServiceDeleteAccountProcedure = "/user.v1.UserService/DeleteAccount"
+	// UserServiceExportAccountProcedure is the fully-qualified name of the UserService's ExportAccount
+	// RPC.
+	UserServiceExportAccountProcedure = "/user.v1.UserService/ExportAccount"
+	// UserServiceGetAccountExportProcedure is the fully-qualified name of the UserService's
+	// GetAccountExport RPC.
+	UserServiceGetAccountExportProcedure = "/user.v1.UserService/GetAccountExport"
 )
 
 // UserServiceClient is a client for the user.v1.UserService service.
 type UserServiceClient interface {
 	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
 	UpdatePassword(context.Context, *connect.Request[v1.UpdatePasswordRequest]) (*connect.Response[v1.UpdatePasswordResponse], error)
+	// Creates an API key with the item and user scopes that expires after a day, use CreateAPIKey instead
+	//
+	// Deprecated: do not use.
 	GetAPIKey(context.Context, *connect.Request[v1.GetAPIKeyRequest]) (*connect.Response[v1.GetAPIKeyResponse], error)
 	UpdateProfilePicture(context.Context, *connect.Request[v1.UpdateProfilePictureRequest]) (*connect.Response[v1.UpdateProfilePictureResponse], error)
 	BeginPasskeyRegistration(context.Context, *connect.Request[v1.BeginPasskeyRegistrationRequest]) (*connect.Response[v1.BeginPasskeyRegistrationResponse], error)
 	FinishPasskeyRegistration(context.Context, *connect.Request[v1.FinishPasskeyRegistrationRequest]) (*connect.Response[v1.FinishPasskeyRegistrationResponse], error)
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	RevokeAllOtherSessions(context.Context, *connect.Request[v1.RevokeAllOtherSessionsRequest]) (*connect.Response[v1.RevokeAllOtherSessionsResponse], error)
+	CreateAPIKey(context.Context, *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error)
+	ListAPIKeys(context.Context, *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error)
+	RevokeAPIKey(context.Context, *connect.Request[v1.RevokeAPIKeyRequest]) (*connect.Response[v1.RevokeAPIKeyResponse], error)
+	BeginTOTPEnrollment(context.Context, *connect.Request[v1.BeginTOTPEnrollmentRequest]) (*connect.Response[v1.BeginTOTPEnrollmentResponse], error)
+	FinishTOTPEnrollment(context.Context, *connect.Request[v1.FinishTOTPEnrollmentRequest]) (*connect.Response[v1.FinishTOTPEnrollmentResponse], error)
+	DisableTOTP(context.Context, *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error)
+	RegenerateRecoveryCodes(context.Context, *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error)
+	ListPasskeys(context.Context, *connect.Request[v1.ListPasskeysRequest]) (*connect.Response[v1.ListPasskeysResponse], error)
+	RenamePasskey(context.Context, *connect.Request[v1.RenamePasskeyRequest]) (*connect.Response[v1.RenamePasskeyResponse], error)
+	DeletePasskey(context.Context, *connect.Request[v1.DeletePasskeyRequest]) (*connect.Response[v1.DeletePasskeyResponse], error)
+	UpdateEmail(context.Context, *connect.Request[v1.UpdateEmailRequest]) (*connect.Response[v1.UpdateEmailResponse], error)
+	ListIdentities(context.Context, *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error)
+	DeleteIdentity(context.Context, *connect.Request[v1.DeleteIdentityRequest]) (*connect.Response[v1.DeleteIdentityResponse], error)
+	ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error)
+	UpdateUsername(context.Context, *connect.Request[v1.UpdateUsernameRequest]) (*connect.Response[v1.UpdateUsernameResponse], error)
+	DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error)
+	ExportAccount(context.Context, *connect.Request[v1.ExportAccountRequest]) (*connect.Response[v1.ExportAccountResponse], error)
+	GetAccountExport(context.Context, *connect.Request[v1.GetAccountExportRequest]) (*connect.Response[v1.GetAccountExportResponse], error)
 }
 
 // NewUserServiceClient constructs a client for the user.v1.UserService service. By default, it uses
@@ -108,6 +192,132 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("FinishPasskeyRegistration")),
 			connect.WithClientOptions(opts...),
 		),
+		listSessions: connect.NewClient[v1.ListSessionsRequest, v1.ListSessionsResponse](
+			httpClient,
+			baseURL+UserServiceListSessionsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeSession: connect.NewClient[v1.RevokeSessionRequest, v1.RevokeSessionResponse](
+			httpClient,
+			baseURL+UserServiceRevokeSessionProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RevokeSession")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAllOtherSessions: connect.NewClient[v1.RevokeAllOtherSessionsRequest, v1.RevokeAllOtherSessionsResponse](
+			httpClient,
+			baseURL+UserServiceRevokeAllOtherSessionsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RevokeAllOtherSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		createAPIKey: connect.NewClient[v1.CreateAPIKeyRequest, v1.CreateAPIKeyResponse](
+			httpClient,
+			baseURL+UserServiceCreateAPIKeyProcedure,
+			connect.WithSchema(userServiceMethods.ByName("CreateAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		listAPIKeys: connect.NewClient[v1.ListAPIKeysRequest, v1.ListAPIKeysResponse](
+			httpClient,
+			baseURL+UserServiceListAPIKeysProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListAPIKeys")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAPIKey: connect.NewClient[v1.RevokeAPIKeyRequest, v1.RevokeAPIKeyResponse](
+			httpClient,
+			baseURL+UserServiceRevokeAPIKeyProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RevokeAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		beginTOTPEnrollment: connect.NewClient[v1.BeginTOTPEnrollmentRequest, v1.BeginTOTPEnrollmentResponse](
+			httpClient,
+			baseURL+UserServiceBeginTOTPEnrollmentProcedure,
+			connect.WithSchema(userServiceMethods.ByName("BeginTOTPEnrollment")),
+			connect.WithClientOptions(opts...),
+		),
+		finishTOTPEnrollment: connect.NewClient[v1.FinishTOTPEnrollmentRequest, v1.FinishTOTPEnrollmentResponse](
+			httpClient,
+			baseURL+UserServiceFinishTOTPEnrollmentProcedure,
+			connect.WithSchema(userServiceMethods.ByName("FinishTOTPEnrollment")),
+			connect.WithClientOptions(opts...),
+		),
+		disableTOTP: connect.NewClient[v1.DisableTOTPRequest, v1.DisableTOTPResponse](
+			httpClient,
+			baseURL+UserServiceDisableTOTPProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DisableTOTP")),
+			connect.WithClientOptions(opts...),
+		),
+		regenerateRecoveryCodes: connect.NewClient[v1.RegenerateRecoveryCodesRequest, v1.RegenerateRecoveryCodesResponse](
+			httpClient,
+			baseURL+UserServiceRegenerateRecoveryCodesProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RegenerateRecoveryCodes")),
+			connect.WithClientOptions(opts...),
+		),
+		listPasskeys: connect.NewClient[v1.ListPasskeysRequest, v1.ListPasskeysResponse](
+			httpClient,
+			baseURL+UserServiceListPasskeysProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListPasskeys")),
+			connect.WithClientOptions(opts...),
+		),
+		renamePasskey: connect.NewClient[v1.RenamePasskeyRequest, v1.RenamePasskeyResponse](
+			httpClient,
+			baseURL+UserServiceRenamePasskeyProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RenamePasskey")),
+			connect.WithClientOptions(opts...),
+		),
+		deletePasskey: connect.NewClient[v1.DeletePasskeyRequest, v1.DeletePasskeyResponse](
+			httpClient,
+			baseURL+UserServiceDeletePasskeyProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeletePasskey")),
+			connect.WithClientOptions(opts...),
+		),
+		updateEmail: connect.NewClient[v1.UpdateEmailRequest, v1.UpdateEmailResponse](
+			httpClient,
+			baseURL+UserServiceUpdateEmailProcedure,
+			connect.WithSchema(userServiceMethods.ByName("UpdateEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		listIdentities: connect.NewClient[v1.ListIdentitiesRequest, v1.ListIdentitiesResponse](
+			httpClient,
+			baseURL+UserServiceListIdentitiesProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListIdentities")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteIdentity: connect.NewClient[v1.DeleteIdentityRequest, v1.DeleteIdentityResponse](
+			httpClient,
+			baseURL+UserServiceDeleteIdentityProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeleteIdentity")),
+			connect.WithClientOptions(opts...),
+		),
+		listAuditEvents: connect.NewClient[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse](
+			httpClient,
+			baseURL+UserServiceListAuditEventsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListAuditEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		updateUsername: connect.NewClient[v1.UpdateUsernameRequest, v1.UpdateUsernameResponse](
+			httpClient,
+			baseURL+UserServiceUpdateUsernameProcedure,
+			connect.WithSchema(userServiceMethods.ByName("UpdateUsername")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAccount: connect.NewClient[v1.DeleteAccountRequest, v1.DeleteAccountResponse](
+			httpClient,
+			baseURL+UserServiceDeleteAccountProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeleteAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		exportAccount: connect.NewClient[v1.ExportAccountRequest, v1.ExportAccountResponse](
+			httpClient,
+			baseURL+UserServiceExportAccountProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ExportAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		getAccountExport: connect.NewClient[v1.GetAccountExportRequest, v1.GetAccountExportResponse](
+			httpClient,
+			baseURL+UserServiceGetAccountExportProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetAccountExport")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -119,6 +329,27 @@ type userServiceClient struct {
 	updateProfilePicture      *connect.Client[v1.UpdateProfilePictureRequest, v1.UpdateProfilePictureResponse]
 	beginPasskeyRegistration  *connect.Client[v1.BeginPasskeyRegistrationRequest, v1.BeginPasskeyRegistrationResponse]
 	finishPasskeyRegistration *connect.Client[v1.FinishPasskeyRegistrationRequest, v1.FinishPasskeyRegistrationResponse]
+	listSessions              *connect.Client[v1.ListSessionsRequest, v1.ListSessionsResponse]
+	revokeSession             *connect.Client[v1.RevokeSessionRequest, v1.RevokeSessionResponse]
+	revokeAllOtherSessions    *connect.Client[v1.RevokeAllOtherSessionsRequest, v1.RevokeAllOtherSessionsResponse]
+	createAPIKey              *connect.Client[v1.CreateAPIKeyRequest, v1.CreateAPIKeyResponse]
+	listAPIKeys               *connect.Client[v1.ListAPIKeysRequest, v1.ListAPIKeysResponse]
+	revokeAPIKey              *connect.Client[v1.RevokeAPIKeyRequest, v1.RevokeAPIKeyResponse]
+	beginTOTPEnrollment       *connect.Client[v1.BeginTOTPEnrollmentRequest, v1.BeginTOTPEnrollmentResponse]
+	finishTOTPEnrollment      *connect.Client[v1.FinishTOTPEnrollmentRequest, v1.FinishTOTPEnrollmentResponse]
+	disableTOTP               *connect.Client[v1.DisableTOTPRequest, v1.DisableTOTPResponse]
+	regenerateRecoveryCodes   *connect.Client[v1.RegenerateRecoveryCodesRequest, v1.RegenerateRecoveryCodesResponse]
+	listPasskeys              *connect.Client[v1.ListPasskeysRequest, v1.ListPasskeysResponse]
+	renamePasskey             *connect.Client[v1.RenamePasskeyRequest, v1.RenamePasskeyResponse]
+	deletePasskey             *connect.Client[v1.DeletePasskeyRequest, v1.DeletePasskeyResponse]
+	updateEmail               *connect.Client[v1.UpdateEmailRequest, v1.UpdateEmailResponse]
+	listIdentities            *connect.Client[v1.ListIdentitiesRequest, v1.ListIdentitiesResponse]
+	deleteIdentity            *connect.Client[v1.DeleteIdentityRequest, v1.DeleteIdentityResponse]
+	listAuditEvents           *connect.Client[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse]
+	updateUsername            *connect.Client[v1.UpdateUsernameRequest, v1.UpdateUsernameResponse]
+	deleteAccount             *connect.Client[v1.DeleteAccountRequest, v1.DeleteAccountResponse]
+	exportAccount             *connect.Client[v1.ExportAccountRequest, v1.ExportAccountResponse]
+	getAccountExport          *connect.Client[v1.GetAccountExportRequest, v1.GetAccountExportResponse]
 }
 
 // GetUser calls user.v1.UserService.GetUser.
@@ -132,6 +363,8 @@ func (c *userServiceClient) UpdatePassword(ctx context.Context, req *connect.Req
 }
 
 // GetAPIKey calls user.v1.UserService.GetAPIKey.
+//
+// Deprecated: do not use.
 func (c *userServiceClient) GetAPIKey(ctx context.Context, req *connect.Request[v1.GetAPIKeyRequest]) (*connect.Response[v1.GetAPIKeyResponse], error) {
 	return c.getAPIKey.CallUnary(ctx, req)
 }
@@ -151,14 +384,143 @@ func (c *userServiceClient) FinishPasskeyRegistration(ctx context.Context, req *
 	return c.finishPasskeyRegistration.CallUnary(ctx, req)
 }
 
+// ListSessions calls user.v1.UserService.ListSessions.
+func (c *userServiceClient) ListSessions(ctx context.Context, req *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return c.listSessions.CallUnary(ctx, req)
+}
+
+// RevokeSession calls user.v1.UserService.RevokeSession.
+func (c *userServiceClient) RevokeSession(ctx context.Context, req *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return c.revokeSession.CallUnary(ctx, req)
+}
+
+// RevokeAllOtherSessions calls user.v1.UserService.RevokeAllOtherSessions.
+func (c *userServiceClient) RevokeAllOtherSessions(ctx context.Context, req *connect.Request[v1.RevokeAllOtherSessionsRequest]) (*connect.Response[v1.RevokeAllOtherSessionsResponse], error) {
+	return c.revokeAllOtherSessions.CallUnary(ctx, req)
+}
+
+// CreateAPIKey calls user.v1.UserService.CreateAPIKey.
+func (c *userServiceClient) CreateAPIKey(ctx context.Context, req *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error) {
+	return c.createAPIKey.CallUnary(ctx, req)
+}
+
+// ListAPIKeys calls user.v1.UserService.ListAPIKeys.
+func (c *userServiceClient) ListAPIKeys(ctx context.Context, req *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error) {
+	return c.listAPIKeys.CallUnary(ctx, req)
+}
+
+// RevokeAPIKey calls user.v1.UserService.RevokeAPIKey.
+func (c *userServiceClient) RevokeAPIKey(ctx context.Context, req *connect.Request[v1.RevokeAPIKeyRequest]) (*connect.Response[v1.RevokeAPIKeyResponse], error) {
+	return c.revokeAPIKey.CallUnary(ctx, req)
+}
+
+// BeginTOTPEnrollment calls user.v1.UserService.BeginTOTPEnrollment.
+func (c *userServiceClient) BeginTOTPEnrollment(ctx context.Context, req *connect.Request[v1.BeginTOTPEnrollmentRequest]) (*connect.Response[v1.BeginTOTPEnrollmentResponse], error) {
+	return c.beginTOTPEnrollment.CallUnary(ctx, req)
+}
+
+// FinishTOTPEnrollment calls user.v1.UserService.FinishTOTPEnrollment.
+func (c *userServiceClient) FinishTOTPEnrollment(ctx context.Context, req *connect.Request[v1.FinishTOTPEnrollmentRequest]) (*connect.Response[v1.FinishTOTPEnrollmentResponse], error) {
+	return c.finishTOTPEnrollment.CallUnary(ctx, req)
+}
+
+// DisableTOTP calls user.v1.UserService.DisableTOTP.
+func (c *userServiceClient) DisableTOTP(ctx context.Context, req *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error) {
+	return c.disableTOTP.CallUnary(ctx, req)
+}
+
+// RegenerateRecoveryCodes calls user.v1.UserService.RegenerateRecoveryCodes.
+func (c *userServiceClient) RegenerateRecoveryCodes(ctx context.Context, req *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error) {
+	return c.regenerateRecoveryCodes.CallUnary(ctx, req)
+}
+
+// ListPasskeys calls user.v1.UserService.ListPasskeys.
+func (c *userServiceClient) ListPasskeys(ctx context.Context, req *connect.Request[v1.ListPasskeysRequest]) (*connect.Response[v1.ListPasskeysResponse], error) {
+	return c.listPasskeys.CallUnary(ctx, req)
+}
+
+// RenamePasskey calls user.v1.UserService.RenamePasskey.
+func (c *userServiceClient) RenamePasskey(ctx context.Context, req *connect.Request[v1.RenamePasskeyRequest]) (*connect.Response[v1.RenamePasskeyResponse], error) {
+	return c.renamePasskey.CallUnary(ctx, req)
+}
+
+// DeletePasskey calls user.v1.UserService.DeletePasskey.
+func (c *userServiceClient) DeletePasskey(ctx context.Context, req *connect.Request[v1.DeletePasskeyRequest]) (*connect.Response[v1.DeletePasskeyResponse], error) {
+	return c.deletePasskey.CallUnary(ctx, req)
+}
+
+// UpdateEmail calls user.v1.UserService.UpdateEmail.
+func (c *userServiceClient) UpdateEmail(ctx context.Context, req *connect.Request[v1.UpdateEmailRequest]) (*connect.Response[v1.UpdateEmailResponse], error) {
+	return c.updateEmail.CallUnary(ctx, req)
+}
+
+// ListIdentities calls user.v1.UserService.ListIdentities.
+func (c *userServiceClient) ListIdentities(ctx context.Context, req *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error) {
+	return c.listIdentities.CallUnary(ctx, req)
+}
+
+// DeleteIdentity calls user.v1.UserService.DeleteIdentity.
+func (c *userServiceClient) DeleteIdentity(ctx context.Context, req *connect.Request[v1.DeleteIdentityRequest]) (*connect.Response[v1.DeleteIdentityResponse], error) {
+	return c.deleteIdentity.CallUnary(ctx, req)
+}
+
+// ListAuditEvents calls user.v1.UserService.ListAuditEvents.
+func (c *userServiceClient) ListAuditEvents(ctx context.Context, req *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error) {
+	return c.listAuditEvents.CallUnary(ctx, req)
+}
+
+// UpdateUsername calls user.v1.UserService.UpdateUsername.
+func (c *userServiceClient) UpdateUsername(ctx context.Context, req *connect.Request[v1.UpdateUsernameRequest]) (*connect.Response[v1.UpdateUsernameResponse], error) {
+	return c.updateUsername.CallUnary(ctx, req)
+}
+
+// DeleteAccount calls user.v1.UserService.DeleteAccount.
+func (c *userServiceClient) DeleteAccount(ctx context.Context, req *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error) {
+	return c.deleteAccount.CallUnary(ctx, req)
+}
+
+// ExportAccount calls user.v1.UserService.ExportAccount.
+func (c *userServiceClient) ExportAccount(ctx context.Context, req *connect.Request[v1.ExportAccountRequest]) (*connect.Response[v1.ExportAccountResponse], error) {
+	return c.exportAccount.CallUnary(ctx, req)
+}
+
+// GetAccountExport calls user.v1.UserService.GetAccountExport.
+func (c *userServiceClient) GetAccountExport(ctx context.Context, req *connect.Request[v1.GetAccountExportRequest]) (*connect.Response[v1.GetAccountExportResponse], error) {
+	return c.getAccountExport.CallUnary(ctx, req)
+}
+
 // UserServiceHandler is an implementation of the user.v1.UserService service.
 type UserServiceHandler interface {
 	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
 	UpdatePassword(context.Context, *connect.Request[v1.UpdatePasswordRequest]) (*connect.Response[v1.UpdatePasswordResponse], error)
+	// Creates an API key with the item and user scopes that expires after a day, use CreateAPIKey instead
+	//
+	// Deprecated: do not use.
 	GetAPIKey(context.Context, *connect.Request[v1.GetAPIKeyRequest]) (*connect.Response[v1.GetAPIKeyResponse], error)
 	UpdateProfilePicture(context.Context, *connect.Request[v1.UpdateProfilePictureRequest]) (*connect.Response[v1.UpdateProfilePictureResponse], error)
 	BeginPasskeyRegistration(context.Context, *connect.Request[v1.BeginPasskeyRegistrationRequest]) (*connect.Response[v1.BeginPasskeyRegistrationResponse], error)
 	FinishPasskeyRegistration(context.Context, *connect.Request[v1.FinishPasskeyRegistrationRequest]) (*connect.Response[v1.FinishPasskeyRegistrationResponse], error)
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	RevokeAllOtherSessions(context.Context, *connect.Request[v1.RevokeAllOtherSessionsRequest]) (*connect.Response[v1.RevokeAllOtherSessionsResponse], error)
+	CreateAPIKey(context.Context, *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error)
+	ListAPIKeys(context.Context, *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error)
+	RevokeAPIKey(context.Context, *connect.Request[v1.RevokeAPIKeyRequest]) (*connect.Response[v1.RevokeAPIKeyResponse], error)
+	BeginTOTPEnrollment(context.Context, *connect.Request[v1.BeginTOTPEnrollmentRequest]) (*connect.Response[v1.BeginTOTPEnrollmentResponse], error)
+	FinishTOTPEnrollment(context.Context, *connect.Request[v1.FinishTOTPEnrollmentRequest]) (*connect.Response[v1.FinishTOTPEnrollmentResponse], error)
+	DisableTOTP(context.Context, *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error)
+	RegenerateRecoveryCodes(context.Context, *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error)
+	ListPasskeys(context.Context, *connect.Request[v1.ListPasskeysRequest]) (*connect.Response[v1.ListPasskeysResponse], error)
+	RenamePasskey(context.Context, *connect.Request[v1.RenamePasskeyRequest]) (*connect.Response[v1.RenamePasskeyResponse], error)
+	DeletePasskey(context.Context, *connect.Request[v1.DeletePasskeyRequest]) (*connect.Response[v1.DeletePasskeyResponse], error)
+	UpdateEmail(context.Context, *connect.Request[v1.UpdateEmailRequest]) (*connect.Response[v1.UpdateEmailResponse], error)
+	ListIdentities(context.Context, *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error)
+	DeleteIdentity(context.Context, *connect.Request[v1.DeleteIdentityRequest]) (*connect.Response[v1.DeleteIdentityResponse], error)
+	ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error)
+	UpdateUsername(context.Context, *connect.Request[v1.UpdateUsernameRequest]) (*connect.Response[v1.UpdateUsernameResponse], error)
+	DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error)
+	ExportAccount(context.Context, *connect.Request[v1.ExportAccountRequest]) (*connect.Response[v1.ExportAccountResponse], error)
+	GetAccountExport(context.Context, *connect.Request[v1.GetAccountExportRequest]) (*connect.Response[v1.GetAccountExportResponse], error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -204,6 +566,132 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("FinishPasskeyRegistration")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceListSessionsHandler := connect.NewUnaryHandler(
+		UserServiceListSessionsProcedure,
+		svc.ListSessions,
+		connect.WithSchema(userServiceMethods.ByName("ListSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRevokeSessionHandler := connect.NewUnaryHandler(
+		UserServiceRevokeSessionProcedure,
+		svc.RevokeSession,
+		connect.WithSchema(userServiceMethods.ByName("RevokeSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRevokeAllOtherSessionsHandler := connect.NewUnaryHandler(
+		UserServiceRevokeAllOtherSessionsProcedure,
+		svc.RevokeAllOtherSessions,
+		connect.WithSchema(userServiceMethods.ByName("RevokeAllOtherSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceCreateAPIKeyHandler := connect.NewUnaryHandler(
+		UserServiceCreateAPIKeyProcedure,
+		svc.CreateAPIKey,
+		connect.WithSchema(userServiceMethods.ByName("CreateAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListAPIKeysHandler := connect.NewUnaryHandler(
+		UserServiceListAPIKeysProcedure,
+		svc.ListAPIKeys,
+		connect.WithSchema(userServiceMethods.ByName("ListAPIKeys")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRevokeAPIKeyHandler := connect.NewUnaryHandler(
+		UserServiceRevokeAPIKeyProcedure,
+		svc.RevokeAPIKey,
+		connect.WithSchema(userServiceMethods.ByName("RevokeAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceBeginTOTPEnrollmentHandler := connect.NewUnaryHandler(
+		UserServiceBeginTOTPEnrollmentProcedure,
+		svc.BeginTOTPEnrollment,
+		connect.WithSchema(userServiceMethods.ByName("BeginTOTPEnrollment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceFinishTOTPEnrollmentHandler := connect.NewUnaryHandler(
+		UserServiceFinishTOTPEnrollmentProcedure,
+		svc.FinishTOTPEnrollment,
+		connect.WithSchema(userServiceMethods.ByName("FinishTOTPEnrollment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDisableTOTPHandler := connect.NewUnaryHandler(
+		UserServiceDisableTOTPProcedure,
+		svc.DisableTOTP,
+		connect.WithSchema(userServiceMethods.ByName("DisableTOTP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRegenerateRecoveryCodesHandler := connect.NewUnaryHandler(
+		UserServiceRegenerateRecoveryCodesProcedure,
+		svc.RegenerateRecoveryCodes,
+		connect.WithSchema(userServiceMethods.ByName("RegenerateRecoveryCodes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListPasskeysHandler := connect.NewUnaryHandler(
+		UserServiceListPasskeysProcedure,
+		svc.ListPasskeys,
+		connect.WithSchema(userServiceMethods.ByName("ListPasskeys")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRenamePasskeyHandler := connect.NewUnaryHandler(
+		UserServiceRenamePasskeyProcedure,
+		svc.RenamePasskey,
+		connect.WithSchema(userServiceMethods.ByName("RenamePasskey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeletePasskeyHandler := connect.NewUnaryHandler(
+		UserServiceDeletePasskeyProcedure,
+		svc.DeletePasskey,
+		connect.WithSchema(userServiceMethods.ByName("DeletePasskey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceUpdateEmailHandler := connect.NewUnaryHandler(
+		UserServiceUpdateEmailProcedure,
+		svc.UpdateEmail,
+		connect.WithSchema(userServiceMethods.ByName("UpdateEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListIdentitiesHandler := connect.NewUnaryHandler(
+		UserServiceListIdentitiesProcedure,
+		svc.ListIdentities,
+		connect.WithSchema(userServiceMethods.ByName("ListIdentities")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeleteIdentityHandler := connect.NewUnaryHandler(
+		UserServiceDeleteIdentityProcedure,
+		svc.DeleteIdentity,
+		connect.WithSchema(userServiceMethods.ByName("DeleteIdentity")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListAuditEventsHandler := connect.NewUnaryHandler(
+		UserServiceListAuditEventsProcedure,
+		svc.ListAuditEvents,
+		connect.WithSchema(userServiceMethods.ByName("ListAuditEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceUpdateUsernameHandler := connect.NewUnaryHandler(
+		UserServiceUpdateUsernameProcedure,
+		svc.UpdateUsername,
+		connect.WithSchema(userServiceMethods.ByName("UpdateUsername")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeleteAccountHandler := connect.NewUnaryHandler(
+		UserServiceDeleteAccountProcedure,
+		svc.DeleteAccount,
+		connect.WithSchema(userServiceMethods.ByName("DeleteAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceExportAccountHandler := connect.NewUnaryHandler(
+		UserServiceExportAccountProcedure,
+		svc.ExportAccount,
+		connect.WithSchema(userServiceMethods.ByName("ExportAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetAccountExportHandler := connect.NewUnaryHandler(
+		UserServiceGetAccountExportProcedure,
+		svc.GetAccountExport,
+		connect.WithSchema(userServiceMethods.ByName("GetAccountExport")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/user.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceGetUserProcedure:
@@ -218,6 +706,48 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceBeginPasskeyRegistrationHandler.ServeHTTP(w, r)
 		case UserServiceFinishPasskeyRegistrationProcedure:
 			userServiceFinishPasskeyRegistrationHandler.ServeHTTP(w, r)
+		case UserServiceListSessionsProcedure:
+			userServiceListSessionsHandler.ServeHTTP(w, r)
+		case UserServiceRevokeSessionProcedure:
+			userServiceRevokeSessionHandler.ServeHTTP(w, r)
+		case UserServiceRevokeAllOtherSessionsProcedure:
+			userServiceRevokeAllOtherSessionsHandler.ServeHTTP(w, r)
+		case UserServiceCreateAPIKeyProcedure:
+			userServiceCreateAPIKeyHandler.ServeHTTP(w, r)
+		case UserServiceListAPIKeysProcedure:
+			userServiceListAPIKeysHandler.ServeHTTP(w, r)
+		case UserServiceRevokeAPIKeyProcedure:
+			userServiceRevokeAPIKeyHandler.ServeHTTP(w, r)
+		case UserServiceBeginTOTPEnrollmentProcedure:
+			userServiceBeginTOTPEnrollmentHandler.ServeHTTP(w, r)
+		case UserServiceFinishTOTPEnrollmentProcedure:
+			userServiceFinishTOTPEnrollmentHandler.ServeHTTP(w, r)
+		case UserServiceDisableTOTPProcedure:
+			userServiceDisableTOTPHandler.ServeHTTP(w, r)
+		case UserServiceRegenerateRecoveryCodesProcedure:
+			userServiceRegenerateRecoveryCodesHandler.ServeHTTP(w, r)
+		case UserServiceListPasskeysProcedure:
+			userServiceListPasskeysHandler.ServeHTTP(w, r)
+		case UserServiceRenamePasskeyProcedure:
+			userServiceRenamePasskeyHandler.ServeHTTP(w, r)
+		case UserServiceDeletePasskeyProcedure:
+			userServiceDeletePasskeyHandler.ServeHTTP(w, r)
+		case UserServiceUpdateEmailProcedure:
+			userServiceUpdateEmailHandler.ServeHTTP(w, r)
+		case UserServiceListIdentitiesProcedure:
+			userServiceListIdentitiesHandler.ServeHTTP(w, r)
+		case UserServiceDeleteIdentityProcedure:
+			userServiceDeleteIdentityHandler.ServeHTTP(w, r)
+		case UserServiceListAuditEventsProcedure:
+			userServiceListAuditEventsHandler.ServeHTTP(w, r)
+		case UserServiceUpdateUsernameProcedure:
+			userServiceUpdateUsernameHandler.ServeHTTP(w, r)
+		case UserServiceDeleteAccountProcedure:
+			userServiceDeleteAccountHandler.ServeHTTP(w, r)
+		case UserServiceExportAccountProcedure:
+			userServiceExportAccountHandler.ServeHTTP(w, r)
+		case UserServiceGetAccountExportProcedure:
+			userServiceGetAccountExportHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -250,3 +780,87 @@ func (UnimplementedUserServiceHandler) BeginPasskeyRegistration(context.Context,
 func (UnimplementedUserServiceHandler) FinishPasskeyRegistration(context.Context, *connect.Request[v1.FinishPasskeyRegistrationRequest]) (*connect.Response[v1.FinishPasskeyRegistrationResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.FinishPasskeyRegistration is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ListSessions is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.RevokeSession is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RevokeAllOtherSessions(context.Context, *connect.Request[v1.RevokeAllOtherSessionsRequest]) (*connect.Response[v1.RevokeAllOtherSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.RevokeAllOtherSessions is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) CreateAPIKey(context.Context, *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.CreateAPIKey is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListAPIKeys(context.Context, *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ListAPIKeys is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RevokeAPIKey(context.Context, *connect.Request[v1.RevokeAPIKeyRequest]) (*connect.Response[v1.RevokeAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.RevokeAPIKey is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) BeginTOTPEnrollment(context.Context, *connect.Request[v1.BeginTOTPEnrollmentRequest]) (*connect.Response[v1.BeginTOTPEnrollmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.BeginTOTPEnrollment is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) FinishTOTPEnrollment(context.Context, *connect.Request[v1.FinishTOTPEnrollmentRequest]) (*connect.Response[v1.FinishTOTPEnrollmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.FinishTOTPEnrollment is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DisableTOTP(context.Context, *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.DisableTOTP is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RegenerateRecoveryCodes(context.Context, *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.RegenerateRecoveryCodes is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListPasskeys(context.Context, *connect.Request[v1.ListPasskeysRequest]) (*connect.Response[v1.ListPasskeysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ListPasskeys is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RenamePasskey(context.Context, *connect.Request[v1.RenamePasskeyRequest]) (*connect.Response[v1.RenamePasskeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.RenamePasskey is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeletePasskey(context.Context, *connect.Request[v1.DeletePasskeyRequest]) (*connect.Response[v1.DeletePasskeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.DeletePasskey is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UpdateEmail(context.Context, *connect.Request[v1.UpdateEmailRequest]) (*connect.Response[v1.UpdateEmailResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.UpdateEmail is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListIdentities(context.Context, *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ListIdentities is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteIdentity(context.Context, *connect.Request[v1.DeleteIdentityRequest]) (*connect.Response[v1.DeleteIdentityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.DeleteIdentity is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ListAuditEvents is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UpdateUsername(context.Context, *connect.Request[v1.UpdateUsernameRequest]) (*connect.Response[v1.UpdateUsernameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.UpdateUsername is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.DeleteAccount is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ExportAccount(context.Context, *connect.Request[v1.ExportAccountRequest]) (*connect.Response[v1.ExportAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ExportAccount is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetAccountExport(context.Context, *connect.Request[v1.GetAccountExportRequest]) (*connect.Response[v1.GetAccountExportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.GetAccountExport is not implemented"))
+}
@@ -14,6 +14,14 @@ func New(dsn string) (*bob.DB, error) {
 		if !strings.HasPrefix(dsn, "/") {
 			dsn = "/" + dsn // Ensure absolute path for sqlite
 		}
+
+		// Enforce foreign keys, so rows owned by a user are deleted with them,
+		// and wait for locks instead of failing concurrent writes with SQLITE_BUSY
+		separator := "?"
+		if strings.Contains(dsn, "?") {
+			separator = "&"
+		}
+		dsn += separator + "_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)"
 	}
 
 	// Open db
@@ -0,0 +1,354 @@
+package export
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/spotdemo4/ts-server/internal/auth"
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+)
+
+type profile struct {
+	ID                    int32      `json:"id"`
+	Username              string     `json:"username"`
+	Role                  string     `json:"role"`
+	Email                 *string    `json:"email,omitempty"`
+	EmailVerifiedAt       *time.Time `json:"email_verified_at,omitempty"`
+	ProfilePictureID      *int32     `json:"profile_picture_id,omitempty"`
+	HasPassword           bool       `json:"has_password"`
+	TOTPEnabled           bool       `json:"totp_enabled"`
+	PasswordResetRequired bool       `json:"password_reset_required"`
+	DisabledAt            *time.Time `json:"disabled_at,omitempty"`
+}
+
+type item struct {
+	ID          int32     `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Price       float32   `json:"price"`
+	Quantity    int32     `json:"quantity"`
+	Added       time.Time `json:"added"`
+}
+
+type passkey struct {
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	Transports     []string  `json:"transports"`
+	BackupEligible bool      `json:"backup_eligible"`
+	BackupState    bool      `json:"backup_state"`
+	CloneWarning   bool      `json:"clone_warning"`
+	CreatedAt      time.Time `json:"created_at"`
+	LastUsed       time.Time `json:"last_used"`
+}
+
+type session struct {
+	ID        string     `json:"id"`
+	UserAgent string     `json:"user_agent"`
+	IP        string     `json:"ip"`
+	ClientID  *string    `json:"client_id,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	LastSeen  time.Time  `json:"last_seen"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+type apiKey struct {
+	ID        int32      `json:"id"`
+	Name      string     `json:"name"`
+	Prefix    string     `json:"prefix"`
+	Scopes    []string   `json:"scopes"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	LastUsed  *time.Time `json:"last_used,omitempty"`
+}
+
+type identity struct {
+	ID        int32     `json:"id"`
+	Provider  string    `json:"provider"`
+	Email     *string   `json:"email,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	LastUsed  time.Time `json:"last_used"`
+}
+
+type auditEvent struct {
+	ID        int32           `json:"id"`
+	ActorID   *int32          `json:"actor_id,omitempty"`
+	Action    string          `json:"action"`
+	Target    string          `json:"target"`
+	Outcome   string          `json:"outcome"`
+	IP        string          `json:"ip"`
+	UserAgent string          `json:"user_agent"`
+	Metadata  json.RawMessage `json:"metadata"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// Write writes a ZIP archive of everything stored about a user. Secrets like password hashes and keys are left out.
+func Write(ctx context.Context, exec bob.Executor, w io.Writer, userid int32) error {
+	archive := zip.NewWriter(w)
+
+	// Profile
+	user, err := models.FindUser(ctx, exec, userid)
+	if err != nil {
+		return err
+	}
+	err = writeJSON(archive, "profile.json", profile{
+		ID:                    user.ID,
+		Username:              user.Username,
+		Role:                  user.Role,
+		Email:                 user.Email.Ptr(),
+		EmailVerifiedAt:       user.EmailVerifiedAt.Ptr(),
+		ProfilePictureID:      user.ProfilePictureID.Ptr(),
+		HasPassword:           user.Password != "",
+		TOTPEnabled:           user.TotpEnabled,
+		PasswordResetRequired: user.PasswordResetRequired,
+		DisabledAt:            user.DisabledAt.Ptr(),
+	})
+	if err != nil {
+		return err
+	}
+
+	// Items
+	items, err := models.Items.Query(
+		models.SelectWhere.Items.UserID.EQ(userid),
+		sm.OrderBy(models.Items.Columns.ID),
+	).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+	resItems := []item{}
+	for _, i := range items {
+		resItems = append(resItems, item{
+			ID:          i.ID,
+			Name:        i.Name,
+			Description: i.Description,
+			Price:       i.Price,
+			Quantity:    i.Quantity,
+			Added:       i.Added,
+		})
+	}
+	err = writeJSON(archive, "items.json", resItems)
+	if err != nil {
+		return err
+	}
+	err = writeItemsCSV(archive, "items.csv", resItems)
+	if err != nil {
+		return err
+	}
+
+	// Passkeys
+	creds, err := models.Credentials.Query(
+		models.SelectWhere.Credentials.UserID.EQ(userid),
+		sm.OrderBy(models.Credentials.Columns.CreatedAt),
+	).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+	passkeys := []passkey{}
+	for _, cred := range creds {
+		transports := []string{}
+		for _, transport := range auth.ParseTransports(cred.Transports.GetOrZero()) {
+			transports = append(transports, string(transport))
+		}
+		passkeys = append(passkeys, passkey{
+			ID:             cred.CredID,
+			Name:           cred.Name,
+			Transports:     transports,
+			BackupEligible: cred.BackupEligible.GetOrZero(),
+			BackupState:    cred.BackupState.GetOrZero(),
+			CloneWarning:   cred.CloneWarning,
+			CreatedAt:      cred.CreatedAt,
+			LastUsed:       cred.LastUsed,
+		})
+	}
+	err = writeJSON(archive, "passkeys.json", passkeys)
+	if err != nil {
+		return err
+	}
+
+	// Sessions
+	rows, err := models.Sessions.Query(
+		models.SelectWhere.Sessions.UserID.EQ(userid),
+		sm.OrderBy(models.Sessions.Columns.CreatedAt),
+	).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+	sessions := []session{}
+	for _, s := range rows {
+		sessions = append(sessions, session{
+			ID:        s.ID,
+			UserAgent: s.UserAgent,
+			IP:        s.IP,
+			ClientID:  s.ClientID.Ptr(),
+			CreatedAt: s.CreatedAt,
+			LastSeen:  s.LastSeen,
+			ExpiresAt: s.ExpiresAt,
+			RevokedAt: s.RevokedAt.Ptr(),
+		})
+	}
+	err = writeJSON(archive, "sessions.json", sessions)
+	if err != nil {
+		return err
+	}
+
+	// API keys
+	keys, err := models.APIKeys.Query(
+		models.SelectWhere.APIKeys.UserID.EQ(userid),
+		sm.OrderBy(models.APIKeys.Columns.CreatedAt),
+	).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+	apiKeys := []apiKey{}
+	for _, key := range keys {
+		scopes := []string{}
+		for _, scope := range auth.ParseScopes(key.Scopes) {
+			scopes = append(scopes, string(scope))
+		}
+		apiKeys = append(apiKeys, apiKey{
+			ID:        key.ID,
+			Name:      key.Name,
+			Prefix:    key.Prefix,
+			Scopes:    scopes,
+			CreatedAt: key.CreatedAt,
+			ExpiresAt: key.ExpiresAt.Ptr(),
+			LastUsed:  key.LastUsed.Ptr(),
+		})
+	}
+	err = writeJSON(archive, "api_keys.json", apiKeys)
+	if err != nil {
+		return err
+	}
+
+	// Linked identities
+	rowsIdentities, err := models.Identities.Query(
+		models.SelectWhere.Identities.UserID.EQ(userid),
+		sm.OrderBy(models.Identities.Columns.CreatedAt),
+	).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+	identities := []identity{}
+	for _, i := range rowsIdentities {
+		identities = append(identities, identity{
+			ID:        i.ID,
+			Provider:  i.Provider,
+			Email:     i.Email.Ptr(),
+			CreatedAt: i.CreatedAt,
+			LastUsed:  i.LastUsed,
+		})
+	}
+	err = writeJSON(archive, "identities.json", identities)
+	if err != nil {
+		return err
+	}
+
+	// Audit history
+	events, err := models.AuditEvents.Query(
+		models.SelectWhere.AuditEvents.UserID.EQ(userid),
+		sm.OrderBy(models.AuditEvents.Columns.CreatedAt),
+		sm.OrderBy(models.AuditEvents.Columns.ID),
+	).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+	auditEvents := []auditEvent{}
+	for _, event := range events {
+		auditEvents = append(auditEvents, auditEvent{
+			ID:        event.ID,
+			ActorID:   event.ActorID.Ptr(),
+			Action:    event.Action,
+			Target:    event.Target,
+			Outcome:   event.Outcome,
+			IP:        event.IP,
+			UserAgent: event.UserAgent,
+			Metadata:  json.RawMessage(event.Metadata),
+			CreatedAt: event.CreatedAt,
+		})
+	}
+	err = writeJSON(archive, "audit_events.json", auditEvents)
+	if err != nil {
+		return err
+	}
+
+	// Uploaded files, one at a time since they can be large
+	ids, err := models.Files.Query(
+		sm.Columns(models.Files.Columns.ID),
+		models.SelectWhere.Files.UserID.EQ(userid),
+		sm.OrderBy(models.Files.Columns.ID),
+	).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		var file *models.File
+		file, err = models.FindFile(ctx, exec, id.ID)
+		if err != nil {
+			return err
+		}
+
+		var f io.Writer
+		f, err = archive.Create(fmt.Sprintf("files/%d-%s", file.ID, path.Base("/"+file.Name)))
+		if err != nil {
+			return err
+		}
+		_, err = f.Write(file.Data)
+		if err != nil {
+			return err
+		}
+	}
+
+	return archive.Close()
+}
+
+// writeJSON adds an indented JSON file to the archive.
+func writeJSON(archive *zip.Writer, name string, v any) error {
+	f, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(f)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// writeItemsCSV adds the items to the archive as a CSV file with a header row.
+func writeItemsCSV(archive *zip.Writer, name string, items []item) error {
+	f, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+
+	w := csv.NewWriter(f)
+	err = w.Write([]string{"id", "name", "description", "price", "quantity", "added"})
+	if err != nil {
+		return err
+	}
+	for _, i := range items {
+		err = w.Write([]string{
+			strconv.Itoa(int(i.ID)),
+			i.Name,
+			i.Description,
+			strconv.FormatFloat(float64(i.Price), 'f', -1, 32),
+			strconv.Itoa(int(i.Quantity)),
+			i.Added.Format(time.RFC3339),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	w.Flush()
+
+	return w.Error()
+}
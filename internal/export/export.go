@@ -0,0 +1,240 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/scan"
+
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+)
+
+const (
+	StatusPending = "pending"
+	StatusReady   = "ready"
+	StatusFailed  = "failed"
+)
+
+const (
+	Retention   = time.Hour * 24   // How long a finished export can be downloaded
+	Timeout     = time.Minute * 10 // Longest an export can take
+	InlineLimit = 5 << 20          // Accounts with fewer bytes of files are exported before responding
+	Sweep       = time.Minute * 10 // How often expired exports are removed
+	Heartbeat   = time.Second * 30 // How often a running export shows it is still alive
+	StaleAfter  = time.Minute * 2  // Pending exports without a heartbeat this long have failed
+)
+
+var ErrExportPending = errors.New("export is not ready yet")
+
+// Exporter builds ZIP archives of everything stored about a user.
+// Small accounts are exported right away, larger ones in the background, and archives are kept until they expire.
+type Exporter struct {
+	db  *bob.DB
+	log *slog.Logger
+
+	swept time.Time
+	mu    sync.Mutex
+}
+
+func New(db *bob.DB, log *slog.Logger) *Exporter {
+	return &Exporter{
+		db:  db,
+		log: log,
+	}
+}
+
+// Start starts exporting a user's account, returning the export to poll until it is ready.
+func (e *Exporter) Start(ctx context.Context, userid int32) (*models.AccountExport, error) {
+	err := e.sweep(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	export, err := models.AccountExports.Insert(
+		&models.AccountExportSetter{
+			ID:          omit.From(uuid.New().String()),
+			Status:      omit.From(StatusPending),
+			CreatedAt:   omit.From(now),
+			HeartbeatAt: omit.From(now),
+			ExpiresAt:   omit.From(now.Add(Retention)),
+			UserID:      omit.From(userid),
+		},
+	).One(ctx, e.db)
+	if err != nil {
+		return nil, err
+	}
+
+	// Export small accounts before responding, so they can be downloaded right away
+	size, err := filesSize(ctx, e.db, userid)
+	if err != nil {
+		return nil, err
+	}
+	if size < InlineLimit {
+		e.run(ctx, export)
+		return export, nil
+	}
+
+	// The caller gets the export as it was when it started
+	background := *export
+	go e.run(context.WithoutCancel(ctx), &background)
+
+	return export, nil
+}
+
+// Get retrieves one of the user's exports.
+func (e *Exporter) Get(ctx context.Context, userid int32, id string) (*models.AccountExport, error) {
+	return models.AccountExports.Query(
+		models.SelectWhere.AccountExports.ID.EQ(id),
+		models.SelectWhere.AccountExports.UserID.EQ(userid),
+		models.SelectWhere.AccountExports.ExpiresAt.GT(time.Now()),
+	).One(ctx, e.db)
+}
+
+// Open opens the archive of a finished export.
+func (e *Exporter) Open(ctx context.Context, export *models.AccountExport) io.ReadSeeker {
+	return newPageReader(ctx, e.db, export.ID, int64(export.Size))
+}
+
+// FailStale fails pending exports that stopped sending heartbeats, the server running them stopped
+// and they will never finish. Exports still running on other servers are left alone.
+func (e *Exporter) FailStale(ctx context.Context) error {
+	now := time.Now()
+	_, err := models.AccountExports.Update(
+		(&models.AccountExportSetter{
+			Status:     omit.From(StatusFailed),
+			Error:      omitnull.From("the server stopped before the export finished"),
+			FinishedAt: omitnull.From(now),
+		}).UpdateMod(),
+		models.UpdateWhere.AccountExports.Status.EQ(StatusPending),
+		models.UpdateWhere.AccountExports.HeartbeatAt.LT(now.Add(-StaleAfter)),
+	).Exec(ctx, e.db)
+
+	return err
+}
+
+// run builds the archive and stores it with the export, or the reason it failed.
+func (e *Exporter) run(ctx context.Context, export *models.AccountExport) {
+	ctx, cancel := context.WithTimeout(ctx, Timeout)
+	defer cancel()
+
+	// Show the export is still running until it is saved
+	stop := e.heartbeat(ctx, export.ID)
+	defer stop()
+
+	// Write archive in pages
+	pages := newPageWriter(ctx, e.db, export.ID)
+	err := Write(ctx, e.db, pages, export.UserID)
+	if err == nil {
+		err = pages.Close()
+	}
+
+	// Save the result even if the export timed out
+	ctx = context.WithoutCancel(ctx)
+	setter := &models.AccountExportSetter{
+		FinishedAt: omitnull.From(time.Now()),
+	}
+	if err != nil {
+		e.log.Error("Failed to export account", "export", export.ID, "error", err)
+		setter.Status = omit.From(StatusFailed)
+		setter.Error = omitnull.From("could not export the account")
+		if errors.Is(err, ErrExportTooLarge) {
+			setter.Error = omitnull.From("the account is too large to export")
+		}
+
+		// Remove the pages already stored
+		_, err = models.AccountExportPages.Delete(
+			models.DeleteWhere.AccountExportPages.ExportID.EQ(export.ID),
+		).Exec(ctx, e.db)
+		if err != nil {
+			e.log.Error("Failed to remove account export pages", "export", export.ID, "error", err)
+		}
+	} else {
+		setter.Status = omit.From(StatusReady)
+		setter.Size = omit.From(int32(pages.size)) //nolint:gosec // At most MaxSize
+	}
+
+	err = export.Update(ctx, e.db, setter)
+	if err != nil {
+		e.log.Error("Failed to save account export", "export", export.ID, "error", err)
+	}
+}
+
+// heartbeat updates the export every heartbeat interval, until the returned function is called.
+func (e *Exporter) heartbeat(ctx context.Context, id string) func() {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(Heartbeat)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				_, err := models.AccountExports.Update(
+					(&models.AccountExportSetter{
+						HeartbeatAt: omit.From(now),
+					}).UpdateMod(),
+					models.UpdateWhere.AccountExports.ID.EQ(id),
+					models.UpdateWhere.AccountExports.Status.EQ(StatusPending),
+				).Exec(ctx, e.db)
+				if err != nil && ctx.Err() == nil {
+					e.log.Error("Failed to update account export heartbeat", "export", id, "error", err)
+				}
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// sweep removes expired exports and fails stale ones, at most every sweep interval.
+func (e *Exporter) sweep(ctx context.Context) error {
+	now := time.Now()
+
+	e.mu.Lock()
+	sweep := now.Sub(e.swept) > Sweep
+	if sweep {
+		e.swept = now
+	}
+	e.mu.Unlock()
+	if !sweep {
+		return nil
+	}
+
+	_, err := models.AccountExports.Delete(
+		models.DeleteWhere.AccountExports.ExpiresAt.LT(now),
+	).Exec(ctx, e.db)
+	if err != nil {
+		return err
+	}
+
+	// Fail exports of servers that stopped while running them
+	return e.FailStale(ctx)
+}
+
+// filesSize returns how many bytes of files the user uploaded.
+func filesSize(ctx context.Context, exec bob.Executor, userid int32) (int64, error) {
+	return bob.One(ctx, exec, sqlite.Select(
+		sm.Columns(sqlite.F("COALESCE", sqlite.F("SUM", sqlite.F("LENGTH", models.Files.Columns.Data)), 0)),
+		sm.From(models.Files.Name()),
+		models.SelectWhere.Files.UserID.EQ(userid),
+	), scan.SingleColumnMapper[int64])
+}
@@ -0,0 +1,156 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+)
+
+const (
+	PageSize = 1 << 20   // Bytes of an archive stored in each row
+	MaxSize  = 512 << 20 // Largest archive stored, exports of larger accounts fail
+)
+
+var ErrExportTooLarge = errors.New("export is too large")
+
+// pageWriter stores an archive in pages as it is written, so it is never held in memory as a whole.
+type pageWriter struct {
+	ctx  context.Context
+	exec bob.Executor
+	id   string
+
+	buf  []byte
+	page int32
+	size int64
+}
+
+func newPageWriter(ctx context.Context, exec bob.Executor, id string) *pageWriter {
+	return &pageWriter{
+		ctx:  ctx,
+		exec: exec,
+		id:   id,
+		buf:  make([]byte, 0, PageSize),
+	}
+}
+
+// Write buffers p, storing every full page.
+func (w *pageWriter) Write(p []byte) (int, error) {
+	if w.size+int64(len(p)) > MaxSize {
+		return 0, ErrExportTooLarge
+	}
+	w.size += int64(len(p))
+
+	n := len(p)
+	for len(p) > 0 {
+		c := min(len(p), PageSize-len(w.buf))
+		w.buf = append(w.buf, p[:c]...)
+		p = p[c:]
+
+		if len(w.buf) == PageSize {
+			err := w.flush()
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return n, nil
+}
+
+// Close stores the last page.
+func (w *pageWriter) Close() error {
+	return w.flush()
+}
+
+func (w *pageWriter) flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+
+	_, err := models.AccountExportPages.Insert(
+		&models.AccountExportPageSetter{
+			ExportID: omit.From(w.id),
+			Page:     omit.From(w.page),
+			Data:     omit.From(w.buf),
+		},
+	).Exec(w.ctx, w.exec)
+	if err != nil {
+		return err
+	}
+	w.page++
+	w.buf = w.buf[:0]
+
+	return nil
+}
+
+// pageReader reads an archive stored in pages, loading one page at a time.
+type pageReader struct {
+	ctx  context.Context
+	exec bob.Executor
+	id   string
+	size int64
+
+	offset int64
+	page   int32
+	data   []byte
+}
+
+func newPageReader(ctx context.Context, exec bob.Executor, id string, size int64) *pageReader {
+	return &pageReader{
+		ctx:  ctx,
+		exec: exec,
+		id:   id,
+		size: size,
+		page: -1,
+	}
+}
+
+// Read reads from the page at the current offset, loading it if needed.
+func (r *pageReader) Read(p []byte) (int, error) {
+	if r.offset >= r.size {
+		return 0, io.EOF
+	}
+
+	page := int32(r.offset / PageSize) //nolint:gosec // At most MaxSize / PageSize
+	if page != r.page {
+		row, err := models.FindAccountExportPage(r.ctx, r.exec, r.id, page)
+		if err != nil {
+			return 0, err
+		}
+		r.page = page
+		r.data = row.Data
+	}
+
+	start := r.offset % PageSize
+	if start >= int64(len(r.data)) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	n := copy(p, r.data[start:])
+	r.offset += int64(n)
+
+	return n, nil
+}
+
+// Seek moves the offset the next Read starts at.
+func (r *pageReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+	r.offset = offset
+
+	return offset, nil
+}
@@ -0,0 +1,323 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+const (
+	OpEQ  = "="
+	OpNE  = "!="
+	OpLT  = "<"
+	OpLTE = "<="
+	OpGT  = ">"
+	OpGTE = ">="
+	OpHas = ":"
+)
+
+const MaxDepth = 32 // Deepest nesting of parentheses and negations
+
+// Error is a problem with a filter, at the position of the offending token.
+type Error struct {
+	Pos     int
+	Token   string
+	Message string
+}
+
+func (e *Error) Error() string {
+	if e.Token == "" {
+		return fmt.Sprintf("invalid filter at position %d: %s", e.Pos, e.Message)
+	}
+
+	return fmt.Sprintf("invalid filter at position %d near %q: %s", e.Pos, e.Token, e.Message)
+}
+
+// Expr is a node of a parsed filter.
+type Expr interface {
+	expr()
+}
+
+// And matches when all of its expressions match.
+type And struct {
+	Exprs []Expr
+}
+
+// Or matches when any of its expressions match.
+type Or struct {
+	Exprs []Expr
+}
+
+// Not matches when its expression does not.
+type Not struct {
+	Expr Expr
+}
+
+// Restriction compares a field to a value, like price < 20.
+type Restriction struct {
+	Field    string
+	FieldPos int
+	Op       string
+	Value    string
+	ValuePos int
+}
+
+func (And) expr()         {}
+func (Or) expr()          {}
+func (Not) expr()         {}
+func (Restriction) expr() {}
+
+// Parse parses an AIP-160 style filter, like price < 20 AND quantity >= 1 AND name:"widget*".
+// As in AIP-160, OR binds tighter than AND, and expressions next to each other are joined with AND.
+// An empty filter returns a nil expression.
+func Parse(filter string) (Expr, error) {
+	tokens, err := lex(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{
+		tokens: tokens,
+	}
+	if p.peek().kind == tokenEOF {
+		return nil, nil //nolint:nilnil // Matches everything
+	}
+
+	expr, err := p.parseAnd(0)
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, tok.error("unexpected token")
+	}
+
+	return expr, nil
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenLParen
+	tokenRParen
+	tokenMinus
+	tokenOp
+	tokenString // Quoted
+	tokenText   // Unquoted, like field names, numbers and keywords
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+func (t token) error(message string) *Error {
+	text := t.value
+	if t.kind == tokenString {
+		text = `"` + t.value + `"`
+	}
+
+	return &Error{
+		Pos:     t.pos,
+		Token:   text,
+		Message: message,
+	}
+}
+
+// lex splits a filter into tokens.
+func lex(filter string) ([]token, error) {
+	tokens := []token{}
+	runes := []rune(filter)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLParen, value: "(", pos: i})
+			i++
+
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRParen, value: ")", pos: i})
+			i++
+
+		// Negation, unless it starts a negative number
+		case r == '-' && (i+1 == len(runes) || !unicode.IsDigit(runes[i+1])):
+			tokens = append(tokens, token{kind: tokenMinus, value: "-", pos: i})
+			i++
+
+		case strings.ContainsRune("=!<>:", r):
+			op := string(r)
+			if i+1 < len(runes) && runes[i+1] == '=' && strings.ContainsRune("!<>", r) {
+				op += "="
+			}
+			if op == "!" {
+				return nil, &Error{Pos: i, Token: op, Message: "expected !="}
+			}
+			tokens = append(tokens, token{kind: tokenOp, value: op, pos: i})
+			i += len(op)
+
+		case r == '"' || r == '\'':
+			start := i
+			value := []rune{}
+			for i++; ; i++ {
+				if i == len(runes) {
+					return nil, &Error{Pos: start, Message: "unterminated string"}
+				}
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+					value = append(value, runes[i])
+					continue
+				}
+				if runes[i] == r {
+					break
+				}
+				value = append(value, runes[i])
+			}
+			tokens = append(tokens, token{kind: tokenString, value: string(value), pos: start})
+			i++
+
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune(`()=!<>:"'`, runes[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenText, value: string(runes[start:i]), pos: start})
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, pos: len(runes)}), nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+
+	return tok
+}
+
+func (p *parser) keyword(keyword string) bool {
+	tok := p.peek()
+	return tok.kind == tokenText && tok.value == keyword
+}
+
+// parseAnd parses expressions joined by AND, or just next to each other.
+func (p *parser) parseAnd(depth int) (Expr, error) {
+	exprs := []Expr{}
+	for {
+		expr, err := p.parseOr(depth)
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+
+		if p.keyword("AND") {
+			p.next()
+			continue
+		}
+		if tok := p.peek(); tok.kind == tokenEOF || tok.kind == tokenRParen {
+			break
+		}
+	}
+
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+	return And{Exprs: exprs}, nil
+}
+
+// parseOr parses expressions joined by OR.
+func (p *parser) parseOr(depth int) (Expr, error) {
+	exprs := []Expr{}
+	for {
+		expr, err := p.parseTerm(depth)
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+
+		if !p.keyword("OR") {
+			break
+		}
+		p.next()
+	}
+
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+	return Or{Exprs: exprs}, nil
+}
+
+// parseTerm parses a negated or parenthesized expression, or a restriction.
+func (p *parser) parseTerm(depth int) (Expr, error) {
+	tok := p.peek()
+	if depth > MaxDepth {
+		return nil, tok.error("filter is nested too deeply")
+	}
+
+	// Negation
+	if tok.kind == tokenMinus || p.keyword("NOT") {
+		p.next()
+		expr, err := p.parseTerm(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		return Not{Expr: expr}, nil
+	}
+
+	// Parentheses
+	if tok.kind == tokenLParen {
+		p.next()
+		expr, err := p.parseAnd(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if end := p.next(); end.kind != tokenRParen {
+			return nil, end.error("expected )")
+		}
+
+		return expr, nil
+	}
+
+	return p.parseRestriction()
+}
+
+// parseRestriction parses a comparison of a field to a value.
+func (p *parser) parseRestriction() (Expr, error) {
+	field := p.next()
+	if field.kind != tokenText || field.value == "AND" || field.value == "OR" {
+		return nil, field.error("expected a field")
+	}
+
+	op := p.next()
+	if op.kind != tokenOp {
+		return nil, op.error("expected a comparison operator after " + field.value)
+	}
+
+	value := p.next()
+	if value.kind != tokenText && value.kind != tokenString {
+		return nil, value.error("expected a value")
+	}
+
+	return Restriction{
+		Field:    field.value,
+		FieldPos: field.pos,
+		Op:       op.value,
+		Value:    value.value,
+		ValuePos: value.pos,
+	}, nil
+}
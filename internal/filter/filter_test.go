@@ -0,0 +1,322 @@
+package filter_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/spotdemo4/ts-server/internal/filter"
+)
+
+func restriction(field string, fieldPos int, op string, value string, valuePos int) filter.Restriction {
+	return filter.Restriction{
+		Field:    field,
+		FieldPos: fieldPos,
+		Op:       op,
+		Value:    value,
+		ValuePos: valuePos,
+	}
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		filter string
+		want   filter.Expr
+	}{
+		{
+			name:   "empty",
+			filter: "  ",
+			want:   nil,
+		},
+		{
+			name:   "restriction",
+			filter: "price<20",
+			want:   restriction("price", 0, filter.OpLT, "20", 6),
+		},
+		{
+			name:   "every operator",
+			filter: "a=1 b!=2 c<3 d<=4 e>5 f>=6 g:7",
+			want: filter.And{Exprs: []filter.Expr{
+				restriction("a", 0, filter.OpEQ, "1", 2),
+				restriction("b", 4, filter.OpNE, "2", 7),
+				restriction("c", 9, filter.OpLT, "3", 11),
+				restriction("d", 13, filter.OpLTE, "4", 16),
+				restriction("e", 18, filter.OpGT, "5", 20),
+				restriction("f", 22, filter.OpGTE, "6", 25),
+				restriction("g", 27, filter.OpHas, "7", 29),
+			}},
+		},
+		{
+			name:   "quoted values",
+			filter: `name:"widget \"a\"*" description='it\'s'`,
+			want: filter.And{Exprs: []filter.Expr{
+				restriction("name", 0, filter.OpHas, `widget "a"*`, 5),
+				restriction("description", 21, filter.OpEQ, "it's", 33),
+			}},
+		},
+		{
+			name:   "negative number",
+			filter: "price > -5",
+			want:   restriction("price", 0, filter.OpGT, "-5", 8),
+		},
+		{
+			name:   "or binds tighter than and",
+			filter: "a=1 OR b=2 AND c=3",
+			want: filter.And{Exprs: []filter.Expr{
+				filter.Or{Exprs: []filter.Expr{
+					restriction("a", 0, filter.OpEQ, "1", 2),
+					restriction("b", 7, filter.OpEQ, "2", 9),
+				}},
+				restriction("c", 15, filter.OpEQ, "3", 17),
+			}},
+		},
+		{
+			name:   "parentheses",
+			filter: "a=1 AND (b=2 OR c=3)",
+			want: filter.And{Exprs: []filter.Expr{
+				restriction("a", 0, filter.OpEQ, "1", 2),
+				filter.Or{Exprs: []filter.Expr{
+					restriction("b", 9, filter.OpEQ, "2", 11),
+					restriction("c", 16, filter.OpEQ, "3", 18),
+				}},
+			}},
+		},
+		{
+			name:   "negation",
+			filter: "NOT a=1 -(b=2 c=3)",
+			want: filter.And{Exprs: []filter.Expr{
+				filter.Not{Expr: restriction("a", 4, filter.OpEQ, "1", 6)},
+				filter.Not{Expr: filter.And{Exprs: []filter.Expr{
+					restriction("b", 10, filter.OpEQ, "2", 12),
+					restriction("c", 14, filter.OpEQ, "3", 16),
+				}}},
+			}},
+		},
+		{
+			name:   "positions count characters",
+			filter: `name:"é" é=1`,
+			want: filter.And{Exprs: []filter.Expr{
+				restriction("name", 0, filter.OpHas, "é", 5),
+				restriction("é", 9, filter.OpEQ, "1", 11),
+			}},
+		},
+		{
+			name:   "max depth",
+			filter: strings.Repeat("(", filter.MaxDepth) + "a=1" + strings.Repeat(")", filter.MaxDepth),
+			want:   restriction("a", filter.MaxDepth, filter.OpEQ, "1", filter.MaxDepth+2),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := filter.Parse(tt.filter)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", tt.filter, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse(%q) = %#v, want %#v", tt.filter, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		filter string
+		want   filter.Error
+	}{
+		{
+			name:   "missing operator",
+			filter: "name",
+			want:   filter.Error{Pos: 4, Message: "expected a comparison operator after name"},
+		},
+		{
+			name:   "missing value",
+			filter: "name = ",
+			want:   filter.Error{Pos: 7, Message: "expected a value"},
+		},
+		{
+			name:   "operator as value",
+			filter: "name = <",
+			want:   filter.Error{Pos: 7, Token: "<", Message: "expected a value"},
+		},
+		{
+			name:   "bang without equals",
+			filter: "a ! 1",
+			want:   filter.Error{Pos: 2, Token: "!", Message: "expected !="},
+		},
+		{
+			name:   "unterminated string",
+			filter: `a=1 name:"widget`,
+			want:   filter.Error{Pos: 9, Message: "unterminated string"},
+		},
+		{
+			name:   "keyword as field",
+			filter: "AND a=1",
+			want:   filter.Error{Pos: 0, Token: "AND", Message: "expected a field"},
+		},
+		{
+			name:   "string as field",
+			filter: `"name"=1`,
+			want:   filter.Error{Pos: 0, Token: `"name"`, Message: "expected a field"},
+		},
+		{
+			name:   "dangling or",
+			filter: "a=1 OR",
+			want:   filter.Error{Pos: 6, Message: "expected a field"},
+		},
+		{
+			name:   "unclosed parenthesis",
+			filter: "(a=1",
+			want:   filter.Error{Pos: 4, Message: "expected )"},
+		},
+		{
+			name:   "unopened parenthesis",
+			filter: "a=1)",
+			want:   filter.Error{Pos: 3, Token: ")", Message: "unexpected token"},
+		},
+		{
+			name:   "positions count characters",
+			filter: "é=ü )",
+			want:   filter.Error{Pos: 4, Token: ")", Message: "unexpected token"},
+		},
+		{
+			name:   "too deep parentheses",
+			filter: strings.Repeat("(", filter.MaxDepth+1) + "a=1" + strings.Repeat(")", filter.MaxDepth+1),
+			want:   filter.Error{Pos: filter.MaxDepth + 1, Token: "a", Message: "filter is nested too deeply"},
+		},
+		{
+			name:   "too deep negations",
+			filter: strings.Repeat("-", filter.MaxDepth+1) + "a=1",
+			want:   filter.Error{Pos: filter.MaxDepth + 1, Token: "a", Message: "filter is nested too deeply"},
+		},
+		{
+			name:   "too deep without overflowing the stack",
+			filter: strings.Repeat("NOT (", 1_000_000),
+			want:   filter.Error{Pos: filter.MaxDepth/2*5 + 4, Token: "(", Message: "filter is nested too deeply"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := filter.Parse(tt.filter)
+			var got *filter.Error
+			if !errors.As(err, &got) {
+				t.Fatalf("Parse(%q) returned %v, want a *filter.Error", tt.filter, err)
+			}
+			if *got != tt.want {
+				t.Errorf("Parse(%q) returned %#v, want %#v", tt.filter, *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWhere(t *testing.T) {
+	t.Parallel()
+
+	fields := filter.Fields{
+		"name":     {Column: sqlite.Quote("name"), Type: filter.TypeString},
+		"price":    {Column: sqlite.Quote("price"), Type: filter.TypeFloat},
+		"quantity": {Column: sqlite.Quote("quantity"), Type: filter.TypeInt},
+		"added":    {Column: sqlite.Quote("added"), Type: filter.TypeTimestamp},
+	}
+
+	tests := []struct {
+		name    string
+		filter  string
+		where   string
+		args    []any
+		wantErr *filter.Error
+	}{
+		{
+			name:   "empty",
+			filter: "",
+		},
+		{
+			name:   "precedence and negation",
+			filter: `price < 20 OR -name:"w_*" quantity >= 1`,
+			where:  `((("price" < ?1) OR NOT (("name" LIKE ?2 ESCAPE '\'))) AND ("quantity" >= ?3))`,
+			args:   []any{float64(20), `w\_%`, int64(1)},
+		},
+		{
+			name:   "has on a number is equality",
+			filter: "quantity:3",
+			where:  `("quantity" = ?1)`,
+			args:   []any{int64(3)},
+		},
+		{
+			name:   "unknown field",
+			filter: "price<1 color=red",
+			wantErr: &filter.Error{
+				Pos:     8,
+				Token:   "color",
+				Message: "unknown field, expected one of added, name, price, quantity",
+			},
+		},
+		{
+			name:    "invalid integer",
+			filter:  "quantity > 1.5",
+			wantErr: &filter.Error{Pos: 11, Token: "1.5", Message: "expected an integer"},
+		},
+		{
+			name:    "invalid number",
+			filter:  "price < cheap",
+			wantErr: &filter.Error{Pos: 8, Token: "cheap", Message: "expected a number"},
+		},
+		{
+			name:    "invalid timestamp",
+			filter:  `added > "yesterday"`,
+			wantErr: &filter.Error{Pos: 8, Token: "yesterday", Message: "expected an RFC 3339 timestamp"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mod, ok, err := filter.Where(tt.filter, fields)
+			if tt.wantErr != nil {
+				var got *filter.Error
+				if !errors.As(err, &got) {
+					t.Fatalf("Where(%q) returned %v, want a *filter.Error", tt.filter, err)
+				}
+				if *got != *tt.wantErr {
+					t.Errorf("Where(%q) returned %#v, want %#v", tt.filter, *got, *tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Where(%q) returned error: %v", tt.filter, err)
+			}
+			if ok != (tt.where != "") {
+				t.Fatalf("Where(%q) returned ok %v", tt.filter, ok)
+			}
+			if !ok {
+				return
+			}
+
+			query, args, err := sqlite.Select(sm.From("item"), mod).Build(t.Context())
+			if err != nil {
+				t.Fatalf("Error building query: %v", err)
+			}
+			where := strings.TrimSpace(query[strings.Index(query, "WHERE ")+len("WHERE "):])
+			if where != tt.where {
+				t.Errorf("Where(%q) = %s, want %s", tt.filter, where, tt.where)
+			}
+			if !reflect.DeepEqual(args, tt.args) {
+				t.Errorf("Where(%q) args = %#v, want %#v", tt.filter, args, tt.args)
+			}
+		})
+	}
+}
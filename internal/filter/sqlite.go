@@ -0,0 +1,176 @@
+package filter
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+)
+
+type Type int
+
+const (
+	TypeString Type = iota
+	TypeInt
+	TypeFloat
+	TypeTimestamp
+)
+
+// Field is a column that can be filtered on.
+type Field struct {
+	Column sqlite.Expression
+	Type   Type
+}
+
+// Fields are the fields that can be filtered on, by name.
+type Fields map[string]Field
+
+// Where parses a filter and compiles it into a where clause over the fields.
+// String fields support : to match case insensitively with * as a wildcard, like name:"widget*".
+// Timestamps are written in RFC 3339, like added > "2024-01-01T00:00:00Z".
+func Where(filter string, fields Fields) (bob.Mod[*dialect.SelectQuery], bool, error) {
+	expr, err := Parse(filter)
+	if err != nil || expr == nil {
+		return nil, false, err
+	}
+
+	where, err := compile(expr, fields)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return sm.Where(where), true, nil
+}
+
+// compile turns a filter expression into a SQL expression.
+func compile(expr Expr, fields Fields) (bob.Expression, error) {
+	switch e := expr.(type) {
+	case And:
+		exprs, err := compileAll(e.Exprs, fields)
+		if err != nil {
+			return nil, err
+		}
+		return sqlite.And(exprs...), nil
+
+	case Or:
+		exprs, err := compileAll(e.Exprs, fields)
+		if err != nil {
+			return nil, err
+		}
+		return sqlite.Or(exprs...), nil
+
+	case Not:
+		inner, err := compile(e.Expr, fields)
+		if err != nil {
+			return nil, err
+		}
+		return sqlite.Not(sqlite.Group(inner)), nil
+
+	case Restriction:
+		return compileRestriction(e, fields)
+
+	default:
+		return nil, &Error{Message: "unsupported expression"}
+	}
+}
+
+func compileAll(exprs []Expr, fields Fields) ([]bob.Expression, error) {
+	compiled := []bob.Expression{}
+	for _, expr := range exprs {
+		c, err := compile(expr, fields)
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, c)
+	}
+
+	return compiled, nil
+}
+
+// compileRestriction compares a column to a value of the column's type.
+func compileRestriction(r Restriction, fields Fields) (bob.Expression, error) {
+	field, ok := fields[r.Field]
+	if !ok {
+		return nil, &Error{Pos: r.FieldPos, Token: r.Field, Message: "unknown field, expected one of " + fields.names()}
+	}
+
+	// Match with wildcards
+	if r.Op == OpHas && field.Type == TypeString {
+		return field.Column.Like(sqlite.Raw(`? ESCAPE '\'`, likePattern(r.Value))), nil
+	}
+
+	value, err := parseValue(field.Type, r.Value)
+	if err != nil {
+		return nil, &Error{Pos: r.ValuePos, Token: r.Value, Message: err.Error()}
+	}
+	arg := sqlite.Arg(value)
+
+	switch r.Op {
+	case OpEQ, OpHas:
+		return field.Column.EQ(arg), nil
+	case OpNE:
+		return field.Column.NE(arg), nil
+	case OpLT:
+		return field.Column.LT(arg), nil
+	case OpLTE:
+		return field.Column.LTE(arg), nil
+	case OpGT:
+		return field.Column.GT(arg), nil
+	case OpGTE:
+		return field.Column.GTE(arg), nil
+	default:
+		return nil, &Error{Pos: r.FieldPos, Token: r.Op, Message: "unsupported operator"}
+	}
+}
+
+// parseValue parses a value for a field of the type.
+func parseValue(t Type, value string) (any, error) {
+	switch t {
+	case TypeInt:
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, errors.New("expected an integer")
+		}
+		return v, nil
+
+	case TypeFloat:
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, errors.New("expected a number")
+		}
+		return v, nil
+
+	case TypeTimestamp:
+		v, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return nil, errors.New("expected an RFC 3339 timestamp")
+		}
+		return v, nil
+
+	default:
+		return value, nil
+	}
+}
+
+// likePattern escapes a value for LIKE, with * as the wildcard.
+func likePattern(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`, `*`, `%`)
+	return replacer.Replace(value)
+}
+
+// names lists the names of the fields.
+func (f Fields) names() string {
+	names := []string{}
+	for name := range f {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,415 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/spotdemo4/ts-server/internal/app"
+	"github.com/spotdemo4/ts-server/internal/audit"
+	"github.com/spotdemo4/ts-server/internal/auth"
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+	adminv1 "github.com/spotdemo4/ts-server/internal/connect/admin/v1"
+	"github.com/spotdemo4/ts-server/internal/connect/admin/v1/adminv1connect"
+	"github.com/spotdemo4/ts-server/internal/putil"
+)
+
+type Handler struct {
+	db    *bob.DB
+	auth  *auth.Auth
+	audit *audit.Log
+}
+
+const DefaultLimit = 10
+
+// ListUsers retrieves a list of users, optionally searching by username.
+func (h *Handler) ListUsers(
+	ctx context.Context,
+	req *connect.Request[adminv1.ListUsersRequest],
+) (*connect.Response[adminv1.ListUsersResponse], error) {
+	_, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	query := models.Users.Query(
+		sm.OrderBy(models.Users.Columns.ID),
+	)
+
+	// Search
+	if req.Msg.Search != nil {
+		query.Apply(
+			models.SelectWhere.Users.Username.Like("%" + req.Msg.GetSearch() + "%"),
+		)
+	}
+
+	// Count
+	count, err := query.Count(ctx, h.db)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Limit
+	if req.Msg.Limit != nil {
+		query.Apply(sm.Limit(req.Msg.GetLimit()))
+	} else {
+		query.Apply(sm.Limit(DefaultLimit))
+	}
+
+	// Offset
+	if req.Msg.Offset != nil {
+		query.Apply(sm.Offset(req.Msg.GetOffset()))
+	}
+
+	// Users
+	users, err := query.All(ctx, h.db)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Convert to connect users
+	resUsers := []*adminv1.User{}
+	for _, user := range users {
+		resUsers = append(resUsers, userToConnect(auth.User{User: *user}))
+	}
+
+	return connect.NewResponse(&adminv1.ListUsersResponse{
+		Users: resUsers,
+		Count: count,
+	}), nil
+}
+
+// GetUser retrieves a user by their ID.
+func (h *Handler) GetUser(
+	ctx context.Context,
+	req *connect.Request[adminv1.GetUserRequest],
+) (*connect.Response[adminv1.GetUserResponse], error) {
+	_, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	user, err := h.auth.GetUser(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, putil.CheckNotFound(err)
+	}
+
+	return connect.NewResponse(&adminv1.GetUserResponse{
+		User: userToConnect(user),
+	}), nil
+}
+
+// SetUserRole changes a user's role.
+func (h *Handler) SetUserRole(
+	ctx context.Context,
+	req *connect.Request[adminv1.SetUserRoleRequest],
+) (*connect.Response[adminv1.SetUserRoleResponse], error) {
+	user, err := h.getOtherUser(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = user.SetRole(ctx, auth.Role(req.Msg.GetRole()))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	h.recordUserEvent(ctx, audit.ActionUserRole, user, map[string]any{"from": user.Role, "to": req.Msg.GetRole()})
+
+	resUser, err := h.reloadUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&adminv1.SetUserRoleResponse{
+		User: resUser,
+	}), nil
+}
+
+// DisableUser disables a user's account and signs them out everywhere.
+func (h *Handler) DisableUser(
+	ctx context.Context,
+	req *connect.Request[adminv1.DisableUserRequest],
+) (*connect.Response[adminv1.DisableUserResponse], error) {
+	user, err := h.getOtherUser(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = user.Disable(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	h.recordUserEvent(ctx, audit.ActionUserDisable, user, nil)
+
+	resUser, err := h.reloadUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&adminv1.DisableUserResponse{
+		User: resUser,
+	}), nil
+}
+
+// EnableUser re-enables a disabled user's account.
+func (h *Handler) EnableUser(
+	ctx context.Context,
+	req *connect.Request[adminv1.EnableUserRequest],
+) (*connect.Response[adminv1.EnableUserResponse], error) {
+	user, err := h.getOtherUser(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = user.Enable(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	h.recordUserEvent(ctx, audit.ActionUserEnable, user, nil)
+
+	resUser, err := h.reloadUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&adminv1.EnableUserResponse{
+		User: resUser,
+	}), nil
+}
+
+// ForcePasswordReset requires a user to change their password the next time they sign in.
+func (h *Handler) ForcePasswordReset(
+	ctx context.Context,
+	req *connect.Request[adminv1.ForcePasswordResetRequest],
+) (*connect.Response[adminv1.ForcePasswordResetResponse], error) {
+	user, err := h.getOtherUser(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = user.RequirePasswordReset(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	h.recordUserEvent(ctx, audit.ActionUserForceReset, user, nil)
+
+	resUser, err := h.reloadUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&adminv1.ForcePasswordResetResponse{
+		User: resUser,
+	}), nil
+}
+
+// DeleteUser deletes a user along with their items, files and credentials.
+func (h *Handler) DeleteUser(
+	ctx context.Context,
+	req *connect.Request[adminv1.DeleteUserRequest],
+) (*connect.Response[adminv1.DeleteUserResponse], error) {
+	user, err := h.getOtherUser(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = user.DeleteAccount(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	h.recordUserEvent(ctx, audit.ActionUserDelete, user, map[string]any{"username": user.Username})
+
+	return connect.NewResponse(&adminv1.DeleteUserResponse{}), nil
+}
+
+// ListOAuthClients retrieves the applications that can sign users in.
+func (h *Handler) ListOAuthClients(
+	ctx context.Context,
+	_ *connect.Request[adminv1.ListOAuthClientsRequest],
+) (*connect.Response[adminv1.ListOAuthClientsResponse], error) {
+	_, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	clients, err := h.auth.OAuthClients(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resClients := []*adminv1.OAuthClient{}
+	for _, client := range clients {
+		resClients = append(resClients, clientToConnect(client))
+	}
+
+	return connect.NewResponse(&adminv1.ListOAuthClientsResponse{
+		Clients: resClients,
+	}), nil
+}
+
+// CreateOAuthClient registers an application that can sign users in.
+// The secret is only returned now.
+func (h *Handler) CreateOAuthClient(
+	ctx context.Context,
+	req *connect.Request[adminv1.CreateOAuthClientRequest],
+) (*connect.Response[adminv1.CreateOAuthClientResponse], error) {
+	_, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	client, secret, err := h.auth.NewOAuthClient(ctx, auth.NewOAuthClientParams{
+		Name:         req.Msg.GetName(),
+		RedirectURIs: req.Msg.GetRedirectUris(),
+		Scopes:       req.Msg.GetScopes(),
+		Public:       req.Msg.GetPublic(),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	h.recordClientEvent(ctx, audit.ActionOAuthClientCreate, client.ID, map[string]any{"name": client.Name})
+
+	res := &adminv1.CreateOAuthClientResponse{
+		Client: clientToConnect(client),
+	}
+	if secret != "" {
+		res.Secret = &secret
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// DeleteOAuthClient deletes an application, signing users out of it.
+func (h *Handler) DeleteOAuthClient(
+	ctx context.Context,
+	req *connect.Request[adminv1.DeleteOAuthClientRequest],
+) (*connect.Response[adminv1.DeleteOAuthClientResponse], error) {
+	_, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	err := h.auth.DeleteOAuthClient(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, putil.CheckNotFound(err)
+	}
+	h.recordClientEvent(ctx, audit.ActionOAuthClientDelete, req.Msg.GetId(), nil)
+
+	return connect.NewResponse(&adminv1.DeleteOAuthClientResponse{}), nil
+}
+
+// ListAuditEvents retrieves the security events of every user, newest first.
+func (h *Handler) ListAuditEvents(
+	ctx context.Context,
+	req *connect.Request[adminv1.ListAuditEventsRequest],
+) (*connect.Response[adminv1.ListAuditEventsResponse], error) {
+	_, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	filter := audit.Filter{
+		ActorID: req.Msg.GetActorId(),
+		UserID:  req.Msg.GetUserId(),
+		Action:  req.Msg.GetAction(),
+		Target:  req.Msg.GetTarget(),
+		Outcome: req.Msg.GetOutcome(),
+		Limit:   DefaultLimit,
+		Offset:  int(req.Msg.GetOffset()),
+	}
+	if req.Msg.Start != nil {
+		start := req.Msg.GetStart().AsTime()
+		filter.Start = &start
+	}
+	if req.Msg.End != nil {
+		end := req.Msg.GetEnd().AsTime()
+		filter.End = &end
+	}
+	if req.Msg.Limit != nil {
+		filter.Limit = int(req.Msg.GetLimit())
+	}
+
+	events, count, err := h.audit.List(ctx, filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resEvents := []*adminv1.AuditEvent{}
+	for _, event := range events {
+		resEvents = append(resEvents, auditEventToConnect(event))
+	}
+
+	return connect.NewResponse(&adminv1.ListAuditEventsResponse{
+		Events: resEvents,
+		Count:  count,
+	}), nil
+}
+
+// getOtherUser retrieves a user to modify, making sure admins can't lock themselves out.
+func (h *Handler) getOtherUser(ctx context.Context, userid int32) (auth.User, error) {
+	admin, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return auth.User{}, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+	if admin.ID == userid {
+		return auth.User{}, connect.NewError(connect.CodeFailedPrecondition, errors.New("cannot modify your own account"))
+	}
+
+	user, err := h.auth.GetUser(ctx, userid)
+	if err != nil {
+		return auth.User{}, putil.CheckNotFound(err)
+	}
+
+	return user, nil
+}
+
+// reloadUser retrieves the latest state of a user after it has been modified.
+func (h *Handler) reloadUser(ctx context.Context, userid int32) (*adminv1.User, error) {
+	user, err := h.auth.GetUser(ctx, userid)
+	if err != nil {
+		return nil, putil.CheckNotFound(err)
+	}
+
+	return userToConnect(user), nil
+}
+
+// recordUserEvent records an action the signed in admin took on a user.
+func (h *Handler) recordUserEvent(ctx context.Context, action string, user auth.User, metadata map[string]any) {
+	admin, _ := h.auth.GetContext(ctx)
+	h.audit.Record(ctx, audit.Event{
+		ActorID:  admin.ID,
+		UserID:   user.ID,
+		Action:   action,
+		Target:   fmt.Sprintf("user:%d", user.ID),
+		Metadata: metadata,
+	})
+}
+
+// recordClientEvent records an action the signed in admin took on an OAuth client.
+func (h *Handler) recordClientEvent(ctx context.Context, action string, clientid string, metadata map[string]any) {
+	admin, _ := h.auth.GetContext(ctx)
+	h.audit.Record(ctx, audit.Event{
+		ActorID:  admin.ID,
+		Action:   action,
+		Target:   "oauth_client:" + clientid,
+		Metadata: metadata,
+	})
+}
+
+// New creates a new Admin service handler.
+func New(app *app.App, interceptors connect.Option) (string, http.Handler) {
+	return adminv1connect.NewAdminServiceHandler(
+		&Handler{
+			db:    app.DB,
+			auth:  app.Auth,
+			audit: app.Audit,
+		},
+		interceptors,
+	)
+}
@@ -0,0 +1,46 @@
+package admin
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/spotdemo4/ts-server/internal/auth"
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+	adminv1 "github.com/spotdemo4/ts-server/internal/connect/admin/v1"
+)
+
+func userToConnect(user auth.User) *adminv1.User {
+	return &adminv1.User{
+		Id:                    user.ID,
+		Username:              user.Username,
+		ProfilePictureId:      user.ProfilePictureID.Ptr(),
+		Role:                  user.Role,
+		Disabled:              user.Disabled(),
+		PasswordResetRequired: user.PasswordResetRequired,
+	}
+}
+
+func clientToConnect(client auth.OAuthClient) *adminv1.OAuthClient {
+	return &adminv1.OAuthClient{
+		Id:           client.ID,
+		Name:         client.Name,
+		RedirectUris: client.RedirectURIs(),
+		Scopes:       client.AllowedScopes(),
+		Public:       client.Public(),
+		CreatedAt:    timestamppb.New(client.CreatedAt),
+	}
+}
+
+func auditEventToConnect(event *models.AuditEvent) *adminv1.AuditEvent {
+	return &adminv1.AuditEvent{
+		Id:        int64(event.ID),
+		ActorId:   event.ActorID.Ptr(),
+		UserId:    event.UserID.Ptr(),
+		Action:    event.Action,
+		Target:    event.Target,
+		Outcome:   event.Outcome,
+		Ip:        event.IP,
+		UserAgent: event.UserAgent,
+		Metadata:  event.Metadata,
+		CreatedAt: timestamppb.New(event.CreatedAt),
+	}
+}
@@ -0,0 +1,63 @@
+package export
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/spotdemo4/ts-server/internal/app"
+	"github.com/spotdemo4/ts-server/internal/auth"
+	"github.com/spotdemo4/ts-server/internal/export"
+	"github.com/spotdemo4/ts-server/internal/interceptors"
+)
+
+type Handler struct {
+	auth     *auth.Auth
+	exporter *export.Exporter
+}
+
+// ServeHTTP downloads the ZIP archive of a finished account export.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.auth.GetContext(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/auth", http.StatusFound)
+		return
+	}
+
+	// Get export
+	accountExport, err := h.exporter.Get(r.Context(), user.ID, r.PathValue("id"))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			http.Error(w, "Not Found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	switch accountExport.Status {
+	case export.StatusReady:
+	case export.StatusFailed:
+		http.Error(w, accountExport.Error.GetOrZero(), http.StatusInternalServerError)
+		return
+	default:
+		http.Error(w, export.ErrExportPending.Error(), http.StatusConflict)
+		return
+	}
+
+	// Send archive in response
+	name := "account-" + accountExport.CreatedAt.Format("2006-01-02") + ".zip"
+	w.Header().Set("Content-Disposition", `attachment; filename="`+name+`"`)
+	w.Header().Set("Cache-Control", "no-store")
+	http.ServeContent(w, r, name, accountExport.CreatedAt, h.exporter.Open(r.Context(), accountExport))
+}
+
+func New(app *app.App) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("GET /export/{id}", &Handler{
+		auth:     app.Auth,
+		exporter: app.Export,
+	})
+
+	return interceptors.WithAuthRedirect(mux, app.Auth)
+}
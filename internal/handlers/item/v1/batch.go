@@ -0,0 +1,205 @@
+package item
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/stephenafamo/bob"
+
+	"github.com/spotdemo4/ts-server/internal/audit"
+	"github.com/spotdemo4/ts-server/internal/auth"
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+	itemv1 "github.com/spotdemo4/ts-server/internal/connect/item/v1"
+	"github.com/spotdemo4/ts-server/internal/putil"
+)
+
+// entry runs one entry of a batch, returning the id and the created or updated item.
+type entry func(ctx context.Context, exec bob.Executor, i int) (int32, *models.Item, error)
+
+// BatchCreateItems creates many items for a user in one transaction.
+func (h *Handler) BatchCreateItems(
+	ctx context.Context,
+	req *connect.Request[itemv1.BatchCreateItemsRequest],
+) (*connect.Response[itemv1.BatchCreateItemsResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	requests := req.Msg.GetRequests()
+	results, err := h.batch(ctx, user, audit.ActionItemCreate, len(requests), req.Msg.GetAllowPartial(),
+		func(ctx context.Context, exec bob.Executor, i int) (int32, *models.Item, error) {
+			item, err := models.Items.Insert(createSetter(user.ID, requests[i])).One(ctx, exec)
+			if err != nil {
+				return 0, nil, connect.NewError(connect.CodeInternal, err)
+			}
+
+			return item.ID, item, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res := connect.NewResponse(&itemv1.BatchCreateItemsResponse{
+		Results: results,
+	})
+	return res, nil
+}
+
+// BatchUpdateItems updates many of a user's items in one transaction.
+func (h *Handler) BatchUpdateItems(
+	ctx context.Context,
+	req *connect.Request[itemv1.BatchUpdateItemsRequest],
+) (*connect.Response[itemv1.BatchUpdateItemsResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	requests := req.Msg.GetRequests()
+	results, err := h.batch(ctx, user, audit.ActionItemUpdate, len(requests), req.Msg.GetAllowPartial(),
+		func(ctx context.Context, exec bob.Executor, i int) (int32, *models.Item, error) {
+			id := requests[i].GetId()
+
+			// Get item
+			item, err := models.Items.Query(
+				models.SelectWhere.Items.ID.EQ(id),
+				models.SelectWhere.Items.UserID.EQ(user.ID),
+			).One(ctx, exec)
+			if err != nil {
+				return id, nil, putil.CheckNotFound(err)
+			}
+
+			// Update item, if any fields were given
+			setter := updateSetter(requests[i])
+			if len(setter.SetColumns()) > 0 {
+				err = item.Update(ctx, exec, setter)
+				if err != nil {
+					return id, nil, connect.NewError(connect.CodeInternal, err)
+				}
+			}
+
+			return id, item, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res := connect.NewResponse(&itemv1.BatchUpdateItemsResponse{
+		Results: results,
+	})
+	return res, nil
+}
+
+// BatchDeleteItems deletes many of a user's items in one transaction.
+func (h *Handler) BatchDeleteItems(
+	ctx context.Context,
+	req *connect.Request[itemv1.BatchDeleteItemsRequest],
+) (*connect.Response[itemv1.BatchDeleteItemsResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	ids := req.Msg.GetIds()
+	results, err := h.batch(ctx, user, audit.ActionItemDelete, len(ids), req.Msg.GetAllowPartial(),
+		func(ctx context.Context, exec bob.Executor, i int) (int32, *models.Item, error) {
+			// Get item
+			item, err := models.Items.Query(
+				models.SelectWhere.Items.ID.EQ(ids[i]),
+				models.SelectWhere.Items.UserID.EQ(user.ID),
+			).One(ctx, exec)
+			if err != nil {
+				return ids[i], nil, putil.CheckNotFound(err)
+			}
+
+			// Delete item
+			err = item.Delete(ctx, exec)
+			if err != nil {
+				return ids[i], nil, connect.NewError(connect.CodeInternal, err)
+			}
+
+			return ids[i], nil, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res := connect.NewResponse(&itemv1.BatchDeleteItemsResponse{
+		Results: results,
+	})
+	return res, nil
+}
+
+// batch runs every entry of a batch in one transaction, returning the outcome of each in order.
+// Unless partial, the first failing entry rolls back the whole batch and fails the request.
+func (h *Handler) batch(
+	ctx context.Context,
+	user auth.User,
+	action string,
+	size int,
+	partial bool,
+	run entry,
+) ([]*itemv1.BatchItemResult, error) {
+	if size > h.batchMaxSize {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("batches can have at most %d entries", h.batchMaxSize),
+		)
+	}
+
+	var results []*itemv1.BatchItemResult
+	err := h.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		results = []*itemv1.BatchItemResult{}
+		for i := range size {
+			id, item, err := run(ctx, exec, i)
+			if err != nil && !partial {
+				return connect.NewError(connect.CodeOf(err), fmt.Errorf("entry %d: %s", i, errorMessage(err)))
+			}
+
+			result := &itemv1.BatchItemResult{
+				Id: id,
+			}
+			if err != nil {
+				result.ErrorCode = connect.CodeOf(err).String()
+				result.ErrorMessage = errorMessage(err)
+			} else if item != nil {
+				result.Item = itemToConnect(*item)
+			}
+			results = append(results, result)
+		}
+
+		return nil
+	})
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, connectErr
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Record the entries that succeeded, once they are committed
+	for _, result := range results {
+		if result.GetErrorCode() == "" {
+			h.record(ctx, user, action, result.GetId())
+		}
+	}
+
+	return results, nil
+}
+
+// errorMessage returns the message of an error without its code.
+func errorMessage(err error) string {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr.Message()
+	}
+
+	return err.Error()
+}
@@ -0,0 +1,178 @@
+package item
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+	itemv1 "github.com/spotdemo4/ts-server/internal/connect/item/v1"
+)
+
+var (
+	ErrInvalidPageToken = errors.New("invalid page token")
+	ErrPageTokenSort    = errors.New("page token was made with a different sort")
+	ErrPageTokenQuery   = errors.New("page token was made with a different filter, start or end")
+	ErrDuplicateSort    = errors.New("items can only be sorted by each field once")
+)
+
+// cursor is the last item of a page, the next page starts after it.
+type cursor struct {
+	Sort     string    `json:"s"`
+	Query    string    `json:"f"`
+	Name     string    `json:"n"`
+	Added    time.Time `json:"a"`
+	Price    float32   `json:"p"`
+	Quantity int32     `json:"q"`
+	ID       int32     `json:"i"`
+}
+
+// sortKey is a column items are ordered by.
+type sortKey struct {
+	column     sqlite.Expression
+	descending bool
+	value      func(c cursor) any
+}
+
+// sortKeys returns the columns to order items by, ending with the id so the order is stable.
+// The spec identifies the sort in page tokens.
+func sortKeys(sorts []*itemv1.ItemSort) ([]sortKey, string, error) {
+	keys := []sortKey{}
+	specs := []string{}
+	seen := map[itemv1.ItemSortField]bool{}
+	for _, sort := range sorts {
+		if seen[sort.GetField()] {
+			return nil, "", ErrDuplicateSort
+		}
+		seen[sort.GetField()] = true
+
+		key := sortKey{
+			descending: sort.GetDescending(),
+		}
+		switch sort.GetField() {
+		case itemv1.ItemSortField_ITEM_SORT_FIELD_NAME:
+			key.column = models.Items.Columns.Name
+			key.value = func(c cursor) any { return c.Name }
+		case itemv1.ItemSortField_ITEM_SORT_FIELD_ADDED:
+			key.column = models.Items.Columns.Added
+			key.value = func(c cursor) any { return c.Added }
+		case itemv1.ItemSortField_ITEM_SORT_FIELD_PRICE:
+			key.column = models.Items.Columns.Price
+			key.value = func(c cursor) any { return c.Price }
+		case itemv1.ItemSortField_ITEM_SORT_FIELD_QUANTITY:
+			key.column = models.Items.Columns.Quantity
+			key.value = func(c cursor) any { return c.Quantity }
+		case itemv1.ItemSortField_ITEM_SORT_FIELD_UNSPECIFIED:
+			continue
+		}
+		keys = append(keys, key)
+		specs = append(specs, fmt.Sprintf("%d:%t", sort.GetField(), sort.GetDescending()))
+	}
+
+	// Tiebreaker
+	keys = append(keys, sortKey{
+		column: models.Items.Columns.ID,
+		value:  func(c cursor) any { return c.ID },
+	})
+
+	return keys, strings.Join(specs, ","), nil
+}
+
+// orderBy orders items by the sort keys.
+func orderBy(keys []sortKey) []bob.Mod[*dialect.SelectQuery] {
+	mods := []bob.Mod[*dialect.SelectQuery]{}
+	for _, key := range keys {
+		if key.descending {
+			mods = append(mods, sm.OrderBy(key.column).Desc())
+		} else {
+			mods = append(mods, sm.OrderBy(key.column).Asc())
+		}
+	}
+
+	return mods
+}
+
+// after matches the items that come after the cursor in the order of the sort keys.
+func after(keys []sortKey, c cursor) bob.Mod[*dialect.SelectQuery] {
+	// (a > ?) OR (a = ? AND b > ?) OR (a = ? AND b = ? AND id > ?)
+	or := []bob.Expression{}
+	for i, key := range keys {
+		and := []bob.Expression{}
+		for _, prev := range keys[:i] {
+			and = append(and, prev.column.EQ(sqlite.Arg(prev.value(c))))
+		}
+		if key.descending {
+			and = append(and, key.column.LT(sqlite.Arg(key.value(c))))
+		} else {
+			and = append(and, key.column.GT(sqlite.Arg(key.value(c))))
+		}
+		or = append(or, sqlite.And(and...))
+	}
+
+	return sm.Where(sqlite.Or(or...))
+}
+
+// queryHash identifies the items a page token was made for, by a hash of their filter, start and end.
+func queryHash(filter string, start, end *timestamppb.Timestamp) string {
+	times := []string{}
+	for _, t := range []*timestamppb.Timestamp{start, end} {
+		if t == nil {
+			times = append(times, "")
+			continue
+		}
+		times = append(times, t.AsTime().Format(time.RFC3339Nano))
+	}
+
+	sum := sha256.Sum256(fmt.Appendf(nil, "%q %q %q", filter, times[0], times[1]))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// encodeCursor creates an opaque page token that continues after the item.
+func encodeCursor(spec string, query string, item *models.Item) (string, error) {
+	b, err := json.Marshal(cursor{
+		Sort:     spec,
+		Query:    query,
+		Name:     item.Name,
+		Added:    item.Added,
+		Price:    item.Price,
+		Quantity: item.Quantity,
+		ID:       item.ID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// decodeCursor parses a page token, which must have been made with the same sort and query.
+func decodeCursor(spec string, query string, token string) (cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return cursor{}, ErrInvalidPageToken
+	}
+
+	var c cursor
+	err = json.Unmarshal(b, &c)
+	if err != nil {
+		return cursor{}, ErrInvalidPageToken
+	}
+	if c.Sort != spec {
+		return cursor{}, ErrPageTokenSort
+	}
+	if c.Query != query {
+		return cursor{}, ErrPageTokenQuery
+	}
+
+	return c, nil
+}
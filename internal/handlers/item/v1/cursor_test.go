@@ -0,0 +1,367 @@
+package item_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+	itemv1 "github.com/spotdemo4/ts-server/internal/connect/item/v1"
+	"github.com/spotdemo4/ts-server/internal/database"
+	"github.com/spotdemo4/ts-server/internal/handlers/item/v1"
+)
+
+func sorts(pairs ...any) []*itemv1.ItemSort {
+	s := []*itemv1.ItemSort{}
+	for i := 0; i < len(pairs); i += 2 {
+		field, _ := pairs[i].(itemv1.ItemSortField)
+		descending, _ := pairs[i+1].(bool)
+		s = append(s, &itemv1.ItemSort{Field: field, Descending: descending})
+	}
+
+	return s
+}
+
+func TestSortKeys(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		sorts   []*itemv1.ItemSort
+		keys    int
+		spec    string
+		wantErr error
+	}{
+		{
+			name:  "id only",
+			sorts: nil,
+			keys:  1,
+			spec:  "",
+		},
+		{
+			name:  "unspecified is skipped",
+			sorts: sorts(itemv1.ItemSortField_ITEM_SORT_FIELD_UNSPECIFIED, true),
+			keys:  1,
+			spec:  "",
+		},
+		{
+			name: "fields in order",
+			sorts: sorts(
+				itemv1.ItemSortField_ITEM_SORT_FIELD_PRICE, true,
+				itemv1.ItemSortField_ITEM_SORT_FIELD_NAME, false,
+			),
+			keys: 3,
+			spec: "3:true,1:false",
+		},
+		{
+			name: "duplicate field",
+			sorts: sorts(
+				itemv1.ItemSortField_ITEM_SORT_FIELD_PRICE, true,
+				itemv1.ItemSortField_ITEM_SORT_FIELD_PRICE, false,
+			),
+			wantErr: item.ErrDuplicateSort,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			keys, spec, err := item.SortKeys(tt.sorts)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SortKeys returned error %v, want %v", err, tt.wantErr)
+			}
+			if len(keys) != tt.keys || spec != tt.spec {
+				t.Errorf("SortKeys returned %d keys and spec %q, want %d and %q", len(keys), spec, tt.keys, tt.spec)
+			}
+		})
+	}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	row := &models.Item{
+		ID:       42,
+		Name:     "widget \"a\" ü",
+		Added:    time.Date(2026, 10, 17, 12, 30, 0, 123456789, time.FixedZone("", 2*60*60)),
+		Price:    -1.25,
+		Quantity: 7,
+	}
+	token, err := item.EncodeCursor("3:true,1:false", "query", row)
+	if err != nil {
+		t.Fatalf("Error encoding cursor: %v", err)
+	}
+
+	c, err := item.DecodeCursor("3:true,1:false", "query", token)
+	if err != nil {
+		t.Fatalf("Error decoding cursor: %v", err)
+	}
+	if c.ID != row.ID || c.Name != row.Name || !c.Added.Equal(row.Added) || c.Price != row.Price ||
+		c.Quantity != row.Quantity {
+		t.Errorf("DecodeCursor = %+v, want the fields of %+v", c, row)
+	}
+}
+
+func TestDecodeCursorErrors(t *testing.T) {
+	t.Parallel()
+
+	token, err := item.EncodeCursor("3:true", "query", &models.Item{ID: 1})
+	if err != nil {
+		t.Fatalf("Error encoding cursor: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		spec  string
+		query string
+		token string
+		want  error
+	}{
+		{
+			name:  "not base64",
+			spec:  "3:true",
+			query: "query",
+			token: "not a token!",
+			want:  item.ErrInvalidPageToken,
+		},
+		{
+			name:  "not json",
+			spec:  "3:true",
+			query: "query",
+			token: base64.RawURLEncoding.EncodeToString([]byte("not json")),
+			want:  item.ErrInvalidPageToken,
+		},
+		{
+			name:  "other direction",
+			spec:  "3:false",
+			query: "query",
+			token: token,
+			want:  item.ErrPageTokenSort,
+		},
+		{
+			name:  "other field",
+			spec:  "4:true",
+			query: "query",
+			token: token,
+			want:  item.ErrPageTokenSort,
+		},
+		{
+			name:  "no sort",
+			spec:  "",
+			query: "query",
+			token: token,
+			want:  item.ErrPageTokenSort,
+		},
+		{
+			name:  "other query",
+			spec:  "3:true",
+			query: "other query",
+			token: token,
+			want:  item.ErrPageTokenQuery,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := item.DecodeCursor(tt.spec, tt.query, tt.token)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("DecodeCursor returned %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryHash(t *testing.T) {
+	t.Parallel()
+
+	start := timestamppb.New(time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC))
+	end := timestamppb.New(time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC))
+	hash := item.QueryHash("price < 20", start, end)
+
+	tests := []struct {
+		name   string
+		filter string
+		start  *timestamppb.Timestamp
+		end    *timestamppb.Timestamp
+		same   bool
+	}{
+		{
+			name:   "same query",
+			filter: "price < 20",
+			start:  timestamppb.New(start.AsTime()),
+			end:    timestamppb.New(end.AsTime()),
+			same:   true,
+		},
+		{
+			name:   "other filter",
+			filter: "price < 21",
+			start:  start,
+			end:    end,
+		},
+		{
+			name:   "no start",
+			filter: "price < 20",
+			end:    end,
+		},
+		{
+			name:   "other end",
+			filter: "price < 20",
+			start:  start,
+			end:    timestamppb.New(end.AsTime().Add(time.Nanosecond)),
+		},
+		{
+			name:   "filter with the times",
+			filter: `price < 20" "2026-10-17T12:00:00Z" "2026-10-18T12:00:00Z`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := item.QueryHash(tt.filter, tt.start, tt.end)
+			if (got == hash) != tt.same {
+				t.Errorf("QueryHash = %q, want same as %q: %t", got, hash, tt.same)
+			}
+		})
+	}
+}
+
+// TestKeysetPages pages through items with ties in every sorted field,
+// checking the pages match the items sorted in one query.
+func TestKeysetPages(t *testing.T) {
+	t.Parallel()
+
+	db, err := database.New("sqlite:" + filepath.Join(t.TempDir(), "items.db"))
+	if err != nil {
+		t.Fatalf("Error opening database: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	_, err = db.ExecContext(t.Context(), `CREATE TABLE item (
+		id INTEGER PRIMARY KEY NOT NULL,
+		name TEXT NOT NULL,
+		added DATETIME NOT NULL,
+		description TEXT NOT NULL,
+		price REAL NOT NULL,
+		quantity INTEGER NOT NULL,
+		user_id INTEGER NOT NULL
+	)`)
+	if err != nil {
+		t.Fatalf("Error creating table: %v", err)
+	}
+
+	start := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	for i := range 20 {
+		_, err = models.Items.Insert(&models.ItemSetter{
+			Name:        omit.From(fmt.Sprintf("item %d", i%4)),
+			Added:       omit.From(start.Add(time.Duration(i%5) * time.Millisecond)),
+			Description: omit.From(""),
+			Price:       omit.From(float32(i%3) - 0.5),
+			Quantity:    omit.From(int32(i % 2)),
+			UserID:      omit.From(int32(1)),
+		}).Exec(t.Context(), db)
+		if err != nil {
+			t.Fatalf("Error inserting item: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		sorts []*itemv1.ItemSort
+	}{
+		{
+			name:  "id",
+			sorts: nil,
+		},
+		{
+			name:  "name",
+			sorts: sorts(itemv1.ItemSortField_ITEM_SORT_FIELD_NAME, false),
+		},
+		{
+			name:  "price descending",
+			sorts: sorts(itemv1.ItemSortField_ITEM_SORT_FIELD_PRICE, true),
+		},
+		{
+			name: "added descending then name",
+			sorts: sorts(
+				itemv1.ItemSortField_ITEM_SORT_FIELD_ADDED, true,
+				itemv1.ItemSortField_ITEM_SORT_FIELD_NAME, false,
+			),
+		},
+		{
+			name: "every field descending",
+			sorts: sorts(
+				itemv1.ItemSortField_ITEM_SORT_FIELD_QUANTITY, true,
+				itemv1.ItemSortField_ITEM_SORT_FIELD_PRICE, true,
+				itemv1.ItemSortField_ITEM_SORT_FIELD_ADDED, true,
+				itemv1.ItemSortField_ITEM_SORT_FIELD_NAME, true,
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			keys, spec, err := item.SortKeys(tt.sorts)
+			if err != nil {
+				t.Fatalf("Error getting sort keys: %v", err)
+			}
+
+			// Sorted in one query
+			all, err := models.Items.Query(item.OrderBy(keys)...).All(t.Context(), db)
+			if err != nil {
+				t.Fatalf("Error getting items: %v", err)
+			}
+			want := []int32{}
+			for _, row := range all {
+				want = append(want, row.ID)
+			}
+
+			// Sorted in pages of 3, each continuing from the token of the last
+			got := []int32{}
+			token := ""
+			for range len(want) {
+				mods := []bob.Mod[*dialect.SelectQuery]{sm.Limit(3)}
+				if token != "" {
+					c, err := item.DecodeCursor(spec, "", token)
+					if err != nil {
+						t.Fatalf("Error decoding cursor: %v", err)
+					}
+					mods = append(mods, item.After(keys, c))
+				}
+				mods = append(mods, item.OrderBy(keys)...)
+
+				page, err := models.Items.Query(mods...).All(t.Context(), db)
+				if err != nil {
+					t.Fatalf("Error getting page: %v", err)
+				}
+				if len(page) == 0 {
+					break
+				}
+				for _, row := range page {
+					got = append(got, row.ID)
+				}
+
+				token, err = item.EncodeCursor(spec, "", page[len(page)-1])
+				if err != nil {
+					t.Fatalf("Error encoding cursor: %v", err)
+				}
+			}
+
+			if len(want) != 20 || !slices.Equal(got, want) {
+				t.Errorf("pages returned %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package item
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"buf.build/go/protovalidate"
+	"github.com/stephenafamo/bob"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/spotdemo4/ts-server/internal/app"
+	"github.com/spotdemo4/ts-server/internal/auth"
+	itemv1 "github.com/spotdemo4/ts-server/internal/connect/item/v1"
+	"github.com/spotdemo4/ts-server/internal/interceptors"
+)
+
+//nolint:gochecknoglobals // Constant
+var (
+	downloadFormats = map[string]itemv1.ItemFormat{
+		"csv":    itemv1.ItemFormat_ITEM_FORMAT_CSV,
+		"json":   itemv1.ItemFormat_ITEM_FORMAT_JSON,
+		"ndjson": itemv1.ItemFormat_ITEM_FORMAT_NDJSON,
+	}
+	downloadSortFields = map[string]itemv1.ItemSortField{
+		"name":     itemv1.ItemSortField_ITEM_SORT_FIELD_NAME,
+		"added":    itemv1.ItemSortField_ITEM_SORT_FIELD_ADDED,
+		"price":    itemv1.ItemSortField_ITEM_SORT_FIELD_PRICE,
+		"quantity": itemv1.ItemSortField_ITEM_SORT_FIELD_QUANTITY,
+	}
+)
+
+type DownloadHandler struct {
+	db   *bob.DB
+	auth *auth.Auth
+	log  *slog.Logger
+}
+
+// ServeHTTP downloads a user's items as a file, like ExportItems.
+// The query takes format (csv, json or ndjson), filter, start and end as RFC 3339 timestamps,
+// and order_by as a list of fields like "name, price desc".
+func (h *DownloadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.auth.GetContext(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/auth", http.StatusFound)
+		return
+	}
+
+	// Parse request
+	req, err := downloadRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	query, err := exportQuery(user.ID, req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	contentType, ext, err := formatType(req.GetFormat())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Stream file in response
+	name := "items-" + time.Now().Format("2006-01-02") + "." + ext
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", `attachment; filename="`+name+`"`)
+	w.Header().Set("Cache-Control", "no-store")
+	err = writeItems(r.Context(), h.db, query, req.GetFormat(), w)
+	if err != nil {
+		h.log.Error("Failed to export items", "user", user.ID, "error", err)
+	}
+}
+
+// downloadRequest parses the query of a download into an export request.
+func downloadRequest(r *http.Request) (*itemv1.ExportItemsRequest, error) {
+	values := r.URL.Query()
+	req := &itemv1.ExportItemsRequest{
+		Format: itemv1.ItemFormat_ITEM_FORMAT_CSV,
+	}
+
+	// Format
+	if values.Has("format") {
+		format, ok := downloadFormats[values.Get("format")]
+		if !ok {
+			return nil, errors.New("format must be csv, json or ndjson")
+		}
+		req.Format = format
+	}
+
+	// Filter
+	if values.Has("filter") {
+		filter := values.Get("filter")
+		req.Filter = &filter
+	}
+
+	// Start and end
+	for _, param := range []struct {
+		name  string
+		value **timestamppb.Timestamp
+	}{
+		{"start", &req.Start},
+		{"end", &req.End},
+	} {
+		if !values.Has(param.name) {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339Nano, values.Get(param.name))
+		if err != nil {
+			return nil, fmt.Errorf("%s must be an RFC 3339 timestamp", param.name)
+		}
+		*param.value = timestamppb.New(t)
+	}
+
+	// Sort
+	for field := range strings.SplitSeq(values.Get("order_by"), ",") {
+		parts := strings.Fields(field)
+		if len(parts) == 0 {
+			continue
+		}
+
+		sortField, ok := downloadSortFields[parts[0]]
+		if !ok || len(parts) > 2 || len(parts) == 2 && parts[1] != "asc" && parts[1] != "desc" {
+			return nil, fmt.Errorf("invalid order_by field %q", strings.TrimSpace(field))
+		}
+		req.Sort = append(req.Sort, &itemv1.ItemSort{
+			Field:      sortField,
+			Descending: len(parts) == 2 && parts[1] == "desc",
+		})
+	}
+
+	return req, protovalidate.Validate(req)
+}
+
+// NewDownload creates a new item download handler.
+func NewDownload(app *app.App) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("GET /items/export", &DownloadHandler{
+		db:   app.DB,
+		auth: app.Auth,
+		log:  app.Log,
+	})
+
+	return interceptors.WithAuthRedirect(mux, app.Auth)
+}
@@ -0,0 +1,21 @@
+package item
+
+// Exported for tests
+//
+//nolint:gochecknoglobals // Constant
+var (
+	NewItemReader = newItemReader
+	NewItemWriter = newItemWriter
+
+	SortKeys     = sortKeys
+	OrderBy      = orderBy
+	After        = after
+	EncodeCursor = encodeCursor
+	DecodeCursor = decodeCursor
+	QueryHash    = queryHash
+)
+
+type (
+	RowError = rowError
+	Cursor   = cursor
+)
@@ -0,0 +1,564 @@
+package item
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	itemv1 "github.com/spotdemo4/ts-server/internal/connect/item/v1"
+)
+
+const (
+	MaxLineSize  = 64 << 10   // Longest CSV row, JSON item or NDJSON line read, longer ones are skipped as invalid rows
+	formulaChars = "=+-@\t\r" // Leading characters spreadsheets read as the start of a formula
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown item format")
+	ErrMissingArray  = errors.New("expected a JSON array of items")
+)
+
+//nolint:gochecknoglobals // Constant
+var csvHeader = []string{"id", "name", "description", "price", "quantity", "added"}
+
+// rowError is a problem with one row of an import, the rows after it can still be read.
+type rowError struct {
+	field   string
+	message string
+}
+
+func (e *rowError) Error() string {
+	if e.field == "" {
+		return e.message
+	}
+
+	return e.field + ": " + e.message
+}
+
+// itemWriter writes items to a file of a format.
+type itemWriter interface {
+	Write(item *itemv1.Item) error
+
+	// Close finishes the file.
+	Close() error
+}
+
+// itemReader reads items from a file of a format.
+type itemReader interface {
+	// Read returns the next item, a *rowError if only that row is invalid, or io.EOF after the last row.
+	Read() (*itemv1.Item, error)
+}
+
+// formatType returns the content type and file extension of a format.
+func formatType(format itemv1.ItemFormat) (string, string, error) {
+	switch format {
+	case itemv1.ItemFormat_ITEM_FORMAT_CSV:
+		return "text/csv; charset=utf-8", "csv", nil
+	case itemv1.ItemFormat_ITEM_FORMAT_JSON:
+		return "application/json", "json", nil
+	case itemv1.ItemFormat_ITEM_FORMAT_NDJSON:
+		return "application/x-ndjson", "ndjson", nil
+	case itemv1.ItemFormat_ITEM_FORMAT_UNSPECIFIED:
+	}
+
+	return "", "", ErrUnknownFormat
+}
+
+func newItemWriter(format itemv1.ItemFormat, w io.Writer) (itemWriter, error) {
+	switch format {
+	case itemv1.ItemFormat_ITEM_FORMAT_CSV:
+		cw := csv.NewWriter(w)
+		return &csvItemWriter{w: cw}, cw.Write(csvHeader)
+	case itemv1.ItemFormat_ITEM_FORMAT_JSON:
+		return &jsonItemWriter{w: w}, nil
+	case itemv1.ItemFormat_ITEM_FORMAT_NDJSON:
+		return &ndjsonItemWriter{w: w}, nil
+	case itemv1.ItemFormat_ITEM_FORMAT_UNSPECIFIED:
+	}
+
+	return nil, ErrUnknownFormat
+}
+
+func newItemReader(format itemv1.ItemFormat, r io.Reader) (itemReader, error) {
+	switch format {
+	case itemv1.ItemFormat_ITEM_FORMAT_CSV:
+		return newCSVItemReader(r)
+	case itemv1.ItemFormat_ITEM_FORMAT_JSON:
+		return newJSONItemReader(r), nil
+	case itemv1.ItemFormat_ITEM_FORMAT_NDJSON:
+		return newNDJSONItemReader(r), nil
+	case itemv1.ItemFormat_ITEM_FORMAT_UNSPECIFIED:
+	}
+
+	return nil, ErrUnknownFormat
+}
+
+// marshalItem encodes an item as a JSON object, with every field.
+func marshalItem(item *itemv1.Item) ([]byte, error) {
+	return protojson.MarshalOptions{
+		UseProtoNames:   true,
+		EmitUnpopulated: true,
+	}.Marshal(item)
+}
+
+// CSV, with a header row
+type csvItemWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvItemWriter) Write(item *itemv1.Item) error {
+	return c.w.Write([]string{
+		strconv.Itoa(int(item.GetId())),
+		escapeCell(item.GetName()),
+		escapeCell(item.GetDescription()),
+		strconv.FormatFloat(float64(item.GetPrice()), 'f', -1, 32),
+		strconv.Itoa(int(item.GetQuantity())),
+		item.GetAdded().AsTime().Format(time.RFC3339Nano),
+	})
+}
+
+func (c *csvItemWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+type csvItemReader struct {
+	s       *bufio.Scanner
+	fields  int // Fields in the header, which every row must have
+	columns map[string]int
+
+	quoted     bool // The scanned part of the current row ends inside a quoted field
+	closed     bool // The scanned part of the current row ends with the quote closing a quoted field
+	fieldStart bool // The scanned part of the current row ends at the start of a field
+	scanned    int  // Bytes of the current row already scanned
+	skipping   bool // The current row is longer than MaxLineSize, the rest of it is being skipped
+	tooLong    bool // The last row was longer than MaxLineSize
+}
+
+// newCSVItemReader reads the header row, which must have the name, description, price and quantity columns.
+// The id column is ignored, and added is optional.
+func newCSVItemReader(r io.Reader) (*csvItemReader, error) {
+	c := &csvItemReader{
+		s:          bufio.NewScanner(r),
+		fieldStart: true,
+	}
+	c.s.Buffer(nil, MaxLineSize)
+	c.s.Split(c.split)
+
+	header, err := c.record()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("missing CSV header")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV header: %w", err)
+	}
+
+	c.fields = len(header)
+	c.columns = map[string]int{}
+	for i, column := range header {
+		if !slices.Contains(csvHeader, column) {
+			return nil, fmt.Errorf("unknown CSV column %q", column)
+		}
+		c.columns[column] = i
+	}
+	for _, column := range []string{"name", "description", "price", "quantity"} {
+		if _, ok := c.columns[column]; !ok {
+			return nil, fmt.Errorf("missing CSV column %q", column)
+		}
+	}
+
+	return c, nil
+}
+
+// split splits rows like ndjsonItemReader.split, but only on line breaks outside quoted fields.
+func (c *csvItemReader) split(data []byte, atEOF bool) (int, []byte, error) {
+	for i := c.scanned; i < len(data); i++ {
+		// Like encoding/csv, quotes only start a quoted field at its start, and are doubled inside it
+		if data[i] == '"' && (c.quoted || c.closed || c.fieldStart) {
+			c.closed = c.quoted
+			c.quoted = !c.quoted
+			c.fieldStart = false
+			continue
+		}
+		c.closed = false
+		c.fieldStart = data[i] == ',' && !c.quoted
+
+		if data[i] == '\n' && !c.quoted {
+			c.fieldStart = true
+			c.scanned = 0
+			if c.skipping {
+				c.skipping = false
+				c.tooLong = true
+				return i + 1, []byte{}, nil
+			}
+			return i + 1, data[:i+1], nil
+		}
+	}
+
+	if c.skipping || len(data) >= MaxLineSize {
+		c.skipping = !atEOF
+		c.tooLong = atEOF
+		c.scanned = 0
+		if atEOF {
+			return len(data), []byte{}, nil
+		}
+		return len(data), nil, nil
+	}
+
+	if atEOF && len(data) > 0 {
+		c.scanned = 0
+		return len(data), data, nil
+	}
+
+	c.scanned = len(data)
+	return 0, nil, nil
+}
+
+// record returns the fields of the next row that isn't blank.
+func (c *csvItemReader) record() ([]string, error) {
+	for c.s.Scan() {
+		if c.tooLong {
+			c.tooLong = false
+			return nil, &rowError{message: fmt.Sprintf("row is longer than %d bytes", MaxLineSize)}
+		}
+
+		record, err := csv.NewReader(bytes.NewReader(c.s.Bytes())).Read()
+		if errors.Is(err, io.EOF) {
+			continue
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, &rowError{message: parseErr.Err.Error()}
+		}
+
+		return record, err
+	}
+
+	err := c.s.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, io.EOF
+}
+
+func (c *csvItemReader) Read() (*itemv1.Item, error) {
+	record, err := c.record()
+	if err != nil {
+		return nil, err
+	}
+	if len(record) != c.fields {
+		return nil, &rowError{message: fmt.Sprintf("expected %d fields, got %d", c.fields, len(record))}
+	}
+
+	item := &itemv1.Item{
+		Name:        unescapeCell(record[c.columns["name"]]),
+		Description: unescapeCell(record[c.columns["description"]]),
+	}
+
+	price, err := strconv.ParseFloat(record[c.columns["price"]], 32)
+	if err != nil {
+		return nil, &rowError{field: "price", message: "expected a number"}
+	}
+	item.Price = float32(price)
+
+	quantity, err := strconv.ParseInt(record[c.columns["quantity"]], 10, 32)
+	if err != nil {
+		return nil, &rowError{field: "quantity", message: "expected an integer"}
+	}
+	item.Quantity = int32(quantity)
+
+	if i, ok := c.columns["added"]; ok && record[i] != "" {
+		added, err := time.Parse(time.RFC3339Nano, record[i])
+		if err != nil {
+			return nil, &rowError{field: "added", message: "expected an RFC 3339 timestamp"}
+		}
+		item.Added = timestamppb.New(added)
+	}
+
+	return item, nil
+}
+
+// isFormula reports whether a spreadsheet would read a cell as a formula, or as one escaped by escapeCell.
+func isFormula(cell string) bool {
+	if cell == "" {
+		return false
+	}
+
+	return strings.IndexByte(formulaChars, cell[0]) >= 0 || (cell[0] == '\'' && isFormula(cell[1:]))
+}
+
+// escapeCell prefixes cells that look like formulas with a quote, so spreadsheets show them as text.
+func escapeCell(cell string) string {
+	if isFormula(cell) {
+		return "'" + cell
+	}
+
+	return cell
+}
+
+// unescapeCell removes the quote escapeCell added.
+func unescapeCell(cell string) string {
+	if strings.HasPrefix(cell, "'") && isFormula(cell[1:]) {
+		return cell[1:]
+	}
+
+	return cell
+}
+
+// JSON, as an array of items
+type jsonItemWriter struct {
+	w     io.Writer
+	count int
+}
+
+func (j *jsonItemWriter) Write(item *itemv1.Item) error {
+	b, err := marshalItem(item)
+	if err != nil {
+		return err
+	}
+
+	prefix := ",\n"
+	if j.count == 0 {
+		prefix = "[\n"
+	}
+	j.count++
+
+	_, err = j.w.Write(append([]byte(prefix), b...))
+	return err
+}
+
+func (j *jsonItemWriter) Close() error {
+	end := "\n]\n"
+	if j.count == 0 {
+		end = "[]\n"
+	}
+
+	_, err := io.WriteString(j.w, end)
+	return err
+}
+
+type jsonItemReader struct {
+	s *bufio.Scanner
+
+	started  bool // The opening bracket of the array was read
+	depth    int  // Objects and arrays open in the scanned part of the current item
+	inString bool // The scanned part of the current item ends inside a string
+	escaped  bool // The scanned part of the current item ends after a backslash in a string
+	scanned  int  // Bytes of the current item already scanned
+	skipping bool // The current item is longer than MaxLineSize, the rest of it is being skipped
+	tooLong  bool // The last item was longer than MaxLineSize
+}
+
+func newJSONItemReader(r io.Reader) *jsonItemReader {
+	j := &jsonItemReader{
+		s: bufio.NewScanner(r),
+	}
+	j.s.Buffer(nil, MaxLineSize)
+	j.s.Split(j.split)
+
+	return j
+}
+
+// split splits the array into items like ndjsonItemReader.split splits lines,
+// finding the commas between items by following strings and nesting.
+func (j *jsonItemReader) split(data []byte, atEOF bool) (int, []byte, error) {
+	if !j.started {
+		rest := bytes.TrimLeft(data, " \t\r\n")
+		if len(rest) == 0 && !atEOF {
+			return len(data), nil, nil
+		}
+		if len(rest) == 0 || rest[0] != '[' {
+			return 0, nil, ErrMissingArray
+		}
+		j.started = true
+		return len(data) - len(rest) + 1, nil, nil
+	}
+
+	for i := j.scanned; i < len(data); i++ {
+		if j.inString {
+			switch {
+			case j.escaped:
+				j.escaped = false
+			case data[i] == '\\':
+				j.escaped = true
+			case data[i] == '"':
+				j.inString = false
+			}
+			continue
+		}
+
+		switch data[i] {
+		case '"':
+			j.inString = true
+		case '{', '[':
+			j.depth++
+		case '}', ']':
+			j.depth--
+		}
+
+		// The item ends at a comma, or at the closing bracket of the array after the last item
+		last := j.depth < 0
+		if !last && (data[i] != ',' || j.depth > 0) {
+			continue
+		}
+
+		item := data[:i]
+		j.scanned = 0
+		if j.skipping {
+			j.skipping = false
+			j.tooLong = true
+			item = []byte{}
+		}
+		if last {
+			return i + 1, item, bufio.ErrFinalToken
+		}
+		return i + 1, item, nil
+	}
+
+	if atEOF {
+		return 0, nil, io.ErrUnexpectedEOF
+	}
+
+	if j.skipping || len(data) >= MaxLineSize {
+		j.skipping = true
+		j.scanned = 0
+		return len(data), nil, nil
+	}
+
+	j.scanned = len(data)
+	return 0, nil, nil
+}
+
+func (j *jsonItemReader) Read() (*itemv1.Item, error) {
+	for j.s.Scan() {
+		if j.tooLong {
+			j.tooLong = false
+			return nil, &rowError{message: fmt.Sprintf("item is longer than %d bytes", MaxLineSize)}
+		}
+
+		// Skip the empty item of an empty array
+		item := bytes.TrimSpace(j.s.Bytes())
+		if len(item) == 0 {
+			continue
+		}
+
+		return unmarshalItem(item)
+	}
+
+	err := j.s.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, io.EOF
+}
+
+// NDJSON, with an item on each line
+type ndjsonItemWriter struct {
+	w io.Writer
+}
+
+func (n *ndjsonItemWriter) Write(item *itemv1.Item) error {
+	b, err := marshalItem(item)
+	if err != nil {
+		return err
+	}
+
+	_, err = n.w.Write(append(b, '\n'))
+	return err
+}
+
+func (*ndjsonItemWriter) Close() error {
+	return nil
+}
+
+type ndjsonItemReader struct {
+	s *bufio.Scanner
+
+	skipping bool // The current line is longer than MaxLineSize, the rest of it is being skipped
+	tooLong  bool // The last line was longer than MaxLineSize
+}
+
+func newNDJSONItemReader(r io.Reader) *ndjsonItemReader {
+	n := &ndjsonItemReader{
+		s: bufio.NewScanner(r),
+	}
+	n.s.Buffer(nil, MaxLineSize)
+	n.s.Split(n.split)
+
+	return n
+}
+
+// split splits lines like bufio.ScanLines, but instead of failing on lines that don't fit in the buffer,
+// skips the rest of them and returns an empty line with tooLong set.
+func (n *ndjsonItemReader) split(data []byte, atEOF bool) (int, []byte, error) {
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		if n.skipping {
+			n.skipping = false
+			n.tooLong = true
+			return i + 1, []byte{}, nil
+		}
+		return i + 1, data[:i], nil
+	}
+
+	if n.skipping || len(data) >= MaxLineSize {
+		n.skipping = !atEOF
+		n.tooLong = atEOF
+		if atEOF {
+			return len(data), []byte{}, nil
+		}
+		return len(data), nil, nil
+	}
+
+	if atEOF && len(data) > 0 {
+		return len(data), data, nil
+	}
+
+	return 0, nil, nil
+}
+
+func (n *ndjsonItemReader) Read() (*itemv1.Item, error) {
+	for n.s.Scan() {
+		if n.tooLong {
+			n.tooLong = false
+			return nil, &rowError{message: fmt.Sprintf("line is longer than %d bytes", MaxLineSize)}
+		}
+
+		// Skip blank lines
+		line := bytes.TrimSpace(n.s.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		return unmarshalItem(line)
+	}
+
+	err := n.s.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, io.EOF
+}
+
+// unmarshalItem decodes an item from a JSON object.
+func unmarshalItem(b []byte) (*itemv1.Item, error) {
+	item := &itemv1.Item{}
+	err := protojson.Unmarshal(b, item)
+	if err != nil {
+		return nil, &rowError{message: err.Error()}
+	}
+
+	return item, nil
+}
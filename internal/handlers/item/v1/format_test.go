@@ -0,0 +1,276 @@
+package item_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	itemv1 "github.com/spotdemo4/ts-server/internal/connect/item/v1"
+	"github.com/spotdemo4/ts-server/internal/handlers/item/v1"
+)
+
+// readAll reads every row, returning the name of each item, "row: <error>" for invalid rows,
+// and "error: <error>" for the error that stopped reading.
+func readAll(t *testing.T, format itemv1.ItemFormat, input string) []string {
+	t.Helper()
+
+	reader, err := item.NewItemReader(format, strings.NewReader(input))
+	if err != nil {
+		return []string{"error: " + err.Error()}
+	}
+
+	rows := []string{}
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows
+		}
+		var rowErr *item.RowError
+		if errors.As(err, &rowErr) {
+			rows = append(rows, "row: "+rowErr.Error())
+			continue
+		}
+		if err != nil {
+			return append(rows, "error: "+err.Error())
+		}
+		rows = append(rows, row.GetName())
+	}
+}
+
+func TestItemReaders(t *testing.T) {
+	t.Parallel()
+
+	long := `{"name":"` + strings.Repeat("a", item.MaxLineSize) + `"}`
+	longCell := `"` + strings.Repeat("a,\n", item.MaxLineSize/3) + `"`
+
+	tests := []struct {
+		name   string
+		format itemv1.ItemFormat
+		input  string
+		want   []string
+	}{
+		{
+			name:   "csv",
+			format: itemv1.ItemFormat_ITEM_FORMAT_CSV,
+			input:  "name,description,price,quantity\na,x,1.5,2\nb,y,0,0\n",
+			want:   []string{"a", "b"},
+		},
+		{
+			name:   "csv with every column in another order",
+			format: itemv1.ItemFormat_ITEM_FORMAT_CSV,
+			input:  "added,quantity,price,description,name,id\n2026-10-17T12:00:00Z,1,1,x,a,7\n,1,1,x,b,8\n",
+			want:   []string{"a", "b"},
+		},
+		{
+			name:   "csv missing header",
+			format: itemv1.ItemFormat_ITEM_FORMAT_CSV,
+			input:  "",
+			want:   []string{"error: missing CSV header"},
+		},
+		{
+			name:   "csv unknown column",
+			format: itemv1.ItemFormat_ITEM_FORMAT_CSV,
+			input:  "name,description,price,quantity,color\n",
+			want:   []string{`error: unknown CSV column "color"`},
+		},
+		{
+			name:   "csv missing column",
+			format: itemv1.ItemFormat_ITEM_FORMAT_CSV,
+			input:  "name,description,price\n",
+			want:   []string{`error: missing CSV column "quantity"`},
+		},
+		{
+			name:   "csv invalid rows",
+			format: itemv1.ItemFormat_ITEM_FORMAT_CSV,
+			input: "name,description,price,quantity,added\n" +
+				"a,x,1\n" +
+				"b,x,cheap,1,\n" +
+				"c,x,1,1.5,\n" +
+				"d,x,1,1,yesterday\n" +
+				"e,x,1,1,\n",
+			want: []string{
+				"row: expected 5 fields, got 3",
+				"row: price: expected a number",
+				"row: quantity: expected an integer",
+				"row: added: expected an RFC 3339 timestamp",
+				"e",
+			},
+		},
+		{
+			name:   "csv quoted fields",
+			format: itemv1.ItemFormat_ITEM_FORMAT_CSV,
+			input:  "name,description,price,quantity\n\"a,\"\"b\"\"\n\",x,1,1\n\"c\",\"x\ny\",1,1\n",
+			want:   []string{"a,\"b\"\n", "c"},
+		},
+		{
+			name:   "csv invalid quotes",
+			format: itemv1.ItemFormat_ITEM_FORMAT_CSV,
+			input:  "name,description,price,quantity\na\"b,x,1,1\nc,x,1,1\n",
+			want:   []string{"row: ", "c"},
+		},
+		{
+			name:   "csv row too long",
+			format: itemv1.ItemFormat_ITEM_FORMAT_CSV,
+			input:  "name,description,price,quantity\na,x,1,1\n" + longCell + ",x,1,1\nb,x,1,1\n",
+			want:   []string{"a", "row: row is longer than 65536 bytes", "b"},
+		},
+		{
+			name:   "csv last row too long",
+			format: itemv1.ItemFormat_ITEM_FORMAT_CSV,
+			input:  "name,description,price,quantity\na,x,1,1\n" + longCell,
+			want:   []string{"a", "row: row is longer than 65536 bytes"},
+		},
+		{
+			name:   "csv header too long",
+			format: itemv1.ItemFormat_ITEM_FORMAT_CSV,
+			input:  longCell + "\n",
+			want:   []string{"error: invalid CSV header: row is longer than 65536 bytes"},
+		},
+		{
+			name:   "csv escaped formulas",
+			format: itemv1.ItemFormat_ITEM_FORMAT_CSV,
+			input:  "name,description,price,quantity\n'=1+1,x,1,1\n'+a,x,1,1\n''-a,x,1,1\n'a,x,1,1\n",
+			want:   []string{"=1+1", "+a", "'-a", "'a"},
+		},
+		{
+			name:   "json",
+			format: itemv1.ItemFormat_ITEM_FORMAT_JSON,
+			input:  `[{"name":"a","price":1},{"name":"b","quantity":2}]`,
+			want:   []string{"a", "b"},
+		},
+		{
+			name:   "json empty array",
+			format: itemv1.ItemFormat_ITEM_FORMAT_JSON,
+			input:  "[]",
+			want:   []string{},
+		},
+		{
+			name:   "json not an array",
+			format: itemv1.ItemFormat_ITEM_FORMAT_JSON,
+			input:  `{"name":"a"}`,
+			want:   []string{"error: " + item.ErrMissingArray.Error()},
+		},
+		{
+			name:   "json strings and nesting",
+			format: itemv1.ItemFormat_ITEM_FORMAT_JSON,
+			input:  " \n[ {\"name\":\"a,]}\\\"\\\\\",\"description\":\"[{\"} ,\n{\"x\":[{},[\"]\"]]},{\"name\":\"b\"} ] trailing",
+			want:   []string{"a,]}\"\\", "row: ", "b"},
+		},
+		{
+			name:   "json not closed",
+			format: itemv1.ItemFormat_ITEM_FORMAT_JSON,
+			input:  `[{"name":"a"},{"name":"b"`,
+			want:   []string{"a", "error: unexpected EOF"},
+		},
+		{
+			name:   "json empty",
+			format: itemv1.ItemFormat_ITEM_FORMAT_JSON,
+			input:  " ",
+			want:   []string{"error: " + item.ErrMissingArray.Error()},
+		},
+		{
+			name:   "json item too long",
+			format: itemv1.ItemFormat_ITEM_FORMAT_JSON,
+			input:  `[{"name":"a"},` + long + `,{"name":"b"}]`,
+			want:   []string{"a", "row: item is longer than 65536 bytes", "b"},
+		},
+		{
+			name:   "json last item too long",
+			format: itemv1.ItemFormat_ITEM_FORMAT_JSON,
+			input:  `[{"name":"a"},` + long + `]`,
+			want:   []string{"a", "row: item is longer than 65536 bytes"},
+		},
+		{
+			name:   "json invalid item",
+			format: itemv1.ItemFormat_ITEM_FORMAT_JSON,
+			input:  `[{"name":"a","price":"cheap"},{"name":"b"}]`,
+			want:   []string{"row: ", "b"},
+		},
+		{
+			name:   "ndjson",
+			format: itemv1.ItemFormat_ITEM_FORMAT_NDJSON,
+			input:  "{\"name\":\"a\"}\n\n  \r\n{\"name\":\"b\"}\r\n{\"name\":\"c\"}",
+			want:   []string{"a", "b", "c"},
+		},
+		{
+			name:   "ndjson invalid line",
+			format: itemv1.ItemFormat_ITEM_FORMAT_NDJSON,
+			input:  "{\"name\":\"a\"}\nnot json\n{\"name\":\"b\"}\n",
+			want:   []string{"a", "row: ", "b"},
+		},
+		{
+			name:   "ndjson line too long",
+			format: itemv1.ItemFormat_ITEM_FORMAT_NDJSON,
+			input:  "{\"name\":\"a\"}\n" + long + "\n{\"name\":\"b\"}\n",
+			want:   []string{"a", "row: line is longer than 65536 bytes", "b"},
+		},
+		{
+			name:   "ndjson last line too long",
+			format: itemv1.ItemFormat_ITEM_FORMAT_NDJSON,
+			input:  "{\"name\":\"a\"}\n" + long,
+			want:   []string{"a", "row: line is longer than 65536 bytes"},
+		},
+		{
+			name:   "unknown format",
+			format: itemv1.ItemFormat_ITEM_FORMAT_UNSPECIFIED,
+			input:  "",
+			want:   []string{"error: " + item.ErrUnknownFormat.Error()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := readAll(t, tt.format, tt.input)
+
+			// protojson's messages are not stable, only check that invalid items are row errors
+			for i, row := range got {
+				if strings.HasPrefix(row, "row: ") && i < len(tt.want) && tt.want[i] == "row: " {
+					got[i] = "row: "
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCSVFormulaRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	names := []string{"=1+1", "+a", "-a", "@a", "\ta", "'=a", "''+a", "'a", "a=1", ""}
+
+	var buf bytes.Buffer
+	writer, err := item.NewItemWriter(itemv1.ItemFormat_ITEM_FORMAT_CSV, &buf)
+	if err != nil {
+		t.Fatalf("Error creating writer: %v", err)
+	}
+	for _, name := range names {
+		err = writer.Write(&itemv1.Item{Name: name, Description: name, Price: -1, Quantity: -1})
+		if err != nil {
+			t.Fatalf("Error writing item: %v", err)
+		}
+	}
+	err = writer.Close()
+	if err != nil {
+		t.Fatalf("Error closing writer: %v", err)
+	}
+
+	// No cell may start like a formula
+	for _, line := range strings.Split(buf.String(), "\n") {
+		for _, cell := range strings.Split(line, ",") {
+			if cell != "" && strings.ContainsAny(cell[:1], "=+@\t\r") {
+				t.Errorf("cell %q starts like a formula", cell)
+			}
+		}
+	}
+
+	got := readAll(t, itemv1.ItemFormat_ITEM_FORMAT_CSV, buf.String())
+	if !slices.Equal(got, names) {
+		t.Errorf("got %q, want %q", got, names)
+	}
+}
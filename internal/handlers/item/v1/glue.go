@@ -1,10 +1,14 @@
 package item
 
 import (
+	"time"
+
+	"github.com/aarondl/opt/omit"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/spotdemo4/ts-server/internal/bob/models"
 	itemv1 "github.com/spotdemo4/ts-server/internal/connect/item/v1"
+	"github.com/spotdemo4/ts-server/internal/search"
 )
 
 func itemToConnect(item models.Item) *itemv1.Item {
@@ -17,3 +21,45 @@ func itemToConnect(item models.Item) *itemv1.Item {
 		Added:       timestamppb.New(item.Added),
 	}
 }
+
+func fragmentsToConnect(fragments []search.Fragment) []*itemv1.TextFragment {
+	res := []*itemv1.TextFragment{}
+	for _, fragment := range fragments {
+		res = append(res, &itemv1.TextFragment{
+			Text:        fragment.Text,
+			Highlighted: fragment.Highlighted,
+		})
+	}
+
+	return res
+}
+
+func createSetter(userid int32, req *itemv1.CreateItemRequest) *models.ItemSetter {
+	return &models.ItemSetter{
+		Name:        omit.From(req.GetName()),
+		Added:       omit.From(time.Now()),
+		Description: omit.From(req.GetDescription()),
+		Price:       omit.From(req.GetPrice()),
+		Quantity:    omit.From(req.GetQuantity()),
+		UserID:      omit.From(userid),
+	}
+}
+
+// updateSetter only sets the fields given in the request.
+func updateSetter(req *itemv1.UpdateItemRequest) *models.ItemSetter {
+	setter := &models.ItemSetter{}
+	if req.Name != nil {
+		setter.Name = omit.From(req.GetName())
+	}
+	if req.Description != nil {
+		setter.Description = omit.From(req.GetDescription())
+	}
+	if req.Price != nil {
+		setter.Price = omit.From(req.GetPrice())
+	}
+	if req.Quantity != nil {
+		setter.Quantity = omit.From(req.GetQuantity())
+	}
+
+	return setter
+}
@@ -2,26 +2,32 @@ package item
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
-	"time"
 
 	"connectrpc.com/connect"
-	"github.com/aarondl/opt/omit"
 	"github.com/stephenafamo/bob"
 	"github.com/stephenafamo/bob/dialect/sqlite/sm"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/spotdemo4/ts-server/internal/app"
+	"github.com/spotdemo4/ts-server/internal/audit"
 	"github.com/spotdemo4/ts-server/internal/auth"
 	"github.com/spotdemo4/ts-server/internal/bob/models"
 	itemv1 "github.com/spotdemo4/ts-server/internal/connect/item/v1"
 	"github.com/spotdemo4/ts-server/internal/connect/item/v1/itemv1connect"
+	"github.com/spotdemo4/ts-server/internal/filter"
 	"github.com/spotdemo4/ts-server/internal/putil"
+	"github.com/spotdemo4/ts-server/internal/search"
 )
 
 type Handler struct {
-	db   *bob.DB
-	auth *auth.Auth
+	db    *bob.DB
+	auth  *auth.Auth
+	audit *audit.Log
+
+	batchMaxSize int
 }
 
 // GetItem retrieves an item by its ID.
@@ -50,7 +56,19 @@ func (h *Handler) GetItem(
 
 const DefaultLimit = 10
 
-// GetItems retrieves a list of items for a user.
+// itemFields are the fields items can be filtered on.
+//
+//nolint:gochecknoglobals // Constant
+var itemFields = filter.Fields{
+	"id":          {Column: models.Items.Columns.ID, Type: filter.TypeInt},
+	"name":        {Column: models.Items.Columns.Name, Type: filter.TypeString},
+	"description": {Column: models.Items.Columns.Description, Type: filter.TypeString},
+	"price":       {Column: models.Items.Columns.Price, Type: filter.TypeFloat},
+	"quantity":    {Column: models.Items.Columns.Quantity, Type: filter.TypeInt},
+	"added":       {Column: models.Items.Columns.Added, Type: filter.TypeTimestamp},
+}
+
+// GetItems retrieves a page of items for a user.
 func (h *Handler) GetItems(
 	ctx context.Context,
 	req *connect.Request[itemv1.GetItemsRequest],
@@ -60,43 +78,54 @@ func (h *Handler) GetItems(
 		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
 	}
 
-	query := models.Items.Query(
-		models.SelectWhere.Items.UserID.EQ(user.ID),
-	)
-
-	// Filter
-	if req.Msg.Filter != nil {
-		query.Apply(
-			models.SelectWhere.Items.Name.Like(req.Msg.GetFilter()),
-		)
+	query, err := itemsQuery(user.ID, req.Msg.GetStart(), req.Msg.GetEnd(), req.Msg.GetFilter())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	// Start
-	if req.Msg.GetStart() != nil {
-		query.Apply(
-			models.SelectWhere.Items.Added.GTE(req.Msg.GetStart().AsTime()),
-		)
+	// Sort
+	keys, spec, err := sortKeys(req.Msg.GetSort())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
+	hash := queryHash(req.Msg.GetFilter(), req.Msg.GetStart(), req.Msg.GetEnd())
 
-	// End
-	if req.Msg.GetEnd() != nil {
-		query.Apply(
-			models.SelectWhere.Items.Added.LTE(req.Msg.GetEnd().AsTime()),
-		)
+	// Count, on the first page unless asked for
+	var count *int64
+	includeCount := req.Msg.PageToken == nil
+	if req.Msg.IncludeCount != nil {
+		includeCount = req.Msg.GetIncludeCount()
+	}
+	if includeCount {
+		var c int64
+		c, err = query.Count(ctx, h.db)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		count = &c
 	}
 
-	// Count
-	count, err := query.Count(ctx, h.db)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+	// Page token
+	if req.Msg.PageToken != nil {
+		if req.Msg.Offset != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("page token cannot be used with offset"))
+		}
+
+		var c cursor
+		c, err = decodeCursor(spec, hash, req.Msg.GetPageToken())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		query.Apply(after(keys, c))
 	}
+	query.Apply(orderBy(keys)...)
 
-	// Limit
+	// Limit, with one more item to know if there is a next page
+	limit := int32(DefaultLimit)
 	if req.Msg.Limit != nil {
-		query.Apply(sm.Limit(req.Msg.GetLimit()))
-	} else {
-		query.Apply(sm.Limit(DefaultLimit))
+		limit = req.Msg.GetLimit()
 	}
+	query.Apply(sm.Limit(limit + 1))
 
 	// Offset
 	if req.Msg.Offset != nil {
@@ -111,6 +140,16 @@ func (h *Handler) GetItems(
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	// Next page
+	nextPageToken := ""
+	if len(items) > int(limit) {
+		items = items[:limit]
+		nextPageToken, err = encodeCursor(spec, hash, items[limit-1])
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
 	// Convert to connect items
 	resItems := []*itemv1.Item{}
 	for _, item := range items {
@@ -120,7 +159,83 @@ func (h *Handler) GetItems(
 	}
 
 	res := connect.NewResponse(&itemv1.GetItemsResponse{
-		Items: resItems,
+		Items:         resItems,
+		Count:         count,
+		NextPageToken: nextPageToken,
+	})
+	return res, nil
+}
+
+// itemsQuery queries a user's items added between start and end that match the filter, each if given.
+func itemsQuery(userid int32, start, end *timestamppb.Timestamp, text string) (models.ItemsQuery, error) {
+	query := models.Items.Query(
+		models.SelectWhere.Items.UserID.EQ(userid),
+	)
+
+	// Filter
+	where, ok, err := filter.Where(text, itemFields)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		query.Apply(where)
+	}
+
+	// Start
+	if start != nil {
+		query.Apply(
+			models.SelectWhere.Items.Added.GTE(start.AsTime()),
+		)
+	}
+
+	// End
+	if end != nil {
+		query.Apply(
+			models.SelectWhere.Items.Added.LTE(end.AsTime()),
+		)
+	}
+
+	return query, nil
+}
+
+// SearchItems searches a user's items by name and description, best matches first.
+func (h *Handler) SearchItems(
+	ctx context.Context,
+	req *connect.Request[itemv1.SearchItemsRequest],
+) (*connect.Response[itemv1.SearchItemsResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	// Limit
+	limit := DefaultLimit
+	if req.Msg.Limit != nil {
+		limit = int(req.Msg.GetLimit())
+	}
+
+	// Search
+	hits, count, err := search.Items(ctx, h.db, user.ID, req.Msg.GetQuery(), limit, int(req.Msg.GetOffset()))
+	if errors.Is(err, search.ErrEmptyQuery) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Convert to connect hits
+	resHits := []*itemv1.ItemSearchHit{}
+	for _, hit := range hits {
+		resHits = append(resHits, &itemv1.ItemSearchHit{
+			Item:        itemToConnect(hit.Item),
+			Score:       hit.Score,
+			Name:        fragmentsToConnect(search.Fragments(hit.NameHighlight)),
+			Description: fragmentsToConnect(search.Fragments(hit.DescriptionSnippet)),
+		})
+	}
+
+	res := connect.NewResponse(&itemv1.SearchItemsResponse{
+		Hits:  resHits,
 		Count: count,
 	})
 	return res, nil
@@ -136,20 +251,13 @@ func (h *Handler) CreateItem(
 		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
 	}
 
-	item, err := models.Items.Insert(
-		&models.ItemSetter{
-			Name:        omit.From(req.Msg.GetName()),
-			Added:       omit.From(time.Now()),
-			Description: omit.From(req.Msg.GetDescription()),
-			Price:       omit.From(req.Msg.GetPrice()),
-			Quantity:    omit.From(req.Msg.GetQuantity()),
-			UserID:      omit.From(user.ID),
-		},
-	).One(ctx, h.db)
+	item, err := models.Items.Insert(createSetter(user.ID, req.Msg)).One(ctx, h.db)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	h.record(ctx, user, audit.ActionItemCreate, item.ID)
+
 	res := connect.NewResponse(&itemv1.CreateItemResponse{
 		Id:    item.ID,
 		Added: timestamppb.New(item.Added),
@@ -176,17 +284,17 @@ func (h *Handler) UpdateItem(
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
 
-	// Update item
-	err = item.Update(ctx, h.db, &models.ItemSetter{
-		Name:        omit.From(req.Msg.GetName()),
-		Description: omit.From(req.Msg.GetDescription()),
-		Price:       omit.From(req.Msg.GetPrice()),
-		Quantity:    omit.From(req.Msg.GetQuantity()),
-	})
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+	// Update item, if any fields were given
+	setter := updateSetter(req.Msg)
+	if len(setter.SetColumns()) > 0 {
+		err = item.Update(ctx, h.db, setter)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
 	}
 
+	h.record(ctx, user, audit.ActionItemUpdate, item.ID)
+
 	res := connect.NewResponse(&itemv1.UpdateItemResponse{})
 	return res, nil
 }
@@ -216,16 +324,31 @@ func (h *Handler) DeleteItem(
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	h.record(ctx, user, audit.ActionItemDelete, item.ID)
+
 	res := connect.NewResponse(&itemv1.DeleteItemResponse{})
 	return res, nil
 }
 
+// record records an action the signed in user took on one of their items.
+func (h *Handler) record(ctx context.Context, user auth.User, action string, itemid int32) {
+	h.audit.Record(ctx, audit.Event{
+		ActorID: user.ID,
+		UserID:  user.ID,
+		Action:  action,
+		Target:  fmt.Sprintf("item:%d", itemid),
+	})
+}
+
 // New creates a new Item service handler.
 func New(app *app.App, interceptors connect.Option) (string, http.Handler) {
 	return itemv1connect.NewItemServiceHandler(
 		&Handler{
-			db:   app.DB,
-			auth: app.Auth,
+			db:    app.DB,
+			auth:  app.Auth,
+			audit: app.Audit,
+
+			batchMaxSize: app.Env.ItemBatchMaxSize,
 		},
 		interceptors,
 	)
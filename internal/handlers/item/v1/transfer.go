@@ -0,0 +1,293 @@
+package item
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+
+	"github.com/spotdemo4/ts-server/internal/audit"
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+	itemv1 "github.com/spotdemo4/ts-server/internal/connect/item/v1"
+)
+
+const (
+	ChunkSize       = 32 << 10 // Bytes of exported data in each message
+	ImportMaxRows   = 100_000  // Most rows in one import
+	ImportMaxErrors = 100      // Most row errors returned from an import
+	ImportBatchSize = 500      // Rows inserted in each statement, sqlite limits how many values a statement can have
+)
+
+// ExportItems streams a user's items as a file, filtered and sorted like GetItems.
+func (h *Handler) ExportItems(
+	ctx context.Context,
+	req *connect.Request[itemv1.ExportItemsRequest],
+	stream *connect.ServerStream[itemv1.ExportItemsResponse],
+) error {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	query, err := exportQuery(user.ID, req.Msg)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// Send the file in chunks
+	w := bufio.NewWriterSize(&streamWriter{stream: stream}, ChunkSize)
+	err = writeItems(ctx, h.db, query, req.Msg.GetFormat(), w)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	err = w.Flush()
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	return nil
+}
+
+// ImportItems creates items for a user from a file, checking every row against the item rules first.
+func (h *Handler) ImportItems(
+	ctx context.Context,
+	stream *connect.ClientStream[itemv1.ImportItemsRequest],
+) (*connect.Response[itemv1.ImportItemsResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	// Options
+	if !stream.Receive() {
+		if stream.Err() != nil {
+			return nil, stream.Err()
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("no data to import"))
+	}
+	options := stream.Msg()
+
+	reader, err := newItemReader(options.GetFormat(), &streamReader{stream: stream, buf: options.GetData()})
+	if err != nil {
+		return nil, importError(err)
+	}
+
+	// Read and check every row
+	res := &itemv1.ImportItemsResponse{
+		DryRun: options.GetDryRun(),
+	}
+	setters := []bob.Mod[*dialect.InsertQuery]{}
+	for row := int32(1); ; row++ {
+		var item *itemv1.Item
+		item, err = reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if row > ImportMaxRows {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				fmt.Errorf("imports can have at most %d rows", ImportMaxRows),
+			)
+		}
+		if err == nil {
+			err = validateItem(item)
+		}
+
+		var rowErr *rowError
+		if errors.As(err, &rowErr) {
+			res.Failed++
+			if len(res.Errors) < ImportMaxErrors {
+				res.Errors = append(res.Errors, &itemv1.ImportItemsError{
+					Row:     row,
+					Field:   rowErr.field,
+					Message: rowErr.message,
+				})
+			}
+			continue
+		}
+		if err != nil {
+			return nil, importError(fmt.Errorf("row %d: %w", row, err))
+		}
+
+		setters = append(setters, importSetter(user.ID, item))
+	}
+
+	// Unless partial, any failed row means nothing is imported
+	if res.GetFailed() > 0 && !options.GetAllowPartial() {
+		setters = nil
+	}
+	res.Imported = int32(len(setters)) //nolint:gosec // At most ImportMaxRows
+	if res.GetDryRun() || len(setters) == 0 {
+		return connect.NewResponse(res), nil
+	}
+
+	// Import
+	err = h.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		for batch := range slices.Chunk(setters, ImportBatchSize) {
+			_, err = models.Items.Insert(batch...).Exec(ctx, exec)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	h.audit.Record(ctx, audit.Event{
+		ActorID:  user.ID,
+		UserID:   user.ID,
+		Action:   audit.ActionItemImport,
+		Target:   "items",
+		Metadata: map[string]any{"count": res.GetImported(), "failed": res.GetFailed()},
+	})
+
+	return connect.NewResponse(res), nil
+}
+
+// exportQuery queries the items to export, in order.
+func exportQuery(userid int32, req *itemv1.ExportItemsRequest) (models.ItemsQuery, error) {
+	query, err := itemsQuery(userid, req.GetStart(), req.GetEnd(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	keys, _, err := sortKeys(req.GetSort())
+	if err != nil {
+		return nil, err
+	}
+	query.Apply(orderBy(keys)...)
+
+	return query, nil
+}
+
+// writeItems writes the items of a query to a file, one at a time.
+func writeItems(
+	ctx context.Context,
+	exec bob.Executor,
+	query models.ItemsQuery,
+	format itemv1.ItemFormat,
+	w io.Writer,
+) error {
+	writer, err := newItemWriter(format, w)
+	if err != nil {
+		return err
+	}
+
+	cursor, err := query.Cursor(ctx, exec)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+
+	for cursor.Next() {
+		var item *models.Item
+		item, err = cursor.Get()
+		if err != nil {
+			return err
+		}
+
+		err = writer.Write(itemToConnect(*item))
+		if err != nil {
+			return err
+		}
+	}
+	if cursor.Err() != nil {
+		return cursor.Err()
+	}
+
+	return writer.Close()
+}
+
+// validateItem checks an item against its rules, returning a *rowError with the first violation.
+func validateItem(item *itemv1.Item) error {
+	err := protovalidate.Validate(item)
+
+	var valErr *protovalidate.ValidationError
+	if errors.As(err, &valErr) && len(valErr.Violations) > 0 {
+		violation := valErr.Violations[0].Proto
+		return &rowError{
+			field:   protovalidate.FieldPathString(violation.GetField()),
+			message: violation.GetMessage(),
+		}
+	}
+
+	return err
+}
+
+// importSetter creates an imported item, added now unless the row says when.
+func importSetter(userid int32, item *itemv1.Item) *models.ItemSetter {
+	added := time.Now()
+	if item.GetAdded() != nil {
+		added = item.GetAdded().AsTime()
+	}
+
+	return &models.ItemSetter{
+		Name:        omit.From(item.GetName()),
+		Added:       omit.From(added),
+		Description: omit.From(item.GetDescription()),
+		Price:       omit.From(item.GetPrice()),
+		Quantity:    omit.From(item.GetQuantity()),
+		UserID:      omit.From(userid),
+	}
+}
+
+// importError keeps the code of stream errors, other errors are problems with the data.
+func importError(err error) error {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr
+	}
+
+	return connect.NewError(connect.CodeInvalidArgument, err)
+}
+
+// streamWriter sends each write as a message.
+type streamWriter struct {
+	stream *connect.ServerStream[itemv1.ExportItemsResponse]
+}
+
+func (s *streamWriter) Write(p []byte) (int, error) {
+	err := s.stream.Send(&itemv1.ExportItemsResponse{
+		Data: p,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// streamReader reads the data of every message.
+type streamReader struct {
+	stream *connect.ClientStream[itemv1.ImportItemsRequest]
+	buf    []byte
+}
+
+func (s *streamReader) Read(p []byte) (int, error) {
+	for len(s.buf) == 0 {
+		if !s.stream.Receive() {
+			if s.stream.Err() != nil {
+				return 0, s.stream.Err()
+			}
+			return 0, io.EOF
+		}
+		s.buf = s.stream.Msg().GetData()
+	}
+
+	n := copy(p, s.buf)
+	s.buf = s.buf[n:]
+
+	return n, nil
+}
@@ -0,0 +1,261 @@
+package oauth
+
+import (
+	"html/template"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/spotdemo4/ts-server/internal/audit"
+	"github.com/spotdemo4/ts-server/internal/auth"
+	"github.com/spotdemo4/ts-server/internal/putil"
+)
+
+const (
+	ConsentDuration = time.Minute * 10 // How long the user has to answer the consent page
+	ConsentAudience = "consent"        // Audience of consent requests
+)
+
+//nolint:gochecknoglobals // Shown on the consent page
+var scopeDescriptions = map[string]string{
+	auth.OAuthScopeOpenID:  "Know who you are",
+	auth.OAuthScopeProfile: "See your username",
+	auth.OAuthScopeEmail:   "See your email address",
+	auth.OAuthScopeOffline: "Stay signed in when you are not using it",
+}
+
+//nolint:gochecknoglobals // Parsed once
+var consentTemplate = template.Must(template.New("consent").Parse(`<!doctype html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Sign in to {{.Client}}</title>
+</head>
+<body>
+<h1>Sign in to {{.Client}}</h1>
+<p>{{.Client}} wants to use your account <b>{{.Username}}</b> to:</p>
+<ul>
+{{range .Scopes}}<li>{{.}}</li>
+{{end}}</ul>
+<form method="post" action="/oauth2/consent">
+<input type="hidden" name="request" value="{{.Request}}">
+<button type="submit" name="action" value="deny">Cancel</button>
+<button type="submit" name="action" value="allow">Allow</button>
+</form>
+</body>
+</html>
+`))
+
+// authorizeRequest is an authorization request, as described in RFC 6749 4.1.1.
+type authorizeRequest struct {
+	jwt.RegisteredClaims
+
+	ClientID      string   `json:"client_id"`
+	RedirectURI   string   `json:"redirect_uri"`
+	Scopes        []string `json:"scopes"`
+	State         string   `json:"state,omitempty"`
+	Nonce         string   `json:"nonce,omitempty"`
+	CodeChallenge string   `json:"code_challenge,omitempty"`
+}
+
+// Authorize asks the signed in user to allow a client to sign them in, and sends them back with a code.
+func (h *Handler) Authorize(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	req := authorizeRequest{
+		ClientID:      r.Form.Get("client_id"),
+		RedirectURI:   r.Form.Get("redirect_uri"),
+		State:         r.Form.Get("state"),
+		Nonce:         r.Form.Get("nonce"),
+		CodeChallenge: r.Form.Get("code_challenge"),
+	}
+
+	// Errors can only be sent to redirect URIs of the client
+	client, err := h.auth.GetOAuthClient(r.Context(), req.ClientID)
+	if err != nil {
+		http.Error(w, auth.ErrInvalidClient.Error(), http.StatusBadRequest)
+		return
+	}
+	if !client.AllowsRedirectURI(req.RedirectURI) {
+		http.Error(w, auth.ErrInvalidRedirectURI.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Validate request
+	if r.Form.Get("response_type") != "code" {
+		h.redirectError(w, r, req, "unsupported_response_type")
+		return
+	}
+	if req.CodeChallenge != "" && r.Form.Get("code_challenge_method") != "S256" {
+		h.redirectError(w, r, req, "invalid_request")
+		return
+	}
+	if req.CodeChallenge == "" && client.Public() {
+		h.redirectError(w, r, req, "invalid_request")
+		return
+	}
+	req.Scopes = client.FilterScopes(strings.Fields(r.Form.Get("scope")))
+	if len(req.Scopes) == 0 {
+		h.redirectError(w, r, req, "invalid_scope")
+		return
+	}
+	prompt := strings.Fields(r.Form.Get("prompt"))
+
+	// Make sure the user is signed in
+	user, ok := h.auth.GetContext(r.Context())
+	if !ok {
+		location := "/oauth2/authorize?" + r.Form.Encode()
+
+		// Session cookies are strict, browsers only send them once the request comes from this site
+		if r.Header.Get("Sec-Fetch-Site") == "cross-site" {
+			putil.Navigate(w, location)
+			return
+		}
+		if slices.Contains(prompt, "none") {
+			h.redirectError(w, r, req, "login_required")
+			return
+		}
+
+		http.Redirect(w, r, "/auth?redir="+url.QueryEscape(location), http.StatusFound)
+		return
+	}
+
+	// Skip the consent page if the user already allowed the scopes
+	consented, err := user.HasConsent(r.Context(), client.ID, req.Scopes)
+	if err != nil {
+		h.redirectError(w, r, req, "server_error")
+		return
+	}
+	if consented && !slices.Contains(prompt, "consent") {
+		h.issueCode(w, r, user, req)
+		return
+	}
+	if slices.Contains(prompt, "none") {
+		h.redirectError(w, r, req, "consent_required")
+		return
+	}
+
+	// Ask for consent, the request is signed so it cannot be changed on the way back
+	scopes := []string{}
+	for _, scope := range req.Scopes {
+		scopes = append(scopes, scopeDescriptions[scope])
+	}
+	req.RegisteredClaims = jwt.RegisteredClaims{
+		Subject:  strconv.Itoa(int(user.ID)),
+		Audience: jwt.ClaimStrings{ConsentAudience},
+		ExpiresAt: &jwt.NumericDate{
+			Time: time.Now().Add(ConsentDuration),
+		},
+	}
+	request, err := h.auth.SignState(req)
+	if err != nil {
+		h.log.Error("Failed to sign consent request", "client", client.ID, "error", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Frame-Options", "DENY")
+	w.Header().Set("Content-Security-Policy", "frame-ancestors 'none'")
+	_ = consentTemplate.Execute(w, map[string]any{
+		"Client":   client.Name,
+		"Username": user.Username,
+		"Scopes":   scopes,
+		"Request":  request,
+	})
+}
+
+// Consent finishes the authorization request once the user answered the consent page.
+func (h *Handler) Consent(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.auth.GetContext(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Get the signed request
+	req := &authorizeRequest{}
+	err := h.auth.ParseState(r.PostFormValue("request"), req, ConsentAudience)
+	if err != nil || req.Subject != strconv.Itoa(int(user.ID)) {
+		http.Error(w, "invalid or expired consent request", http.StatusBadRequest)
+		return
+	}
+
+	event := audit.Event{
+		ActorID:  user.ID,
+		UserID:   user.ID,
+		Action:   audit.ActionOAuthConsent,
+		Target:   "oauth_client:" + req.ClientID,
+		Metadata: map[string]any{"scopes": req.Scopes},
+	}
+	if r.PostFormValue("action") != "allow" {
+		event.Outcome = audit.OutcomeFailure
+		h.audit.Record(r.Context(), event)
+		h.redirectError(w, r, *req, "access_denied")
+		return
+	}
+
+	err = user.GrantConsent(r.Context(), req.ClientID, req.Scopes)
+	if err != nil {
+		h.redirectError(w, r, *req, "server_error")
+		return
+	}
+	h.audit.Record(r.Context(), event)
+
+	h.issueCode(w, r, user, *req)
+}
+
+// issueCode sends the user back to the client with an authorization code.
+func (h *Handler) issueCode(w http.ResponseWriter, r *http.Request, user auth.User, req authorizeRequest) {
+	code, err := user.NewAuthorizationCode(r.Context(), auth.AuthorizationParams{
+		ClientID:      req.ClientID,
+		RedirectURI:   req.RedirectURI,
+		Scopes:        req.Scopes,
+		Nonce:         req.Nonce,
+		CodeChallenge: req.CodeChallenge,
+	})
+	if err != nil {
+		h.log.Error("Failed to issue authorization code", "client", req.ClientID, "error", err)
+		h.redirectError(w, r, req, "server_error")
+		return
+	}
+
+	h.redirect(w, r, req, url.Values{"code": {code}})
+}
+
+// redirectError sends the user back to the client with an error, as described in RFC 6749 4.1.2.1.
+func (h *Handler) redirectError(w http.ResponseWriter, r *http.Request, req authorizeRequest, code string) {
+	h.redirect(w, r, req, url.Values{"error": {code}})
+}
+
+// redirect sends the user back to the redirect URI of the client.
+func (h *Handler) redirect(w http.ResponseWriter, r *http.Request, req authorizeRequest, values url.Values) {
+	location, err := url.Parse(req.RedirectURI)
+	if err != nil {
+		http.Error(w, auth.ErrInvalidRedirectURI.Error(), http.StatusBadRequest)
+		return
+	}
+
+	query := location.Query()
+	for key, value := range values {
+		query[key] = value
+	}
+	if req.State != "" {
+		query.Set("state", req.State)
+	}
+	query.Set("iss", h.auth.IssuerURL())
+	location.RawQuery = query.Encode()
+
+	http.Redirect(w, r, location.String(), http.StatusSeeOther)
+}
@@ -0,0 +1,206 @@
+package oauth
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/rs/cors"
+
+	"github.com/spotdemo4/ts-server/internal/app"
+	"github.com/spotdemo4/ts-server/internal/audit"
+	"github.com/spotdemo4/ts-server/internal/auth"
+	"github.com/spotdemo4/ts-server/internal/interceptors"
+	"github.com/spotdemo4/ts-server/internal/putil"
+)
+
+const (
+	GrantAuthorizationCode = "authorization_code"
+	GrantRefreshToken      = "refresh_token"
+)
+
+type Handler struct {
+	auth  *auth.Auth
+	audit *audit.Log
+	log   *slog.Logger
+}
+
+// tokenError is an error response of the token and userinfo endpoints, as described in RFC 6749 5.2.
+type tokenError struct {
+	Error       string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+// Discovery describes the provider to clients, as described in OpenID Connect Discovery 1.0.
+func (h *Handler) Discovery(w http.ResponseWriter, _ *http.Request) {
+	issuer := h.auth.IssuerURL()
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                issuer,
+		"authorization_endpoint":                issuer + "/oauth2/authorize",
+		"token_endpoint":                        issuer + "/oauth2/token",
+		"userinfo_endpoint":                     issuer + "/oauth2/userinfo",
+		"jwks_uri":                              issuer + "/.well-known/jwks.json",
+		"scopes_supported":                      auth.OAuthScopes,
+		"response_types_supported":              []string{"code"},
+		"response_modes_supported":              []string{"query"},
+		"grant_types_supported":                 []string{GrantAuthorizationCode, GrantRefreshToken},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": h.auth.SigningAlgorithms(),
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post", "none"},
+		"code_challenge_methods_supported":      []string{"S256"},
+
+		"authorization_response_iss_parameter_supported": true,
+		"claims_supported": []string{
+			"sub", "iss", "aud", "exp", "iat", "nonce", "preferred_username", "name", "email", "email_verified",
+		},
+	})
+}
+
+// JWKS returns the public keys tokens can be verified with, so other services can verify them without a secret.
+// Keys are rotated, clients should refetch the set when they see an unknown key ID.
+func (h *Handler) JWKS(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, h.auth.JWKS())
+}
+
+// Token exchanges an authorization code or refresh token for tokens.
+func (h *Handler) Token(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, tokenError{Error: "invalid_request"})
+		return
+	}
+
+	// Authenticate client, with basic auth or in the body
+	clientID, secret, ok := r.BasicAuth()
+	if !ok {
+		clientID = r.PostForm.Get("client_id")
+		secret = r.PostForm.Get("client_secret")
+	}
+	client, err := h.auth.AuthenticateOAuthClient(r.Context(), clientID, secret)
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidClient) {
+			w.Header().Set("WWW-Authenticate", `Basic realm="token"`)
+			writeJSON(w, http.StatusUnauthorized, tokenError{Error: "invalid_client"})
+			return
+		}
+		h.log.Error("Failed to authenticate OAuth client", "error", err)
+		writeJSON(w, http.StatusInternalServerError, tokenError{Error: "server_error"})
+		return
+	}
+
+	// Exchange grant
+	var tokens auth.OAuthTokens
+	switch r.PostForm.Get("grant_type") {
+	case GrantAuthorizationCode:
+		tokens, err = h.auth.ExchangeAuthorizationCode(
+			r.Context(),
+			client,
+			r.PostForm.Get("code"),
+			r.PostForm.Get("redirect_uri"),
+			r.PostForm.Get("code_verifier"),
+			auth.SessionParams{
+				UserAgent: r.UserAgent(),
+				IP:        putil.PeerIP(connect.Peer{Addr: r.RemoteAddr}),
+			},
+		)
+
+	case GrantRefreshToken:
+		tokens, err = h.auth.RefreshOAuth(r.Context(), client, r.PostForm.Get("refresh_token"))
+
+	default:
+		writeJSON(w, http.StatusBadRequest, tokenError{Error: "unsupported_grant_type"})
+		return
+	}
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidGrant) || errors.Is(err, auth.ErrUserDisabled) {
+			writeJSON(w, http.StatusBadRequest, tokenError{Error: "invalid_grant", Description: err.Error()})
+			return
+		}
+		h.log.Error("Failed to issue OAuth tokens", "client", client.ID, "error", err)
+		writeJSON(w, http.StatusInternalServerError, tokenError{Error: "server_error"})
+		return
+	}
+
+	res := map[string]any{
+		"access_token": tokens.Access,
+		"token_type":   "Bearer",
+		"expires_in":   int(tokens.ExpiresIn.Seconds()),
+		"scope":        strings.Join(tokens.Scopes, " "),
+	}
+	if tokens.Refresh != "" {
+		res["refresh_token"] = tokens.Refresh
+	}
+	if tokens.IDToken != "" {
+		res["id_token"] = tokens.IDToken
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+// UserInfo returns claims about the user the access token was issued for.
+func (h *Handler) UserInfo(w http.ResponseWriter, r *http.Request) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		w.Header().Set("WWW-Authenticate", `Bearer`)
+		writeJSON(w, http.StatusUnauthorized, tokenError{Error: "invalid_token"})
+		return
+	}
+
+	user, scopes, err := h.auth.GetUserFromClientToken(r.Context(), token)
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		writeJSON(w, http.StatusUnauthorized, tokenError{Error: "invalid_token"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, user.UserInfo(scopes))
+}
+
+// writeJSON writes a response that must not be cached.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func New(app *app.App) http.Handler {
+	h := &Handler{
+		auth:  app.Auth,
+		audit: app.Audit,
+		log:   app.Log,
+	}
+
+	// Clients call these from the browser
+	api := http.NewServeMux()
+	api.HandleFunc("GET /.well-known/openid-configuration", h.Discovery)
+	api.HandleFunc("GET /.well-known/jwks.json", h.JWKS)
+	api.HandleFunc("GET /oauth2/jwks", h.JWKS)
+	api.HandleFunc("POST /oauth2/token", h.Token)
+	api.HandleFunc("GET /oauth2/userinfo", h.UserInfo)
+	api.HandleFunc("POST /oauth2/userinfo", h.UserInfo)
+	middleware := cors.New(cors.Options{
+		AllowedOrigins: []string{"*"},
+		AllowedMethods: []string{http.MethodGet, http.MethodPost},
+		AllowedHeaders: []string{"Authorization", "Content-Type"},
+	})
+
+	// The user is sent to these
+	browser := http.NewServeMux()
+	browser.HandleFunc("GET /oauth2/authorize", h.Authorize)
+	browser.HandleFunc("POST /oauth2/authorize", h.Authorize)
+	browser.HandleFunc("POST /oauth2/consent", h.Consent)
+
+	mux := http.NewServeMux()
+	mux.Handle("/.well-known/", middleware.Handler(api))
+	mux.Handle("/oauth2/jwks", middleware.Handler(api))
+	mux.Handle("/oauth2/token", middleware.Handler(api))
+	mux.Handle("/oauth2/userinfo", middleware.Handler(api))
+	mux.Handle("/oauth2/", interceptors.WithAuthContext(browser, app.Auth))
+
+	return mux
+}
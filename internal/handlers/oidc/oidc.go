@@ -0,0 +1,275 @@
+package oidc
+
+import (
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/spotdemo4/ts-server/internal/app"
+	"github.com/spotdemo4/ts-server/internal/audit"
+	"github.com/spotdemo4/ts-server/internal/auth"
+	authoidc "github.com/spotdemo4/ts-server/internal/auth/oidc"
+	"github.com/spotdemo4/ts-server/internal/interceptors"
+	"github.com/spotdemo4/ts-server/internal/putil"
+)
+
+const CookieFlowName = "oidc_flow"
+
+type Handler struct {
+	auth      *auth.Auth
+	audit     *audit.Log
+	providers map[string]*authoidc.Provider
+	url       *url.URL
+	log       *slog.Logger
+}
+
+// Start sends the user to the provider to sign in.
+// Users that are already signed in link the identity to their account instead.
+func (h *Handler) Start(w http.ResponseWriter, r *http.Request) {
+	provider, ok := h.providers[r.PathValue("provider")]
+	if !ok {
+		http.Error(w, "Not Found", http.StatusNotFound)
+		return
+	}
+
+	// Remember who to link the identity to
+	var link int32
+	if user, ok := h.auth.GetContext(r.Context()); ok {
+		link = user.ID
+	}
+
+	flow, err := authoidc.NewFlow(provider.Name(), safeRedirect(r.URL.Query().Get("redir")), link)
+	if err != nil {
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	state, err := flow.Sign(h.auth)
+	if err != nil {
+		h.log.Error("Failed to sign OIDC flow", "provider", provider.Name(), "error", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	authURL, err := provider.AuthCodeURL(r.Context(), h.callbackURL(provider), flow)
+	if err != nil {
+		h.log.Error("Failed to start OIDC sign in", "provider", provider.Name(), "error", err)
+		h.fail(w, "could not reach the sign in provider")
+		return
+	}
+
+	// Lax, so the cookie is sent when the provider redirects back
+	http.SetCookie(w, &http.Cookie{
+		Name:     CookieFlowName,
+		Value:    state,
+		Path:     "/auth/oidc/" + provider.Name(),
+		MaxAge:   int(authoidc.FlowDuration.Seconds()),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(w, r, authURL, http.StatusFound)
+}
+
+// Callback finishes signing in once the provider sends the user back.
+func (h *Handler) Callback(w http.ResponseWriter, r *http.Request) {
+	provider, ok := h.providers[r.PathValue("provider")]
+	if !ok {
+		http.Error(w, "Not Found", http.StatusNotFound)
+		return
+	}
+
+	// The flow can only be finished once
+	http.SetCookie(w, &http.Cookie{
+		Name:     CookieFlowName,
+		Path:     "/auth/oidc/" + provider.Name(),
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	query := r.URL.Query()
+	if query.Get("error") != "" {
+		h.fail(w, "sign in was cancelled")
+		return
+	}
+
+	// Make sure this browser started the flow
+	cookie, err := r.Cookie(CookieFlowName)
+	if err != nil {
+		h.fail(w, authoidc.ErrInvalidFlow.Error())
+		return
+	}
+	flow, err := authoidc.ParseFlow(h.auth, cookie.Value, provider.Name(), query.Get("state"))
+	if err != nil {
+		h.fail(w, err.Error())
+		return
+	}
+
+	// Exchange code
+	claims, err := provider.Exchange(r.Context(), h.callbackURL(provider), query.Get("code"), flow)
+	if err != nil {
+		h.log.Error("Failed to finish OIDC sign in", "provider", provider.Name(), "error", err)
+		h.fail(w, "could not verify the sign in")
+		return
+	}
+	params := identityParams(provider.Name(), claims)
+
+	// Link identity to the signed in user
+	if flow.Link != 0 {
+		h.link(w, r, flow, params)
+		return
+	}
+
+	// Get or create user
+	user, err := h.auth.GetUserByIdentity(r.Context(), params.Provider, params.Subject)
+	if errors.Is(err, sql.ErrNoRows) {
+		user, err = h.auth.NewUserFromIdentity(r.Context(), params)
+	}
+	if err != nil {
+		h.log.Error("Failed to get OIDC user", "provider", provider.Name(), "error", err)
+		h.fail(w, "could not sign in")
+		return
+	}
+
+	// Require a second factor before creating a session
+	if user.TwoFactorEnabled() {
+		challenge, err := user.NewChallenge(r.Context())
+		if err != nil {
+			h.log.Error("Failed to create OIDC challenge", "provider", provider.Name(), "error", err)
+			h.fail(w, "could not sign in")
+			return
+		}
+
+		fragment := url.Values{
+			"challenge_token": {challenge},
+			"redir":           {flow.Redirect},
+		}
+		h.finish(w, "/auth#"+fragment.Encode())
+		return
+	}
+
+	// Create session
+	tokens, err := user.NewSession(r.Context(), auth.SessionParams{
+		UserAgent: r.UserAgent(),
+		IP:        putil.PeerIP(connect.Peer{Addr: r.RemoteAddr}),
+	})
+	if err != nil {
+		if errors.Is(err, auth.ErrUserDisabled) {
+			h.fail(w, auth.ErrUserDisabled.Error())
+			return
+		}
+		h.fail(w, "could not sign in")
+		return
+	}
+	for _, cookie := range tokens.Cookies() {
+		http.SetCookie(w, cookie)
+	}
+	h.audit.Record(r.Context(), audit.Event{
+		ActorID:  user.ID,
+		UserID:   user.ID,
+		Action:   audit.ActionLoginOIDC,
+		Target:   "session:" + tokens.Session.ID,
+		Metadata: map[string]any{"provider": params.Provider},
+	})
+
+	h.finish(w, flow.Redirect)
+}
+
+// link links the identity to the user that started the flow.
+func (h *Handler) link(w http.ResponseWriter, r *http.Request, flow authoidc.Flow, params auth.IdentityParams) {
+	user, err := h.auth.GetUser(r.Context(), flow.Link)
+	if err != nil {
+		h.fail(w, "could not link the account")
+		return
+	}
+
+	err = user.LinkIdentity(r.Context(), params)
+	if err != nil {
+		if errors.Is(err, auth.ErrIdentityInUse) {
+			h.fail(w, auth.ErrIdentityInUse.Error())
+			return
+		}
+		h.fail(w, "could not link the account")
+		return
+	}
+	h.audit.Record(r.Context(), audit.Event{
+		ActorID:  user.ID,
+		UserID:   user.ID,
+		Action:   audit.ActionIdentityLink,
+		Metadata: map[string]any{"provider": params.Provider},
+	})
+
+	h.finish(w, flow.Redirect)
+}
+
+// finish sends the user on to a path of the client.
+// Session cookies are strict, browsers ignore them on redirects that started at the provider,
+// so the page navigates itself instead of answering with a redirect.
+func (*Handler) finish(w http.ResponseWriter, location string) {
+	if location == "" {
+		location = "/"
+	}
+
+	putil.Navigate(w, location)
+}
+
+// fail sends the user back to the sign in page with an error.
+func (h *Handler) fail(w http.ResponseWriter, message string) {
+	h.finish(w, "/auth?"+url.Values{"error": {message}}.Encode())
+}
+
+// callbackURL is where the provider sends the user back to, it must be registered with the provider.
+func (h *Handler) callbackURL(provider *authoidc.Provider) string {
+	return h.url.JoinPath("auth", "oidc", provider.Name(), "callback").String()
+}
+
+// identityParams describes the identity in the ID token claims.
+func identityParams(provider string, claims *authoidc.Claims) auth.IdentityParams {
+	username := claims.PreferredUsername
+	if username == "" && claims.Email != "" {
+		username, _, _ = strings.Cut(claims.Email, "@")
+	}
+	if username == "" {
+		username = claims.Name
+	}
+
+	return auth.IdentityParams{
+		Provider:      provider,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: bool(claims.EmailVerified),
+		Username:      username,
+	}
+}
+
+// safeRedirect only allows redirects to paths on this site.
+func safeRedirect(redirect string) string {
+	if !strings.HasPrefix(redirect, "/") || strings.HasPrefix(redirect, "//") || strings.Contains(redirect, "\\") {
+		return ""
+	}
+
+	return redirect
+}
+
+func New(app *app.App) http.Handler {
+	h := &Handler{
+		auth:      app.Auth,
+		audit:     app.Audit,
+		providers: app.OIDC,
+		url:       app.Env.URL,
+		log:       app.Log,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /auth/oidc/{provider}/start", h.Start)
+	mux.HandleFunc("GET /auth/oidc/{provider}/callback", h.Callback)
+
+	return interceptors.WithAuthContext(mux, app.Auth)
+}
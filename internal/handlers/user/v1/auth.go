@@ -5,54 +5,81 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"log/slog"
+	"math"
 	"net/http"
-	"sync"
-	"time"
+	"net/url"
+	"strconv"
 
 	"connectrpc.com/connect"
-	"github.com/aarondl/opt/omit"
 	"github.com/go-webauthn/webauthn/protocol"
 	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/stephenafamo/bob"
 
 	"github.com/spotdemo4/ts-server/internal/app"
+	"github.com/spotdemo4/ts-server/internal/audit"
 	"github.com/spotdemo4/ts-server/internal/auth"
-	"github.com/spotdemo4/ts-server/internal/bob/models"
 	userv1 "github.com/spotdemo4/ts-server/internal/connect/user/v1"
 	"github.com/spotdemo4/ts-server/internal/connect/user/v1/userv1connect"
+	"github.com/spotdemo4/ts-server/internal/mail"
 	"github.com/spotdemo4/ts-server/internal/putil"
 )
 
-const DefaultCookiDuration = time.Hour * 8 // 8 hours
-
 type AuthHandler struct {
-	db   *bob.DB
-	auth *auth.Auth
-
-	sessions *map[string]*webauthn.SessionData
-	mu       sync.Mutex
+	db        *bob.DB
+	auth      *auth.Auth
+	audit     *audit.Log
+	mail      mail.Mailer
+	url       *url.URL
+	log       *slog.Logger
+	providers []string
 }
 
 func (h *AuthHandler) Login(
 	ctx context.Context,
 	req *connect.Request[userv1.LoginRequest],
 ) (*connect.Response[userv1.LoginResponse], error) {
-	// Get user
-	user, err := h.auth.GetUserByName(ctx, req.Msg.GetUsername())
+	// Check username and password
+	params := sessionParams(req.Header(), req.Peer())
+	user, err := h.auth.Login(ctx, auth.LoginParams{
+		Username:  req.Msg.GetUsername(),
+		Password:  req.Msg.GetPassword(),
+		IP:        params.IP,
+		UserAgent: params.UserAgent,
+	})
 	if err != nil {
-		return nil, putil.CheckNotFound(err)
+		return nil, loginError(err)
 	}
 
-	// Check password
-	if !user.Validate(req.Msg.GetPassword()) {
-		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("invalid username or password"))
+	// Require a second factor before creating a session
+	if user.TwoFactorEnabled() {
+		challenge, err := user.NewChallenge(ctx)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		return connect.NewResponse(&userv1.LoginResponse{
+			ChallengeToken: challenge,
+		}), nil
+	}
+
+	// Create session
+	tokens, err := user.NewSession(ctx, params)
+	if err != nil {
+		if errors.Is(err, auth.ErrUserDisabled) {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
 	// Create response
 	res := connect.NewResponse(&userv1.LoginResponse{
-		Token: user.Token(time.Now().Add(DefaultCookiDuration)),
+		Token:        tokens.Access,
+		RefreshToken: tokens.Refresh,
 	})
-	res.Header().Set("Set-Cookie", user.Cookie(DefaultCookiDuration).String())
+	for _, cookie := range tokens.Cookies() {
+		res.Header().Add("Set-Cookie", cookie.String())
+	}
 
 	return res, nil
 }
@@ -81,31 +108,91 @@ func (h *AuthHandler) SignUp(
 		Username: req.Msg.GetUsername(),
 		Password: req.Msg.GetPassword(),
 	})
+	if err != nil {
+		return nil, passwordError(err)
+	}
+	user, err := h.auth.GetUserByName(ctx, req.Msg.GetUsername())
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	h.audit.Record(ctx, audit.Event{
+		ActorID: user.ID,
+		UserID:  user.ID,
+		Action:  audit.ActionSignUp,
+		Target:  "username:" + user.Username,
+	})
 
 	res := connect.NewResponse(&userv1.SignUpResponse{})
 	return res, nil
 }
 
 func (h *AuthHandler) Logout(
-	_ context.Context,
-	_ *connect.Request[userv1.LogoutRequest],
+	ctx context.Context,
+	req *connect.Request[userv1.LogoutRequest],
 ) (*connect.Response[userv1.LogoutResponse], error) {
-	// Clear cookie
-	cookie := http.Cookie{
-		Name:     "token",
-		Value:    "",
-		Path:     "/",
-		MaxAge:   -1,
-		HttpOnly: true,
-		Secure:   true,
-		SameSite: http.SameSiteStrictMode,
+	// Revoke session
+	request := http.Request{Header: req.Header()}
+	if cookie, err := request.Cookie(auth.CookieRefreshName); err == nil {
+		_ = h.auth.RevokeRefreshToken(ctx, cookie.Value)
+	}
+	if cookie, err := request.Cookie(auth.CookieTokenName); err == nil {
+		user, tokenErr := h.auth.GetUserFromToken(ctx, cookie.Value)
+		if tokenErr == nil {
+			_ = user.RevokeSession(ctx, user.SessionID)
+			h.audit.Record(ctx, audit.Event{
+				ActorID: user.ID,
+				UserID:  user.ID,
+				Action:  audit.ActionLogout,
+				Target:  "session:" + user.SessionID,
+			})
+		}
 	}
 
+	// Clear cookies
 	res := connect.NewResponse(&userv1.LogoutResponse{})
-	res.Header().Set("Set-Cookie", cookie.String())
+	for _, cookie := range auth.ClearCookies() {
+		res.Header().Add("Set-Cookie", cookie.String())
+	}
+
+	return res, nil
+}
+
+func (h *AuthHandler) Refresh(
+	ctx context.Context,
+	req *connect.Request[userv1.RefreshRequest],
+) (*connect.Response[userv1.RefreshResponse], error) {
+	// Get refresh token from the request or cookie
+	refreshToken := req.Msg.GetRefreshToken()
+	if req.Msg.RefreshToken == nil {
+		request := http.Request{Header: req.Header()}
+		cookie, err := request.Cookie(auth.CookieRefreshName)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeUnauthenticated, auth.ErrInvalidRefreshToken)
+		}
+		refreshToken = cookie.Value
+	}
+
+	// Exchange refresh token
+	_, tokens, err := h.auth.Refresh(ctx, refreshToken)
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidRefreshToken) {
+			connectErr := connect.NewError(connect.CodeUnauthenticated, err)
+			for _, cookie := range auth.ClearCookies() {
+				connectErr.Meta().Add("Set-Cookie", cookie.String())
+			}
+			return nil, connectErr
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Create response
+	res := connect.NewResponse(&userv1.RefreshResponse{
+		Token:        tokens.Access,
+		RefreshToken: tokens.Refresh,
+	})
+	for _, cookie := range tokens.Cookies() {
+		res.Header().Add("Set-Cookie", cookie.String())
+	}
 
 	return res, nil
 }
@@ -114,16 +201,35 @@ func (h *AuthHandler) BeginPasskeyLogin(
 	ctx context.Context,
 	req *connect.Request[userv1.BeginPasskeyLoginRequest],
 ) (*connect.Response[userv1.BeginPasskeyLoginResponse], error) {
-	// Get user
-	user, err := h.auth.GetUserByName(ctx, req.Msg.GetUsername())
-	if err != nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, err)
-	}
+	var (
+		options *protocol.CredentialAssertion
+		session *webauthn.SessionData
+		err     error
+	)
 
-	// Get options for user
-	options, session, err := h.auth.Web.BeginLogin(user)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	if req.Msg.GetUsername() == "" {
+		// Get options for any discoverable credential
+		mediation := protocol.MediationDefault
+		if req.Msg.GetConditional() {
+			mediation = protocol.MediationConditional
+		}
+		options, session, err = h.auth.Web.BeginDiscoverableMediatedLogin(mediation)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	} else {
+		// Get user
+		var user auth.User
+		user, err = h.auth.GetUserByName(ctx, req.Msg.GetUsername())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+
+		// Get options for user
+		options, session, err = h.auth.Web.BeginLogin(user)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
 	}
 
 	// Turn the options into json
@@ -132,11 +238,15 @@ func (h *AuthHandler) BeginPasskeyLogin(
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	// Set session for validation later
-	h.setSession(req.Msg.GetUsername(), session)
+	// Store session for validation later
+	ceremonyID, err := h.auth.Ceremonies.Put(ctx, session)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
 
 	return connect.NewResponse(&userv1.BeginPasskeyLoginResponse{
 		OptionsJson: string(optionsJSON),
+		CeremonyId:  ceremonyID,
 	}), nil
 }
 
@@ -144,86 +254,440 @@ func (h *AuthHandler) FinishPasskeyLogin(
 	ctx context.Context,
 	req *connect.Request[userv1.FinishPasskeyLoginRequest],
 ) (*connect.Response[userv1.FinishPasskeyLoginResponse], error) {
+	var (
+		user auth.User
+		err  error
+	)
+
+	if req.Msg.GetUsername() == "" {
+		// Validate the passkey, finding the user from the credential
+		user, err = h.validateDiscoverablePasskey(ctx, req.Msg.GetCeremonyId(), req.Msg.GetAttestation())
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Get user
+		user, err = h.auth.GetUserByName(ctx, req.Msg.GetUsername())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		// Validate the passkey
+		err = validatePasskey(ctx, h.auth, user, req.Msg.GetCeremonyId(), req.Msg.GetAttestation())
+		if err != nil {
+			h.audit.Record(ctx, audit.Event{
+				UserID:  user.ID,
+				Action:  audit.ActionLoginPasskey,
+				Target:  "username:" + user.Username,
+				Outcome: audit.OutcomeFailure,
+			})
+			return nil, err
+		}
+	}
+
+	// Create session
+	tokens, err := user.NewSession(ctx, sessionParams(req.Header(), req.Peer()))
+	if err != nil {
+		if errors.Is(err, auth.ErrUserDisabled) {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	h.audit.Record(ctx, audit.Event{
+		ActorID: user.ID,
+		UserID:  user.ID,
+		Action:  audit.ActionLoginPasskey,
+		Target:  "session:" + tokens.Session.ID,
+	})
+
+	// Create response
+	resp := connect.NewResponse(&userv1.FinishPasskeyLoginResponse{
+		Token:        tokens.Access,
+		RefreshToken: tokens.Refresh,
+	})
+	for _, cookie := range tokens.Cookies() {
+		resp.Header().Add("Set-Cookie", cookie.String())
+	}
+
+	return resp, nil
+}
+
+func (h *AuthHandler) VerifySecondFactor(
+	ctx context.Context,
+	req *connect.Request[userv1.VerifySecondFactorRequest],
+) (*connect.Response[userv1.VerifySecondFactorResponse], error) {
 	// Get user
-	user, err := h.auth.GetUserByName(ctx, req.Msg.GetUsername())
+	user, challenge, err := h.auth.GetUserFromChallenge(ctx, req.Msg.GetChallengeToken())
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		if errors.Is(err, auth.ErrInvalidChallenge) {
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	// Get the session data previously set
-	session, err := h.getSession(req.Msg.GetUsername())
+	// Second factors are throttled like passwords, only a verified second factor forgives their failures
+	params := sessionParams(req.Header(), req.Peer())
+	err = h.auth.CheckLockout(ctx, auth.FactorSecondFactor, user.Username, params.IP)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, loginError(err)
 	}
 
-	// Parse the attestation response
-	parsedResponse, err := protocol.ParseCredentialRequestResponseBytes([]byte(req.Msg.GetAttestation()))
+	// Check the second factor
+	switch factor := req.Msg.GetFactor().(type) {
+	case *userv1.VerifySecondFactorRequest_Code:
+		err = user.VerifyTOTP(ctx, factor.Code)
+	case *userv1.VerifySecondFactorRequest_RecoveryCode:
+		err = user.UseRecoveryCode(ctx, factor.RecoveryCode)
+	case *userv1.VerifySecondFactorRequest_Attestation:
+		err = validatePasskey(ctx, h.auth, user, req.Msg.GetCeremonyId(), factor.Attestation)
+		if err != nil {
+			// Throttle passkeys that fail to verify like wrong codes, but not failures of the server
+			if connect.CodeOf(err) != connect.CodeInternal {
+				recordErr := h.auth.RecordLoginAttempt(ctx, auth.FactorSecondFactor, auth.LoginParams{
+					Username:  user.Username,
+					IP:        params.IP,
+					UserAgent: params.UserAgent,
+				}, false)
+				if recordErr != nil {
+					return nil, connect.NewError(connect.CodeInternal, recordErr)
+				}
+			}
+			failErr := h.auth.FailChallenge(ctx, challenge)
+			if failErr != nil {
+				return nil, connect.NewError(connect.CodeInternal, failErr)
+			}
+			h.recordSecondFactor(ctx, user, "passkey", audit.OutcomeFailure)
+			return nil, err
+		}
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("missing second factor"))
+	}
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidCode) {
+			err = h.auth.RecordLoginAttempt(ctx, auth.FactorSecondFactor, auth.LoginParams{
+				Username:  user.Username,
+				IP:        params.IP,
+				UserAgent: params.UserAgent,
+			}, false)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, err)
+			}
+			err = h.auth.FailChallenge(ctx, challenge)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, err)
+			}
+			h.recordSecondFactor(ctx, user, secondFactorName(req.Msg), audit.OutcomeFailure)
+			return nil, connect.NewError(connect.CodePermissionDenied, auth.ErrInvalidCode)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	err = h.auth.RecordLoginAttempt(ctx, auth.FactorSecondFactor, auth.LoginParams{
+		Username:  user.Username,
+		IP:        params.IP,
+		UserAgent: params.UserAgent,
+	}, true)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	// Validate the login
-	credential, err := h.auth.Web.ValidateLogin(user, *session, parsedResponse)
+	// Use up the challenge, so the token cannot be exchanged for another session
+	err = h.auth.UseChallenge(ctx, challenge)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		if errors.Is(err, auth.ErrInvalidChallenge) {
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	h.recordSecondFactor(ctx, user, secondFactorName(req.Msg), audit.OutcomeSuccess)
 
-	// Get cred
-	cred, err := models.Credentials.Query(
-		models.SelectWhere.Credentials.CredID.EQ(string(credential.ID)),
-		models.SelectWhere.Credentials.UserID.EQ(user.ID),
-	).One(ctx, h.db)
+	// Create session
+	tokens, err := user.NewSession(ctx, params)
 	if err != nil {
-		return nil, putil.CheckNotFound(err)
+		if errors.Is(err, auth.ErrUserDisabled) {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	// Update cred
-	err = cred.Update(ctx, h.db, &models.CredentialSetter{
-		LastUsed:  omit.From(time.Now()),
-		SignCount: omit.From(int32(credential.Authenticator.SignCount)),
+	// Create response
+	res := connect.NewResponse(&userv1.VerifySecondFactorResponse{
+		Token:        tokens.Access,
+		RefreshToken: tokens.Refresh,
 	})
+	for _, cookie := range tokens.Cookies() {
+		res.Header().Add("Set-Cookie", cookie.String())
+	}
+
+	return res, nil
+}
+
+func (h *AuthHandler) RequestPasswordReset(
+	ctx context.Context,
+	req *connect.Request[userv1.RequestPasswordResetRequest],
+) (*connect.Response[userv1.RequestPasswordResetResponse], error) {
+	// Always succeed without waiting for the reset, so neither the response nor how long it takes
+	// reveals which addresses have an account
+	go h.sendPasswordReset(context.WithoutCancel(ctx), req.Msg.GetEmail())
+
+	return connect.NewResponse(&userv1.RequestPasswordResetResponse{}), nil
+}
+
+// sendPasswordReset mails a password reset token to the address, if it belongs to an account.
+func (h *AuthHandler) sendPasswordReset(ctx context.Context, email string) {
+	user, token, err := h.auth.NewPasswordReset(ctx, email)
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, auth.ErrUserDisabled) {
+		return
+	}
+	if err != nil {
+		h.log.Error("Failed to create password reset", "error", err)
+		return
+	}
+
+	h.audit.Record(ctx, audit.Event{
+		UserID: user.ID,
+		Action: audit.ActionPasswordResetRequest,
+		Target: "username:" + user.Username,
+	})
+
+	err = h.mail.Send(ctx, resetPasswordMessage(h.url, user.Email.MustGet(), user.Username, token))
 	if err != nil {
+		h.log.Error("Failed to send password reset email", "error", err)
+	}
+}
+
+func (h *AuthHandler) ResetPassword(
+	ctx context.Context,
+	req *connect.Request[userv1.ResetPasswordRequest],
+) (*connect.Response[userv1.ResetPasswordResponse], error) {
+	// Check if confirmation passwords match
+	if req.Msg.GetPassword() != req.Msg.GetConfirmPassword() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("passwords do not match"))
+	}
+
+	// Reset password, revoking every session
+	user, err := h.auth.ResetPassword(ctx, req.Msg.GetToken(), req.Msg.GetPassword())
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidEmailToken) {
+			return nil, connect.NewError(connect.CodePermissionDenied, auth.ErrInvalidEmailToken)
+		}
+		return nil, passwordError(err)
+	}
+	h.audit.Record(ctx, audit.Event{
+		ActorID: user.ID,
+		UserID:  user.ID,
+		Action:  audit.ActionPasswordReset,
+		Target:  "username:" + user.Username,
+	})
+
+	return connect.NewResponse(&userv1.ResetPasswordResponse{}), nil
+}
+
+func (h *AuthHandler) VerifyEmail(
+	ctx context.Context,
+	req *connect.Request[userv1.VerifyEmailRequest],
+) (*connect.Response[userv1.VerifyEmailResponse], error) {
+	user, err := h.auth.VerifyEmail(ctx, req.Msg.GetToken())
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidEmailToken) {
+			return nil, connect.NewError(connect.CodePermissionDenied, auth.ErrInvalidEmailToken)
+		}
+		if errors.Is(err, auth.ErrEmailInUse) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, auth.ErrEmailInUse)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	// Create response
-	resp := connect.NewResponse(&userv1.FinishPasskeyLoginResponse{
-		Token: user.Token(time.Now().Add(DefaultCookiDuration)),
+	h.audit.Record(ctx, audit.Event{
+		ActorID: user.ID,
+		UserID:  user.ID,
+		Action:  audit.ActionEmailVerify,
 	})
-	resp.Header().Set("Set-Cookie", user.Cookie(DefaultCookiDuration).String())
 
-	return resp, nil
+	return connect.NewResponse(&userv1.VerifyEmailResponse{}), nil
+}
+
+func (h *AuthHandler) ListIdentityProviders(
+	_ context.Context,
+	_ *connect.Request[userv1.ListIdentityProvidersRequest],
+) (*connect.Response[userv1.ListIdentityProvidersResponse], error) {
+	return connect.NewResponse(&userv1.ListIdentityProvidersResponse{
+		Providers: h.providers,
+	}), nil
 }
 
-func (h *AuthHandler) getSession(username string) (*webauthn.SessionData, error) {
-	h.mu.Lock()
-	defer h.mu.Unlock()
+// validatePasskey validates a passkey login for the user started with BeginPasskeyLogin.
+func validatePasskey(ctx context.Context, a *auth.Auth, user auth.User, ceremonyID string, attestation string) error {
+	// Get the session data previously stored
+	session, err := a.Ceremonies.Take(ctx, ceremonyID)
+	if err != nil {
+		if errors.Is(err, auth.ErrCeremonyNotFound) {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Parse the attestation response
+	parsedResponse, err := protocol.ParseCredentialRequestResponseBytes([]byte(attestation))
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
 
-	session, ok := (*h.sessions)[username]
+	// Validate the login
+	credential, err := a.Web.ValidateLogin(user, *session, parsedResponse)
+	if err != nil {
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	return updatePasskey(ctx, user, credential)
+}
+
+// validateDiscoverablePasskey validates a passkey login started without a username, returning the passkey's user.
+func (h *AuthHandler) validateDiscoverablePasskey(
+	ctx context.Context,
+	ceremonyID string,
+	attestation string,
+) (auth.User, error) {
+	// Get the session data previously stored
+	session, err := h.auth.Ceremonies.Take(ctx, ceremonyID)
+	if err != nil {
+		if errors.Is(err, auth.ErrCeremonyNotFound) {
+			return auth.User{}, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return auth.User{}, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Parse the attestation response
+	parsedResponse, err := protocol.ParseCredentialRequestResponseBytes([]byte(attestation))
+	if err != nil {
+		return auth.User{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// Validate the login, the user handle is the user's webauthn ID
+	webUser, credential, err := h.auth.Web.ValidatePasskeyLogin(
+		func(_, userHandle []byte) (webauthn.User, error) {
+			return h.auth.GetUserByWebAuthnID(ctx, userHandle)
+		},
+		*session,
+		parsedResponse,
+	)
+	if err != nil {
+		return auth.User{}, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	user, ok := webUser.(auth.User)
 	if !ok {
-		return nil, errors.New("session does not exist")
+		return auth.User{}, connect.NewError(connect.CodeInternal, errors.New("unexpected webauthn user"))
+	}
+
+	err = updatePasskey(ctx, user, credential)
+	if err != nil {
+		return auth.User{}, err
+	}
+
+	return user, nil
+}
+
+// updatePasskey records that a passkey was used.
+func updatePasskey(ctx context.Context, user auth.User, credential *webauthn.Credential) error {
+	err := user.RecordPasskeyLogin(ctx, credential)
+	if err != nil {
+		if errors.Is(err, auth.ErrClonedAuthenticator) {
+			return connect.NewError(connect.CodePermissionDenied, err)
+		}
+		return putil.CheckNotFound(err)
+	}
+
+	return nil
+}
+
+// loginError converts a failed sign in into a response, telling the client when to retry after a lockout.
+func loginError(err error) error {
+	var lockout *auth.LockoutError
+	if errors.As(err, &lockout) {
+		connectErr := connect.NewError(connect.CodeResourceExhausted, lockout)
+		connectErr.Meta().Set("Retry-After", strconv.Itoa(int(math.Ceil(lockout.RetryAfter.Seconds()))))
+		return connectErr
+	}
+	if errors.Is(err, auth.ErrInvalidCredentials) {
+		return connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	return connect.NewError(connect.CodeInternal, err)
+}
+
+// passwordError converts a password policy failure to an InvalidArgument error,
+// with a PasswordPolicyViolation detail the client can show.
+func passwordError(err error) error {
+	var policy *auth.PasswordPolicyError
+	if !errors.As(err, &policy) {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	connectErr := connect.NewError(connect.CodeInvalidArgument, policy)
+	detail, detailErr := connect.NewErrorDetail(&userv1.PasswordPolicyViolation{
+		Problems:    policy.Problems,
+		Score:       int32(policy.Score),     //nolint:gosec // 0 to 4
+		MinScore:    int32(policy.MinScore),  //nolint:gosec // 0 to 4
+		MinLength:   int32(policy.MinLength), //nolint:gosec // Configured length
+		Suggestions: policy.Suggestions,
+	})
+	if detailErr == nil {
+		connectErr.AddDetail(detail)
 	}
 
-	delete(*h.sessions, username)
-	return session, nil
+	return connectErr
+}
+
+// recordSecondFactor records a second factor check of a sign in.
+func (h *AuthHandler) recordSecondFactor(ctx context.Context, user auth.User, factor string, outcome string) {
+	event := audit.Event{
+		UserID:   user.ID,
+		Action:   audit.ActionLoginSecondFactor,
+		Target:   "username:" + user.Username,
+		Outcome:  outcome,
+		Metadata: map[string]any{"factor": factor},
+	}
+	if outcome == audit.OutcomeSuccess {
+		event.ActorID = user.ID
+	}
+	h.audit.Record(ctx, event)
 }
 
-func (h *AuthHandler) setSession(username string, data *webauthn.SessionData) {
-	h.mu.Lock()
-	defer h.mu.Unlock()
+// secondFactorName returns the kind of second factor of a request, for audit events.
+func secondFactorName(msg *userv1.VerifySecondFactorRequest) string {
+	switch msg.GetFactor().(type) {
+	case *userv1.VerifySecondFactorRequest_Code:
+		return "totp"
+	case *userv1.VerifySecondFactorRequest_RecoveryCode:
+		return "recovery_code"
+	default:
+		return "passkey"
+	}
+}
 
-	(*h.sessions)[username] = data
+// sessionParams describes the client a session is created for.
+func sessionParams(header http.Header, peer connect.Peer) auth.SessionParams {
+	return auth.SessionParams{
+		UserAgent: header.Get("User-Agent"),
+		IP:        putil.PeerIP(peer),
+	}
 }
 
 func NewAuth(app *app.App, interceptors connect.Option) (string, http.Handler) {
-	sd := map[string]*webauthn.SessionData{}
+	providers := []string{}
+	for _, config := range app.Env.OIDCProviders {
+		providers = append(providers, config.Name)
+	}
+
 	return userv1connect.NewAuthServiceHandler(
 		&AuthHandler{
-			db:   app.DB,
-			auth: app.Auth,
-
-			sessions: &sd,
-			mu:       sync.Mutex{},
+			db:        app.DB,
+			auth:      app.Auth,
+			audit:     app.Audit,
+			mail:      app.Mail,
+			url:       app.Env.URL,
+			log:       app.Log,
+			providers: providers,
 		},
 		interceptors,
 	)
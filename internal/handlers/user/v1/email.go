@@ -0,0 +1,55 @@
+package user
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/spotdemo4/ts-server/internal/auth"
+	"github.com/spotdemo4/ts-server/internal/mail"
+)
+
+// verifyEmailMessage creates the email sent to verify an email address.
+func verifyEmailMessage(base *url.URL, to string, token string) mail.Message {
+	link := base.JoinPath("verify-email")
+	link.RawQuery = url.Values{"token": {token}}.Encode()
+
+	return mail.Message{
+		To:      to,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf(
+			"Open the link below to verify your email address. It expires in %s.\n\n%s\n\n"+
+				"If you did not add this address to your account, you can ignore this email.\n",
+			formatDuration(auth.EmailVerifyTokenDuration),
+			link.String(),
+		),
+	}
+}
+
+// resetPasswordMessage creates the email sent to reset a password.
+func resetPasswordMessage(base *url.URL, to string, username string, token string) mail.Message {
+	link := base.JoinPath("auth", "reset-password")
+	link.RawQuery = url.Values{"token": {token}}.Encode()
+
+	return mail.Message{
+		To:      to,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"Someone asked to reset the password of %s. Open the link below to choose a new one. "+
+				"It expires in %s.\n\n%s\n\nIf this was not you, you can ignore this email.\n",
+			username,
+			formatDuration(auth.PasswordResetTokenDuration),
+			link.String(),
+		),
+	}
+}
+
+// formatDuration formats a whole number of hours for people.
+func formatDuration(d time.Duration) string {
+	hours := int(d.Hours())
+	if hours == 1 {
+		return "1 hour"
+	}
+
+	return fmt.Sprintf("%d hours", hours)
+}
@@ -0,0 +1,110 @@
+package user
+
+import (
+	"net/url"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/spotdemo4/ts-server/internal/auth"
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+	userv1 "github.com/spotdemo4/ts-server/internal/connect/user/v1"
+)
+
+func userToConnect(user auth.User) *userv1.User {
+	return &userv1.User{
+		Id:                    user.ID,
+		Username:              user.Username,
+		ProfilePictureId:      user.ProfilePictureID.Ptr(),
+		Role:                  user.Role,
+		PasswordResetRequired: user.PasswordResetRequired,
+		TotpEnabled:           user.TotpEnabled,
+		Email:                 user.Email.Ptr(),
+		EmailVerified:         user.EmailVerified(),
+	}
+}
+
+func apiKeyToConnect(apiKey *models.APIKey) *userv1.APIKey {
+	scopes := []string{}
+	for _, scope := range auth.ParseScopes(apiKey.Scopes) {
+		scopes = append(scopes, string(scope))
+	}
+
+	res := &userv1.APIKey{
+		Id:        apiKey.ID,
+		Name:      apiKey.Name,
+		Prefix:    apiKey.Prefix,
+		Scopes:    scopes,
+		CreatedAt: timestamppb.New(apiKey.CreatedAt),
+	}
+	if apiKey.ExpiresAt.IsValue() {
+		res.ExpiresAt = timestamppb.New(apiKey.ExpiresAt.MustGet())
+	}
+	if apiKey.LastUsed.IsValue() {
+		res.LastUsed = timestamppb.New(apiKey.LastUsed.MustGet())
+	}
+
+	return res
+}
+
+func passkeyToConnect(cred *models.Credential) *userv1.Passkey {
+	transports := []string{}
+	for _, transport := range auth.ParseTransports(cred.Transports.GetOrZero()) {
+		transports = append(transports, string(transport))
+	}
+
+	return &userv1.Passkey{
+		Id:             cred.CredID,
+		Name:           cred.Name,
+		CreatedAt:      timestamppb.New(cred.CreatedAt),
+		LastUsed:       timestamppb.New(cred.LastUsed),
+		Transports:     transports,
+		BackupEligible: cred.BackupEligible.GetOrZero(),
+		BackupState:    cred.BackupState.GetOrZero(),
+		CloneWarning:   cred.CloneWarning,
+	}
+}
+
+func identityToConnect(identity *models.Identity) *userv1.Identity {
+	return &userv1.Identity{
+		Id:        identity.ID,
+		Provider:  identity.Provider,
+		Email:     identity.Email.Ptr(),
+		CreatedAt: timestamppb.New(identity.CreatedAt),
+		LastUsed:  timestamppb.New(identity.LastUsed),
+	}
+}
+
+// auditEventToConnect converts an event on the user's account, the actor is only set when it was someone else.
+func auditEventToConnect(event *models.AuditEvent, userid int32) *userv1.AuditEvent {
+	resEvent := &userv1.AuditEvent{
+		Id:        int64(event.ID),
+		Action:    event.Action,
+		Target:    event.Target,
+		Outcome:   event.Outcome,
+		Ip:        event.IP,
+		UserAgent: event.UserAgent,
+		Metadata:  event.Metadata,
+		CreatedAt: timestamppb.New(event.CreatedAt),
+	}
+	if actorid, ok := event.ActorID.Get(); ok && actorid != userid {
+		resEvent.ActorId = &actorid
+	}
+
+	return resEvent
+}
+
+func exportToConnect(base *url.URL, accountExport *models.AccountExport) *userv1.AccountExport {
+	res := &userv1.AccountExport{
+		Id:          accountExport.ID,
+		Status:      accountExport.Status,
+		Size:        int64(accountExport.Size),
+		CreatedAt:   timestamppb.New(accountExport.CreatedAt),
+		ExpiresAt:   timestamppb.New(accountExport.ExpiresAt),
+		DownloadUrl: base.JoinPath("export", accountExport.ID).String(),
+	}
+	if accountExport.FinishedAt.IsValue() {
+		res.FinishedAt = timestamppb.New(accountExport.FinishedAt.MustGet())
+	}
+
+	return res
+}
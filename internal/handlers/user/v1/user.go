@@ -4,30 +4,34 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
-	"sync"
+	"net/url"
 	"time"
 
 	"connectrpc.com/connect"
-	"github.com/aarondl/opt/omit"
-	"github.com/aarondl/opt/omitnull"
 	"github.com/go-webauthn/webauthn/protocol"
 	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/stephenafamo/bob"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/spotdemo4/ts-server/internal/app"
+	"github.com/spotdemo4/ts-server/internal/audit"
 	"github.com/spotdemo4/ts-server/internal/auth"
-	"github.com/spotdemo4/ts-server/internal/bob/models"
 	userv1 "github.com/spotdemo4/ts-server/internal/connect/user/v1"
 	"github.com/spotdemo4/ts-server/internal/connect/user/v1/userv1connect"
+	"github.com/spotdemo4/ts-server/internal/export"
+	"github.com/spotdemo4/ts-server/internal/mail"
+	"github.com/spotdemo4/ts-server/internal/putil"
 )
 
 type Handler struct {
-	db   *bob.DB
-	auth *auth.Auth
-
-	sessions *map[int32]*webauthn.SessionData
-	mu       sync.Mutex
+	db     *bob.DB
+	auth   *auth.Auth
+	audit  *audit.Log
+	export *export.Exporter
+	mail   mail.Mailer
+	url    *url.URL
 }
 
 func (h *Handler) GetUser(
@@ -40,11 +44,7 @@ func (h *Handler) GetUser(
 	}
 
 	return connect.NewResponse(&userv1.GetUserResponse{
-		User: &userv1.User{
-			Id:               user.ID,
-			Username:         user.Username,
-			ProfilePictureId: user.ProfilePictureID.Ptr(),
-		},
+		User: userToConnect(user),
 	}), nil
 }
 
@@ -59,6 +59,11 @@ func (h *Handler) UpdatePassword(
 
 	// Validate
 	if !user.Validate(req.Msg.GetOldPassword()) {
+		h.record(ctx, user, audit.Event{
+			Action:   audit.ActionPasswordChange,
+			Outcome:  audit.OutcomeFailure,
+			Metadata: map[string]any{"reason": "invalid_password"},
+		})
 		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("invalid password"))
 	}
 	if req.Msg.GetNewPassword() != req.Msg.GetConfirmPassword() {
@@ -67,16 +72,42 @@ func (h *Handler) UpdatePassword(
 
 	// Update password
 	err := user.SetPassword(ctx, req.Msg.GetNewPassword())
+	if err != nil {
+		return nil, passwordError(err)
+	}
+	h.record(ctx, user, audit.Event{Action: audit.ActionPasswordChange})
+
+	// Reissue the current session's token, the old one is no longer valid
+	sessionID := user.SessionID
+	user, err = h.auth.GetUser(ctx, user.ID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	access, err := user.Token(sessionID, time.Now().Add(auth.AccessTokenDuration))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	tokens := auth.Tokens{
+		Access: access,
+	}
+
+	res := connect.NewResponse(&userv1.UpdatePasswordResponse{
+		User:  userToConnect(user),
+		Token: tokens.Access,
+	})
+	for _, cookie := range tokens.Cookies() {
+		res.Header().Add("Set-Cookie", cookie.String())
+	}
 
-	res := connect.NewResponse(&userv1.UpdatePasswordResponse{})
 	return res, nil
 }
 
-const DefaultAPIKeyDuration = time.Hour * 24
+const (
+	DefaultAPIKeyDuration = time.Hour * 24
+	DefaultLimit          = 10
+)
 
+// GetAPIKey creates a scoped API key that expires after a day, for clients that predate CreateAPIKey.
 func (h *Handler) GetAPIKey(
 	ctx context.Context,
 	req *connect.Request[userv1.GetAPIKeyRequest],
@@ -88,14 +119,35 @@ func (h *Handler) GetAPIKey(
 
 	// Validate
 	if !user.Validate(req.Msg.GetPassword()) {
+		h.record(ctx, user, audit.Event{
+			Action:   audit.ActionAPIKeyCreate,
+			Outcome:  audit.OutcomeFailure,
+			Metadata: map[string]any{"reason": "invalid_password"},
+		})
 		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("invalid username or password"))
 	}
 	if req.Msg.GetPassword() != req.Msg.GetConfirmPassword() {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("passwords do not match"))
 	}
 
+	// Create a key with the item and user scopes, like a key from CreateAPIKey
+	expiresAt := time.Now().Add(DefaultAPIKeyDuration)
+	apiKey, key, err := user.NewAPIKey(ctx, auth.NewAPIKeyParams{
+		Name:      "API key",
+		Scopes:    auth.DefaultScopes,
+		ExpiresAt: &expiresAt,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	h.record(ctx, user, audit.Event{
+		Action:   audit.ActionAPIKeyCreate,
+		Target:   fmt.Sprintf("apikey:%d", apiKey.ID),
+		Metadata: map[string]any{"name": apiKey.Name, "scopes": auth.FormatScopes(auth.DefaultScopes)},
+	})
+
 	res := connect.NewResponse(&userv1.GetAPIKeyResponse{
-		Key: user.Token(time.Now().Add(DefaultAPIKeyDuration)),
+		Key: key,
 	})
 	return res, nil
 }
@@ -122,25 +174,27 @@ func (h *Handler) UpdateProfilePicture(
 	}
 
 	return connect.NewResponse(&userv1.UpdateProfilePictureResponse{
-		User: &userv1.User{
-			Id:               user.ID,
-			Username:         user.Username,
-			ProfilePictureId: user.ProfilePictureID.Ptr(),
-		},
+		User: userToConnect(user),
 	}), nil
 }
 
 func (h *Handler) BeginPasskeyRegistration(
 	ctx context.Context,
-	_ *connect.Request[userv1.BeginPasskeyRegistrationRequest],
+	req *connect.Request[userv1.BeginPasskeyRegistrationRequest],
 ) (*connect.Response[userv1.BeginPasskeyRegistrationResponse], error) {
 	user, ok := h.auth.GetContext(ctx)
 	if !ok {
 		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
 	}
 
+	// Require a resident key if the passkey should be discoverable
+	opts := []webauthn.RegistrationOption{}
+	if req.Msg.GetDiscoverable() {
+		opts = append(opts, webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementRequired))
+	}
+
 	// Get options for user
-	options, session, err := h.auth.Web.BeginRegistration(user)
+	options, session, err := h.auth.Web.BeginRegistration(user, opts...)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
@@ -151,11 +205,15 @@ func (h *Handler) BeginPasskeyRegistration(
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	// Set session for validation later
-	h.setSession(user.ID, session)
+	// Store session for validation later
+	ceremonyID, err := h.auth.Ceremonies.Put(ctx, session)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
 
 	return connect.NewResponse(&userv1.BeginPasskeyRegistrationResponse{
 		OptionsJson: string(optionsJSON),
+		CeremonyId:  ceremonyID,
 	}), nil
 }
 
@@ -168,10 +226,13 @@ func (h *Handler) FinishPasskeyRegistration(
 		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
 	}
 
-	// Get the session data previously set
-	session, err := h.getSession(user.ID)
+	// Get the session data previously stored
+	session, err := h.auth.Ceremonies.Take(ctx, req.Msg.GetCeremonyId())
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		if errors.Is(err, auth.ErrCeremonyNotFound) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
 	// Parse the attestation response
@@ -186,70 +247,692 @@ func (h *Handler) FinishPasskeyRegistration(
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	// Turn transports into strings to save in db
-	transports := transportsToString(credential.Transport)
+	// Get name
+	name := auth.DefaultPasskeyName
+	if req.Msg.Name != nil {
+		name = req.Msg.GetName()
+	}
 
 	// Save the credential
-	_, err = models.Credentials.Insert(
-		&models.CredentialSetter{
-			CredID:                omit.From(string(credential.ID)),
-			CredPublicKey:         omit.From(credential.PublicKey),
-			SignCount:             omit.From(int32(credential.Authenticator.SignCount)),
-			Transports:            omitnull.From(transports),
-			UserVerified:          omitnull.From(credential.Flags.UserVerified),
-			BackupEligible:        omitnull.From(credential.Flags.BackupEligible),
-			BackupState:           omitnull.From(credential.Flags.BackupState),
-			AttestationObject:     omitnull.From(credential.Attestation.Object),
-			AttestationClientData: omitnull.From(credential.Attestation.ClientDataJSON),
-			CreatedAt:             omit.From(time.Now()),
-			LastUsed:              omit.From(time.Now()),
-			UserID:                omit.From(user.ID),
-		},
-	).Exec(ctx, h.db)
+	cred, err := user.AddPasskey(ctx, name, credential)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	h.record(ctx, user, audit.Event{
+		Action:   audit.ActionPasskeyRegister,
+		Target:   "passkey:" + cred.CredID,
+		Metadata: map[string]any{"name": name},
+	})
+
+	return connect.NewResponse(&userv1.FinishPasskeyRegistrationResponse{
+		Passkey: passkeyToConnect(cred),
+	}), nil
+}
+
+func (h *Handler) ListSessions(
+	ctx context.Context,
+	_ *connect.Request[userv1.ListSessionsRequest],
+) (*connect.Response[userv1.ListSessionsResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	sessions, err := user.Sessions(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Convert to connect sessions
+	resSessions := []*userv1.Session{}
+	for _, session := range sessions {
+		resSessions = append(resSessions, &userv1.Session{
+			Id:        session.ID,
+			CreatedAt: timestamppb.New(session.CreatedAt),
+			LastSeen:  timestamppb.New(session.LastSeen),
+			UserAgent: session.UserAgent,
+			Ip:        session.IP,
+			Current:   session.ID == user.SessionID,
+			ClientId:  session.ClientID.Ptr(),
+		})
+	}
+
+	return connect.NewResponse(&userv1.ListSessionsResponse{
+		Sessions: resSessions,
+	}), nil
+}
+
+func (h *Handler) RevokeSession(
+	ctx context.Context,
+	req *connect.Request[userv1.RevokeSessionRequest],
+) (*connect.Response[userv1.RevokeSessionResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	err := user.RevokeSession(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, putil.CheckNotFound(err)
+	}
+	h.record(ctx, user, audit.Event{
+		Action: audit.ActionSessionRevoke,
+		Target: "session:" + req.Msg.GetId(),
+	})
+
+	return connect.NewResponse(&userv1.RevokeSessionResponse{}), nil
+}
+
+func (h *Handler) RevokeAllOtherSessions(
+	ctx context.Context,
+	_ *connect.Request[userv1.RevokeAllOtherSessionsRequest],
+) (*connect.Response[userv1.RevokeAllOtherSessionsResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	count, err := user.RevokeOtherSessions(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	h.record(ctx, user, audit.Event{
+		Action:   audit.ActionSessionRevokeOthers,
+		Metadata: map[string]any{"count": count},
+	})
+
+	return connect.NewResponse(&userv1.RevokeAllOtherSessionsResponse{
+		Count: count,
+	}), nil
+}
+
+func (h *Handler) CreateAPIKey(
+	ctx context.Context,
+	req *connect.Request[userv1.CreateAPIKeyRequest],
+) (*connect.Response[userv1.CreateAPIKeyResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	// Parse scopes
+	scopes := []auth.Scope{}
+	for _, scope := range req.Msg.GetScopes() {
+		scopes = append(scopes, auth.Scope(scope))
+	}
+
+	// Parse expiration
+	var expiresAt *time.Time
+	if req.Msg.ExpiresAt != nil {
+		t := req.Msg.GetExpiresAt().AsTime()
+		expiresAt = &t
+	}
+
+	// Create key
+	apiKey, key, err := user.NewAPIKey(ctx, auth.NewAPIKeyParams{
+		Name:      req.Msg.GetName(),
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	h.record(ctx, user, audit.Event{
+		Action:   audit.ActionAPIKeyCreate,
+		Target:   fmt.Sprintf("apikey:%d", apiKey.ID),
+		Metadata: map[string]any{"name": apiKey.Name, "scopes": req.Msg.GetScopes()},
+	})
+
+	return connect.NewResponse(&userv1.CreateAPIKeyResponse{
+		ApiKey: apiKeyToConnect(apiKey),
+		Key:    key,
+	}), nil
+}
+
+func (h *Handler) ListAPIKeys(
+	ctx context.Context,
+	_ *connect.Request[userv1.ListAPIKeysRequest],
+) (*connect.Response[userv1.ListAPIKeysResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	apiKeys, err := user.APIKeys(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Convert to connect API keys
+	resAPIKeys := []*userv1.APIKey{}
+	for _, apiKey := range apiKeys {
+		resAPIKeys = append(resAPIKeys, apiKeyToConnect(apiKey))
+	}
+
+	return connect.NewResponse(&userv1.ListAPIKeysResponse{
+		ApiKeys: resAPIKeys,
+	}), nil
+}
+
+func (h *Handler) RevokeAPIKey(
+	ctx context.Context,
+	req *connect.Request[userv1.RevokeAPIKeyRequest],
+) (*connect.Response[userv1.RevokeAPIKeyResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	err := user.RevokeAPIKey(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, putil.CheckNotFound(err)
+	}
+	h.record(ctx, user, audit.Event{
+		Action: audit.ActionAPIKeyRevoke,
+		Target: fmt.Sprintf("apikey:%d", req.Msg.GetId()),
+	})
+
+	return connect.NewResponse(&userv1.RevokeAPIKeyResponse{}), nil
+}
+
+func (h *Handler) BeginTOTPEnrollment(
+	ctx context.Context,
+	_ *connect.Request[userv1.BeginTOTPEnrollmentRequest],
+) (*connect.Response[userv1.BeginTOTPEnrollmentResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	secret, uri, err := user.BeginTOTPEnrollment(ctx)
+	if err != nil {
+		if errors.Is(err, auth.ErrTOTPAlreadyEnabled) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&userv1.BeginTOTPEnrollmentResponse{
+		Secret:          secret,
+		ProvisioningUri: uri,
+	}), nil
+}
+
+func (h *Handler) FinishTOTPEnrollment(
+	ctx context.Context,
+	req *connect.Request[userv1.FinishTOTPEnrollmentRequest],
+) (*connect.Response[userv1.FinishTOTPEnrollmentResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	// Get the pending secret, the cached user may be outdated
+	user, err := h.auth.GetUser(ctx, user.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	codes, err := user.FinishTOTPEnrollment(ctx, req.Msg.GetCode())
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrInvalidCode):
+			h.record(ctx, user, audit.Event{
+				Action:   audit.ActionTOTPEnable,
+				Outcome:  audit.OutcomeFailure,
+				Metadata: map[string]any{"reason": "invalid_code"},
+			})
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, auth.ErrTOTPAlreadyEnabled), errors.Is(err, auth.ErrTOTPNotEnrolled):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	h.record(ctx, user, audit.Event{Action: audit.ActionTOTPEnable})
+
+	return connect.NewResponse(&userv1.FinishTOTPEnrollmentResponse{
+		RecoveryCodes: codes,
+	}), nil
+}
+
+func (h *Handler) DisableTOTP(
+	ctx context.Context,
+	req *connect.Request[userv1.DisableTOTPRequest],
+) (*connect.Response[userv1.DisableTOTPResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	// Validate
+	if !user.Validate(req.Msg.GetPassword()) {
+		h.record(ctx, user, audit.Event{
+			Action:   audit.ActionTOTPDisable,
+			Outcome:  audit.OutcomeFailure,
+			Metadata: map[string]any{"reason": "invalid_password"},
+		})
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("invalid password"))
+	}
+
+	err := user.DisableTOTP(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	h.record(ctx, user, audit.Event{Action: audit.ActionTOTPDisable})
+
+	return connect.NewResponse(&userv1.DisableTOTPResponse{}), nil
+}
+
+func (h *Handler) RegenerateRecoveryCodes(
+	ctx context.Context,
+	req *connect.Request[userv1.RegenerateRecoveryCodesRequest],
+) (*connect.Response[userv1.RegenerateRecoveryCodesResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	// Validate
+	if !user.Validate(req.Msg.GetPassword()) {
+		h.record(ctx, user, audit.Event{
+			Action:   audit.ActionRecoveryCodesReset,
+			Outcome:  audit.OutcomeFailure,
+			Metadata: map[string]any{"reason": "invalid_password"},
+		})
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("invalid password"))
+	}
+
+	codes, err := user.RegenerateRecoveryCodes(ctx)
+	if err != nil {
+		if errors.Is(err, auth.ErrTwoFactorNotEnabled) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	h.record(ctx, user, audit.Event{Action: audit.ActionRecoveryCodesReset})
+
+	return connect.NewResponse(&userv1.RegenerateRecoveryCodesResponse{
+		RecoveryCodes: codes,
+	}), nil
+}
+
+func (h *Handler) ListPasskeys(
+	ctx context.Context,
+	_ *connect.Request[userv1.ListPasskeysRequest],
+) (*connect.Response[userv1.ListPasskeysResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	creds, err := user.Passkeys(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Convert to connect passkeys
+	resPasskeys := []*userv1.Passkey{}
+	for _, cred := range creds {
+		resPasskeys = append(resPasskeys, passkeyToConnect(cred))
+	}
+
+	return connect.NewResponse(&userv1.ListPasskeysResponse{
+		Passkeys: resPasskeys,
+	}), nil
+}
+
+func (h *Handler) RenamePasskey(
+	ctx context.Context,
+	req *connect.Request[userv1.RenamePasskeyRequest],
+) (*connect.Response[userv1.RenamePasskeyResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	cred, err := user.RenamePasskey(ctx, req.Msg.GetId(), req.Msg.GetName())
+	if err != nil {
+		return nil, putil.CheckNotFound(err)
+	}
+	h.record(ctx, user, audit.Event{
+		Action:   audit.ActionPasskeyRename,
+		Target:   "passkey:" + req.Msg.GetId(),
+		Metadata: map[string]any{"name": req.Msg.GetName()},
+	})
+
+	return connect.NewResponse(&userv1.RenamePasskeyResponse{
+		Passkey: passkeyToConnect(cred),
+	}), nil
+}
+
+func (h *Handler) DeletePasskey(
+	ctx context.Context,
+	req *connect.Request[userv1.DeletePasskeyRequest],
+) (*connect.Response[userv1.DeletePasskeyResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	err := user.DeletePasskey(ctx, req.Msg.GetId())
+	if err != nil {
+		if errors.Is(err, auth.ErrLastSignInMethod) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, auth.ErrLastSignInMethod)
+		}
+		return nil, putil.CheckNotFound(err)
+	}
+
+	h.record(ctx, user, audit.Event{
+		Action: audit.ActionPasskeyDelete,
+		Target: "passkey:" + req.Msg.GetId(),
+	})
+
+	return connect.NewResponse(&userv1.DeletePasskeyResponse{}), nil
+}
+
+func (h *Handler) UpdateEmail(
+	ctx context.Context,
+	req *connect.Request[userv1.UpdateEmailRequest],
+) (*connect.Response[userv1.UpdateEmailResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	// Validate
+	if user.HasPassword() && !user.Validate(req.Msg.GetPassword()) {
+		h.record(ctx, user, audit.Event{
+			Action:   audit.ActionEmailChange,
+			Outcome:  audit.OutcomeFailure,
+			Metadata: map[string]any{"reason": "invalid_password"},
+		})
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("invalid password"))
+	}
+
+	// Remove email
+	if req.Msg.GetEmail() == "" {
+		err := user.RemoveEmail(ctx)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	} else {
+		// Set email and send verification
+		token, err := user.SetEmail(ctx, req.Msg.GetEmail())
+		if err != nil {
+			if errors.Is(err, auth.ErrEmailAlreadyVerified) {
+				return nil, connect.NewError(connect.CodeAlreadyExists, err)
+			}
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		err = h.mail.Send(ctx, verifyEmailMessage(h.url, auth.NormalizeEmail(req.Msg.GetEmail()), token))
+		if err != nil {
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		}
+	}
+
+	h.record(ctx, user, audit.Event{
+		Action:   audit.ActionEmailChange,
+		Metadata: map[string]any{"removed": req.Msg.GetEmail() == ""},
+	})
+
+	user, err := h.auth.GetUser(ctx, user.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&userv1.UpdateEmailResponse{
+		User: userToConnect(user),
+	}), nil
+}
+
+func (h *Handler) ListIdentities(
+	ctx context.Context,
+	_ *connect.Request[userv1.ListIdentitiesRequest],
+) (*connect.Response[userv1.ListIdentitiesResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	identities, err := user.Identities(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Convert to connect identities
+	resIdentities := []*userv1.Identity{}
+	for _, identity := range identities {
+		resIdentities = append(resIdentities, identityToConnect(identity))
+	}
+
+	return connect.NewResponse(&userv1.ListIdentitiesResponse{
+		Identities: resIdentities,
+	}), nil
+}
+
+func (h *Handler) DeleteIdentity(
+	ctx context.Context,
+	req *connect.Request[userv1.DeleteIdentityRequest],
+) (*connect.Response[userv1.DeleteIdentityResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	err := user.UnlinkIdentity(ctx, req.Msg.GetId())
+	if err != nil {
+		if errors.Is(err, auth.ErrLastSignInMethod) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, auth.ErrLastSignInMethod)
+		}
+		return nil, putil.CheckNotFound(err)
+	}
+
+	h.record(ctx, user, audit.Event{
+		Action: audit.ActionIdentityUnlink,
+		Target: fmt.Sprintf("identity:%d", req.Msg.GetId()),
+	})
+
+	return connect.NewResponse(&userv1.DeleteIdentityResponse{}), nil
+}
+
+// UpdateUsername changes the user's username, returning a new token for the current session.
+func (h *Handler) UpdateUsername(
+	ctx context.Context,
+	req *connect.Request[userv1.UpdateUsernameRequest],
+) (*connect.Response[userv1.UpdateUsernameResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	// Update username
+	err := user.SetUsername(ctx, req.Msg.GetUsername())
 	if err != nil {
+		if errors.Is(err, auth.ErrUsernameTaken) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, auth.ErrUsernameTaken)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	h.record(ctx, user, audit.Event{
+		Action:   audit.ActionUsernameChange,
+		Metadata: map[string]any{"from": user.Username, "to": req.Msg.GetUsername()},
+	})
 
-	return connect.NewResponse(&userv1.FinishPasskeyRegistrationResponse{}), nil
+	// Get updated user
+	user, err = h.auth.GetUser(ctx, user.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&userv1.UpdateUsernameResponse{
+		User: userToConnect(user),
+	}), nil
 }
 
-func (h *Handler) getSession(userid int32) (*webauthn.SessionData, error) {
-	h.mu.Lock()
-	defer h.mu.Unlock()
+// DeleteAccount deletes the user's account and everything they own, after they sign in again.
+func (h *Handler) DeleteAccount(
+	ctx context.Context,
+	req *connect.Request[userv1.DeleteAccountRequest],
+) (*connect.Response[userv1.DeleteAccountResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	// Validate
+	switch reauth := req.Msg.GetReauth().(type) {
+	case *userv1.DeleteAccountRequest_Password:
+		if !user.HasPassword() || !user.Validate(reauth.Password) {
+			h.record(ctx, user, audit.Event{
+				Action:   audit.ActionAccountDelete,
+				Outcome:  audit.OutcomeFailure,
+				Metadata: map[string]any{"reason": "invalid_password"},
+			})
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.New("invalid password"))
+		}
+	case *userv1.DeleteAccountRequest_Attestation:
+		err := validatePasskey(ctx, h.auth, user, req.Msg.GetCeremonyId(), reauth.Attestation)
+		if err != nil {
+			h.record(ctx, user, audit.Event{
+				Action:   audit.ActionAccountDelete,
+				Outcome:  audit.OutcomeFailure,
+				Metadata: map[string]any{"reason": "invalid_passkey"},
+			})
+			return nil, err
+		}
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("missing password or passkey"))
+	}
+
+	// Delete account
+	err := user.DeleteAccount(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	h.record(ctx, user, audit.Event{
+		Action:   audit.ActionAccountDelete,
+		Metadata: map[string]any{"username": user.Username},
+	})
 
-	session, ok := (*h.sessions)[userid]
+	// Clear cookies
+	res := connect.NewResponse(&userv1.DeleteAccountResponse{})
+	for _, cookie := range auth.ClearCookies() {
+		res.Header().Add("Set-Cookie", cookie.String())
+	}
+
+	return res, nil
+}
+
+// ExportAccount starts exporting everything stored about the user as a ZIP archive.
+// Small accounts are ready right away, larger ones are exported in the background.
+func (h *Handler) ExportAccount(
+	ctx context.Context,
+	_ *connect.Request[userv1.ExportAccountRequest],
+) (*connect.Response[userv1.ExportAccountResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
 	if !ok {
-		return nil, errors.New("session does not exist")
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	accountExport, err := h.export.Start(ctx, user.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	h.record(ctx, user, audit.Event{
+		Action: audit.ActionAccountExport,
+		Target: "export:" + accountExport.ID,
+	})
 
-	delete(*h.sessions, userid)
-	return session, nil
+	return connect.NewResponse(&userv1.ExportAccountResponse{
+		Export: exportToConnect(h.url, accountExport),
+	}), nil
 }
 
-func (h *Handler) setSession(userid int32, data *webauthn.SessionData) {
-	h.mu.Lock()
-	defer h.mu.Unlock()
+// GetAccountExport retrieves an export, to check if it is ready to download.
+func (h *Handler) GetAccountExport(
+	ctx context.Context,
+	req *connect.Request[userv1.GetAccountExportRequest],
+) (*connect.Response[userv1.GetAccountExportResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	accountExport, err := h.export.Get(ctx, user.ID, req.Msg.GetId())
+	if err != nil {
+		return nil, putil.CheckNotFound(err)
+	}
 
-	(*h.sessions)[userid] = data
+	return connect.NewResponse(&userv1.GetAccountExportResponse{
+		Export: exportToConnect(h.url, accountExport),
+	}), nil
 }
 
-func transportsToString(transports []protocol.AuthenticatorTransport) string {
-	s := ""
-	for _, transport := range transports {
-		s += string(transport) + ", "
+// ListAuditEvents retrieves the security history of the user's account, newest first.
+func (h *Handler) ListAuditEvents(
+	ctx context.Context,
+	req *connect.Request[userv1.ListAuditEventsRequest],
+) (*connect.Response[userv1.ListAuditEventsResponse], error) {
+	user, ok := h.auth.GetContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
 	}
-	return s
+
+	filter := audit.Filter{
+		UserID: user.ID,
+		Action: req.Msg.GetAction(),
+		Limit:  DefaultLimit,
+		Offset: int(req.Msg.GetOffset()),
+	}
+	if req.Msg.Start != nil {
+		start := req.Msg.GetStart().AsTime()
+		filter.Start = &start
+	}
+	if req.Msg.End != nil {
+		end := req.Msg.GetEnd().AsTime()
+		filter.End = &end
+	}
+	if req.Msg.Limit != nil {
+		filter.Limit = int(req.Msg.GetLimit())
+	}
+
+	events, count, err := h.audit.List(ctx, filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Convert to connect events
+	resEvents := []*userv1.AuditEvent{}
+	for _, event := range events {
+		resEvents = append(resEvents, auditEventToConnect(event, user.ID))
+	}
+
+	return connect.NewResponse(&userv1.ListAuditEventsResponse{
+		Events: resEvents,
+		Count:  count,
+	}), nil
+}
+
+// record records an event the signed in user caused on their own account.
+func (h *Handler) record(ctx context.Context, user auth.User, event audit.Event) {
+	event.ActorID = user.ID
+	event.UserID = user.ID
+	h.audit.Record(ctx, event)
 }
 
 func New(app *app.App, interceptors connect.Option) (string, http.Handler) {
-	sd := map[int32]*webauthn.SessionData{}
 	return userv1connect.NewUserServiceHandler(
 		&Handler{
-			db:   app.DB,
-			auth: app.Auth,
-
-			sessions: &sd,
-			mu:       sync.Mutex{},
+			db:     app.DB,
+			auth:   app.Auth,
+			audit:  app.Audit,
+			export: app.Export,
+			mail:   app.Mail,
+			url:    app.Env.URL,
 		},
 		interceptors,
 	)
@@ -0,0 +1,23 @@
+package interceptors
+
+import (
+	"net/http"
+
+	"connectrpc.com/connect"
+
+	"github.com/spotdemo4/ts-server/internal/audit"
+	"github.com/spotdemo4/ts-server/internal/putil"
+)
+
+// WithAuditContext adds the client of the request to the context, so audit events record where they came from.
+// It must run after WithClientIP, so the client IP is the one behind any trusted proxies.
+func WithAuditContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := audit.NewContext(r.Context(), audit.Request{
+			IP:        putil.PeerIP(connect.Peer{Addr: r.RemoteAddr}),
+			UserAgent: r.UserAgent(),
+		})
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
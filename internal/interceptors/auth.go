@@ -2,6 +2,7 @@ package interceptors
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"connectrpc.com/connect"
@@ -13,8 +14,6 @@ type AuthInterceptor struct {
 	auth *auth.Auth
 }
 
-const CookieTokenName = "token"
-
 func NewAuthInterceptor(auth *auth.Auth) *AuthInterceptor {
 	return &AuthInterceptor{
 		auth: auth,
@@ -32,28 +31,21 @@ func (i *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 			return next(ctx, req)
 		}
 
-		// Check if the request contains a valid cookie token
-		cookies := getCookies(req.Header().Get("Cookie"))
-		for _, cookie := range cookies {
-			if cookie.Name == CookieTokenName {
-				user, err := i.auth.GetUserFromToken(cookie.Value)
-				if err == nil {
-					return next(i.auth.NewContext(ctx, user), req)
-				}
+		// Authenticate the request
+		user, cookies, ok := authenticate(ctx, i.auth, req.Header())
+		if ok {
+			// Make sure the user may call the procedure
+			err := authorize(user, req.Spec().Procedure)
+			if err != nil {
+				return nil, err
 			}
-		}
 
-		// Check if the request contains a valid authorization bearer token
-		authorization := req.Header().Get("Authorization")
-		if authorization != "" && len(authorization) > 7 {
-			user, err := i.auth.GetUserFromToken(authorization[7:])
-			if err == nil {
-				return next(i.auth.NewContext(ctx, user), req)
-			}
+			ctx = i.auth.NewContext(ctx, user)
 		}
 
-		// Return without setting the context
-		return next(ctx, req)
+		res, err := next(ctx, req)
+		setCookies(res, err, cookies)
+		return res, err
 	})
 }
 
@@ -71,29 +63,83 @@ func (i *AuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc
 		ctx context.Context,
 		conn connect.StreamingHandlerConn,
 	) error {
-		// Check if the request contains a valid cookie token
-		cookies := getCookies(conn.RequestHeader().Get("Cookie"))
+		// Authenticate the request
+		user, cookies, ok := authenticate(ctx, i.auth, conn.RequestHeader())
 		for _, cookie := range cookies {
-			if cookie.Name == CookieTokenName {
-				user, err := i.auth.GetUserFromToken(cookie.Value)
-				if err == nil {
-					return next(i.auth.NewContext(ctx, user), conn)
-				}
-			}
+			conn.ResponseHeader().Add("Set-Cookie", cookie.String())
 		}
+		if !ok {
+			// Return without setting the context
+			return next(ctx, conn)
+		}
+
+		// Make sure the user may call the procedure
+		err := authorize(user, conn.Spec().Procedure)
+		if err != nil {
+			return err
+		}
+
+		return next(i.auth.NewContext(ctx, user), conn)
+	})
+}
 
-		// Check if the request contains a valid authorization bearer token
-		authorization := conn.RequestHeader().Get("Authorization")
-		if authorization != "" && len(authorization) > 7 {
-			user, err := i.auth.GetUserFromToken(authorization[7:])
+// authenticate retrieves the user from the access token cookie, authorization bearer token, or API key.
+// If neither is valid, the refresh token cookie is exchanged for new tokens,
+// and the returned cookies must be sent back to the client.
+func authenticate(ctx context.Context, a *auth.Auth, header http.Header) (auth.User, []*http.Cookie, bool) {
+	// Check if the request contains a valid cookie token
+	cookies := getCookies(header.Get("Cookie"))
+	for _, cookie := range cookies {
+		if cookie.Name == auth.CookieTokenName {
+			user, err := a.GetUserFromToken(ctx, cookie.Value)
 			if err == nil {
-				return next(i.auth.NewContext(ctx, user), conn)
+				return user, nil, true
 			}
 		}
+	}
 
-		// Return without setting the context
-		return next(ctx, conn)
-	})
+	// Check if the request contains a valid authorization bearer token or API key
+	authorization := header.Get("Authorization")
+	if authorization != "" && len(authorization) > 7 {
+		token := authorization[7:]
+		if auth.IsAPIKey(token) {
+			user, err := a.GetUserFromAPIKey(ctx, token)
+			return user, nil, err == nil
+		}
+
+		user, err := a.GetUserFromToken(ctx, token)
+		if err == nil {
+			return user, nil, true
+		}
+	}
+
+	// Check if the request contains a refresh token cookie
+	for _, cookie := range cookies {
+		if cookie.Name == auth.CookieRefreshName {
+			user, tokens, err := a.Refresh(ctx, cookie.Value)
+			if err != nil {
+				if errors.Is(err, auth.ErrInvalidRefreshToken) {
+					return auth.User{}, auth.ClearCookies(), false
+				}
+				return auth.User{}, nil, false
+			}
+
+			return user, tokens.Cookies(), true
+		}
+	}
+
+	return auth.User{}, nil, false
+}
+
+// setCookies adds cookies to a unary response, or to the error metadata if the request failed.
+func setCookies(res connect.AnyResponse, err error, cookies []*http.Cookie) {
+	for _, cookie := range cookies {
+		if connectErr := new(connect.Error); errors.As(err, &connectErr) {
+			connectErr.Meta().Add("Set-Cookie", cookie.String())
+		} else if res != nil {
+			res.Header().Add("Set-Cookie", cookie.String())
+		}
+	}
 }
 
 func getCookies(rawCookies string) []*http.Cookie {
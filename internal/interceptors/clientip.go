@@ -0,0 +1,58 @@
+package interceptors
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// WithClientIP replaces the remote address with the client IP from X-Forwarded-For,
+// if the request came through a trusted proxy. Proxies append the address they received the request from,
+// so the client is the last address not belonging to a trusted proxy.
+func WithClientIP(next http.Handler, trusted []netip.Prefix) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(trusted) == 0 || !trustedProxy(r.RemoteAddr, trusted) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		forwarded := []string{}
+		for _, header := range r.Header.Values("X-Forwarded-For") {
+			forwarded = append(forwarded, strings.Split(header, ",")...)
+		}
+		for i := len(forwarded) - 1; i >= 0; i-- {
+			addr, err := netip.ParseAddr(strings.TrimSpace(forwarded[i]))
+			if err != nil {
+				break
+			}
+
+			r.RemoteAddr = net.JoinHostPort(addr.Unmap().String(), "0")
+			if !isTrusted(addr, trusted) {
+				break
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// trustedProxy checks if a remote address belongs to a trusted proxy.
+func trustedProxy(remoteAddr string, trusted []netip.Prefix) bool {
+	addrPort, err := netip.ParseAddrPort(remoteAddr)
+	if err != nil {
+		return false
+	}
+
+	return isTrusted(addrPort.Addr(), trusted)
+}
+
+func isTrusted(addr netip.Addr, trusted []netip.Prefix) bool {
+	for _, prefix := range trusted {
+		if prefix.Contains(addr.Unmap()) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -13,7 +13,10 @@ func WithCORS(pattern string, h http.Handler) (string, http.Handler) {
 		AllowedOrigins: []string{"*"},
 		AllowedMethods: connectcors.AllowedMethods(),
 		AllowedHeaders: connectcors.AllowedHeaders(),
-		ExposedHeaders: connectcors.ExposedHeaders(),
+		ExposedHeaders: append(
+			connectcors.ExposedHeaders(),
+			"RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "Retry-After",
+		),
 	})
 	return pattern, middleware.Handler(h)
 }
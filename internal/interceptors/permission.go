@@ -0,0 +1,75 @@
+package interceptors
+
+import (
+	"errors"
+
+	"connectrpc.com/connect"
+
+	"github.com/spotdemo4/ts-server/internal/auth"
+	"github.com/spotdemo4/ts-server/internal/connect/admin/v1/adminv1connect"
+	"github.com/spotdemo4/ts-server/internal/connect/user/v1/userv1connect"
+)
+
+var errMissingPermission = errors.New("missing the required permission")
+
+// requiredPermission returns the permission a user's role needs to call a procedure.
+// Procedures that are not listed can be called by any user.
+func requiredPermission(procedure string) (auth.Permission, bool) {
+	switch procedure {
+	case adminv1connect.AdminServiceListUsersProcedure,
+		adminv1connect.AdminServiceGetUserProcedure:
+		return auth.PermissionUsersRead, true
+
+	case adminv1connect.AdminServiceSetUserRoleProcedure,
+		adminv1connect.AdminServiceDisableUserProcedure,
+		adminv1connect.AdminServiceEnableUserProcedure,
+		adminv1connect.AdminServiceForcePasswordResetProcedure,
+		adminv1connect.AdminServiceDeleteUserProcedure:
+		return auth.PermissionUsersWrite, true
+
+	case adminv1connect.AdminServiceListOAuthClientsProcedure:
+		return auth.PermissionClientsRead, true
+
+	case adminv1connect.AdminServiceCreateOAuthClientProcedure,
+		adminv1connect.AdminServiceDeleteOAuthClientProcedure:
+		return auth.PermissionClientsWrite, true
+
+	case adminv1connect.AdminServiceListAuditEventsProcedure:
+		return auth.PermissionAuditRead, true
+
+	default:
+		return "", false
+	}
+}
+
+// passwordResetProcedure checks if a procedure can be called by a user that must reset their password.
+func passwordResetProcedure(procedure string) bool {
+	switch procedure {
+	case userv1connect.UserServiceGetUserProcedure,
+		userv1connect.UserServiceUpdatePasswordProcedure:
+		return true
+
+	default:
+		return false
+	}
+}
+
+// authorize checks if an authenticated user may call a procedure.
+func authorize(user auth.User, procedure string) error {
+	// Make sure API keys have the required scope
+	if !allowed(user, procedure) {
+		return connect.NewError(connect.CodePermissionDenied, errMissingScope)
+	}
+
+	// Users that must reset their password can only do that
+	if user.PasswordResetRequired && !passwordResetProcedure(procedure) {
+		return connect.NewError(connect.CodePermissionDenied, auth.ErrPasswordResetRequired)
+	}
+
+	// Make sure the user's role has the required permission
+	if permission, ok := requiredPermission(procedure); ok && !user.Can(permission) {
+		return connect.NewError(connect.CodePermissionDenied, errMissingPermission)
+	}
+
+	return nil
+}
@@ -3,32 +3,42 @@ package interceptors
 import (
 	"context"
 	"errors"
-	"sync"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
 	"time"
 
 	"connectrpc.com/connect"
-	"golang.org/x/time/rate"
+
+	"github.com/spotdemo4/ts-server/internal/auth"
+	"github.com/spotdemo4/ts-server/internal/putil"
+	"github.com/spotdemo4/ts-server/internal/ratelimit"
 )
 
-type visitor struct {
-	limiter  *rate.Limiter
-	lastSeen time.Time
-}
+var errRateLimited = errors.New("rate limit exceeded")
 
+// RatelimitInterceptor limits requests by the policy matching their procedure.
+// It must come after the auth interceptor to limit by user or API key.
 type RatelimitInterceptor struct {
-	visitors map[string]*visitor
-	mu       sync.Mutex
+	policies []ratelimit.Policy
+	store    ratelimit.Store
+	auth     *auth.Auth
+	log      *slog.Logger
 }
 
-func NewRateLimitInterceptor() *RatelimitInterceptor {
-	rl := &RatelimitInterceptor{
-		visitors: make(map[string]*visitor),
-		mu:       sync.Mutex{},
+func NewRateLimitInterceptor(
+	policies []ratelimit.Policy,
+	store ratelimit.Store,
+	auth *auth.Auth,
+	log *slog.Logger,
+) *RatelimitInterceptor {
+	return &RatelimitInterceptor{
+		policies: policies,
+		store:    store,
+		auth:     auth,
+		log:      log,
 	}
-
-	go rl.cleanupVisitors()
-
-	return rl
 }
 
 func (i *RatelimitInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
@@ -42,13 +52,25 @@ func (i *RatelimitInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFu
 			return next(ctx, req)
 		}
 
-		// Get user agent
-		limiter := i.getVisitor(req.Header().Get("User-Agent"))
-		if !limiter.Allow() {
-			return nil, connect.NewError(connect.CodeResourceExhausted, errors.New("rate limit exceeded"))
+		res, limited := i.take(ctx, req.Spec().Procedure, req.Peer())
+		if !limited {
+			return next(ctx, req)
+		}
+		if !res.Allowed {
+			err := connect.NewError(connect.CodeResourceExhausted, errRateLimited)
+			setRateLimitHeaders(err.Meta(), res)
+			return nil, err
+		}
+
+		// Add headers to the response, or to the error metadata if the request failed
+		resp, err := next(ctx, req)
+		if connectErr := new(connect.Error); errors.As(err, &connectErr) {
+			setRateLimitHeaders(connectErr.Meta(), res)
+		} else if resp != nil {
+			setRateLimitHeaders(resp.Header(), res)
 		}
 
-		return next(ctx, req)
+		return resp, err
 	})
 }
 
@@ -66,47 +88,67 @@ func (i *RatelimitInterceptor) WrapStreamingHandler(next connect.StreamingHandle
 		ctx context.Context,
 		conn connect.StreamingHandlerConn,
 	) error {
-		// Get user agent
-		limiter := i.getVisitor(conn.RequestHeader().Get("User-Agent"))
-		if !limiter.Allow() {
-			return connect.NewError(connect.CodeResourceExhausted, errors.New("rate limit exceeded"))
+		res, limited := i.take(ctx, conn.Spec().Procedure, conn.Peer())
+		if !limited {
+			return next(ctx, conn)
+		}
+
+		setRateLimitHeaders(conn.ResponseHeader(), res)
+		if !res.Allowed {
+			return connect.NewError(connect.CodeResourceExhausted, errRateLimited)
 		}
 
 		return next(ctx, conn)
 	})
 }
 
-const RateLimit = 3 // Rate Limit up to 3 requests per second
+// take takes a request from the bucket of the client calling a procedure.
+// Requests are let through if no policy matches, or if the store fails, so an outage doesn't take the API down.
+func (i *RatelimitInterceptor) take(
+	ctx context.Context,
+	procedure string,
+	peer connect.Peer,
+) (ratelimit.Result, bool) {
+	policy, ok := ratelimit.Match(i.policies, procedure)
+	if !ok {
+		return ratelimit.Result{}, false
+	}
 
-// getVisitor retrieves the visitor for the given user agent, or creates a new one if it doesn't exist.
-func (i *RatelimitInterceptor) getVisitor(userAgent string) *rate.Limiter {
-	i.mu.Lock()
-	defer i.mu.Unlock()
+	// Buckets are per policy, so limits of different procedures don't add up
+	key := policy.Procedure + " " + i.client(ctx, policy.Key, peer)
 
-	v, exists := i.visitors[userAgent]
-	if !exists {
-		limiter := rate.NewLimiter(1, RateLimit)
-		// Include the current time when creating a new visitor.
-		i.visitors[userAgent] = &visitor{limiter, time.Now()}
-		return limiter
+	res, err := i.store.Take(ctx, key, policy.Limit)
+	if err != nil {
+		i.log.Error("Failed to check rate limit", "procedure", procedure, "error", err)
+		return ratelimit.Result{}, false
 	}
 
-	// Update the last seen time for the visitor.
-	v.lastSeen = time.Now()
-	return v.limiter
+	return res, true
 }
 
-// cleanupVisitors checks the map for visitors that haven't been seen for more than 3 minutes.
-func (i *RatelimitInterceptor) cleanupVisitors() {
-	for {
-		time.Sleep(time.Minute)
+// client returns what identifies the client for a policy key, falling back to the client IP.
+func (i *RatelimitInterceptor) client(ctx context.Context, key string, peer connect.Peer) string {
+	user, ok := i.auth.GetContext(ctx)
+	if ok && key == ratelimit.KeyAPIKey && user.APIKey != nil {
+		return "apikey:" + strconv.Itoa(int(user.APIKey.ID))
+	}
+	if ok && (key == ratelimit.KeyAPIKey || key == ratelimit.KeyUser) {
+		return "user:" + strconv.Itoa(int(user.ID))
+	}
 
-		i.mu.Lock()
-		for ip, v := range i.visitors {
-			if time.Since(v.lastSeen) > 3*time.Minute {
-				delete(i.visitors, ip)
-			}
-		}
-		i.mu.Unlock()
+	return "ip:" + putil.PeerIP(peer)
+}
+
+// setRateLimitHeaders describes the limit with the RateLimit headers, and Retry-After once it is exceeded.
+func setRateLimitHeaders(header http.Header, res ratelimit.Result) {
+	header.Set("RateLimit-Limit", strconv.Itoa(res.Limit))
+	header.Set("RateLimit-Remaining", strconv.Itoa(max(res.Remaining, 0)))
+	header.Set("RateLimit-Reset", ceilSeconds(res.Reset))
+	if !res.Allowed {
+		header.Set("Retry-After", ceilSeconds(res.RetryAfter))
 	}
 }
+
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
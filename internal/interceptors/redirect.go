@@ -14,18 +14,16 @@ func WithAuthRedirect(next http.Handler, auth *auth.Auth) http.Handler {
 		pathItems := strings.Split(r.URL.Path, "/")
 
 		// Check if the user is authenticated
-		authenticated := false
-		cookies := getCookies(r.Header.Get("Cookie"))
+		user, cookies, authenticated := authenticate(r.Context(), auth, r.Header)
 		for _, cookie := range cookies {
-			if cookie.Name == CookieTokenName {
-				user, err := auth.GetUserFromToken(cookie.Value)
-				if err == nil {
-					r = r.WithContext(auth.NewContext(r.Context(), user))
-					authenticated = true
-				}
-
-				break
-			}
+			http.SetCookie(w, cookie)
+		}
+		if user.APIKey != nil {
+			// API keys are only for the API
+			authenticated = false
+		}
+		if authenticated {
+			r = r.WithContext(auth.NewContext(r.Context(), user))
 		}
 
 		switch pathItems[1] {
@@ -36,7 +34,7 @@ func WithAuthRedirect(next http.Handler, auth *auth.Auth) http.Handler {
 			}
 			next.ServeHTTP(w, r)
 
-		case "_app", "favicon.png", "icon.png":
+		case "_app", "favicon.png", "icon.png", "verify-email":
 			next.ServeHTTP(w, r)
 
 		default:
@@ -52,3 +50,18 @@ func WithAuthRedirect(next http.Handler, auth *auth.Auth) http.Handler {
 		}
 	})
 }
+
+// WithAuthContext adds the user to the request context if they are signed in, without redirecting.
+func WithAuthContext(next http.Handler, auth *auth.Auth) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, cookies, authenticated := authenticate(r.Context(), auth, r.Header)
+		for _, cookie := range cookies {
+			http.SetCookie(w, cookie)
+		}
+		if authenticated && user.APIKey == nil {
+			r = r.WithContext(auth.NewContext(r.Context(), user))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
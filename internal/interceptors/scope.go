@@ -0,0 +1,52 @@
+package interceptors
+
+import (
+	"errors"
+
+	"github.com/spotdemo4/ts-server/internal/auth"
+	"github.com/spotdemo4/ts-server/internal/connect/item/v1/itemv1connect"
+	"github.com/spotdemo4/ts-server/internal/connect/user/v1/userv1connect"
+)
+
+var errMissingScope = errors.New("api key is missing the required scope")
+
+// requiredScope returns the scope an API key needs to call a procedure.
+// Procedures that are not listed cannot be called with an API key.
+func requiredScope(procedure string) (auth.Scope, bool) {
+	switch procedure {
+	case itemv1connect.ItemServiceGetItemProcedure,
+		itemv1connect.ItemServiceGetItemsProcedure,
+		itemv1connect.ItemServiceSearchItemsProcedure,
+		itemv1connect.ItemServiceExportItemsProcedure:
+		return auth.ScopeItemRead, true
+
+	case itemv1connect.ItemServiceCreateItemProcedure,
+		itemv1connect.ItemServiceUpdateItemProcedure,
+		itemv1connect.ItemServiceDeleteItemProcedure,
+		itemv1connect.ItemServiceBatchCreateItemsProcedure,
+		itemv1connect.ItemServiceBatchUpdateItemsProcedure,
+		itemv1connect.ItemServiceBatchDeleteItemsProcedure,
+		itemv1connect.ItemServiceImportItemsProcedure:
+		return auth.ScopeItemWrite, true
+
+	case userv1connect.UserServiceGetUserProcedure:
+		return auth.ScopeUserRead, true
+
+	default:
+		return "", false
+	}
+}
+
+// allowed checks if the user may call a procedure.
+func allowed(user auth.User, procedure string) bool {
+	if user.APIKey == nil {
+		return true
+	}
+
+	scope, ok := requiredScope(procedure)
+	if !ok {
+		return false
+	}
+
+	return user.HasScope(scope)
+}
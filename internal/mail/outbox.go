@@ -0,0 +1,52 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const outboxFileMode = 0o600 // Emails contain secret links
+
+// Outbox writes emails to a directory instead of sending them, so mail can be used without a mail server.
+// Without a directory, emails are only logged.
+type Outbox struct {
+	dir  string
+	from string
+	log  *slog.Logger
+}
+
+func NewOutbox(dir string, from string, log *slog.Logger) *Outbox {
+	return &Outbox{
+		dir:  dir,
+		from: from,
+		log:  log,
+	}
+}
+
+func (o *Outbox) Send(_ context.Context, msg Message) error {
+	if o.dir == "" {
+		o.log.Info("Email not sent, no outbox configured", "to", msg.To, "subject", msg.Subject, "body", msg.Body)
+		return nil
+	}
+
+	b, err := format(o.from, msg)
+	if err != nil {
+		return err
+	}
+
+	// Name files so they sort by the time they were sent
+	path := filepath.Join(o.dir, fmt.Sprintf("%d-%s.eml", time.Now().UnixNano(), uuid.New().String()))
+	err = os.WriteFile(path, b, outboxFileMode)
+	if err != nil {
+		return err
+	}
+	o.log.Info("Email written to outbox", "to", msg.To, "subject", msg.Subject, "path", path)
+
+	return nil
+}
@@ -3,6 +3,7 @@ package putil
 import (
 	"database/sql"
 	"errors"
+	"net"
 
 	"connectrpc.com/connect"
 )
@@ -14,3 +15,13 @@ func CheckNotFound(err error) error {
 
 	return connect.NewError(connect.CodeInternal, err)
 }
+
+// PeerIP returns the IP address of the peer, without the port.
+func PeerIP(peer connect.Peer) string {
+	host, _, err := net.SplitHostPort(peer.Addr)
+	if err != nil {
+		return peer.Addr
+	}
+
+	return host
+}
@@ -0,0 +1,23 @@
+package putil
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+)
+
+// Navigate sends the browser on to a location with a page that navigates itself.
+// Strict cookies are not sent on redirects that started on another site, they are once the page navigates.
+func Navigate(w http.ResponseWriter, location string) {
+	location = html.EscapeString(location)
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+	_, _ = fmt.Fprintf(w,
+		`<!doctype html><html><head><meta http-equiv="refresh" content="0;url=%s"></head>`+
+			`<body><a href="%s">Continue</a></body></html>`,
+		location,
+		location,
+	)
+}
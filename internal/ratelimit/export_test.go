@@ -0,0 +1,8 @@
+package ratelimit
+
+// Exported for tests
+//
+//nolint:gochecknoglobals // Constant
+var (
+	Take = take
+)
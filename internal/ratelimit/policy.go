@@ -0,0 +1,154 @@
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spotdemo4/ts-server/internal/connect/user/v1/userv1connect"
+)
+
+const (
+	KeyIP     = "ip"     // Limit each client IP
+	KeyUser   = "user"   // Limit each signed in user, clients that are not signed in by IP
+	KeyAPIKey = "apikey" // Limit each API key, other clients by user or IP
+
+	AllProcedures = "*" // Matches procedures no other policy matches
+)
+
+// Limit allows Requests per period on average, with bursts of up to Burst requests.
+type Limit struct {
+	Requests int
+	Per      time.Duration
+	Burst    int
+}
+
+// Rate returns how many requests are allowed per second.
+func (l Limit) Rate() float64 {
+	return float64(l.Requests) / l.Per.Seconds()
+}
+
+// Policy limits the procedures matching its pattern.
+type Policy struct {
+	// Procedure is a full procedure like /user.v1.AuthService/Login,
+	// a service like /user.v1.AuthService/, or * for all procedures.
+	Procedure string
+
+	// Key is what requests are counted by, one of KeyIP, KeyUser or KeyAPIKey.
+	Key string
+
+	Limit Limit
+}
+
+// DefaultPolicies limits the auth service by IP, account exports by user, and everything else by API key.
+//
+//nolint:gochecknoglobals // Used when no policies are configured
+var DefaultPolicies = []Policy{
+	{
+		Procedure: "/user.v1.AuthService/",
+		Key:       KeyIP,
+		Limit:     Limit{Requests: 1, Per: time.Second, Burst: 3},
+	},
+	{
+		Procedure: userv1connect.UserServiceExportAccountProcedure,
+		Key:       KeyUser,
+		Limit:     Limit{Requests: 1, Per: time.Minute, Burst: 2},
+	},
+	{
+		Procedure: AllProcedures,
+		Key:       KeyAPIKey,
+		Limit:     Limit{Requests: 20, Per: time.Second, Burst: 40}, //nolint:mnd // Generous for normal use
+	},
+}
+
+// ParsePolicies parses policies separated by semicolons,
+// each formatted as "<procedure> <key> <requests>/<period> [burst]". The burst defaults to the requests.
+// For example "/user.v1.AuthService/Login ip 5/1m; * user 10/1s 20".
+func ParsePolicies(s string) ([]Policy, error) {
+	policies := []Policy{}
+	for entry := range strings.SplitSeq(s, ";") {
+		fields := strings.Fields(entry)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 3 || len(fields) > 4 {
+			return nil, fmt.Errorf("invalid rate limit policy '%s'", strings.TrimSpace(entry))
+		}
+
+		policy := Policy{
+			Procedure: fields[0],
+			Key:       fields[1],
+		}
+		if policy.Procedure != AllProcedures && !strings.HasPrefix(policy.Procedure, "/") {
+			return nil, fmt.Errorf("invalid rate limit procedure '%s', must start with '/' or be '*'", policy.Procedure)
+		}
+		switch policy.Key {
+		case KeyIP, KeyUser, KeyAPIKey:
+		default:
+			return nil, fmt.Errorf("invalid rate limit key '%s', must be 'ip', 'user' or 'apikey'", policy.Key)
+		}
+
+		// Parse rate
+		requests, period, ok := strings.Cut(fields[2], "/")
+		if !ok {
+			return nil, fmt.Errorf("invalid rate limit rate '%s', must be like '5/1m'", fields[2])
+		}
+		var err error
+		policy.Limit.Requests, err = strconv.Atoi(requests)
+		if err != nil || policy.Limit.Requests <= 0 {
+			return nil, fmt.Errorf("invalid rate limit requests '%s'", requests)
+		}
+		policy.Limit.Per, err = time.ParseDuration(period)
+		if err != nil || policy.Limit.Per <= 0 {
+			return nil, fmt.Errorf("invalid rate limit period '%s'", period)
+		}
+
+		// Parse burst
+		policy.Limit.Burst = policy.Limit.Requests
+		if len(fields) == 4 { //nolint:mnd // Burst is optional
+			policy.Limit.Burst, err = strconv.Atoi(fields[3])
+			if err != nil || policy.Limit.Burst <= 0 {
+				return nil, fmt.Errorf("invalid rate limit burst '%s'", fields[3])
+			}
+		}
+
+		policies = append(policies, policy)
+	}
+	if len(policies) == 0 {
+		return nil, errors.New("no rate limit policies")
+	}
+
+	return policies, nil
+}
+
+// Match returns the policy for a procedure, preferring exact procedures over services over all procedures.
+func Match(policies []Policy, procedure string) (Policy, bool) {
+	var match Policy
+	found := false
+	for _, policy := range policies {
+		matches := policy.Procedure == AllProcedures ||
+			policy.Procedure == procedure ||
+			(strings.HasSuffix(policy.Procedure, "/") && strings.HasPrefix(procedure, policy.Procedure))
+		if !matches {
+			continue
+		}
+
+		if !found || specificity(policy) > specificity(match) {
+			match = policy
+			found = true
+		}
+	}
+
+	return match, found
+}
+
+// specificity ranks how narrowly a policy's procedure matches.
+func specificity(policy Policy) int {
+	if policy.Procedure == AllProcedures {
+		return 0
+	}
+
+	return len(policy.Procedure)
+}
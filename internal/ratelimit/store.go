@@ -0,0 +1,189 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/im"
+
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+)
+
+const (
+	BucketIdleTTL       = time.Hour * 1   // Buckets unused this long are removed, they would be full anyway
+	BucketSweepInterval = time.Minute * 1 // How often idle buckets are removed
+)
+
+// Result is the outcome of taking a request from a bucket.
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+
+	// Reset is how long until the bucket is full again.
+	Reset time.Duration
+
+	// RetryAfter is how long until the next request is allowed, zero if it is allowed.
+	RetryAfter time.Duration
+}
+
+// Store keeps the token buckets requests are counted in.
+type Store interface {
+	// Take takes a request from the bucket of a key, refilling it at the limit's rate.
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// take takes a request from a bucket that had tokens at the last update, returning the tokens left.
+func take(tokens float64, updated time.Time, now time.Time, limit Limit) (float64, Result) {
+	rate := limit.Rate()
+	burst := float64(limit.Burst)
+
+	// Refill the bucket
+	tokens = min(burst, tokens+now.Sub(updated).Seconds()*rate)
+
+	res := Result{
+		Allowed: tokens >= 1,
+		Limit:   limit.Burst,
+	}
+	if res.Allowed {
+		tokens--
+	} else {
+		res.RetryAfter = seconds((1 - tokens) / rate)
+	}
+	res.Remaining = int(math.Floor(tokens))
+	res.Reset = seconds((burst - tokens) / rate)
+
+	return tokens, res
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+type memoryBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// MemoryStore keeps buckets in memory, each replica counts requests separately.
+type MemoryStore struct {
+	buckets map[string]memoryBucket
+	swept   time.Time
+	mu      sync.Mutex
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: make(map[string]memoryBucket),
+		swept:   time.Now(),
+		mu:      sync.Mutex{},
+	}
+}
+
+// Take takes a request from a bucket, removing idle buckets every sweep interval.
+func (s *MemoryStore) Take(_ context.Context, key string, limit Limit) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.swept) > BucketSweepInterval {
+		for k, bucket := range s.buckets {
+			if now.Sub(bucket.updated) > BucketIdleTTL {
+				delete(s.buckets, k)
+			}
+		}
+		s.swept = now
+	}
+
+	bucket, ok := s.buckets[key]
+	if !ok {
+		bucket = memoryBucket{
+			tokens:  float64(limit.Burst),
+			updated: now,
+		}
+	}
+
+	tokens, res := take(bucket.tokens, bucket.updated, now, limit)
+	s.buckets[key] = memoryBucket{
+		tokens:  tokens,
+		updated: now,
+	}
+
+	return res, nil
+}
+
+// SQLiteStore keeps buckets in the database, so requests are counted across replicas.
+type SQLiteStore struct {
+	db    *bob.DB
+	swept time.Time
+	mu    sync.Mutex
+}
+
+func NewSQLiteStore(db *bob.DB) *SQLiteStore {
+	return &SQLiteStore{
+		db: db,
+	}
+}
+
+// Take takes a request from a bucket, removing idle buckets every sweep interval.
+func (s *SQLiteStore) Take(ctx context.Context, key string, limit Limit) (Result, error) {
+	now := time.Now()
+
+	// Remove idle buckets
+	s.mu.Lock()
+	sweep := now.Sub(s.swept) > BucketSweepInterval
+	if sweep {
+		s.swept = now
+	}
+	s.mu.Unlock()
+	if sweep {
+		_, err := models.RateLimits.Delete(
+			models.DeleteWhere.RateLimits.UpdatedAt.LT(now.Add(-BucketIdleTTL)),
+		).Exec(ctx, s.db)
+		if err != nil {
+			return Result{}, err
+		}
+	}
+
+	var res Result
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		// Write before reading, so the transaction holds the write lock while the refill is computed.
+		// Concurrent requests wait for it instead of both taking the last token, or failing with SQLITE_BUSY
+		// when upgrading a read lock.
+		_, err := models.RateLimits.Insert(
+			&models.RateLimitSetter{
+				Key:       omit.From(key),
+				Tokens:    omit.From(float32(limit.Burst)),
+				UpdatedAt: omit.From(now),
+			},
+			im.OnConflict("key").DoNothing(),
+		).Exec(ctx, exec)
+		if err != nil {
+			return err
+		}
+
+		// Get bucket
+		bucket, err := models.FindRateLimit(ctx, exec, key)
+		if err != nil {
+			return err
+		}
+
+		var tokens float64
+		tokens, res = take(float64(bucket.Tokens), bucket.UpdatedAt, now, limit)
+
+		// Save bucket
+		return bucket.Update(ctx, exec, &models.RateLimitSetter{
+			Tokens:    omit.From(float32(tokens)),
+			UpdatedAt: omit.From(now),
+		})
+	})
+	if err != nil {
+		return Result{}, err
+	}
+
+	return res, nil
+}
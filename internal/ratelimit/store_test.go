@@ -0,0 +1,173 @@
+package ratelimit_test
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spotdemo4/ts-server/internal/database"
+	"github.com/spotdemo4/ts-server/internal/ratelimit"
+)
+
+func TestTake(t *testing.T) {
+	t.Parallel()
+
+	// Two tokens a second, up to five
+	limit := ratelimit.Limit{Requests: 20, Per: time.Second * 10, Burst: 5}
+	updated := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		tokens  float64
+		elapsed time.Duration
+		left    float64
+		want    ratelimit.Result
+	}{
+		{
+			name:   "full",
+			tokens: 5,
+			left:   4,
+			want:   ratelimit.Result{Allowed: true, Limit: 5, Remaining: 4, Reset: time.Second / 2},
+		},
+		{
+			name:    "refill is capped at the burst",
+			tokens:  0,
+			elapsed: time.Hour,
+			left:    4,
+			want:    ratelimit.Result{Allowed: true, Limit: 5, Remaining: 4, Reset: time.Second / 2},
+		},
+		{
+			name:    "refilled to one token",
+			tokens:  0,
+			elapsed: time.Second / 2,
+			left:    0,
+			want:    ratelimit.Result{Allowed: true, Limit: 5, Remaining: 0, Reset: time.Millisecond * 2500},
+		},
+		{
+			name:    "partly refilled",
+			tokens:  0.5,
+			elapsed: time.Second / 8,
+			left:    0.75,
+			want: ratelimit.Result{
+				Allowed:    false,
+				Limit:      5,
+				Remaining:  0,
+				Reset:      time.Millisecond * 2125,
+				RetryAfter: time.Millisecond * 125,
+			},
+		},
+		{
+			name:   "empty",
+			tokens: 0,
+			left:   0,
+			want: ratelimit.Result{
+				Allowed:    false,
+				Limit:      5,
+				Remaining:  0,
+				Reset:      time.Millisecond * 2500,
+				RetryAfter: time.Second / 2,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			left, res := ratelimit.Take(tt.tokens, updated, updated.Add(tt.elapsed), limit)
+			if left != tt.left || res != tt.want {
+				t.Errorf("take = %v, %+v, want %v, %+v", left, res, tt.left, tt.want)
+			}
+		})
+	}
+}
+
+func newSQLiteStore(t *testing.T) *ratelimit.SQLiteStore {
+	t.Helper()
+
+	db, err := database.New("sqlite:" + filepath.Join(t.TempDir(), "ratelimit.db"))
+	if err != nil {
+		t.Fatalf("Error opening database: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	schema, err := os.ReadFile("../../db/schema.sql")
+	if err != nil {
+		t.Fatalf("Error reading schema: %v", err)
+	}
+	_, err = db.ExecContext(t.Context(), string(schema))
+	if err != nil {
+		t.Fatalf("Error creating schema: %v", err)
+	}
+
+	return ratelimit.NewSQLiteStore(db)
+}
+
+// TestStores takes concurrent requests from each store, only the burst may be allowed.
+func TestStores(t *testing.T) {
+	t.Parallel()
+
+	// Refills too slowly to matter
+	limit := ratelimit.Limit{Requests: 1, Per: time.Hour, Burst: 5}
+
+	tests := []struct {
+		name  string
+		store func(t *testing.T) ratelimit.Store
+	}{
+		{
+			name:  "memory",
+			store: func(*testing.T) ratelimit.Store { return ratelimit.NewMemoryStore() },
+		},
+		{
+			name:  "sqlite",
+			store: func(t *testing.T) ratelimit.Store { return newSQLiteStore(t) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := tt.store(t)
+
+			var (
+				wg      sync.WaitGroup
+				mu      sync.Mutex
+				allowed int
+			)
+			for range 20 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+
+					res, err := store.Take(t.Context(), "a", limit)
+					if err != nil {
+						t.Errorf("Error taking request: %v", err)
+						return
+					}
+					if res.Allowed {
+						mu.Lock()
+						allowed++
+						mu.Unlock()
+					} else if res.RetryAfter <= 0 {
+						t.Errorf("Take denied a request with RetryAfter %s", res.RetryAfter)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if allowed != limit.Burst {
+				t.Errorf("Take allowed %d requests, want %d", allowed, limit.Burst)
+			}
+
+			// Other keys have their own bucket
+			res, err := store.Take(t.Context(), "b", limit)
+			if err != nil {
+				t.Fatalf("Error taking request: %v", err)
+			}
+			if !res.Allowed || res.Remaining != limit.Burst-1 {
+				t.Errorf("Take returned %+v for another key, want an allowed request", res)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/scan"
+
+	"github.com/spotdemo4/ts-server/internal/bob/models"
+)
+
+const (
+	highlightStart = "\ue000" // Private use characters that mark matches in highlights
+	highlightEnd   = "\ue001"
+
+	SnippetTokens = 16 // Most words in a description snippet
+)
+
+var ErrEmptyQuery = errors.New("search query is empty")
+
+// Hit is an item that matched a search.
+type Hit struct {
+	models.Item
+
+	// Highlighted name and snippet of the description, with matches between markers
+	NameHighlight      string `db:"name_highlight"`
+	DescriptionSnippet string `db:"description_snippet"`
+
+	// Score is how well the item matched, higher is better
+	Score float64 `db:"score"`
+}
+
+// Fragment is part of a highlighted text.
+type Fragment struct {
+	Text        string
+	Highlighted bool
+}
+
+// Items searches a user's items by name and description, best matches first.
+// Every word of the query must match the start of a word in the item.
+func Items(
+	ctx context.Context,
+	exec bob.Executor,
+	userid int32,
+	text string,
+	limit int,
+	offset int,
+) ([]Hit, int64, error) {
+	query, ok := Query(text)
+	if !ok {
+		return nil, 0, ErrEmptyQuery
+	}
+
+	where := []bob.Mod[*dialect.SelectQuery]{
+		sm.From(models.ItemSearches.Name()),
+		sm.InnerJoin(models.Items.Name()).On(
+			models.Items.Columns.ID.EQ(sqlite.Quote("item_search", "rowid")),
+		),
+		sm.Where(models.ItemSearches.Columns.ItemSearch.OP("MATCH", sqlite.Arg(query))),
+		models.SelectWhere.Items.UserID.EQ(userid),
+	}
+
+	// Count
+	count, err := bob.One(ctx, exec, sqlite.Select(
+		append(where, sm.Columns(sqlite.F("COUNT", sqlite.Raw("*"))))...,
+	), scan.SingleColumnMapper[int64])
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Hits, ranked with the weights set in the migration
+	hits, err := bob.All(ctx, exec, sqlite.Select(
+		append(
+			where,
+			sm.Columns(
+				models.Items.Columns,
+				sqlite.Raw("highlight(item_search, 0, ?, ?)", highlightStart, highlightEnd).As("name_highlight"),
+				sqlite.Raw(
+					"snippet(item_search, 1, ?, ?, '…', ?)", highlightStart, highlightEnd, SnippetTokens,
+				).As("description_snippet"),
+				sqlite.Raw("-rank").As("score"),
+			),
+			sm.OrderBy(models.ItemSearches.Columns.Rank),
+			sm.OrderBy(models.Items.Columns.ID),
+			sm.Limit(limit),
+			sm.Offset(offset),
+		)...,
+	), scan.StructMapper[Hit]())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return hits, count, nil
+}
+
+// Query turns search text into an FTS5 query that prefix matches every word.
+// Words are quoted, so the text can not use FTS5 syntax.
+func Query(text string) (string, bool) {
+	terms := []string{}
+	for _, word := range strings.Fields(text) {
+		word = strings.NewReplacer(highlightStart, "", highlightEnd, "").Replace(word)
+		if word == "" {
+			continue
+		}
+		terms = append(terms, `"`+strings.ReplaceAll(word, `"`, `""`)+`"*`)
+	}
+
+	return strings.Join(terms, " "), len(terms) > 0
+}
+
+// Fragments splits a highlight into its matched and unmatched parts.
+func Fragments(highlight string) []Fragment {
+	fragments := []Fragment{}
+	for highlight != "" {
+		start := strings.Index(highlight, highlightStart)
+		if start == -1 {
+			fragments = append(fragments, Fragment{Text: highlight})
+			break
+		}
+		if start > 0 {
+			fragments = append(fragments, Fragment{Text: highlight[:start]})
+		}
+		highlight = highlight[start+len(highlightStart):]
+
+		end := strings.Index(highlight, highlightEnd)
+		if end == -1 {
+			end = len(highlight)
+		}
+		fragments = append(fragments, Fragment{Text: highlight[:end], Highlighted: true})
+		highlight = strings.TrimPrefix(highlight[end:], highlightEnd)
+	}
+
+	return fragments
+}
+
+// Rebuild rebuilds the search index from the items, for databases where it got out of sync.
+func Rebuild(ctx context.Context, exec bob.Executor) error {
+	_, err := exec.ExecContext(ctx, "INSERT INTO item_search (item_search) VALUES ('rebuild')")
+	return err
+}
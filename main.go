@@ -19,14 +19,22 @@ import (
 	"golang.org/x/net/http2/h2c"
 
 	"github.com/spotdemo4/ts-server/internal/app"
+	adminv1 "github.com/spotdemo4/ts-server/internal/handlers/admin/v1"
 	"github.com/spotdemo4/ts-server/internal/handlers/client"
+	"github.com/spotdemo4/ts-server/internal/handlers/export"
 	"github.com/spotdemo4/ts-server/internal/handlers/file"
 	itemv1 "github.com/spotdemo4/ts-server/internal/handlers/item/v1"
+	"github.com/spotdemo4/ts-server/internal/handlers/oauth"
+	"github.com/spotdemo4/ts-server/internal/handlers/oidc"
 	userv1 "github.com/spotdemo4/ts-server/internal/handlers/user/v1"
 	"github.com/spotdemo4/ts-server/internal/interceptors"
+	"github.com/spotdemo4/ts-server/internal/search"
 )
 
-const Timeout = 10 * time.Second
+const (
+	Timeout        = 10 * time.Second
+	CommandTimeout = 10 * time.Minute
+)
 
 //nolint:gochecknoglobals // Embed the web client
 var clientFS embed.FS
@@ -43,33 +51,61 @@ func main() {
 		log.Fatalf("failed to create app: %s", err.Error())
 	}
 
+	// Run a maintenance command instead of the server
+	if len(os.Args) > 1 {
+		err = command(base, os.Args[1])
+		if err != nil {
+			log.Fatalf("failed to run %s: %s", os.Args[1], err.Error())
+		}
+		return
+	}
+
+	// Fail exports left pending by servers that stopped while running them
+	err = base.Export.FailStale(context.Background())
+	if err != nil {
+		base.Log.Error("failed to fail stale account exports", "error", err)
+		return
+	}
+
 	// Create interceptors
 	li := interceptors.NewLoggingInterceptor(base.Log) // Logging interceptor for request logging
 	ai := interceptors.NewAuthInterceptor(base.Auth)   // Auth interceptor for user authentication
-	ri := interceptors.NewRateLimitInterceptor()       // Rate limit interceptor for protecting endpoints
 	vi, err := validate.NewInterceptor()               // Validator interceptor for validating requests
 	if err != nil {
 		base.Log.Error("failed to create validator interceptor", "error", err)
 		return
 	}
 
+	// Rate limit interceptor for protecting endpoints, by the policy of each procedure
+	ri := interceptors.NewRateLimitInterceptor(base.Env.RateLimits, base.RateLimits, base.Auth, base.Log)
+
 	// Serve gRPC Handlers
 	api := http.NewServeMux()
-	api.Handle(interceptors.WithCORS(userv1.New(base, connect.WithInterceptors(li, vi, ai))))     // User handler
-	api.Handle(interceptors.WithCORS(userv1.NewAuth(base, connect.WithInterceptors(li, vi, ri)))) // User auth handler
-	api.Handle(interceptors.WithCORS(itemv1.New(base, connect.WithInterceptors(li, vi, ai))))     // Item handler
+	api.Handle(interceptors.WithCORS(userv1.New(base, connect.WithInterceptors(li, ai, ri, vi))))  // User handler
+	api.Handle(interceptors.WithCORS(userv1.NewAuth(base, connect.WithInterceptors(li, ri, vi))))  // User auth handler
+	api.Handle(interceptors.WithCORS(itemv1.New(base, connect.WithInterceptors(li, ai, ri, vi))))  // Item handler
+	api.Handle(interceptors.WithCORS(adminv1.New(base, connect.WithInterceptors(li, ai, ri, vi)))) // Admin handler
 
 	// Serve web interface
+	provider := oauth.New(base)
 	mux := http.NewServeMux()
 	mux.Handle("/", client.New(base, clientFS))          // Web client handler
 	mux.Handle("/file/", file.New(base))                 // File handler for serving files
+	mux.Handle("/export/", export.New(base))             // Account export download handler
+	mux.Handle("/items/", itemv1.NewDownload(base))      // Item export download handler
+	mux.Handle("/auth/oidc/", oidc.New(base))            // OIDC sign in handler
+	mux.Handle("/oauth2/", provider)                     // OAuth2 provider handler
+	mux.Handle("/.well-known/", provider)                // OpenID Connect discovery and JWKS handler
 	mux.Handle("/grpc/", http.StripPrefix("/grpc", api)) // gRPC API handler
 
+	// Resolve the client behind trusted proxies, then record it for audit events
+	handler := interceptors.WithClientIP(interceptors.WithAuditContext(mux), base.Env.TrustedProxies)
+
 	// Start server
 	base.Log.Info("Starting server", "port", base.Env.Port)
 	server := &http.Server{
 		Addr:              fmt.Sprintf(":%s", base.Env.Port),
-		Handler:           h2c.NewHandler(mux, &http2.Server{}),
+		Handler:           h2c.NewHandler(handler, &http2.Server{}),
 		ReadHeaderTimeout: Timeout,
 	}
 
@@ -103,3 +139,25 @@ func main() {
 		}
 	}
 }
+
+// command runs a maintenance command against the database.
+func command(base *app.App, name string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), CommandTimeout)
+	defer cancel()
+	defer base.DB.Close() //nolint:errcheck // Exiting after the command
+
+	switch name {
+	case "rebuild-search":
+		// Reindex every item, for databases where the search index got out of sync
+		err := search.Rebuild(ctx, base.DB)
+		if err != nil {
+			return err
+		}
+		base.Log.Info("Rebuilt search index")
+
+	default:
+		return fmt.Errorf("unknown command %q, expected rebuild-search", name)
+	}
+
+	return nil
+}